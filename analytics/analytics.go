@@ -0,0 +1,287 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/analytics/analytics.go
+
+package analytics
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/playerrole"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Statistics aggregated from the stored matches, for map design and balance.
+// Only matches with a map and a decisive result are counted, and spawns are
+// only counted for matches that have been indexed.
+type Report struct {
+	Matchups     []Matchup     `json:"matchups"`
+	FirstPlayer  []FirstPlayer `json:"first_player"`
+	GameLengths  []GameLength  `json:"game_lengths"`
+	SpawnsByTurn []SpawnCount  `json:"spawns_by_turn"`
+}
+
+// The win rate of a race against another race on a map.  Only solo matches
+// where both races are known are counted.  Each match is counted once from
+// each player's perspective, so mirror matchups are counted twice and always
+// have an even win rate.
+type Matchup struct {
+	Map      string                `json:"map"`
+	Race     witsjson.UnitRaceJSON `json:"race"`
+	Opponent witsjson.UnitRaceJSON `json:"opponent"`
+	Games    int                   `json:"games"`
+	Wins     int                   `json:"wins"`
+	WinRate  float64               `json:"win_rate"`
+}
+
+// How often the side that takes the first turn wins, on a map.
+type FirstPlayer struct {
+	Map     string  `json:"map"`
+	Games   int     `json:"games"`
+	Wins    int     `json:"wins"`
+	WinRate float64 `json:"win_rate"`
+}
+
+// The average number of turns in a match, on a map.
+type GameLength struct {
+	Map          string  `json:"map"`
+	Games        int     `json:"games"`
+	AverageTurns float64 `json:"average_turns"`
+	MinTurns     int     `json:"min_turns"`
+	MaxTurns     int     `json:"max_turns"`
+}
+
+// The number of units of a class spawned on a turn, and their share of all
+// units spawned on that turn.
+type SpawnCount struct {
+	Turn      int                    `json:"turn"`
+	Class     witsjson.UnitClassJSON `json:"class"`
+	Count     int                    `json:"count"`
+	Frequency float64                `json:"frequency"`
+}
+
+// Computes every statistic in the report.
+func Compute(ctx context.Context, client *ent.Client) (*Report, error) {
+	matches, err := decidedMatches(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	spawns, err := SpawnFrequencies(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return &Report{
+		Matchups:     matchups(matches),
+		FirstPlayer:  firstPlayerAdvantage(matches),
+		GameLengths:  gameLengths(matches),
+		SpawnsByTurn: spawns,
+	}, nil
+}
+
+// An indexed match with a map, its roles in turn order.  Matches that have not
+// finished ingesting (or could not) may have roles without all of their turns.
+type played struct {
+	mapName   string
+	turnCount int
+	roles     []*ent.PlayerRole
+}
+
+func decidedMatches(ctx context.Context, client *ent.Client) ([]played, error) {
+	matches, err := client.Match.Query().
+		Where(match.HasMap(), match.FetchStatusEQ(match.FetchStatusINDEXED)).
+		WithMap().
+		WithRoles(func(query *ent.PlayerRoleQuery) {
+			query.Order(playerrole.ByTurnOrder())
+		}).
+		Order(match.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]played, 0, len(matches))
+	for _, recorded := range matches {
+		if len(recorded.Edges.Roles) < 2 || !decided(recorded.Edges.Roles) {
+			continue
+		}
+		results = append(results, played{
+			recorded.Edges.Map.Shortname, recorded.TurnCount, recorded.Edges.Roles})
+	}
+	return results, nil
+}
+
+// True if every role has a victory or loss result.
+func decided(roles []*ent.PlayerRole) bool {
+	for _, role := range roles {
+		if !won(role.Result) && !lost(role.Result) {
+			return false
+		}
+	}
+	return true
+}
+
+func won(result wits.TerminalStatus) bool {
+	return result == wits.VICTORY_DESTRUCTION ||
+		result == wits.VICTORY_EXTINCTION ||
+		result == wits.VICTORY_RESIGNATION
+}
+
+func lost(result wits.TerminalStatus) bool {
+	return result == wits.LOSS_DESTRUCTION ||
+		result == wits.LOSS_EXTINCTION ||
+		result == wits.LOSS_RESIGNATION
+}
+
+func matchups(matches []played) []Matchup {
+	type key struct {
+		mapName        string
+		race, opponent wits.UnitRaceEnum
+	}
+	counts := make(map[key]*Matchup)
+	for _, match := range matches {
+		if len(match.roles) != 2 ||
+			match.roles[0].Race == wits.RACE_UNKNOWN ||
+			match.roles[1].Race == wits.RACE_UNKNOWN {
+			continue
+		}
+		for i, role := range match.roles {
+			opponent := match.roles[1-i]
+			k := key{match.mapName, role.Race, opponent.Race}
+			if counts[k] == nil {
+				counts[k] = &Matchup{
+					Map:      match.mapName,
+					Race:     witsjson.UnitRaceJSON(role.Race),
+					Opponent: witsjson.UnitRaceJSON(opponent.Race),
+				}
+			}
+			counts[k].Games += 1
+			if won(role.Result) {
+				counts[k].Wins += 1
+			}
+		}
+	}
+	matchups := make([]Matchup, 0, len(counts))
+	for _, matchup := range counts {
+		matchup.WinRate = rate(matchup.Wins, matchup.Games)
+		matchups = append(matchups, *matchup)
+	}
+	sort.Slice(matchups, func(i, j int) bool {
+		a, b := matchups[i], matchups[j]
+		if a.Map != b.Map {
+			return a.Map < b.Map
+		}
+		if a.Race != b.Race {
+			return a.Race < b.Race
+		}
+		return a.Opponent < b.Opponent
+	})
+	return matchups
+}
+
+func firstPlayerAdvantage(matches []played) []FirstPlayer {
+	counts := make(map[string]*FirstPlayer)
+	for _, match := range matches {
+		if counts[match.mapName] == nil {
+			counts[match.mapName] = &FirstPlayer{Map: match.mapName}
+		}
+		counts[match.mapName].Games += 1
+		// Roles are in turn order, and allies share the same result.
+		if won(match.roles[0].Result) {
+			counts[match.mapName].Wins += 1
+		}
+	}
+	advantages := make([]FirstPlayer, 0, len(counts))
+	for _, advantage := range counts {
+		advantage.WinRate = rate(advantage.Wins, advantage.Games)
+		advantages = append(advantages, *advantage)
+	}
+	sort.Slice(advantages, func(i, j int) bool {
+		return advantages[i].Map < advantages[j].Map
+	})
+	return advantages
+}
+
+func gameLengths(matches []played) []GameLength {
+	counts := make(map[string]*GameLength)
+	totals := make(map[string]int)
+	for _, match := range matches {
+		length := counts[match.mapName]
+		if length == nil {
+			length = &GameLength{Map: match.mapName, MinTurns: match.turnCount}
+			counts[match.mapName] = length
+		}
+		length.Games += 1
+		length.MinTurns = min(length.MinTurns, match.turnCount)
+		length.MaxTurns = max(length.MaxTurns, match.turnCount)
+		totals[match.mapName] += match.turnCount
+	}
+	lengths := make([]GameLength, 0, len(counts))
+	for name, length := range counts {
+		length.AverageTurns = rate(totals[name], length.Games)
+		lengths = append(lengths, *length)
+	}
+	sort.Slice(lengths, func(i, j int) bool {
+		return lengths[i].Map < lengths[j].Map
+	})
+	return lengths
+}
+
+// Counts the spawn actions of indexed matches by their turn and unit class.
+func SpawnFrequencies(ctx context.Context, client *ent.Client) ([]SpawnCount, error) {
+	var counts []struct {
+		Turn  int `json:"turn_number"`
+		Class int `json:"class"`
+		Count int `json:"count"`
+	}
+	err := client.Action.Query().
+		Where(action.NameEQ(action.NameSpawnUnit)).
+		GroupBy(action.FieldTurnNumber, action.FieldClass).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	totals := make(map[int]int)
+	for _, count := range counts {
+		totals[count.Turn] += count.Count
+	}
+	spawns := make([]SpawnCount, len(counts))
+	for i, count := range counts {
+		spawns[i] = SpawnCount{
+			Turn:      count.Turn,
+			Class:     witsjson.UnitClassJSON(count.Class),
+			Count:     count.Count,
+			Frequency: rate(count.Count, totals[count.Turn]),
+		}
+	}
+	sort.Slice(spawns, func(i, j int) bool {
+		if spawns[i].Turn != spawns[j].Turn {
+			return spawns[i].Turn < spawns[j].Turn
+		}
+		return spawns[i].Class < spawns[j].Class
+	})
+	return spawns, nil
+}
+
+func rate(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
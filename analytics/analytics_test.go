@@ -0,0 +1,167 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/analytics/analytics_test.go
+
+package analytics_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/analytics"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/ent/enttest"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/witsjson"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestCompute(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	peekaboo := client.OsnMap.Create().
+		SetName("Peek-a-Boo").
+		SetShortname("peekaboo").
+		SetRoleCount(2).
+		SaveX(ctx)
+	alice := client.Player.Create().SetName("alice").SaveX(ctx)
+	bob := client.Player.Create().SetName("bob").SaveX(ctx)
+
+	count, status := 0, match.FetchStatusINDEXED
+	record := func(turns int, first, second wits.UnitRaceEnum, result wits.TerminalStatus) *ent.Match {
+		count += 1
+		recorded := client.Match.Create().
+			SetMatchHash(string(rune('a' + count))).
+			SetVersion(1603).
+			SetTurnCount(turns).
+			SetFetchStatus(status).
+			SetMap(peekaboo).
+			SaveX(ctx)
+		for i, player := range []*ent.Player{alice, bob} {
+			race, outcome := first, result
+			if i == 1 {
+				race, outcome = second, result.Opposing()
+			}
+			client.PlayerRole.Create().
+				SetMatchID(recorded.ID).
+				SetPlayerID(player.ID).
+				SetPosition(i + 1).
+				SetTurnOrder(i + 1).
+				SetRace(race).
+				SetResult(outcome).
+				AddMatch(recorded).
+				AddPlayers(player).
+				ExecX(ctx)
+		}
+		return recorded
+	}
+	first := record(10, wits.RACE_FEEDBACK, wits.RACE_SCALLYWAGS, wits.VICTORY_DESTRUCTION)
+	record(20, wits.RACE_FEEDBACK, wits.RACE_SCALLYWAGS, wits.VICTORY_RESIGNATION)
+	record(30, wits.RACE_SCALLYWAGS, wits.RACE_FEEDBACK, wits.LOSS_EXTINCTION)
+	record(99, wits.RACE_FEEDBACK, wits.RACE_SCALLYWAGS, wits.DELAY_OF_GAME)
+
+	// Matches that have not been (or cannot be) indexed are not counted, even
+	// when their roles and results are stored.
+	for _, status = range []match.FetchStatus{
+		match.FetchStatusCONVERTED,
+		match.FetchStatusVALIDATED,
+		match.FetchStatusINVALID,
+		match.FetchStatusLEGACY,
+	} {
+		record(0, wits.RACE_SCALLYWAGS, wits.RACE_FEEDBACK, wits.VICTORY_DESTRUCTION)
+	}
+
+	for number, classes := range [][]wits.UnitClassEnum{
+		{wits.CLASS_RUNNER, wits.CLASS_RUNNER, wits.CLASS_SOLDIER},
+		{wits.CLASS_HEAVY},
+	} {
+		turn := client.Turn.Create().
+			SetNumber(number + 1).
+			SetTeam(wits.FR_SELF).
+			SetMatch(first).
+			SaveX(ctx)
+		for i, class := range classes {
+			client.Action.Create().
+				SetSequence(i).
+				SetName(action.NameSpawnUnit).
+				SetClass(class).
+				SetRace(wits.RACE_FEEDBACK).
+				SetTurnNumber(number + 1).
+				SetAgentI(5).
+				SetAgentJ(8).
+				SetTurn(turn).
+				SetMap(peekaboo).
+				ExecX(ctx)
+		}
+	}
+
+	report, err := analytics.Compute(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Matchups) != 2 {
+		t.Fatalf("expected both sides of one matchup, got %v", report.Matchups)
+	}
+	feedback := report.Matchups[0]
+	if feedback.Race != witsjson.UnitRaceJSON(wits.RACE_FEEDBACK) || feedback.Games != 3 || feedback.Wins != 3 {
+		t.Errorf("unexpected feedback matchup %v", feedback)
+	}
+	if advantage := report.FirstPlayer[0]; advantage.Games != 3 || advantage.Wins != 2 {
+		t.Errorf("unexpected first player advantage %v", advantage)
+	}
+	if length := report.GameLengths[0]; length.AverageTurns != 20 || length.MinTurns != 10 || length.MaxTurns != 30 {
+		t.Errorf("unexpected game length %v", length)
+	}
+	if len(report.SpawnsByTurn) != 3 {
+		t.Fatalf("expected 3 spawn counts, got %v", report.SpawnsByTurn)
+	}
+	if runners := report.SpawnsByTurn[0]; runners.Turn != 1 || runners.Count != 2 ||
+		runners.Frequency < 0.66 || runners.Frequency > 0.67 {
+		t.Errorf("unexpected runner spawns %v", runners)
+	}
+
+	var encoded bytes.Buffer
+	if err := report.WriteJSON(&encoded, ""); err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string][]map[string]any
+	if err := json.Unmarshal(encoded.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded["matchups"][0]["race"] != "FEEDBACK" || decoded["spawns_by_turn"][2]["class"] != "HEAVY" {
+		t.Errorf("unexpected JSON report %s", encoded.String())
+	}
+
+	for _, section := range analytics.SECTIONS {
+		encoded.Reset()
+		if err := report.WriteCSV(&encoded, section); err != nil {
+			t.Fatal(err)
+		}
+		rows, err := csv.NewReader(&encoded).ReadAll()
+		if err != nil || len(rows) < 2 {
+			t.Errorf("expected a header and rows for %s, got %v %v", section, rows, err)
+		}
+	}
+	if err := report.WriteCSV(&encoded, "everything"); err == nil {
+		t.Error("expected an error for an unknown section")
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/analytics/output.go
+
+package analytics
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/kevindamm/wits-go"
+)
+
+// The sections of a report, by the name used when selecting one for output.
+const (
+	SECTION_MATCHUPS = "matchups"
+	SECTION_FIRST    = "first_player"
+	SECTION_LENGTHS  = "game_lengths"
+	SECTION_SPAWNS   = "spawns_by_turn"
+)
+
+var SECTIONS = []string{SECTION_MATCHUPS, SECTION_FIRST, SECTION_LENGTHS, SECTION_SPAWNS}
+
+// Writes one section of the report as an indented JSON array, or the whole
+// report as an object if the section is empty.
+func (report *Report) WriteJSON(w io.Writer, section string) error {
+	var value any = report
+	switch section {
+	case "":
+	case SECTION_MATCHUPS:
+		value = report.Matchups
+	case SECTION_FIRST:
+		value = report.FirstPlayer
+	case SECTION_LENGTHS:
+		value = report.GameLengths
+	case SECTION_SPAWNS:
+		value = report.SpawnsByTurn
+	default:
+		return fmt.Errorf("unknown report section %q", section)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// Writes one section of the report as CSV, with a header row.
+func (report *Report) WriteCSV(w io.Writer, section string) error {
+	records, err := report.Records(section)
+	if err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(records); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// The rows of one section of the report, beginning with the column names.
+func (report *Report) Records(section string) ([][]string, error) {
+	var records [][]string
+	switch section {
+	case SECTION_MATCHUPS:
+		records = append(records, []string{"map", "race", "opponent", "games", "wins", "win_rate"})
+		for _, matchup := range report.Matchups {
+			records = append(records, []string{
+				matchup.Map,
+				wits.UnitRaceEnum(matchup.Race).String(),
+				wits.UnitRaceEnum(matchup.Opponent).String(),
+				strconv.Itoa(matchup.Games),
+				strconv.Itoa(matchup.Wins),
+				ratio(matchup.WinRate),
+			})
+		}
+	case SECTION_FIRST:
+		records = append(records, []string{"map", "games", "wins", "win_rate"})
+		for _, advantage := range report.FirstPlayer {
+			records = append(records, []string{
+				advantage.Map,
+				strconv.Itoa(advantage.Games),
+				strconv.Itoa(advantage.Wins),
+				ratio(advantage.WinRate),
+			})
+		}
+	case SECTION_LENGTHS:
+		records = append(records, []string{"map", "games", "average_turns", "min_turns", "max_turns"})
+		for _, length := range report.GameLengths {
+			records = append(records, []string{
+				length.Map,
+				strconv.Itoa(length.Games),
+				ratio(length.AverageTurns),
+				strconv.Itoa(length.MinTurns),
+				strconv.Itoa(length.MaxTurns),
+			})
+		}
+	case SECTION_SPAWNS:
+		records = append(records, []string{"turn", "class", "count", "frequency"})
+		for _, spawn := range report.SpawnsByTurn {
+			records = append(records, []string{
+				strconv.Itoa(spawn.Turn),
+				wits.UnitClassEnum(spawn.Class).String(),
+				strconv.Itoa(spawn.Count),
+				ratio(spawn.Frequency),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unknown report section %q", section)
+	}
+	return records, nil
+}
+
+func ratio(value float64) string {
+	return strconv.FormatFloat(value, 'f', 4, 64)
+}
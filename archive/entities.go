@@ -0,0 +1,63 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/archive/entities.go
+
+package archive
+
+import (
+	"context"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/osnmap"
+	"github.com/kevindamm/wits-go/ent/player"
+)
+
+// Finds the map entity by its short name, creating it if it has not been
+// stored yet.
+func FindOrCreateMap(ctx context.Context, client *ent.Client,
+	shortname string, name wits.GameMapName, roleCount int) (*ent.OsnMap, error) {
+	entity, err := client.OsnMap.Query().
+		Where(osnmap.ShortnameEQ(shortname)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return client.OsnMap.Create().
+			SetName(string(name)).
+			SetShortname(shortname).
+			SetRoleCount(roleCount).
+			Save(ctx)
+	}
+	return entity, err
+}
+
+// Finds the player entity by name, creating it if it has not been stored yet.
+// The GCID is set if it is given and the player does not already have one.
+func FindOrCreatePlayer(ctx context.Context, client *ent.Client,
+	name wits.PlayerName, gcid wits.GCID) (*ent.Player, error) {
+	entity, err := client.Player.Query().
+		Where(player.NameEQ(string(name))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		create := client.Player.Create().SetName(string(name))
+		if len(gcid) > 0 {
+			create.SetGcid(string(gcid))
+		}
+		return create.Save(ctx)
+	}
+	if err == nil && entity.Gcid == nil && len(gcid) > 0 {
+		return entity.Update().SetGcid(string(gcid)).Save(ctx)
+	}
+	return entity, err
+}
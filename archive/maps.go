@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/archive/maps.go
+
+package archive
+
+import (
+	"context"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/osnmap"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The outcome of storing a map definition.
+type MapSync struct {
+	Map     *ent.OsnMap
+	Created bool
+
+	// The definition differs from the one stored for the map before.  Matches
+	// counts the matches that were played on another definition of the map
+	// (those with a different Match.map_hash, or none recorded).
+	Changed bool
+	Matches int
+}
+
+// Stores the map definition, along with its metadata and thumbnail (see
+// render.Thumbnail), creating the map entity (by its short name) if it has not
+// been stored yet.
+func SyncMap(ctx context.Context, client *ent.Client,
+	definition *witsjson.MapDefinition, thumbnail string) (MapSync, error) {
+	shortname := witsjson.ShortName(wits.GameMapID(definition.MapID))
+	hash := definition.ContentHash()
+
+	entity, err := client.OsnMap.Query().
+		Where(osnmap.ShortnameEQ(shortname)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		create := client.OsnMap.Create().SetShortname(shortname)
+		setMapMetadata(create.Mutation(), definition, hash, thumbnail)
+		entity, err = create.Save(ctx)
+		return MapSync{Map: entity, Created: true}, err
+	}
+	if err != nil {
+		return MapSync{}, err
+	}
+
+	var sync MapSync
+	if len(entity.ContentHash) > 0 && entity.ContentHash != hash {
+		sync.Changed = true
+		sync.Matches, err = entity.QueryMatches().
+			Where(match.Or(match.MapHashIsNil(), match.MapHashNEQ(hash))).
+			Count(ctx)
+		if err != nil {
+			return MapSync{}, err
+		}
+	}
+	update := entity.Update()
+	setMapMetadata(update.Mutation(), definition, hash, thumbnail)
+	sync.Map, err = update.Save(ctx)
+	return sync, err
+}
+
+func setMapMetadata(mutation *ent.OsnMapMutation,
+	definition *witsjson.MapDefinition, hash, thumbnail string) {
+	terrain := definition.Terrain
+	mutation.SetName(definition.Name)
+	mutation.SetRoleCount(len(terrain.Base()))
+	mutation.SetMapID(definition.MapID)
+	mutation.SetContentHash(hash)
+	mutation.SetFloorCount(len(terrain.Floor()))
+	mutation.SetWallCount(len(terrain.Wall()))
+	mutation.SetBonusCount(len(terrain.Bonus()))
+	mutation.SetSpawnCount(len(terrain.Spawn()))
+	mutation.SetSymmetry(symmetryOf(definition))
+	mutation.SetLegacy(definition.Legacy != nil && *definition.Legacy)
+	mutation.SetThumbnailSvg(thumbnail)
+	mutation.SetDefinition(definition)
+}
+
+func symmetryOf(definition *witsjson.MapDefinition) osnmap.Symmetry {
+	if definition.Rotate != nil {
+		return osnmap.SymmetryROTATE
+	}
+	if definition.Mirror != nil {
+		switch definition.Mirror.Flip {
+		case witsjson.FLIP_VERTICAL:
+			return osnmap.SymmetryVERTICAL
+		case witsjson.FLIP_HORIZONTAL:
+			return osnmap.SymmetryHORIZONTAL
+		}
+	}
+	return osnmap.SymmetryNONE
+}
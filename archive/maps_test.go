@@ -0,0 +1,112 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/archive/maps_test.go
+
+package archive_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kevindamm/wits-go/archive"
+	"github.com/kevindamm/wits-go/ent/enttest"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/osnmap"
+	"github.com/kevindamm/wits-go/render"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSyncMap(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	gamemap, err := witsjson.ReadMapFile("../maps/solo/peekaboo.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	definition := gamemap.Definition()
+	thumbnail, err := render.Thumbnail(gamemap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := archive.SyncMap(ctx, client, definition, thumbnail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entity := created.Map
+	if !created.Created || created.Changed {
+		t.Errorf("first sync should create the map, got %+v", created)
+	}
+	if entity.Shortname != "peekaboo" || entity.MapID != "oml/solo/peekaboo" ||
+		entity.Name != "Peek-a-Boo" || entity.RoleCount != 2 {
+		t.Errorf("unexpected map entity %v", entity)
+	}
+	if entity.FloorCount != len(definition.Terrain.Floor()) ||
+		entity.SpawnCount != 2 || entity.BonusCount != len(definition.Terrain.Bonus()) {
+		t.Errorf("unexpected tile counts %v", entity)
+	}
+	if entity.Symmetry != osnmap.SymmetryROTATE || !entity.Legacy {
+		t.Errorf("unexpected symmetry %s or legacy %t", entity.Symmetry, entity.Legacy)
+	}
+	if !strings.HasPrefix(entity.ThumbnailSvg, "<svg") {
+		t.Errorf("unexpected thumbnail %.40s", entity.ThumbnailSvg)
+	}
+	stored := client.OsnMap.GetX(ctx, entity.ID)
+	if stored.Definition == nil ||
+		stored.Definition.ContentHash() != entity.ContentHash {
+		t.Error("the stored definition differs from the synced one")
+	}
+
+	again, err := archive.SyncMap(ctx, client, definition, thumbnail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Created || again.Changed || again.Map.ID != entity.ID {
+		t.Errorf("syncing the same definition should not change the map, got %+v", again)
+	}
+
+	changed := *definition
+	changed.Terrain.Bonus_ = changed.Terrain.Bonus_[:1]
+	for hash, mapHash := range map[string]string{
+		"abc123": entity.ContentHash,
+		"def456": changed.ContentHash(),
+	} {
+		client.Match.Create().
+			SetMatchHash(hash).
+			SetVersion(state.LATEST_VERSION).
+			SetTurnCount(10).
+			SetMap(entity).
+			SetMapHash(mapHash).
+			SaveX(ctx)
+	}
+	synced, err := archive.SyncMap(ctx, client, &changed, thumbnail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !synced.Changed || synced.Matches != 1 {
+		t.Errorf("changed definition should be reported with the match on the original, got %+v", synced)
+	}
+	if synced.Map.ContentHash == entity.ContentHash || synced.Map.BonusCount != 1 {
+		t.Errorf("changed definition not stored, %v", synced.Map)
+	}
+	original := client.Match.Query().Where(match.MatchHashEQ("abc123")).OnlyX(ctx)
+	if original.MapHash != entity.ContentHash {
+		t.Errorf("the match should keep the hash of the map it was played on, got %s", original.MapHash)
+	}
+}
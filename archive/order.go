@@ -0,0 +1,49 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/archive/order.go
+
+package archive
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/match"
+)
+
+// Orders matches by when they were played, or when they were recorded if that
+// is not known.  Matches are often ingested long after they were played (and
+// not in order), so a backfill of older matches is still ordered before the
+// newer matches.  Ties are ordered by ID.
+func ByPlayed() match.OrderOption {
+	return func(selector *sql.Selector) {
+		selector.OrderExpr(sql.ExprP(fmt.Sprintf("COALESCE(%s, %s)",
+			selector.C(match.FieldPlayedTs), selector.C(match.FieldCreatedTs))))
+		selector.OrderBy(selector.C(match.FieldID))
+	}
+}
+
+// When the match was played, or when it was recorded if that is not known.
+func PlayedAt(recorded *ent.Match) time.Time {
+	switch {
+	case recorded.PlayedTs != nil:
+		return *recorded.PlayedTs
+	case recorded.CreatedTs != nil:
+		return *recorded.CreatedTs
+	}
+	return time.Now()
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/archive/turns.go
+
+package archive
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Stores the turns of a match and each of their actions, simulating the match
+// from its initial state (which is not modified) so that the acting units are
+// known.  Passing is not stored, it is implied by the end of each turn.  The
+// client should be transactional, an error may leave some turns stored.
+func IndexTurns(ctx context.Context, client *ent.Client,
+	match *ent.Match, gamemap *ent.OsnMap,
+	initial *state.GameState, turns []wits.PlayerTurn) error {
+	game := initial.Clone()
+	for _, turn := range turns {
+		if game.IsOver() {
+			return fmt.Errorf("turn %d was played after the match ended", turn.TurnCount())
+		}
+		created, err := client.Turn.Create().
+			SetNumber(int(game.Turn())).
+			SetTeam(game.Current()).
+			SetMatch(match).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		builders := make([]*ent.ActionCreate, 0, len(turn.Actions()))
+		for i, played := range turn.Actions() {
+			resolved, err := game.Map().Resolve(played)
+			if err != nil {
+				return fmt.Errorf("turn %d action %d: %w", game.Turn(), i, err)
+			}
+			if resolved.IsPass() {
+				break
+			}
+			builder := newAction(client, game, resolved).
+				SetSequence(len(builders)).
+				SetTurn(created).
+				SetNillableMapID(mapID(gamemap))
+			if err := game.Apply(resolved); err != nil {
+				return fmt.Errorf("turn %d action %d: %w", game.Turn(), i, err)
+			}
+			builders = append(builders, builder)
+		}
+		if err := client.Action.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+		game.EndTurn()
+	}
+	return nil
+}
+
+// Describes the action by the unit performing it (or being spawned) and the
+// coordinates it refers to, before it is applied to the state.
+func newAction(client *ent.Client, game *state.GameState, resolved state.Action) *ent.ActionCreate {
+	gamemap := game.Map()
+	unit := game.UnitAt(resolved.Agent)
+	class, race := unit.Class(), unit.Race()
+	if resolved.Name == witsjson.SPAWN_UNIT {
+		class, race = resolved.Class, game.Player(game.Current()).Race
+	}
+	agent := gamemap.Coord(resolved.Agent)
+	builder := client.Action.Create().
+		SetName(action.Name(resolved.Name)).
+		SetClass(class).
+		SetRace(race).
+		SetTurnNumber(int(game.Turn())).
+		SetAgentI(agent.I()).
+		SetAgentJ(agent.J())
+	if resolved.Target != state.NO_TILE {
+		target := gamemap.Coord(resolved.Target)
+		builder.SetTargetI(target.I()).SetTargetJ(target.J())
+	}
+	if resolved.Dest != state.NO_TILE {
+		dest := gamemap.Coord(resolved.Dest)
+		builder.SetDestI(dest.I()).SetDestJ(dest.J())
+	}
+	return builder
+}
+
+func mapID(gamemap *ent.OsnMap) *int {
+	if gamemap == nil {
+		return nil
+	}
+	return &gamemap.ID
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/archive/turns_test.go
+
+package archive_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/archive"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/ent/enttest"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/turn"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const peekabooTurns = `[
+	{"turn": 1, "actions": [
+		{"name": "SpawnUnit", "action": {"spawn": [5, 8], "class": "RUNNER"}},
+		{"name": "MoveUnit", "action": {"from": [3, 6], "to": [4, 5]}},
+		{"name": "Pass"}]},
+	{"turn": 2, "actions": []},
+	{"turn": 3, "actions": []},
+	{"turn": 4, "actions": []},
+	{"turn": 5, "actions": []},
+	{"turn": 6, "actions": [
+		{"name": "SpawnUnit", "action": {"spawn": [7, 1], "class": "SPECIAL"}},
+		{"name": "Pass"}]}
+]`
+
+func TestIndexTurns(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	defn, err := witsjson.ReadMapFile("../maps/solo/peekaboo.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gamemap := state.NewGameMap(defn)
+	initial, err := state.NewGame(&gamemap,
+		[]wits.UnitRaceEnum{wits.RACE_FEEDBACK, wits.RACE_SCALLYWAGS})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []witsjson.PlayerTurnJSON
+	if err := json.Unmarshal([]byte(peekabooTurns), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	turns := make([]wits.PlayerTurn, len(decoded))
+	for i, played := range decoded {
+		turns[i] = played
+	}
+
+	osnmap := client.OsnMap.Create().
+		SetName("Peek-a-Boo").
+		SetShortname("peekaboo").
+		SetRoleCount(2).
+		SaveX(ctx)
+	recorded := client.Match.Create().
+		SetMatchHash("abc123").
+		SetVersion(state.LATEST_VERSION).
+		SetTurnCount(len(turns)).
+		SetMap(osnmap).
+		SaveX(ctx)
+	if err := archive.IndexTurns(ctx, client, recorded, osnmap, initial, turns); err != nil {
+		t.Fatal(err)
+	}
+	if initial.Turn() != 1 || len(initial.Units()) != 6 {
+		t.Errorf("the initial state should not be modified")
+	}
+
+	if count := client.Action.Query().CountX(ctx); count != 3 {
+		t.Errorf("expected 3 actions (passing is implied), found %d", count)
+	}
+	moved := client.Action.Query().
+		Where(action.NameEQ(action.NameMoveUnit)).
+		OnlyX(ctx)
+	if moved.Class != wits.CLASS_SOLDIER || moved.Race != wits.RACE_FEEDBACK ||
+		*moved.TargetI != 4 || *moved.TargetJ != 5 {
+		t.Errorf("unexpected move %v", moved)
+	}
+
+	// All matches where a Bombshell was spawned on turn 6.
+	matches := client.Match.Query().
+		Where(match.HasTurnsWith(
+			turn.NumberEQ(6),
+			turn.HasActionsWith(
+				action.NameEQ(action.NameSpawnUnit),
+				action.ClassEQ(wits.CLASS_SPECIAL),
+				action.RaceEQ(wits.RACE_SCALLYWAGS)))).
+		AllX(ctx)
+	if len(matches) != 1 || matches[0].MatchHash != "abc123" {
+		t.Errorf("expected the match with a bombshell spawned on turn 6, got %v", matches)
+	}
+
+	// The first player's spawn tile cannot be used by the second player.
+	illegal := []wits.PlayerTurn{turns[1], turns[0]}
+	other := client.Match.Create().
+		SetMatchHash("def456").
+		SetVersion(state.LATEST_VERSION).
+		SetTurnCount(len(illegal)).
+		SetMap(osnmap).
+		SaveX(ctx)
+	if err := archive.IndexTurns(ctx, client, other, osnmap, initial, illegal); err == nil {
+		t.Errorf("expected an error indexing turns that cannot be simulated")
+	}
+}
+
+func TestIndexTurns_Teleport(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	defn, err := witsjson.ReadMapFile("../maps/solo/peekaboo.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gamemap := state.NewGameMap(defn)
+	initial, err := state.NewGame(&gamemap,
+		[]wits.UnitRaceEnum{wits.RACE_ADORABLES, wits.RACE_SCALLYWAGS})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first player spawns their mobi as soon as they can afford it, then
+	// moves a unit next to it and teleports that unit.  The second player
+	// passes.
+	game := initial.Clone()
+	turns := make([]wits.PlayerTurn, 0)
+	var teleport state.Action
+	canTeleport := func(game *state.GameState) bool {
+		for _, legal := range game.LegalActions() {
+			if legal.Name == witsjson.TELEPORT_UNIT {
+				teleport = legal
+				return true
+			}
+		}
+		return false
+	}
+	choose := func(legal state.Action) bool {
+		if legal.Name == witsjson.SPAWN_UNIT {
+			return legal.Class == wits.CLASS_SPECIAL
+		}
+		if legal.Name == witsjson.MOVE_UNIT {
+			next := game.Clone()
+			return next.Apply(legal) == nil && canTeleport(next)
+		}
+		return false
+	}
+	for teleport.Name != witsjson.TELEPORT_UNIT && game.Turn() < 20 {
+		played := make([]state.Action, 0)
+		if game.Current() == wits.FR_SELF {
+			for _, legal := range game.LegalActions() {
+				if choose(legal) {
+					if err := game.Apply(legal); err != nil {
+						t.Fatal(err)
+					}
+					played = append(played, legal)
+					break
+				}
+			}
+		}
+		if teleport.Name == witsjson.TELEPORT_UNIT {
+			if err := game.Apply(teleport); err != nil {
+				t.Fatal(err)
+			}
+			played = append(played, teleport)
+		}
+		turns = append(turns, bot.TurnJSON(&gamemap, game.Turn(), played))
+		game.EndTurn()
+	}
+	if teleport.Name != witsjson.TELEPORT_UNIT {
+		t.Fatalf("the mobi did not teleport within %d turns", game.Turn())
+	}
+
+	osnmap := client.OsnMap.Create().
+		SetName("Peek-a-Boo").
+		SetShortname("peekaboo").
+		SetRoleCount(2).
+		SaveX(ctx)
+	recorded := client.Match.Create().
+		SetMatchHash("abc123").
+		SetVersion(state.LATEST_VERSION).
+		SetTurnCount(len(turns)).
+		SetMap(osnmap).
+		SaveX(ctx)
+	if err := archive.IndexTurns(ctx, client, recorded, osnmap, initial, turns); err != nil {
+		t.Fatal(err)
+	}
+
+	stored := client.Action.Query().
+		Where(action.NameEQ(action.NameTeleport)).
+		OnlyX(ctx)
+	if stored.DestI == nil || stored.DestJ == nil {
+		t.Fatalf("the teleport's destination was not stored %v", stored)
+	}
+	mobi := gamemap.Coord(teleport.Agent)
+	from := gamemap.Coord(teleport.Target)
+	to := gamemap.Coord(teleport.Dest)
+	if stored.AgentI != mobi.I() || stored.AgentJ != mobi.J() ||
+		*stored.TargetI != from.I() || *stored.TargetJ != from.J() ||
+		*stored.DestI != to.I() || *stored.DestJ != to.J() {
+		t.Errorf("stored teleport %v differs from %v", stored, gamemap.PlayerAction(teleport))
+	}
+	if stored.Class != wits.CLASS_SPECIAL || stored.Race != wits.RACE_ADORABLES {
+		t.Errorf("expected the mobi to be the acting unit, got %v", stored)
+	}
+}
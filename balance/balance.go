@@ -0,0 +1,289 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/balance/balance.go
+
+// Package balance measures how fair a map is to each of its teams: how far
+// each team walks to the enemy base and to the bonus tiles, which tiles it
+// reaches first, where the map narrows to a single tile, and (by simulating
+// bot-vs-bot games) how often each team wins.
+package balance
+
+import (
+	"slices"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The metrics of a map.  Distances are walking distances, the fewest steps
+// over walkable tiles, or -1 where a tile cannot be reached.
+type Report struct {
+	MapID wits.GameMapID `json:"map_id"`
+	Teams []TeamMetrics  `json:"teams"`
+
+	// The two sides, which are the teams themselves in solo maps and the
+	// pairs of allies in duos.
+	Sides [2]SideMetrics `json:"sides"`
+
+	// Walkable tiles that, if they were walls, would separate the others.
+	Chokepoints []witsjson.HexCoordJSON `json:"chokepoints"`
+
+	// Walkable tiles that both sides reach at the same time.
+	Contested int `json:"contested"`
+
+	Asymmetry Asymmetry `json:"asymmetry"`
+
+	// Only included when games were simulated (see Simulate).
+	WinRates *WinRates `json:"win_rates,omitempty"`
+}
+
+type TeamMetrics struct {
+	Team witsjson.FriendlyEnumJSON `json:"team"`
+
+	// From each of the team's spawns to the nearest tile within range of an
+	// enemy base.
+	SpawnToBase []int `json:"spawn_to_base"`
+
+	// From the team's nearest spawn to each bonus tile, in the map's order.
+	SpawnToBonus []int `json:"spawn_to_bonus"`
+}
+
+// The metrics of a side, from the nearest spawn of any of its teams.
+type SideMetrics struct {
+	// The first team of the side (RED or BLUE).
+	Side witsjson.FriendlyEnumJSON `json:"side"`
+
+	SpawnToBase  int   `json:"spawn_to_base"`
+	SpawnToBonus []int `json:"spawn_to_bonus"`
+
+	// The walkable tiles that this side reaches before the other side does.
+	Territory int `json:"territory"`
+}
+
+// The differences between the sides.  Every difference is zero on a map where
+// each side has the same position.
+type Asymmetry struct {
+	SpawnToBase int `json:"spawn_to_base"`
+
+	// The largest, comparing the sides' bonus distances from nearest to
+	// farthest.
+	SpawnToBonus int `json:"spawn_to_bonus"`
+
+	Territory int `json:"territory"`
+
+	// Tiles and units without a matching image (of the same kind, for the
+	// corresponding team) under the symmetry that the map declares.
+	Unmatched []witsjson.HexCoordJSON `json:"unmatched,omitempty"`
+}
+
+func (asymmetry Asymmetry) IsBalanced() bool {
+	return asymmetry.SpawnToBase == 0 && asymmetry.SpawnToBonus == 0 &&
+		asymmetry.Territory == 0 && len(asymmetry.Unmatched) == 0
+}
+
+// Measures the map, which must be valid (see state.ValidateMap).
+func Analyze(description wits.MapDescription) (*Report, error) {
+	if err := state.ValidateMap(description); err != nil {
+		return nil, err
+	}
+	gamemap := state.NewGameMap(description)
+	teams := gamemap.RoleCount()
+	report := &Report{
+		MapID:       description.MapID(),
+		Teams:       make([]TeamMetrics, teams),
+		Chokepoints: chokepoints(&gamemap),
+	}
+
+	bonus := gamemap.BonusTiles()
+	sources := [2][]wits.HexCoordIndex{}
+	toEnemy := [2]int{-1, -1}
+	for i := range teams {
+		team := wits.FriendlyEnum(i + 1)
+		side := state.Side(team) - 1
+		spawns := gamemap.Spawns(team)
+		sources[side] = append(sources[side], spawns...)
+		reach := walk(&gamemap, spawns)
+
+		inRange := make([]wits.HexCoordIndex, 0)
+		for j := range teams {
+			enemy := wits.FriendlyEnum(j + 1)
+			if state.Side(enemy) != state.Side(team) {
+				inRange = append(inRange, attackTiles(&gamemap, gamemap.Base(enemy))...)
+			}
+		}
+		toBase := walk(&gamemap, inRange)
+
+		metrics := TeamMetrics{
+			Team:         witsjson.FriendlyEnumJSON(team),
+			SpawnToBase:  make([]int, len(spawns)),
+			SpawnToBonus: make([]int, len(bonus)),
+		}
+		for k, spawn := range spawns {
+			metrics.SpawnToBase[k] = toBase[spawn]
+		}
+		toEnemy[side] = nearestOf([]int{toEnemy[side], nearestOf(metrics.SpawnToBase)})
+		for k, tile := range bonus {
+			metrics.SpawnToBonus[k] = reach[tile]
+		}
+		report.Teams[i] = metrics
+	}
+
+	reach := [2][]int{}
+	for side := range 2 {
+		reach[side] = walk(&gamemap, sources[side])
+		metrics := SideMetrics{
+			Side:         witsjson.FriendlyEnumJSON(side + 1),
+			SpawnToBase:  toEnemy[side],
+			SpawnToBonus: make([]int, len(bonus)),
+		}
+		for k, tile := range bonus {
+			metrics.SpawnToBonus[k] = reach[side][tile]
+		}
+		report.Sides[side] = metrics
+	}
+	for index := range gamemap.TileCount() {
+		first, second := reach[0][index], reach[1][index]
+		switch {
+		case first < 0 && second < 0:
+		case first == second:
+			report.Contested++
+		case second < 0 || (first >= 0 && first < second):
+			report.Sides[0].Territory++
+		default:
+			report.Sides[1].Territory++
+		}
+	}
+
+	report.Asymmetry = asymmetry(report.Sides)
+	if defined, ok := description.(interface {
+		Definition() *witsjson.MapDefinition
+	}); ok {
+		report.Asymmetry.Unmatched = unmatched(description, defined.Definition())
+	}
+	return report, nil
+}
+
+func asymmetry(sides [2]SideMetrics) Asymmetry {
+	difference := func(a, b int) int { return max(a-b, b-a) }
+	asymmetry := Asymmetry{
+		SpawnToBase: difference(sides[0].SpawnToBase, sides[1].SpawnToBase),
+		Territory:   difference(sides[0].Territory, sides[1].Territory),
+	}
+	first, second := slices.Clone(sides[0].SpawnToBonus), slices.Clone(sides[1].SpawnToBonus)
+	slices.Sort(first)
+	slices.Sort(second)
+	for k := range first {
+		asymmetry.SpawnToBonus = max(asymmetry.SpawnToBonus, difference(first[k], second[k]))
+	}
+	return asymmetry
+}
+
+// The least of the distances that can be walked, or -1 if there are none.
+func nearestOf(distances []int) int {
+	nearest := -1
+	for _, distance := range distances {
+		if distance >= 0 && (nearest < 0 || distance < nearest) {
+			nearest = distance
+		}
+	}
+	return nearest
+}
+
+// The walking distance of every tile from the nearest of the sources.
+func walk(gamemap *state.GameMap, sources []wits.HexCoordIndex) []int {
+	distance := make([]int, gamemap.TileCount())
+	for i := range distance {
+		distance[i] = -1
+	}
+	queue := make([]wits.HexCoordIndex, 0, len(distance))
+	for _, source := range sources {
+		if distance[source] < 0 {
+			distance[source] = 0
+			queue = append(queue, source)
+		}
+	}
+	for len(queue) > 0 {
+		at := queue[0]
+		queue = queue[1:]
+		for _, next := range gamemap.Neighbors(at) {
+			if distance[next] < 0 && gamemap.IsWalkable(next) {
+				distance[next] = distance[at] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return distance
+}
+
+// The walkable tiles from which a unit attacks the base, just beyond the ring
+// of coordinates that the base covers.
+func attackTiles(gamemap *state.GameMap, base wits.HexCoordIndex) []wits.HexCoordIndex {
+	tiles := make([]wits.HexCoordIndex, 0)
+	for index := range gamemap.TileCount() {
+		at := wits.HexCoordIndex(index)
+		if gamemap.IsWalkable(at) && gamemap.Distance(base, at) == 2 {
+			tiles = append(tiles, at)
+		}
+	}
+	return tiles
+}
+
+// The articulation points of the walkable tiles, in the map's order.
+func chokepoints(gamemap *state.GameMap) []witsjson.HexCoordJSON {
+	count := gamemap.TileCount()
+	order, low := make([]int, count), make([]int, count)
+	cut := make([]bool, count)
+	visited := 0
+	// The parent is -1 at the root of the search.
+	var search func(at wits.HexCoordIndex, parent int)
+	search = func(at wits.HexCoordIndex, parent int) {
+		visited++
+		order[at], low[at] = visited, visited
+		children := 0
+		for _, next := range gamemap.Neighbors(at) {
+			if !gamemap.IsWalkable(next) || int(next) == parent {
+				continue
+			}
+			if order[next] > 0 {
+				low[at] = min(low[at], order[next])
+				continue
+			}
+			children++
+			search(next, int(at))
+			low[at] = min(low[at], low[next])
+			if parent >= 0 && low[next] >= order[at] {
+				cut[at] = true
+			}
+		}
+		if parent < 0 && children > 1 {
+			cut[at] = true
+		}
+	}
+	for index := range count {
+		at := wits.HexCoordIndex(index)
+		if gamemap.IsWalkable(at) && order[at] == 0 {
+			search(at, -1)
+		}
+	}
+
+	points := make([]witsjson.HexCoordJSON, 0)
+	for index, isCut := range cut {
+		if isCut {
+			points = append(points, gamemap.Coord(wits.HexCoordIndex(index)))
+		}
+	}
+	return points
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/balance/balance_test.go
+
+package balance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/balance"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/internal/testutil"
+	"github.com/kevindamm/wits-go/mapgen"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func TestAnalyze(t *testing.T) {
+	maps := testutil.LoadMaps(t)
+	report, err := balance.Analyze(maps["peekaboo"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Asymmetry.IsBalanced() {
+		t.Errorf("expected peekaboo to be balanced, found %+v", report.Asymmetry)
+	}
+	for _, team := range report.Teams {
+		if len(team.SpawnToBase) != 1 || team.SpawnToBase[0] != 8 {
+			t.Errorf("expected %s to walk 8 tiles to the enemy base, found %v", team.Team, team.SpawnToBase)
+		}
+		if len(team.SpawnToBonus) != 2 {
+			t.Errorf("expected distances to 2 bonus tiles, found %v", team.SpawnToBonus)
+		}
+	}
+	gamemap := state.NewGameMap(maps["peekaboo"])
+	total := report.Contested + report.Sides[0].Territory + report.Sides[1].Territory
+	if walkable := countWalkable(&gamemap); total != walkable {
+		t.Errorf("expected the split to cover %d walkable tiles, covers %d", walkable, total)
+	}
+
+	report, err = balance.Analyze(maps["skullduggery"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Asymmetry.Territory == 0 || len(report.Asymmetry.Unmatched) != 2 {
+		t.Errorf("expected the asymmetry of skullduggery's missing tiles, found %+v", report.Asymmetry)
+	}
+
+	broken := witsjson.NewGameMap(&witsjson.MapDefinition{MapID: "broken"})
+	if _, err := balance.Analyze(broken); err == nil {
+		t.Error("expected an error for an invalid map")
+	}
+}
+
+func countWalkable(gamemap *state.GameMap) int {
+	count := 0
+	for index := range gamemap.TileCount() {
+		if gamemap.IsWalkable(wits.HexCoordIndex(index)) {
+			count++
+		}
+	}
+	return count
+}
+
+// Walling off a chokepoint separates the remaining walkable tiles, and no
+// other tile does.
+func TestAnalyze_Chokepoints(t *testing.T) {
+	for name, description := range testutil.LoadMaps(t) {
+		report, err := balance.Analyze(description)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gamemap := state.NewGameMap(description)
+		chokepoints := make(map[wits.HexCoordIndex]bool)
+		for _, coord := range report.Chokepoints {
+			chokepoints[gamemap.Index(coord)] = true
+		}
+		walkable := countWalkable(&gamemap)
+		for index := range gamemap.TileCount() {
+			at := wits.HexCoordIndex(index)
+			if !gamemap.IsWalkable(at) {
+				continue
+			}
+			separated := reachable(&gamemap, at) < walkable-1
+			if separated != chokepoints[at] {
+				t.Errorf("%s: walling %s separates the map: %t, reported: %t",
+					name, gamemap.Coord(at), separated, chokepoints[at])
+			}
+		}
+	}
+}
+
+// The number of walkable tiles reached from any one of them, without passing
+// through the wall.
+func reachable(gamemap *state.GameMap, wall wits.HexCoordIndex) int {
+	reached := map[wits.HexCoordIndex]bool{wall: true}
+	queue := make([]wits.HexCoordIndex, 0)
+	for index := range gamemap.TileCount() {
+		at := wits.HexCoordIndex(index)
+		if at != wall && gamemap.IsWalkable(at) {
+			reached[at] = true
+			queue = append(queue, at)
+			break
+		}
+	}
+	for len(queue) > 0 {
+		at := queue[0]
+		queue = queue[1:]
+		for _, next := range gamemap.Neighbors(at) {
+			if !reached[next] && gamemap.IsWalkable(next) {
+				reached[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return len(reached) - 1
+}
+
+func TestAnalyze_Generated(t *testing.T) {
+	for seed := range uint64(4) {
+		options := mapgen.DefaultOptions()
+		options.Seed = seed
+		definition, err := mapgen.Generate(options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		report, err := balance.Analyze(witsjson.NewGameMap(definition))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !report.Asymmetry.IsBalanced() {
+			t.Errorf("%s: expected a generated map to be balanced, found %+v", definition.MapID, report.Asymmetry)
+		}
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	gamemap := state.NewGameMap(testutil.LoadMaps(t)["peekaboo"])
+	options := balance.SimulationOptions{
+		Bot:      func(seed uint64) bot.Bot { return bot.NewRandomBot(seed) },
+		Games:    3,
+		Seed:     7,
+		Budget:   100 * time.Millisecond,
+		MaxTurns: 20,
+	}
+	rates, err := balance.Simulate(&gamemap, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rates.Bot != "random" || rates.Games != 3 || len(rates.Teams) != 2 {
+		t.Fatalf("unexpected win rates %+v", rates)
+	}
+	if decided := rates.Teams[0].Wins + rates.Teams[1].Wins + rates.Draws; decided != 3 {
+		t.Errorf("expected 3 games won or drawn, found %d", decided)
+	}
+
+	options.Games = 0
+	if _, err := balance.Simulate(&gamemap, options); err != balance.ErrNoGames {
+		t.Errorf("expected ErrNoGames, found %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/balance/simulate.go
+
+package balance
+
+import (
+	"errors"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+type SimulationOptions struct {
+	// Creates the bot that plays each seat, seeded differently for each seat
+	// of each game.
+	Bot func(seed uint64) bot.Bot
+
+	Games    int
+	Seed     uint64
+	Budget   time.Duration
+	MaxTurns uint
+}
+
+func DefaultSimulationOptions() SimulationOptions {
+	return SimulationOptions{
+		Bot:      func(seed uint64) bot.Bot { return bot.NewGreedyBot(seed) },
+		Games:    8,
+		Seed:     1,
+		Budget:   time.Second,
+		MaxTurns: 60,
+	}
+}
+
+// The outcomes of games where every seat was played by the same bot, so that
+// any difference between the teams is due to the map (and the turn order).
+type WinRates struct {
+	Bot   string     `json:"bot"`
+	Games int        `json:"games"`
+	Draws int        `json:"draws"`
+	Teams []TeamWins `json:"teams"`
+}
+
+type TeamWins struct {
+	Team    witsjson.FriendlyEnumJSON `json:"team"`
+	Wins    int                       `json:"wins"`
+	WinRate float64                   `json:"win_rate"`
+}
+
+var ErrNoGames = errors.New("no games to simulate")
+
+// Plays the games on the map with the bot in every seat.  Every player of a
+// game has the same race, each race is played in turn.  Games still going at
+// the turn limit are draws.
+func Simulate(gamemap *state.GameMap, options SimulationOptions) (*WinRates, error) {
+	if options.Games <= 0 || options.Bot == nil {
+		return nil, ErrNoGames
+	}
+	teams := gamemap.RoleCount()
+	rates := &WinRates{Games: options.Games, Teams: make([]TeamWins, teams)}
+	for i := range teams {
+		rates.Teams[i].Team = witsjson.FriendlyEnumJSON(i + 1)
+	}
+
+	for game := range options.Games {
+		race := wits.UnitRaceEnum(int(wits.RACE_FEEDBACK) + game%4)
+		races := make([]wits.UnitRaceEnum, teams)
+		bots := make([]bot.Bot, teams)
+		for i := range teams {
+			races[i] = race
+			bots[i] = options.Bot(options.Seed + uint64(game*teams+i))
+		}
+		rates.Bot = bots[0].Name()
+		played, err := state.NewGame(gamemap, races)
+		if err != nil {
+			return nil, err
+		}
+		bot.PlayMatch(played, bots, options.Budget, options.MaxTurns)
+
+		draw := true
+		for i := range teams {
+			if isVictory(played.ResultFor(wits.FriendlyEnum(i + 1))) {
+				rates.Teams[i].Wins++
+				draw = false
+			}
+		}
+		if draw {
+			rates.Draws++
+		}
+	}
+	for i := range rates.Teams {
+		rates.Teams[i].WinRate = float64(rates.Teams[i].Wins) / float64(rates.Games)
+	}
+	return rates, nil
+}
+
+func isVictory(result wits.TerminalStatus) bool {
+	switch result {
+	case wits.VICTORY_DESTRUCTION, wits.VICTORY_EXTINCTION, wits.VICTORY_RESIGNATION:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/balance/symmetry.go
+
+package balance
+
+import (
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// A tile or unit, as compared with its image.
+type feature struct {
+	kind string
+	team wits.FriendlyEnum
+}
+
+// The coordinates of the tiles and units whose image under the map's declared
+// symmetry is not the same kind of tile (or unit) for the corresponding team.
+// Teams correspond by the images of their bases.  Maps that declare no
+// symmetry have nothing to compare.
+func unmatched(description wits.MapDescription, definition *witsjson.MapDefinition) []witsjson.HexCoordJSON {
+	symmetry, ok := state.MapSymmetry(definition)
+	if !ok {
+		return nil
+	}
+	legacy := description.Legacy()
+	type placed struct {
+		feature
+		at       [2]int
+		position wits.HexCoord
+		unit     bool
+	}
+	all := make([]placed, 0)
+	tiles, units := make(map[[2]int]feature), make(map[[2]int]feature)
+	terrain := description.Terrain()
+	for _, defs := range [][]wits.TileDefinition{
+		terrain.Floor(), terrain.Wall(), terrain.Bonus(), terrain.Spawn(), terrain.Base()} {
+		for _, def := range defs {
+			at := state.ToAxial(def.Position(), legacy)
+			tiles[at] = feature{def.Typename(), def.Team()}
+			all = append(all, placed{tiles[at], at, def.Position(), false})
+		}
+	}
+	for _, unit := range description.Units() {
+		at := state.ToAxial(unit.Position(), legacy)
+		units[at] = feature{"unit " + unit.Class().String(), unit.Team()}
+		all = append(all, placed{units[at], at, unit.Position(), true})
+	}
+
+	corresponding := map[wits.FriendlyEnum]wits.FriendlyEnum{wits.FR_UNKNOWN: wits.FR_UNKNOWN}
+	for _, base := range terrain.Base() {
+		image := tiles[symmetry(state.ToAxial(base.Position(), legacy))]
+		if image.kind == base.Typename() {
+			corresponding[base.Team()] = image.team
+		}
+	}
+
+	coords := make([]witsjson.HexCoordJSON, 0)
+	for _, original := range all {
+		features := tiles
+		if original.unit {
+			features = units
+		}
+		image, found := features[symmetry(original.at)]
+		team, teamFound := corresponding[original.team]
+		if !found || !teamFound || image.kind != original.kind || image.team != team {
+			coords = append(coords, witsjson.NewHexCoord(original.position.I(), original.position.J()))
+		}
+	}
+	return coords
+}
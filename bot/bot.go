@@ -0,0 +1,133 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/bot/bot.go
+
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/endgame"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// A computer player.  Bots are given the state as the current player sees it
+// (see GameState.Fogged) and choose the actions for the whole turn.
+type Bot interface {
+	// Identifies the bot in match records.
+	Name() string
+
+	// Returns the actions for the current player's turn, in the order they are
+	// to be applied, within the time budget.  The turn ends after the last
+	// action (or at the first PassAction).  The view belongs to the bot, it
+	// may be modified freely.
+	Turn(view *state.GameState, budget time.Duration) []state.Action
+}
+
+// Bots that exceed their budget by more than this have run out of time.
+const GRACE_PERIOD = 50 * time.Millisecond
+
+var ErrTimeout = errors.New("bot did not choose a turn within its budget")
+
+// Plays the current player's turn with the bot, applying its actions to the
+// game and ending the turn.  Returns the actions that were applied.
+//
+// An action may be legal in the bot's view but not in the game when it is
+// blocked by a unit hidden in the fog.  The turn ends at the first illegal
+// action and its IllegalActionError is returned, the game continues.  If the
+// bot runs out of time, the game ends in a DELAY_OF_GAME and ErrTimeout is
+// returned.
+func PlayTurn(game *state.GameState, bot Bot, budget time.Duration) ([]state.Action, error) {
+	view := game.Fogged(game.Current())
+	chosen := make(chan []state.Action, 1)
+	go func() {
+		chosen <- bot.Turn(view, budget)
+	}()
+
+	var actions []state.Action
+	select {
+	case actions = <-chosen:
+	case <-time.After(budget + GRACE_PERIOD):
+		game.Timeout()
+		return nil, ErrTimeout
+	}
+
+	applied := make([]state.Action, 0, len(actions))
+	var err error
+	for _, action := range actions {
+		if action.IsPass() {
+			break
+		}
+		if err = game.Apply(action); err != nil {
+			break
+		}
+		applied = append(applied, action)
+	}
+	game.EndTurn()
+	return applied, err
+}
+
+// Plays the game to its end with a bot for each player (in order of their
+// team) or until the turn limit is reached, when it is a DELAY_OF_GAME.
+// Returns the turns that were played, in the same form as a replay.
+func PlayMatch(game *state.GameState, bots []Bot,
+	budget time.Duration, maxTurns uint) []witsjson.PlayerTurnJSON {
+	turns := make([]witsjson.PlayerTurnJSON, 0)
+	for !game.IsOver() {
+		if game.Turn() > maxTurns {
+			game.Timeout()
+			break
+		}
+		turn := game.Turn()
+		applied, err := PlayTurn(game, bots[game.Current()-1], budget)
+		if errors.Is(err, ErrTimeout) {
+			break
+		}
+		turns = append(turns, TurnJSON(game.Map(), turn, applied))
+	}
+	return turns
+}
+
+// The replay representation of a turn's actions, ending with a pass.
+func TurnJSON(gamemap *state.GameMap, turn uint, applied []state.Action) witsjson.PlayerTurnJSON {
+	actions := make([]wits.PlayerAction, 0, len(applied)+1)
+	for _, action := range applied {
+		actions = append(actions, gamemap.PlayerAction(action))
+	}
+	actions = append(actions, wits.PassAction{})
+	return witsjson.PlayerTurnJSON{Turn_: turn, Actions_: actions}
+}
+
+// The names of the bots that can be created with ByName.
+var NAMES = []string{"random", "greedy", "mcts", "endgame"}
+
+// Creates a bot by its name, seeding its random choices.
+func ByName(name string, seed uint64) (Bot, error) {
+	switch name {
+	case "random":
+		return NewRandomBot(seed), nil
+	case "greedy":
+		return NewGreedyBot(seed), nil
+	case "mcts":
+		return NewMCTSBot(seed, DefaultMCTSConfig()), nil
+	case "endgame":
+		return NewEndgameBot(NewGreedyBot(seed), endgame.DefaultConfig(), nil), nil
+	}
+	return nil, fmt.Errorf("unknown bot %q", name)
+}
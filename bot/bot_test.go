@@ -0,0 +1,118 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/bot/bot_test.go
+
+package bot_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/internal/testutil"
+	"github.com/kevindamm/wits-go/state"
+)
+
+func TestPlayMatch_Replayable(t *testing.T) {
+	game := testutil.NewPeekaboo(t)
+	initial := game.Clone()
+	turns := bot.PlayMatch(game,
+		[]bot.Bot{bot.NewRandomBot(1), testutil.NewGreedyBot(2)}, time.Minute, 100)
+	if !game.IsOver() {
+		t.Fatal("expected the match to be over")
+	}
+
+	// The recorded turns reproduce the same result when simulated.
+	for _, turn := range turns {
+		for _, action := range turn.Actions() {
+			resolved, err := initial.Map().Resolve(action)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resolved.IsPass() {
+				break
+			}
+			if err := initial.Apply(resolved); err != nil {
+				t.Fatalf("turn %d: %v", turn.TurnCount(), err)
+			}
+		}
+		initial.EndTurn()
+	}
+	if game.Result() != wits.DELAY_OF_GAME && initial.Result() != game.Result() {
+		t.Errorf("replayed result %d differs from %d", initial.Result(), game.Result())
+	}
+}
+
+func TestGreedyBot_BeatsRandom(t *testing.T) {
+	wins := 0
+	for seed := range uint64(4) {
+		game := testutil.NewPeekaboo(t)
+		greedy := testutil.NewGreedyBot(seed)
+		random := bot.NewRandomBot(seed)
+		bots := []bot.Bot{greedy, random}
+		team := wits.FR_SELF
+		if seed%2 == 1 {
+			bots, team = []bot.Bot{random, greedy}, wits.FR_ENEMY
+		}
+		bot.PlayMatch(game, bots, time.Minute, 200)
+		switch game.ResultFor(team) {
+		case wits.VICTORY_DESTRUCTION, wits.VICTORY_EXTINCTION:
+			wins += 1
+		}
+	}
+	if wins < 3 {
+		t.Errorf("expected the greedy bot to win at least 3 of 4, won %d", wins)
+	}
+}
+
+func TestGreedyBot_Evaluations(t *testing.T) {
+	game := testutil.NewPeekaboo(t)
+	turns := make([][]state.Action, 2)
+	for i := range turns {
+		greedy := bot.NewGreedyBot(1)
+		greedy.Evaluations = 500
+		turns[i] = greedy.Turn(game.Fogged(game.Current()), time.Minute)
+	}
+	if len(turns[0]) == 0 || !slices.Equal(turns[0], turns[1]) {
+		t.Errorf("expected the same turn within the same limit, got %v and %v", turns[0], turns[1])
+	}
+
+	greedy := bot.NewGreedyBot(1)
+	greedy.Evaluations = 1
+	if turn := greedy.Turn(game.Fogged(game.Current()), time.Minute); len(turn) != 0 {
+		t.Errorf("expected no actions after a single evaluation, got %v", turn)
+	}
+}
+
+type slowBot struct{}
+
+func (slowBot) Name() string { return "slow" }
+
+func (slowBot) Turn(view *state.GameState, budget time.Duration) []state.Action {
+	time.Sleep(budget + 2*bot.GRACE_PERIOD)
+	return nil
+}
+
+func TestPlayTurn_Timeout(t *testing.T) {
+	game := testutil.NewPeekaboo(t)
+	if _, err := bot.PlayTurn(game, slowBot{}, time.Millisecond); err != bot.ErrTimeout {
+		t.Errorf("expected a timeout, got %v", err)
+	}
+	if game.Result() != wits.DELAY_OF_GAME {
+		t.Errorf("expected a delay of game, got %d", game.Result())
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/bot/endgame.go
+
+package bot
+
+import (
+	"time"
+
+	"github.com/kevindamm/wits-go/endgame"
+	"github.com/kevindamm/wits-go/state"
+)
+
+// Plays perfectly once few enough units remain to solve the position (see
+// package endgame), taking the fastest win or delaying a loss for as long as
+// possible.  Otherwise, and when neither side can force a result, the turn is
+// played by the fallback bot.
+//
+// The position is solved as the bot sees it, without the units hidden in the
+// fog of war.
+type EndgameBot struct {
+	Fallback Bot
+	Solver   *endgame.Solver
+
+	// The portion of the time budget used for solving, the fallback bot has
+	// whatever remains.
+	BudgetFraction float64
+}
+
+// Creates the bot with a solver that keeps its solutions in this table (which
+// may be shared between bots), or in a new table if it is nil.
+func NewEndgameBot(fallback Bot, config endgame.Config, table *endgame.Table) *EndgameBot {
+	return &EndgameBot{fallback, endgame.NewSolver(config, table), 0.5}
+}
+
+func (bot *EndgameBot) Name() string { return "endgame" }
+
+func (bot *EndgameBot) Turn(view *state.GameState, budget time.Duration) []state.Action {
+	start := time.Now()
+	fraction := bot.BudgetFraction
+	if fraction <= 0 || fraction > 1 {
+		fraction = 1
+	}
+	deadline := start.Add(time.Duration(float64(budget) * fraction))
+	result, err := bot.Solver.Solve(view, deadline)
+	if err == nil && result.Outcome != endgame.DRAW {
+		return result.Turn
+	}
+	return bot.Fallback.Turn(view, budget-time.Since(start))
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/bot/greedy.go
+
+package bot
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/kevindamm/wits-go/eval"
+	"github.com/kevindamm/wits-go/state"
+)
+
+// Looks one action ahead, repeatedly choosing the action that most improves
+// its evaluation of the position until passing is better than any action.
+// Ties are broken randomly.
+type GreedyBot struct {
+	Evaluator eval.Evaluator
+
+	// The number of positions evaluated in a turn, unlimited if zero (the time
+	// budget still applies).  A bot limited this way chooses the same turns however
+	// fast it runs, as long as it stays within the budget.
+	Evaluations int
+
+	rng *rand.Rand
+}
+
+func NewGreedyBot(seed uint64) *GreedyBot {
+	return &GreedyBot{
+		Evaluator: eval.Default(),
+		rng:       rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+func (bot *GreedyBot) Name() string { return "greedy" }
+
+func (bot *GreedyBot) Turn(view *state.GameState, budget time.Duration) []state.Action {
+	deadline := time.Now().Add(budget)
+	evaluations := 0
+	done := func() bool {
+		if bot.Evaluations > 0 && evaluations >= bot.Evaluations {
+			return true
+		}
+		evaluations += 1
+		return !time.Now().Before(deadline)
+	}
+
+	actions := make([]state.Action, 0)
+	for !view.IsOver() && !done() {
+		best := greedyAction(view, bot.rng, bot.Evaluator, done)
+		if best.IsPass() {
+			break
+		}
+		view.Apply(best)
+		actions = append(actions, best)
+	}
+	return actions
+}
@@ -0,0 +1,364 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/bot/mcts.go
+
+package bot
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/eval"
+	"github.com/kevindamm/wits-go/state"
+)
+
+// Searches with Monte Carlo Tree Search, where each decision in the tree is a
+// single action and passing ends the turn (turns after the current one are in
+// the tree as well, alternating between the sides).
+//
+// The opposing units hidden in the fog are guessed at by determinization.
+// The bot remembers where it last saw opposing units, and every iteration of
+// the search begins from a state where they (probably) still are.  Because
+// the actions available differ between determinizations, a child is selected
+// by how often it was available as well as how often it was visited.
+//
+// Searches run in parallel, each with its own tree, and their statistics are
+// combined when choosing the actions for the turn.
+type MCTSBot struct {
+	MCTSConfig
+	rng *rand.Rand
+
+	// Opposing units last seen at tiles now in the fog, for determinization.
+	gamemap *state.GameMap
+	turn    uint
+	seen    map[wits.HexCoordIndex]state.UnitBits
+}
+
+type MCTSConfig struct {
+	// The total number of iterations across all searches, unlimited if zero
+	// (the time budget still applies).
+	Iterations int
+
+	// How many searches run in parallel.
+	Workers int
+
+	// The exploration constant of the UCB1 selection.
+	Exploration float64
+
+	// Chooses the actions of the playouts after leaving the tree, the
+	// UniformPolicy if nil.
+	Playout Policy
+
+	// The number of turns played out before the position is evaluated.
+	PlayoutTurns int
+
+	// Evaluates the position at the end of a playout.
+	Evaluator eval.Evaluator
+
+	// The portion of the time budget used for searching (the remainder is
+	// kept as a margin for returning the turn).
+	BudgetFraction float64
+}
+
+func DefaultMCTSConfig() MCTSConfig {
+	return MCTSConfig{
+		Workers:        4,
+		Exploration:    0.7,
+		Playout:        UniformPolicy,
+		PlayoutTurns:   4,
+		Evaluator:      eval.Default(),
+		BudgetFraction: 0.8,
+	}
+}
+
+// How likely a remembered unit is to still be in the fog, and to have moved
+// to a neighboring tile since it was seen.
+const (
+	REMEMBER_ODDS = 0.8
+	MOVED_ODDS    = 0.5
+)
+
+func NewMCTSBot(seed uint64, config MCTSConfig) *MCTSBot {
+	return &MCTSBot{
+		MCTSConfig: config,
+		rng:        rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+func (bot *MCTSBot) Name() string { return "mcts" }
+
+func (bot *MCTSBot) Turn(view *state.GameState, budget time.Duration) []state.Action {
+	fraction := bot.BudgetFraction
+	if fraction <= 0 || fraction > 1 {
+		fraction = 1
+	}
+	deadline := time.Now().Add(time.Duration(float64(budget) * fraction))
+	bot.remember(view)
+
+	workers := max(bot.Workers, 1)
+	roots := make([]*node, workers)
+	var wg sync.WaitGroup
+	for i := range workers {
+		roots[i] = &node{}
+		search := &search{
+			MCTSConfig: bot.MCTSConfig,
+			rng:        rand.New(rand.NewPCG(bot.rng.Uint64(), bot.rng.Uint64())),
+			view:       view,
+			hidden:     bot.hidden(),
+			deadline:   deadline,
+		}
+		iterations := 0
+		if bot.Iterations > 0 {
+			iterations = max(bot.Iterations/workers, 1)
+		}
+		wg.Add(1)
+		go func(root *node) {
+			defer wg.Done()
+			search.run(root, iterations)
+		}(roots[i])
+	}
+	wg.Wait()
+	return bestTurn(view, roots)
+}
+
+// Updates the memory of opposing units with what is visible this turn.
+func (bot *MCTSBot) remember(view *state.GameState) {
+	if bot.seen == nil || view.Map() != bot.gamemap || view.Turn() <= bot.turn {
+		bot.seen = make(map[wits.HexCoordIndex]state.UnitBits)
+	}
+	bot.gamemap, bot.turn = view.Map(), view.Turn()
+	side := state.Side(view.Current())
+	for index, visible := range view.Visible(view.Current()) {
+		at := wits.HexCoordIndex(index)
+		if !visible {
+			continue
+		}
+		delete(bot.seen, at)
+		unit := view.UnitAt(at)
+		if !unit.IsEmpty() && state.Side(unit.Team()) != side && unit.Class() != wits.CLASS_THORN {
+			bot.seen[at] = unit
+		}
+	}
+}
+
+// The remembered units that are now hidden, as candidates for determinizing.
+func (bot *MCTSBot) hidden() []state.TileUnit {
+	hidden := make([]state.TileUnit, 0, len(bot.seen))
+	for at, unit := range bot.seen {
+		hidden = append(hidden, state.TileUnit{UnitBits: unit, At: at})
+	}
+	return hidden
+}
+
+// A decision in the search tree, reached by taking the action from its parent.
+type node struct {
+	action   state.Action
+	side     wits.FriendlyEnum // the side that took the action
+	children map[state.Action]*node
+
+	visits    int
+	available int
+	reward    float64 // the sum of rewards for the side that took the action
+}
+
+func (node *node) child(action state.Action) *node {
+	return node.children[action]
+}
+
+// The state of one of the parallel searches.
+type search struct {
+	MCTSConfig
+	rng      *rand.Rand
+	view     *state.GameState
+	hidden   []state.TileUnit
+	deadline time.Time
+}
+
+func (search *search) run(root *node, iterations int) {
+	for i := 0; iterations == 0 || i < iterations; i++ {
+		if time.Now().After(search.deadline) {
+			return
+		}
+		search.iterate(root)
+	}
+}
+
+// One iteration: determinize, select and expand, play out and back-propagate.
+func (search *search) iterate(root *node) {
+	game := search.determinize()
+	path := []*node{root}
+	current := root
+	for !game.IsOver() {
+		legal := append(game.LegalActions(), state.PassAction)
+		untried := make([]state.Action, 0)
+		for _, action := range legal {
+			if child := current.child(action); child != nil {
+				child.available += 1
+			} else {
+				untried = append(untried, action)
+			}
+		}
+
+		var next *node
+		if len(untried) > 0 {
+			action := untried[search.rng.IntN(len(untried))]
+			next = &node{action: action, side: state.Side(game.Current()), available: 1}
+			if current.children == nil {
+				current.children = make(map[state.Action]*node)
+			}
+			current.children[action] = next
+		} else {
+			next = search.selectChild(current, legal)
+		}
+		play(game, next.action)
+		path = append(path, next)
+		current = next
+		if len(untried) > 0 {
+			break
+		}
+	}
+
+	reward := search.playout(game)
+	for _, visited := range path {
+		visited.visits += 1
+		if visited.side == wits.FR_SELF {
+			visited.reward += reward
+		} else {
+			visited.reward += 1 - reward
+		}
+	}
+}
+
+// Chooses among the available children by their upper confidence bound.
+func (search *search) selectChild(parent *node, legal []state.Action) *node {
+	var best *node
+	bestBound := math.Inf(-1)
+	for _, action := range legal {
+		child := parent.child(action)
+		bound := child.reward/float64(child.visits) +
+			search.Exploration*math.Sqrt(math.Log(float64(child.available))/float64(child.visits))
+		if bound > bestBound {
+			best, bestBound = child, bound
+		}
+	}
+	return best
+}
+
+// A copy of the view with some of the remembered opposing units placed at (or
+// next to) where they were last seen, if those tiles are still in the fog.
+func (search *search) determinize() *state.GameState {
+	if len(search.hidden) == 0 {
+		return search.view.Clone()
+	}
+	visible := search.view.Visible(search.view.Current())
+	gamemap := search.view.Map()
+	guessed := make([]state.TileUnit, 0, len(search.hidden))
+	for _, unit := range search.hidden {
+		if search.rng.Float64() > REMEMBER_ODDS {
+			continue
+		}
+		if search.rng.Float64() < MOVED_ODDS {
+			neighbors := gamemap.Neighbors(unit.At)
+			if len(neighbors) > 0 {
+				unit.At = neighbors[search.rng.IntN(len(neighbors))]
+			}
+		}
+		if int(unit.At) < len(visible) && !visible[unit.At] {
+			guessed = append(guessed, unit)
+		}
+	}
+	return search.view.Determinized(guessed)
+}
+
+// Plays the policy for a number of turns, returning the reward for FR_SELF's
+// side: 1 for a victory, 0 for a loss, otherwise the evaluated probability.
+func (search *search) playout(game *state.GameState) float64 {
+	for turns := 0; !game.IsOver() && turns < search.PlayoutTurns; {
+		policy := search.Playout
+		if policy == nil {
+			policy = UniformPolicy
+		}
+		action := policy(game, search.rng)
+		play(game, action)
+		if action.IsPass() {
+			turns += 1
+		}
+	}
+	return search.Evaluator.WinProbability(game, wits.FR_SELF)
+}
+
+// Applies the action, ending the turn if it is a pass.
+func play(game *state.GameState, action state.Action) {
+	if action.IsPass() {
+		game.EndTurn()
+		return
+	}
+	game.Apply(action)
+}
+
+// Follows the most visited actions (across all of the searches) for the
+// current turn, until passing or until an action is no longer legal in the
+// view (when it depended on a guessed unit).
+func bestTurn(view *state.GameState, roots []*node) []state.Action {
+	game := view.Clone()
+	actions := make([]state.Action, 0)
+	nodes := roots
+	for {
+		visits := make(map[state.Action]int)
+		for _, node := range nodes {
+			for action, child := range node.children {
+				visits[action] += child.visits
+			}
+		}
+		best, bestVisits := state.PassAction, 0
+		for action, count := range visits {
+			if count > bestVisits || (count == bestVisits && less(action, best)) {
+				best, bestVisits = action, count
+			}
+		}
+		if best.IsPass() || bestVisits == 0 || game.Apply(best) != nil {
+			return actions
+		}
+		actions = append(actions, best)
+
+		next := make([]*node, 0, len(nodes))
+		for _, node := range nodes {
+			if child := node.child(best); child != nil {
+				next = append(next, child)
+			}
+		}
+		nodes = next
+	}
+}
+
+// A stable order for breaking ties between equally visited actions.
+func less(a, b state.Action) bool {
+	if a.Name != b.Name {
+		return a.Name < b.Name
+	}
+	if a.Agent != b.Agent {
+		return a.Agent < b.Agent
+	}
+	if a.Target != b.Target {
+		return a.Target < b.Target
+	}
+	if a.Dest != b.Dest {
+		return a.Dest < b.Dest
+	}
+	return a.Class < b.Class
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/bot/mcts_test.go
+
+package bot_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/internal/testutil"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Plays the MCTS bot against the random bot, alternating who plays first, and
+// returns how many of the games the MCTS bot won.
+func playRandom(t testing.TB, mapPath string, games int, config bot.MCTSConfig) int {
+	defn, err := witsjson.ReadMapFile(mapPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gamemap := state.NewGameMap(defn)
+	wins := 0
+	for seed := range uint64(games) {
+		game, err := state.NewGame(&gamemap,
+			[]wits.UnitRaceEnum{wits.RACE_FEEDBACK, wits.RACE_SCALLYWAGS})
+		if err != nil {
+			t.Fatal(err)
+		}
+		mcts := bot.NewMCTSBot(seed, config)
+		random := bot.NewRandomBot(seed)
+		bots, team := []bot.Bot{mcts, random}, wits.FR_SELF
+		if seed%2 == 1 {
+			bots, team = []bot.Bot{random, mcts}, wits.FR_ENEMY
+		}
+		// The budget is generous so that the iteration limit applies instead.
+		bot.PlayMatch(game, bots, 10*time.Second, 200)
+		switch game.ResultFor(team) {
+		case wits.VICTORY_DESTRUCTION, wits.VICTORY_EXTINCTION:
+			wins += 1
+		}
+	}
+	return wins
+}
+
+func TestMCTSBot_BeatsRandom(t *testing.T) {
+	config := bot.DefaultMCTSConfig()
+	config.Iterations = 200
+	config.Workers = 1
+	if wins := playRandom(t, "../maps/solo/peekaboo.json", 4, config); wins < 3 {
+		t.Errorf("expected the MCTS bot to win at least 3 of 4, won %d", wins)
+	}
+}
+
+func TestMCTSBot_Turn(t *testing.T) {
+	game := testutil.NewPeekaboo(t)
+	config := bot.DefaultMCTSConfig()
+	config.Iterations = 100
+	mcts := bot.NewMCTSBot(1, config)
+	actions := mcts.Turn(game.Fogged(wits.FR_SELF), time.Second)
+	for _, action := range actions {
+		if err := game.Apply(action); err != nil {
+			t.Errorf("chose an illegal action: %v", err)
+		}
+	}
+
+	// The time limit applies when iterations are unlimited.
+	config.Iterations = 0
+	mcts = bot.NewMCTSBot(1, config)
+	start := time.Now()
+	mcts.Turn(testutil.NewPeekaboo(t), 50*time.Millisecond)
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond+bot.GRACE_PERIOD {
+		t.Errorf("search took %s, longer than its budget", elapsed)
+	}
+}
+
+// Reports the MCTS bot's win rate against the random bot on each solo map:
+//
+//	go test ./bot -run XXX -bench MCTS -benchtime 4x
+func BenchmarkMCTSBot_VsRandom(b *testing.B) {
+	paths, err := filepath.Glob("../maps/solo/*.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	config := bot.DefaultMCTSConfig()
+	config.Iterations = 400
+	for _, path := range paths {
+		name := filepath.Base(path)
+		b.Run(name[:len(name)-len(".json")], func(b *testing.B) {
+			wins := playRandom(b, path, b.N, config)
+			b.ReportMetric(float64(wins)/float64(b.N), "wins/game")
+		})
+	}
+}
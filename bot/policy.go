@@ -0,0 +1,70 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/bot/policy.go
+
+package bot
+
+import (
+	"math/rand/v2"
+
+	"github.com/kevindamm/wits-go/eval"
+	"github.com/kevindamm/wits-go/state"
+)
+
+// Chooses the next action (possibly a PassAction) for the current player, for
+// playing out a game quickly during a search.
+type Policy func(game *state.GameState, rng *rand.Rand) state.Action
+
+// Chooses uniformly among the legal actions, passing with the same likelihood
+// as any one of them.
+func UniformPolicy(game *state.GameState, rng *rand.Rand) state.Action {
+	legal := game.LegalActions()
+	choice := rng.IntN(len(legal) + 1)
+	if choice == len(legal) {
+		return state.PassAction
+	}
+	return legal[choice]
+}
+
+// Chooses the action that most improves the default evaluation for the
+// current player (see GreedyBot), passing when none of them do.  Slower than
+// the uniform policy but its playouts are more like real games.
+func GreedyPolicy(game *state.GameState, rng *rand.Rand) state.Action {
+	return greedyAction(game, rng, eval.Default(), nil)
+}
+
+// Tries the legal actions in a random order, choosing the one that most
+// improves the evaluation.  If done is not nil it is called before each action
+// is tried, when it returns true the best action found so far is chosen.
+func greedyAction(game *state.GameState, rng *rand.Rand,
+	evaluator eval.Evaluator, done func() bool) state.Action {
+	team := game.Current()
+	legal := game.LegalActions()
+	rng.Shuffle(len(legal), func(i, j int) {
+		legal[i], legal[j] = legal[j], legal[i]
+	})
+	best, bestScore := state.PassAction, evaluator.Evaluate(game, team)
+	for _, action := range legal {
+		if done != nil && done() {
+			break
+		}
+		next := game.Clone()
+		next.Apply(action)
+		if score := evaluator.Evaluate(next, team); score > bestScore {
+			best, bestScore = action, score
+		}
+	}
+	return best
+}
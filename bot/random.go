@@ -0,0 +1,51 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/bot/random.go
+
+package bot
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/kevindamm/wits-go/state"
+)
+
+// Plays uniformly random legal actions, passing with the same likelihood as
+// any one of them.  Useful as a baseline opponent.
+type RandomBot struct {
+	rng *rand.Rand
+}
+
+func NewRandomBot(seed uint64) *RandomBot {
+	return &RandomBot{rand.New(rand.NewPCG(seed, seed))}
+}
+
+func (bot *RandomBot) Name() string { return "random" }
+
+func (bot *RandomBot) Turn(view *state.GameState, budget time.Duration) []state.Action {
+	deadline := time.Now().Add(budget)
+	actions := make([]state.Action, 0)
+	for time.Now().Before(deadline) {
+		legal := view.LegalActions()
+		choice := bot.rng.IntN(len(legal) + 1)
+		if choice == len(legal) {
+			break
+		}
+		view.Apply(legal[choice])
+		actions = append(actions, legal[choice])
+	}
+	return actions
+}
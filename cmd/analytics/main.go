@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/analytics/main.go
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"github.com/kevindamm/wits-go/analytics"
+	"github.com/kevindamm/wits-go/ent"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func main() {
+	dbPath := flag.String("db", "file:wits.db?_fk=1",
+		"data source name for the SQLite database.")
+	format := flag.String("format", "json",
+		"the output format, either json or csv.")
+	section := flag.String("section", "",
+		"only output this section of the report, one of "+
+			strings.Join(analytics.SECTIONS, ", ")+" (required for csv).")
+	flag.Parse()
+
+	client, err := ent.Open(dialect.SQLite, *dbPath)
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+
+	report, err := analytics.Compute(context.Background(), client)
+	if err != nil {
+		log.Fatalf("failed computing statistics: %v", err)
+	}
+	switch *format {
+	case "json":
+		err = report.WriteJSON(os.Stdout, *section)
+	case "csv":
+		if *section == "" {
+			log.Fatalf("a -section is required for csv output")
+		}
+		err = report.WriteCSV(os.Stdout, *section)
+	default:
+		log.Fatalf("unknown output format %q", *format)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/animate/main.go
+
+// Exports a replay as an animated GIF, for sharing without the frontend.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"image/gif"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ingest"
+	"github.com/kevindamm/wits-go/render"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func main() {
+	mapsDir := flag.String("maps", "maps",
+		"directory containing the map definitions (JSON).")
+	outPath := flag.String("out", "replay.gif",
+		"file where the animation is written.")
+	tileSize := flag.Float64("size", render.DefaultOptions().TileSize,
+		"the radius of each hexagon, in pixels.")
+	fog := flag.String("fog", "",
+		"show the replay as this team (RED, BLUE, ...) saw it.")
+	defaults := render.DefaultTiming()
+	banner := flag.Duration("banner", defaults.Banner,
+		"how long the banner at the start of each turn is shown.")
+	action := flag.Duration("action", defaults.Action,
+		"how long each action is shown.")
+	move := flag.Duration("move", defaults.Move,
+		"how long a unit takes to slide to where it moves.")
+	moveSteps := flag.Int("move-steps", defaults.MoveSteps,
+		"the number of frames of a unit sliding, none if zero.")
+	end := flag.Duration("end", defaults.End,
+		"how long the final state is shown.")
+	version := flag.Int("version", state.LATEST_VERSION,
+		"the runtime version the replay was played on, it is simulated under its rules.")
+	variant := flag.String("rules", "",
+		"a JSON file of the custom variant of the rules the replay was played under.")
+	versionsDir := flag.String("versions", "",
+		"directory of the rules (JSON) of earlier runtime versions; optional.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] <replay.json>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	maps, skipped, err := witsjson.LoadMapLibrary(*mapsDir)
+	if err != nil {
+		log.Fatalf("failed loading map definitions: %v", err)
+	}
+	for _, err := range skipped {
+		log.Printf("skipping map file: %v", err)
+	}
+	replay, err := readReplay(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	description, found := maps.Find(replay.MapID(), replay.MapName())
+	if !found {
+		log.Fatalf("map not found (id %q, name %q)", replay.MapID(), replay.MapName())
+	}
+	if len(*versionsDir) > 0 {
+		if err := state.LoadVersions(*versionsDir); err != nil {
+			log.Fatal(err)
+		}
+	}
+	rules, err := state.RulesForVersion(*version)
+	if len(*variant) > 0 {
+		rules, err = state.ReadVariantFile(*variant)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	initial, canonical, err := ingest.Setup(maps, replay, rules)
+	if err != nil {
+		log.Fatal(err)
+	}
+	options := render.Options{TileSize: *tileSize}
+	if len(*fog) > 0 {
+		if options.Fog = wits.FriendlyEnum(witsjson.ParseTeam(strings.ToUpper(*fog))); options.Fog == wits.FR_UNKNOWN {
+			log.Fatalf("unknown team %q", *fog)
+		}
+	}
+
+	timing := render.Timing{
+		Banner:    *banner,
+		Action:    *action,
+		Move:      *move,
+		MoveSteps: *moveSteps,
+		End:       *end,
+	}
+	// The animation up to where a replay diverges is still written.
+	animation, err := render.New(description, options).Animate(initial, canonical.MatchReplay(), timing)
+	if err != nil {
+		log.Printf("stopped early: %v", err)
+	}
+	out, err := os.Create(*outPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+	if err := gif.EncodeAll(out, animation); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%d frames written to %s\n", len(animation.Image), *outPath)
+}
+
+func readReplay(filename string) (witsjson.GameReplayJSON, error) {
+	var replay witsjson.GameReplayJSON
+	encoded, err := os.ReadFile(filename)
+	if err != nil {
+		return replay, err
+	}
+	err = json.Unmarshal(encoded, &replay)
+	return replay, err
+}
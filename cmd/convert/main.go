@@ -0,0 +1,125 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/convert/main.go
+
+// Rewrites maps, and the replays on them, between the legacy (column and row)
+// coordinates and axial coordinates.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func main() {
+	to := flag.String("to", "axial",
+		"the coordinates to convert into, axial or legacy.")
+	mapsDir := flag.String("maps", "maps",
+		"directory containing the map definitions (JSON) that the replays are on.")
+	outDir := flag.String("out", "",
+		"directory where the converted files are written, with the same names.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(),
+			"usage: %s -out <directory> [flags] <map or replay JSON>...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 || len(*outDir) == 0 || (*to != "axial" && *to != "legacy") {
+		flag.Usage()
+		os.Exit(2)
+	}
+	toAxial := *to == "axial"
+
+	var maps witsjson.MapLibrary
+	failed := 0
+	for _, filename := range flag.Args() {
+		encoded, err := os.ReadFile(filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(encoded, &fields); err != nil {
+			log.Printf("skipping %s: %v", filename, err)
+			failed++
+			continue
+		}
+		outPath := filepath.Join(*outDir, filepath.Base(filename))
+		if _, isMap := fields["terrain"]; isMap {
+			err = convertMap(filename, outPath, toAxial)
+		} else {
+			if maps == nil {
+				var skipped []error
+				if maps, skipped, err = witsjson.LoadMapLibrary(*mapsDir); err != nil {
+					log.Fatalf("failed loading map definitions: %v", err)
+				}
+				for _, err := range skipped {
+					log.Printf("skipping map file: %v", err)
+				}
+			}
+			err = convertReplay(encoded, outPath, maps, toAxial)
+		}
+		if err != nil {
+			log.Printf("skipping %s: %v", filename, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func convertMap(filename, outPath string, toAxial bool) error {
+	gamemap, err := witsjson.ReadMapFile(filename)
+	if err != nil {
+		return err
+	}
+	var converted witsjson.MapDefinition
+	if toAxial {
+		converted = gamemap.Definition().ToAxial()
+	} else if converted, err = gamemap.Definition().ToLegacy(); err != nil {
+		return err
+	}
+	return os.WriteFile(outPath, converted.Format(), 0644)
+}
+
+// Replays are in the coordinates of their map, they are only converted when
+// their map is.
+func convertReplay(encoded []byte, outPath string, maps witsjson.MapLibrary, toAxial bool) error {
+	var replay witsjson.GameReplayJSON
+	if err := json.Unmarshal(encoded, &replay); err != nil {
+		return err
+	}
+	gamemap, found := maps.Find(replay.MapID(), replay.MapName())
+	if !found {
+		return fmt.Errorf("map %s (%s) not found", replay.MapID(), replay.MapName())
+	}
+	var err error
+	switch {
+	case toAxial && gamemap.Legacy():
+		replay, err = replay.ToAxial()
+	case !toAxial && !gamemap.Legacy():
+		replay, err = replay.ToLegacy()
+	}
+	if err != nil {
+		return err
+	}
+	return replay.WriteJSON(outPath)
+}
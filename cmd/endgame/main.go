@@ -0,0 +1,137 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/endgame/main.go
+
+// Solves positions (in the notation of package notation) exactly, one per
+// line of stdin or given as arguments, printing the result for the side to
+// move and the turn to play.  Solutions are kept in a tablebase file, which is
+// read at start (if it exists) and written with any new solutions at the end.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/endgame"
+	"github.com/kevindamm/wits-go/engine"
+	"github.com/kevindamm/wits-go/notation"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func main() {
+	mapsDir := flag.String("maps", "maps",
+		"directory containing the map definitions (JSON).")
+	tablePath := flag.String("table", "",
+		"tablebase file that solutions are read from and written to.")
+	defaults := endgame.DefaultConfig()
+	maxUnits := flag.Int("max-units", defaults.MaxUnits,
+		"positions with more units than this are not solved.")
+	maxTurns := flag.Int("max-turns", defaults.MaxTurns,
+		"the most turns searched, without a result it is a draw.")
+	maxNodes := flag.Int("max-nodes", defaults.MaxNodes,
+		"the most positions searched for each solution, unlimited if zero.")
+	timeout := flag.Duration("timeout", 0,
+		"the most time spent on each solution, unlimited if zero.")
+	version := flag.Int("version", state.LATEST_VERSION,
+		"the runtime version whose rules the positions are played under.")
+	variant := flag.String("rules", "",
+		"a JSON file of the custom variant of the rules the positions are played under.")
+	versionsDir := flag.String("versions", "",
+		"directory of the rules (JSON) of earlier runtime versions; optional.")
+	flag.Parse()
+
+	maps, skipped, err := witsjson.LoadMapLibrary(*mapsDir)
+	if err != nil {
+		log.Fatalf("failed loading map definitions: %v", err)
+	}
+	for _, err := range skipped {
+		log.Printf("skipping map file: %v", err)
+	}
+	if len(*versionsDir) > 0 {
+		if err := state.LoadVersions(*versionsDir); err != nil {
+			log.Fatal(err)
+		}
+	}
+	rules, err := state.RulesForVersion(*version)
+	if len(*variant) > 0 {
+		rules, err = state.ReadVariantFile(*variant)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	table := endgame.NewTable()
+	if len(*tablePath) > 0 {
+		if table, err = endgame.ReadTableFile(*tablePath); err != nil {
+			log.Fatal(err)
+		}
+	}
+	config := defaults
+	config.MaxUnits, config.MaxTurns, config.MaxNodes = *maxUnits, *maxTurns, *maxNodes
+	solver := endgame.NewSolver(config, table)
+
+	solve := func(encoded string) {
+		game, err := notation.ParseWithRules(encoded, maps, rules)
+		if err != nil {
+			fmt.Printf("invalid: %v\n", err)
+			return
+		}
+		var deadline time.Time
+		if *timeout > 0 {
+			deadline = time.Now().Add(*timeout)
+		}
+		result, err := solver.Solve(game, deadline)
+		if result.Outcome == endgame.UNKNOWN {
+			fmt.Printf("%s: %v\n", result.Outcome, err)
+			return
+		}
+		gamemap := game.Map()
+		actions := make([]wits.PlayerAction, len(result.Turn))
+		for i, action := range result.Turn {
+			actions[i] = gamemap.PlayerAction(action)
+		}
+		line := fmt.Sprintf("%s in %d %s", result.Outcome, result.Depth, engine.FormatTurn(actions))
+		if err != nil {
+			// The deepest complete search is reported, it may be a draw only
+			// because the budget ran out.
+			line += fmt.Sprintf(" (%v)", err)
+		}
+		fmt.Println(line)
+	}
+	if flag.NArg() > 0 {
+		for _, encoded := range flag.Args() {
+			solve(encoded)
+		}
+	} else {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); len(line) > 0 {
+				solve(line)
+			}
+		}
+	}
+
+	if len(*tablePath) > 0 {
+		if err := table.WriteFile(*tablePath); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/engine/main.go
+
+// A reference engine for the text protocol (see package engine), playing one
+// of the built-in bots.  Engines in other languages can be checked against it,
+// or it can stand in for them in a tournament.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/engine"
+)
+
+func main() {
+	name := flag.String("bot", "greedy",
+		"the bot that plays, one of random, greedy, mcts or endgame.")
+	seed := flag.Uint64("seed", 1,
+		"seeds the bot's random choices, incremented for each game.")
+	flag.Parse()
+
+	if _, err := bot.ByName(*name, *seed); err != nil {
+		log.Fatal(err)
+	}
+	games := uint64(0)
+	newBot := func() bot.Bot {
+		created, _ := bot.ByName(*name, *seed+games)
+		games++
+		return created
+	}
+	if err := engine.Serve(os.Stdin, os.Stdout, newBot); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/ingest/main.go
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"entgo.io/ent/dialect"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ingest"
+	"github.com/kevindamm/wits-go/rating"
+	"github.com/kevindamm/wits-go/season"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func main() {
+	dbPath := flag.String("db", "file:wits.db?_fk=1",
+		"data source name for the SQLite database.")
+	mapsDir := flag.String("maps", "maps",
+		"directory containing the map definitions (JSON).")
+	replayDir := flag.String("replays", "replays",
+		"directory containing the OSN replays (JSON) named by match hash.")
+	canonicalDir := flag.String("canonical", "",
+		"directory where canonical replays are written; optional.")
+	workers := flag.Int("workers", 4,
+		"the number of matches to process concurrently.")
+	version := flag.Int("version", state.LATEST_VERSION,
+		"the runtime version to record for newly discovered matches.")
+	versionsDir := flag.String("versions", "",
+		"directory of the rules (JSON) of earlier runtime versions; optional.")
+	rankedSeason := flag.Int("season", 0,
+		"the ranked season to record for newly discovered matches; zero if unranked.")
+	retry := flag.Bool("retry-invalid", false,
+		"set this flag to retry matches that were previously found INVALID.")
+	ratings := flag.Bool("ratings", false,
+		"set this flag to recompute player ratings after ingesting.")
+	seasons := flag.Bool("seasons", false,
+		"set this flag to recompute the standings of every ranked season after ingesting.")
+	flag.Parse()
+
+	client, err := ent.Open(dialect.SQLite, *dbPath)
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+
+	if len(*versionsDir) > 0 {
+		if err := state.LoadVersions(*versionsDir); err != nil {
+			log.Fatal(err)
+		}
+	}
+	maps, skipped, err := witsjson.LoadMapLibrary(*mapsDir)
+	if err != nil {
+		log.Fatalf("failed loading map definitions: %v", err)
+	}
+	for _, err := range skipped {
+		log.Printf("skipping map file: %v", err)
+	}
+
+	pipeline := ingest.New(client, maps, *replayDir)
+	pipeline.CanonicalDir = *canonicalDir
+	pipeline.Workers = *workers
+	pipeline.Version = *version
+	pipeline.Season = int8(*rankedSeason)
+	pipeline.RetryInvalid = *retry
+	summary, err := pipeline.Run(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, status := range []match.FetchStatus{
+		match.FetchStatusLISTED,
+		match.FetchStatusFETCHED,
+		match.FetchStatusUNWRAPPED,
+		match.FetchStatusCONVERTED,
+		match.FetchStatusCANONICAL,
+		match.FetchStatusVALIDATED,
+		match.FetchStatusINDEXED,
+		match.FetchStatusINVALID,
+		match.FetchStatusLEGACY,
+	} {
+		if count := summary[status]; count > 0 {
+			fmt.Printf("%-10s %d\n", status, count)
+		}
+	}
+
+	if *ratings {
+		rated, err := rating.Recompute(ctx, client)
+		if err != nil {
+			log.Fatalf("failed computing ratings: %v", err)
+		}
+		fmt.Printf("rated %d matches\n", rated)
+	}
+	if *seasons {
+		ranked, err := season.Recompute(ctx, client)
+		if err != nil {
+			log.Fatalf("failed computing season standings: %v", err)
+		}
+		fmt.Printf("ranked %d matches\n", ranked)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/mapgen/main.go
+
+// Generates a symmetric map from a seed, written as JSON in the layout of the
+// map files.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/kevindamm/wits-go/mapgen"
+)
+
+func main() {
+	defaults := mapgen.DefaultOptions()
+	seed := flag.Uint64("seed", 1,
+		"the seed of the random layout, the same seed generates the same map.")
+	symmetry := flag.String("symmetry", string(defaults.Symmetry),
+		"how the map maps onto itself: ROTATE, VERTICAL or HORIZONTAL.")
+	width := flag.Int("width", 0,
+		"the number of columns that the map is drawn within (13, or 11 if HORIZONTAL).")
+	height := flag.Int("height", 0,
+		"the number of rows that the map is drawn within (11, or 15 if HORIZONTAL).")
+	tiles := flag.Int("tiles", defaults.Tiles,
+		"the number of walkable tiles (including walls).")
+	walls := flag.Int("walls", defaults.Walls,
+		"the number of walls.")
+	spawns := flag.Int("spawns", defaults.Spawns,
+		"the number of spawns for each team.")
+	bonus := flag.Int("bonus", defaults.Bonus,
+		"the number of bonus tiles.")
+	minBase := flag.Int("min-base-distance", defaults.MinBaseDistance,
+		"the least distance between the bases.")
+	minSpawn := flag.Int("min-spawn-distance", defaults.MinSpawnDistance,
+		"the least distance from each spawn to the other team's base and spawns.")
+	mapID := flag.String("id", "",
+		"the map's ID, derived from the symmetry and seed if empty.")
+	name := flag.String("name", "",
+		"the map's name, derived from the symmetry and seed if empty.")
+	attempts := flag.Int("attempts", defaults.Attempts,
+		"the most layouts generated before giving up.")
+	outPath := flag.String("out", "",
+		"file where the map is written, stdout if empty.")
+	flag.Parse()
+
+	options := mapgen.DefaultOptionsFor(mapgen.Symmetry(strings.ToUpper(*symmetry)))
+	options.Seed = *seed
+	if *width > 0 {
+		options.Width = *width
+	}
+	if *height > 0 {
+		options.Height = *height
+	}
+	options.Tiles, options.Walls = *tiles, *walls
+	options.Spawns, options.Bonus = *spawns, *bonus
+	options.MinBaseDistance, options.MinSpawnDistance = *minBase, *minSpawn
+	options.MapID, options.Name = *mapID, *name
+	options.Attempts = *attempts
+
+	definition, err := mapgen.Generate(options)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(*outPath) == 0 {
+		os.Stdout.Write(definition.Format())
+		return
+	}
+	if err := os.WriteFile(*outPath, definition.Format(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/puzzles/main.go
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/kevindamm/wits-go/ingest"
+	"github.com/kevindamm/wits-go/puzzle"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func main() {
+	mapsDir := flag.String("maps", "maps",
+		"directory containing the map definitions (JSON).")
+	replayDir := flag.String("replays", "replays",
+		"directory containing the replays (JSON) to search for puzzles.")
+	outPath := flag.String("out", "puzzles.jsonl",
+		"file where the puzzles are written, one JSON object per line.")
+	defaults := puzzle.DefaultOptions()
+	maxActions := flag.Int("max-actions", defaults.MaxActions,
+		"the most actions a solution may take.")
+	minActions := flag.Int("min-actions", defaults.MinActions,
+		"the fewest actions a solution may take.")
+	maxPositions := flag.Int("max-positions", defaults.MaxPositions,
+		"the most positions searched for each goal, before giving up.")
+	flag.Parse()
+
+	maps, unreadable, err := witsjson.LoadMapLibrary(*mapsDir)
+	if err != nil {
+		log.Fatalf("failed loading map definitions: %v", err)
+	}
+	for _, err := range unreadable {
+		log.Printf("skipping map file: %v", err)
+	}
+	filenames, err := filepath.Glob(filepath.Join(*replayDir, "*.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	out, err := os.Create(*outPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+
+	options := puzzle.Options{
+		MaxActions:   *maxActions,
+		MinActions:   *minActions,
+		MaxPositions: *maxPositions,
+	}
+	count, skipped := 0, 0
+	for _, filename := range filenames {
+		replay, err := readReplay(filename)
+		if err != nil {
+			log.Printf("skipping %s: %v", filename, err)
+			skipped++
+			continue
+		}
+		initial, canonical, err := ingest.Prepare(maps, replay, state.LatestRules())
+		if err != nil {
+			log.Printf("skipping %s: %v", filename, err)
+			skipped++
+			continue
+		}
+		// Puzzles found before a replay's invalid turn are still kept.
+		puzzles, err := puzzle.FromReplay(initial, canonical.MatchReplay(), options)
+		if err != nil {
+			log.Printf("stopped early in %s: %v", filename, err)
+		}
+		for _, found := range puzzles {
+			found.GameID = string(canonical.GameID())
+			if err := encoder.Encode(found); err != nil {
+				log.Fatal(err)
+			}
+		}
+		count += len(puzzles)
+	}
+	fmt.Printf("%d puzzles from %d replays (%d skipped)\n",
+		count, len(filenames)-skipped, skipped)
+}
+
+func readReplay(filename string) (witsjson.GameReplayJSON, error) {
+	var replay witsjson.GameReplayJSON
+	encoded, err := os.ReadFile(filename)
+	if err != nil {
+		return replay, err
+	}
+	err = json.Unmarshal(encoded, &replay)
+	return replay, err
+}
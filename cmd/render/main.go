@@ -0,0 +1,126 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/render/main.go
+
+// Draws a map, or a position on it (in the notation of package notation), as
+// SVG.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/notation"
+	"github.com/kevindamm/wits-go/render"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func main() {
+	mapsDir := flag.String("maps", "maps",
+		"directory containing the map definitions (JSON).")
+	mapName := flag.String("map", "",
+		"the map to draw, by its short name (as in peekaboo).")
+	position := flag.String("position", "",
+		"the position to draw, in notation (instead of -map).")
+	outPath := flag.String("out", "",
+		"file where the SVG is written, stdout if empty.")
+	defaults := render.DefaultOptions()
+	tileSize := flag.Float64("size", defaults.TileSize,
+		"the radius of each hexagon, in pixels.")
+	fog := flag.String("fog", "",
+		"shade the tiles that this team (RED, BLUE, ...) cannot see.")
+	ranges := flag.String("ranges", "",
+		"shade the movement ranges of the units at these i:j coordinates (comma-separated).")
+	coords := flag.Bool("coords", false,
+		"label each tile with its coordinate.")
+	flag.Parse()
+
+	maps, skipped, err := witsjson.LoadMapLibrary(*mapsDir)
+	if err != nil {
+		log.Fatalf("failed loading map definitions: %v", err)
+	}
+	for _, err := range skipped {
+		log.Printf("skipping map file: %v", err)
+	}
+	options := render.Options{TileSize: *tileSize, Coords: *coords}
+	if len(*fog) > 0 {
+		if options.Fog = wits.FriendlyEnum(witsjson.ParseTeam(strings.ToUpper(*fog))); options.Fog == wits.FR_UNKNOWN {
+			log.Fatalf("unknown team %q", *fog)
+		}
+	}
+	if len(*ranges) > 0 {
+		for _, field := range strings.Split(*ranges, ",") {
+			coord, err := parseCoord(field)
+			if err != nil {
+				log.Fatal(err)
+			}
+			options.Ranges = append(options.Ranges, coord)
+		}
+	}
+
+	var out io.Writer = os.Stdout
+	if len(*outPath) > 0 {
+		file, err := os.Create(*outPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	switch {
+	case len(*position) > 0:
+		description, found := maps.Find(notation.MapID(*position), "")
+		if !found {
+			log.Fatalf("map %s not found", notation.MapID(*position))
+		}
+		game, err := notation.Parse(*position, maps)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = render.New(description, options).Game(out, game)
+		if err != nil {
+			log.Fatal(err)
+		}
+	case len(*mapName) > 0:
+		description, found := maps[*mapName]
+		if !found {
+			log.Fatalf("map %s not found", *mapName)
+		}
+		if err := render.New(description, options).Map(out); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, "either -map or -position is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func parseCoord(field string) (witsjson.HexCoordJSON, error) {
+	i, j, found := strings.Cut(strings.TrimSpace(field), ":")
+	ival, ierr := strconv.Atoi(i)
+	jval, jerr := strconv.Atoi(j)
+	if !found || ierr != nil || jerr != nil {
+		return witsjson.HexCoordJSON{}, fmt.Errorf("invalid coordinate %q, expected i:j", field)
+	}
+	return witsjson.NewHexCoord(ival, jval), nil
+}
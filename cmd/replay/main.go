@@ -0,0 +1,276 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/replay/main.go
+
+// Steps through a replay in the terminal, drawing the board as text after
+// each action.  The replay is simulated up front, if one of its actions cannot
+// be resolved or is not legal, the replay can be viewed up to that point and
+// where it diverged is reported.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ingest"
+	"github.com/kevindamm/wits-go/render"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+const USAGE = `commands:
+  n, enter  next action         p  previous action
+  ]         next turn           [  previous turn
+  g N       go to turn N        e  go to the end
+  h         this help           q  quit
+`
+
+func main() {
+	mapsDir := flag.String("maps", "maps",
+		"directory containing the map definitions (JSON).")
+	startTurn := flag.Int("turn", 1,
+		"the turn to begin viewing from.")
+	fog := flag.String("fog", "",
+		"hide what this team (RED, BLUE, ...) cannot see.")
+	printAll := flag.Bool("print", false,
+		"print the board after each turn and exit, instead of stepping interactively.")
+	version := flag.Int("version", state.LATEST_VERSION,
+		"the runtime version the replay was played on, it is simulated under its rules.")
+	variant := flag.String("rules", "",
+		"a JSON file of the custom variant of the rules the replay was played under.")
+	versionsDir := flag.String("versions", "",
+		"directory of the rules (JSON) of earlier runtime versions; optional.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] <replay.json>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	maps, skipped, err := witsjson.LoadMapLibrary(*mapsDir)
+	if err != nil {
+		log.Fatalf("failed loading map definitions: %v", err)
+	}
+	for _, err := range skipped {
+		log.Printf("skipping map file: %v", err)
+	}
+	replay, err := readReplay(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	description, found := maps.Find(replay.MapID(), replay.MapName())
+	if !found {
+		log.Fatalf("map not found (id %q, name %q)", replay.MapID(), replay.MapName())
+	}
+	if len(*versionsDir) > 0 {
+		if err := state.LoadVersions(*versionsDir); err != nil {
+			log.Fatal(err)
+		}
+	}
+	rules, err := state.RulesForVersion(*version)
+	if len(*variant) > 0 {
+		rules, err = state.ReadVariantFile(*variant)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	initial, canonical, err := ingest.Setup(maps, replay, rules)
+	if err != nil {
+		log.Fatal(err)
+	}
+	options := render.DefaultOptions()
+	if len(*fog) > 0 {
+		if options.Fog = wits.FriendlyEnum(witsjson.ParseTeam(strings.ToUpper(*fog))); options.Fog == wits.FR_UNKNOWN {
+			log.Fatalf("unknown team %q", *fog)
+		}
+	}
+
+	viewer := &viewer{
+		renderer: render.New(description, options),
+		out:      bufio.NewWriter(os.Stdout),
+	}
+	viewer.simulate(initial, canonical)
+	defer viewer.out.Flush()
+	if *printAll {
+		viewer.printTurns()
+		return
+	}
+	viewer.goTo(*startTurn)
+	viewer.interact(os.Stdin)
+}
+
+func readReplay(filename string) (witsjson.GameReplayJSON, error) {
+	var replay witsjson.GameReplayJSON
+	encoded, err := os.ReadFile(filename)
+	if err != nil {
+		return replay, err
+	}
+	err = json.Unmarshal(encoded, &replay)
+	return replay, err
+}
+
+// The state after some of a turn's actions (none at the start of the turn).
+type frame struct {
+	turn    int // index into the replay's turns
+	actions int // how many of the turn's actions have been played
+	action  string
+	game    *state.GameState
+}
+
+type viewer struct {
+	renderer *render.Renderer
+	out      *bufio.Writer
+	frames   []frame
+	current  int
+
+	// Describes where the replay diverged from the simulation, if it did.
+	diverged string
+}
+
+// Plays the replay's turns, keeping the state after every action, until the
+// end of the replay or an action that cannot be played.
+func (viewer *viewer) simulate(initial *state.GameState, replay witsjson.GameReplayJSON) {
+	game := initial.Clone()
+	gamemap := game.Map()
+	for turn, played := range replay.Turns_ {
+		if game.IsOver() {
+			viewer.diverged = fmt.Sprintf("turn %d was played after the match ended", played.Turn_)
+			return
+		}
+		viewer.frames = append(viewer.frames, frame{turn, 0, "", game.Clone()})
+		for i, action := range played.Actions_ {
+			resolved, err := gamemap.Resolve(action)
+			if err != nil {
+				viewer.diverged = fmt.Sprintf("turn %d action %d %s: %s", played.Turn_, i+1, action.RelVarEncoding(), err)
+				return
+			}
+			if resolved.IsPass() {
+				break
+			}
+			if err := game.Apply(resolved); err != nil {
+				viewer.diverged = fmt.Sprintf("turn %d action %d %s: %s", played.Turn_, i+1, action.RelVarEncoding(), err)
+				return
+			}
+			viewer.frames = append(viewer.frames, frame{turn, i + 1, action.RelVarEncoding(), game.Clone()})
+		}
+		game.EndTurn()
+	}
+	viewer.frames = append(viewer.frames, frame{len(replay.Turns_), 0, "", game})
+
+	result := replay.MatchResult()
+	if game.IsOver() && result != wits.STATUS_UNKNOWN && result != game.Result() {
+		viewer.diverged = fmt.Sprintf("the replay's result is %d but the simulation's is %d", result, game.Result())
+	}
+}
+
+func (viewer *viewer) show() {
+	frame := viewer.frames[viewer.current]
+	switch {
+	case frame.actions > 0:
+		fmt.Fprintf(viewer.out, "\naction %d: %s\n", frame.actions, frame.action)
+	case viewer.current == len(viewer.frames)-1:
+		fmt.Fprintf(viewer.out, "\nend of the replay\n")
+	default:
+		fmt.Fprintf(viewer.out, "\nstart of turn %d\n", frame.game.Turn())
+	}
+	if err := viewer.renderer.Text(viewer.out, frame.game); err != nil {
+		fmt.Fprintln(viewer.out, err)
+	}
+	if viewer.current == len(viewer.frames)-1 && len(viewer.diverged) > 0 {
+		fmt.Fprintf(viewer.out, "the replay diverges after this: %s\n", viewer.diverged)
+	}
+	viewer.out.Flush()
+}
+
+// Prints the state at the end of each turn.
+func (viewer *viewer) printTurns() {
+	for i := range viewer.frames {
+		if i+1 == len(viewer.frames) || viewer.frames[i+1].actions == 0 {
+			viewer.current = i
+			viewer.show()
+		}
+	}
+}
+
+// Moves to the start of the (1-based) turn, or the last frame if the replay
+// ends before then.
+func (viewer *viewer) goTo(turn int) {
+	viewer.current = len(viewer.frames) - 1
+	for i, frame := range viewer.frames {
+		if frame.turn >= turn-1 {
+			viewer.current = i
+			return
+		}
+	}
+}
+
+func (viewer *viewer) interact(in io.Reader) {
+	viewer.show()
+	fmt.Fprint(viewer.out, "> ")
+	viewer.out.Flush()
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		command := "n"
+		if len(fields) > 0 {
+			command = fields[0]
+		}
+		last := len(viewer.frames) - 1
+		switch command {
+		case "n":
+			viewer.current = min(viewer.current+1, last)
+		case "p":
+			viewer.current = max(viewer.current-1, 0)
+		case "]":
+			viewer.goTo(viewer.frames[viewer.current].turn + 2)
+		case "[":
+			turn := viewer.frames[viewer.current].turn
+			if viewer.frames[viewer.current].actions == 0 {
+				turn -= 1
+			}
+			viewer.goTo(max(turn, 0) + 1)
+		case "g":
+			turn, err := strconv.Atoi(strings.Join(fields[1:], ""))
+			if err != nil {
+				fmt.Fprintln(viewer.out, "expected a turn number, as in: g 12")
+				break
+			}
+			viewer.goTo(turn)
+		case "e":
+			viewer.current = last
+		case "q":
+			return
+		default:
+			fmt.Fprint(viewer.out, USAGE)
+			fmt.Fprint(viewer.out, "> ")
+			viewer.out.Flush()
+			continue
+		}
+		viewer.show()
+		fmt.Fprint(viewer.out, "> ")
+		viewer.out.Flush()
+	}
+}
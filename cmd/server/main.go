@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/server/main.go
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"entgo.io/ent/dialect"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/server"
+	"github.com/kevindamm/wits-go/witsjson"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080",
+		"the address (host:port) that the server listens on.")
+	dbPath := flag.String("db", "file:wits.db?_fk=1",
+		"data source name for the SQLite database.")
+	mapsDir := flag.String("maps", "maps",
+		"directory containing the map definitions (JSON).")
+	replayDir := flag.String("replays", "",
+		"directory containing replays (JSON) named by match hash; optional.")
+	rankedSeason := flag.Int("season", 0,
+		"the ranked season that live games are recorded in; zero if unranked.")
+	flag.Parse()
+
+	client, err := ent.Open(dialect.SQLite, *dbPath)
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+
+	// Run the automatic migration tool to create all schema resources.
+	if err := client.Schema.Create(context.Background()); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+
+	maps, skipped, err := witsjson.LoadMapLibrary(*mapsDir)
+	if err != nil {
+		log.Fatalf("failed loading map definitions: %v", err)
+	}
+	for _, err := range skipped {
+		log.Printf("skipping map file: %v", err)
+	}
+
+	service := server.New(client, maps, *replayDir)
+	service.Season = int8(*rankedSeason)
+	router := service.Router()
+	if err := router.Run(*addr); err != nil {
+		log.Fatal(err)
+	}
+}
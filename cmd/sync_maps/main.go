@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/sync_maps/main.go
+
+// Stores every map definition (with its metadata and thumbnail) in the DB,
+// reporting the maps that have changed since matches were played on them.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"github.com/kevindamm/wits-go/archive"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/render"
+	"github.com/kevindamm/wits-go/witsjson"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func main() {
+	dbPath := flag.String("db", "file:wits.db?_fk=1",
+		"data source name for the SQLite database.")
+	mapsDir := flag.String("maps", "maps",
+		"directory containing the map definitions (JSON), searched recursively.")
+	flag.Parse()
+
+	client, err := ent.Open(dialect.SQLite, *dbPath)
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+
+	err = filepath.WalkDir(*mapsDir,
+		func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !strings.HasSuffix(path, ".json") {
+				return nil
+			}
+			gamemap, err := witsjson.ReadMapFile(path)
+			if err != nil {
+				log.Printf("skipping map file: %v", err)
+				return nil
+			}
+			thumbnail, err := render.Thumbnail(gamemap)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			sync, err := archive.SyncMap(ctx, client, gamemap.Definition(), thumbnail)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			switch {
+			case sync.Created:
+				fmt.Printf("added   %s\n", sync.Map.Shortname)
+			case sync.Changed:
+				fmt.Printf("changed %s, %d matches were played on another version\n",
+					sync.Map.Shortname, sync.Matches)
+			default:
+				fmt.Printf("synced  %s\n", sync.Map.Shortname)
+			}
+			return nil
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/tournament/main.go
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/eval"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/tournament"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func main() {
+	bots := flag.String("bots", "random,greedy,mcts",
+		"comma-separated entrants, each a bot name optionally followed by\n"+
+			"@weights.json for the weights of its evaluator (see cmd/tune).")
+	format := flag.String("format", string(tournament.ROUND_ROBIN),
+		"the tournament format, round-robin or swiss.")
+	rounds := flag.Int("rounds", 5,
+		"the number of rounds of a swiss tournament.")
+	mapsDir := flag.String("maps", "maps/solo",
+		"directory containing the map definitions (JSON).")
+	mapNames := flag.String("map", "",
+		"comma-separated short names of the maps to play on; all maps if empty.")
+	raceNames := flag.String("races", "",
+		"comma-separated races to choose from; all races if empty.")
+	seed := flag.Uint64("seed", 1,
+		"seeds the pairings, races and bots.")
+	budget := flag.Duration("budget", time.Second,
+		"the time limit for each turn.")
+	maxTurns := flag.Uint("max-turns", 100,
+		"games still going after this many turns are a delay of game (a draw).")
+	iterations := flag.Int("mcts-iterations", 0,
+		"limits the MCTS search iterations per turn, for reproducible games.")
+	evaluations := flag.Int("greedy-evaluations", 0,
+		"limits the positions the greedy bot evaluates per turn, for reproducible games.")
+	replayDir := flag.String("replays", "",
+		"directory where the replay of each game is written; optional.")
+	flag.Parse()
+
+	entrants := make([]tournament.Entrant, 0)
+	for _, spec := range strings.Split(*bots, ",") {
+		entrant, err := newEntrant(strings.TrimSpace(spec), *iterations, *evaluations)
+		if err != nil {
+			log.Fatal(err)
+		}
+		entrants = append(entrants, entrant)
+	}
+
+	library, skipped, err := witsjson.LoadMapLibrary(*mapsDir)
+	if err != nil {
+		log.Fatalf("failed loading map definitions: %v", err)
+	}
+	for _, err := range skipped {
+		log.Printf("skipping map file: %v", err)
+	}
+	names := make([]string, 0, len(library))
+	if len(*mapNames) > 0 {
+		names = strings.Split(*mapNames, ",")
+	} else {
+		for name := range library {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+	}
+	maps := make([]*state.GameMap, 0, len(names))
+	for _, name := range names {
+		definition, found := library[strings.TrimSpace(name)]
+		if !found {
+			log.Fatalf("map %q not found in %s", name, *mapsDir)
+		}
+		gamemap := state.NewGameMap(definition)
+		maps = append(maps, &gamemap)
+	}
+
+	races, err := parseRaces(*raceNames)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(*replayDir) > 0 {
+		if err := os.MkdirAll(*replayDir, 0755); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	event, err := tournament.New(tournament.Config{
+		Format:    tournament.Format(*format),
+		Maps:      maps,
+		Races:     races,
+		Rounds:    *rounds,
+		Seed:      *seed,
+		Budget:    *budget,
+		MaxTurns:  *maxTurns,
+		ReplayDir: *replayDir,
+	}, entrants)
+	if err != nil {
+		log.Fatal(err)
+	}
+	event.OnGame = func(game tournament.Game) {
+		fmt.Printf("round %d  %-12s %s vs %s  %g-%g in %d turns\n",
+			game.Round, game.Map.MapName(),
+			entrants[game.Players[0]].Name, entrants[game.Players[1]].Name,
+			game.Score(), 1-game.Score(), game.Turns)
+	}
+	if err := event.Run(); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println()
+	if err := event.CrossTable().Write(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Parses an entrant's name and, for the bots that evaluate positions, the
+// file that their evaluator's weights are loaded from.  The search limits
+// apply to the bots of their kind, unlimited if zero.
+func newEntrant(spec string, iterations int, evaluations int) (tournament.Entrant, error) {
+	name, weightsFile, _ := strings.Cut(spec, "@")
+	if _, err := bot.ByName(name, 0); err != nil {
+		return tournament.Entrant{}, err
+	}
+	evaluator := eval.Default()
+	if len(weightsFile) > 0 {
+		weights, err := eval.LoadWeights(weightsFile)
+		if err != nil {
+			return tournament.Entrant{}, err
+		}
+		evaluator = eval.Evaluator{Weights: weights}
+	}
+
+	return tournament.Entrant{
+		Name: spec,
+		New: func(seed uint64) bot.Bot {
+			created, _ := bot.ByName(name, seed)
+			switch created := created.(type) {
+			case *bot.GreedyBot:
+				created.Evaluator = evaluator
+				created.Evaluations = evaluations
+			case *bot.MCTSBot:
+				created.Evaluator = evaluator
+				created.Iterations = iterations
+			}
+			return created
+		},
+	}, nil
+}
+
+func parseRaces(names string) ([]wits.UnitRaceEnum, error) {
+	races := make([]wits.UnitRaceEnum, 0)
+	if len(names) == 0 {
+		return races, nil
+	}
+	for _, name := range strings.Split(names, ",") {
+		found := false
+		for race := wits.RACE_FEEDBACK; race <= wits.RACE_VEGGIENAUTS; race++ {
+			if strings.EqualFold(race.String(), strings.TrimSpace(name)) {
+				races = append(races, race)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown race %q", name)
+		}
+	}
+	return races, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/tune/main.go
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/kevindamm/wits-go/eval"
+	"github.com/kevindamm/wits-go/ingest"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func main() {
+	mapsDir := flag.String("maps", "maps",
+		"directory containing the map definitions (JSON).")
+	replayDir := flag.String("replays", "replays",
+		"directory containing the replays (JSON) to fit the weights to.")
+	outPath := flag.String("out", "weights.json",
+		"file where the fitted weights are written.")
+	defaults := eval.DefaultTuneOptions()
+	iterations := flag.Int("iterations", defaults.Iterations,
+		"the number of gradient descent iterations.")
+	rate := flag.Float64("rate", defaults.LearningRate,
+		"the gradient descent learning rate.")
+	regularization := flag.Float64("l2", defaults.Regularization,
+		"the L2 regularization of the weights.")
+	flag.Parse()
+
+	maps, unreadable, err := witsjson.LoadMapLibrary(*mapsDir)
+	if err != nil {
+		log.Fatalf("failed loading map definitions: %v", err)
+	}
+	for _, err := range unreadable {
+		log.Printf("skipping map file: %v", err)
+	}
+	filenames, err := filepath.Glob(filepath.Join(*replayDir, "*.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	samples := make([]eval.Sample, 0)
+	skipped := 0
+	for _, filename := range filenames {
+		replay, err := readReplay(filename)
+		if err != nil {
+			log.Printf("skipping %s: %v", filename, err)
+			skipped++
+			continue
+		}
+		initial, canonical, err := ingest.Prepare(maps, replay, state.LatestRules())
+		if err != nil {
+			log.Printf("skipping %s: %v", filename, err)
+			skipped++
+			continue
+		}
+		more, err := eval.Samples(initial, canonical.MatchReplay(), canonical.MatchResult())
+		if err != nil {
+			log.Printf("skipping %s: %v", filename, err)
+			skipped++
+			continue
+		}
+		samples = append(samples, more...)
+	}
+	if len(samples) == 0 {
+		log.Fatalf("no samples found in %d replays", len(filenames))
+	}
+
+	weights, loss := eval.Tune(samples, eval.TuneOptions{
+		Iterations:     *iterations,
+		LearningRate:   *rate,
+		Regularization: *regularization,
+	})
+	if err := weights.WriteJSON(*outPath); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%d samples from %d replays (%d skipped), log-loss %.4f\n",
+		len(samples), len(filenames)-skipped, skipped, loss)
+}
+
+func readReplay(filename string) (witsjson.GameReplayJSON, error) {
+	var replay witsjson.GameReplayJSON
+	encoded, err := os.ReadFile(filename)
+	if err != nil {
+		return replay, err
+	}
+	err = json.Unmarshal(encoded, &replay)
+	return replay, err
+}
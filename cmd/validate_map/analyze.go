@@ -0,0 +1,136 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/cmd/validate_map/analyze.go
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kevindamm/wits-go/balance"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Reports the balance metrics of each map, optionally with the win rates of
+// simulated games.
+func analyze(args []string) {
+	flags := flag.NewFlagSet("analyze", flag.ExitOnError)
+	asJSON := flags.Bool("json", false,
+		"write the reports as JSON, one object per line.")
+	defaults := balance.DefaultSimulationOptions()
+	games := flags.Int("games", 0,
+		"the number of games to simulate on each map, none if 0.")
+	botName := flags.String("bot", "greedy",
+		fmt.Sprintf("the bot that plays every seat of the simulated games (%s).",
+			strings.Join(bot.NAMES, ", ")))
+	budget := flags.Duration("budget", defaults.Budget,
+		"the time that the bot is given for each turn.")
+	maxTurns := flags.Uint("max-turns", defaults.MaxTurns,
+		"simulated games still going after this many turns are draws.")
+	seed := flags.Uint64("seed", defaults.Seed,
+		"the seed of the bots' random choices.")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if _, err := bot.ByName(*botName, 0); err != nil {
+		fmt.Println(err)
+		os.Exit(2)
+	}
+	options := balance.SimulationOptions{
+		Bot: func(seed uint64) bot.Bot {
+			player, _ := bot.ByName(*botName, seed)
+			return player
+		},
+		Games:    *games,
+		Seed:     *seed,
+		Budget:   *budget,
+		MaxTurns: *maxTurns,
+	}
+
+	failed := 0
+	for _, filename := range mapPaths(flags.Arg(0), false) {
+		report, err := analyzeMap(filename, options)
+		if err != nil {
+			fmt.Printf("error analyzing map %s\n", filename)
+			fmt.Println(err)
+			failed++
+			continue
+		}
+		if *asJSON {
+			encoded, _ := json.Marshal(report)
+			fmt.Println(string(encoded))
+		} else {
+			printReport(os.Stdout, report)
+		}
+	}
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func analyzeMap(filename string, options balance.SimulationOptions) (*balance.Report, error) {
+	description, err := witsjson.ReadMapFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	report, err := balance.Analyze(description)
+	if err != nil || options.Games == 0 {
+		return report, err
+	}
+	gamemap := state.NewGameMap(description)
+	report.WinRates, err = balance.Simulate(&gamemap, options)
+	return report, err
+}
+
+func printReport(w io.Writer, report *balance.Report) {
+	fmt.Fprintln(w, report.MapID)
+	for _, team := range report.Teams {
+		fmt.Fprintf(w, "  %-6s spawn to base %v, to bonus %v\n",
+			team.Team, team.SpawnToBase, team.SpawnToBonus)
+	}
+	for _, side := range report.Sides {
+		fmt.Fprintf(w, "  %-6s side reaches %d tiles first\n", side.Side, side.Territory)
+	}
+	fmt.Fprintf(w, "  %d contested tiles, %d chokepoints %v\n",
+		report.Contested, len(report.Chokepoints), report.Chokepoints)
+
+	asymmetry := report.Asymmetry
+	if asymmetry.IsBalanced() {
+		fmt.Fprintln(w, "  balanced")
+	} else {
+		fmt.Fprintf(w, "  asymmetry: spawn to base %d, to bonus %d, territory %d\n",
+			asymmetry.SpawnToBase, asymmetry.SpawnToBonus, asymmetry.Territory)
+		if len(asymmetry.Unmatched) > 0 {
+			fmt.Fprintf(w, "  not matched by the map's symmetry: %v\n", asymmetry.Unmatched)
+		}
+	}
+
+	if rates := report.WinRates; rates != nil {
+		fmt.Fprintf(w, "  %s vs %s, %d games:", rates.Bot, rates.Bot, rates.Games)
+		for _, team := range rates.Teams {
+			fmt.Fprintf(w, " %s %.0f%%", team.Team, 100*team.WinRate)
+		}
+		fmt.Fprintf(w, ", %d draws\n", rates.Draws)
+	}
+}
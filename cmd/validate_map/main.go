@@ -24,140 +24,78 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
 	"github.com/kevindamm/wits-go/witsjson"
 )
 
 func main() {
-	// Expects a single argument, the path to the map to open and analyze.
+	// Expects a single argument, the path to the map (or directory of maps) to
+	// open and validate.
 	debug := flag.Bool("debug", false,
 		"set this flag to see more detail printed to the console.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(),
+			"usage: %s [flags] <map.json or directory>\n"+
+				"       %s analyze [flags] <map.json or directory>\n", os.Args[0], os.Args[0])
+		flag.PrintDefaults()
+	}
 	flag.Parse()
-
-	filename := flag.Args()[0]
-	fileInfo, err := os.Stat(filename)
-	if err != nil {
-		fmt.Println(err)
+	if flag.NArg() > 0 && flag.Arg(0) == "analyze" {
+		analyze(flag.Args()[1:])
 		return
 	}
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
 
-	// IsDir is short for fileInfo.Mode().IsDir()
-	if fileInfo.IsDir() {
-		if *debug {
-			fmt.Printf("parsing maps in directory %s\n", filename)
-		}
-		for filename := range mapFiles(filename) {
-			if *debug {
-				fmt.Printf("parsing map %s\n", filename)
-			}
-			//if _, err := readAndValidateGameMap(filename); err != nil {
-			//	fmt.Printf("error loading map %s\n", filename)
-			//	fmt.Println(err)
-			//}
-		}
-	} else {
-		if *debug {
-			fmt.Printf("parsing map %s\n", filename)
+	invalid := 0
+	for _, filename := range mapPaths(flag.Arg(0), *debug) {
+		if !readAndValidateGameMap(filename, *debug) {
+			invalid++
 		}
-		//if _, err := readAndValidateGameMap(filename); err != nil {
-		//	fmt.Printf("error loading map %s\n", filename)
-		//	fmt.Println(err)
-		//}
+	}
+	if invalid > 0 {
+		os.Exit(1)
 	}
 }
 
-//func readAndValidateGameMap(filename string) (state.GameMap, error) {
-//	filedata, err := os.ReadFile(filename)
-//	if err != nil {
-//		return state.GameMap{}, err
-//	}
-//
-//	defer func() {
-//		if err := recover(); err != nil {
-//			fmt.Printf("error when reading map file %s\n", filename)
-//			fmt.Println(err)
-//		}
-//	}()
-//
-//	var gamemap witsjson.GameMapJSON
-//	if err = json.Unmarshal(filedata, &gamemap); err != nil {
-//		fmt.Println(err)
-//		return state.GameMap{}, err
-//	}
-//
-//	// Ensure no two tiles are located at the same coordinate.
-//	if err := checkExclusivity(gamemap.Terrain()); err != nil {
-//		fmt.Println(err)
-//		return state.GameMap{}, err
-//	}
-//
-//	return state.NewGameMap(gamemap), nil
-//}
-
-func checkExclusivity(terrain wits.TerrainDefinition) error {
-	size := len(terrain.Floor()) + len(terrain.Wall()) + len(terrain.Bonus()) + len(terrain.Spawn()) + len(terrain.Base())
-	positions := make(map[int16]wits.TileDefinition, size)
-	coord := func(hx wits.HexCoord) int16 {
-		// This is a bit of a cheat but we know that no coordinate value exceeds 12.  It'll fit with plenty of room.
-		// THIS WOULDN'T SCALE TO ARBITRARY USER COORDINATES AS THE REST OF THE SYSTEM EASILY WOULD.  do it better if it comes to that.
-		return int16((hx.I() << 8) + hx.J())
+// The map files at the path, which may be a single map or a directory of them.
+func mapPaths(path string, debug bool) []string {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
 	}
-
-	for _, floor := range terrain.Floor() {
-		hex := coord(floor.Position())
-		if positions[hex] != nil {
-			fmt.Printf("Repeated floor position: [%d, %d]\n", floor.Position().I(), floor.Position().J())
-			return fmt.Errorf("coordinate repeat [%d, %d]", floor.Position().I(), floor.Position().J())
-		}
-		positions[coord(floor.Position())] = floor
+	// IsDir is short for fileInfo.Mode().IsDir()
+	if !fileInfo.IsDir() {
+		return []string{path}
 	}
-	for _, wall := range terrain.Wall() {
-		hex := coord(wall.Position())
-		if positions[hex] != nil {
-			fmt.Printf("Coordinate [%d, %d] repeated betewen %s, %s",
-				wall.Position().I(), wall.Position().J(), positions[hex].Typename(), wall.Typename())
-			return fmt.Errorf("coordinate repeat [%d, %d]", wall.Position().I(), wall.Position().J())
-		}
-		positions[coord(wall.Position())] = wall
+	if debug {
+		fmt.Printf("parsing maps in directory %s\n", path)
 	}
-	for _, bonus := range terrain.Bonus() {
-		hex := coord(bonus.Position())
-		if positions[hex] != nil {
-			fmt.Printf("Coordinate [%d, %d] repeated betewen %s, %s",
-				bonus.Position().I(), bonus.Position().J(), positions[hex].Typename(), bonus.Typename())
-			return fmt.Errorf("coordinate repeat [%d, %d]", bonus.Position().I(), bonus.Position().J())
-		}
-		positions[coord(bonus.Position())] = bonus
+	paths := make([]string, 0)
+	for filename := range mapFiles(path) {
+		paths = append(paths, filename)
 	}
-	for _, spawn := range terrain.Spawn() {
-		hex := coord(spawn.Position())
-		if positions[hex] != nil {
-			fmt.Printf("Coordinate [%d, %d] repeated betewen %s, %s",
-				spawn.Position().I(), spawn.Position().J(), positions[hex].Typename(), spawn.Typename())
-			return fmt.Errorf("coordinate repeat [%d, %d]", spawn.Position().I(), spawn.Position().J())
-		}
-		positions[coord(spawn.Position())] = spawn
+	return paths
+}
+
+// Prints the problems found with the map, returning true if there were none.
+func readAndValidateGameMap(filename string, debug bool) bool {
+	if debug {
+		fmt.Printf("parsing map %s\n", filename)
 	}
-	for _, base := range terrain.Base() {
-		hex := coord(base.Position())
-		if positions[hex] != nil {
-			fmt.Printf("Coordinate [%d, %d] repeated betewen %s, %s",
-				base.Position().I(), base.Position().J(), positions[hex].Typename(), base.Typename())
-			return fmt.Errorf("coordinate repeat [%d, %d]", base.Position().I(), base.Position().J())
-		}
-		// Also check six coordinates surrounding base.
-		for _, neighbor := range listSurroundingPositions(base.Position().I(), base.Position().J()) {
-			hex := coord(neighbor)
-			if positions[hex] != nil {
-				fmt.Printf("Coordinate [%d, %d] repeated betewen %s, %s",
-					neighbor.I(), neighbor.J(), positions[hex].Typename(), base.Typename())
-				return fmt.Errorf("coordinate collides with base [%d, %d]", neighbor.I(), neighbor.J())
-			}
-		}
-		positions[coord(base.Position())] = base
+	gamemap, err := witsjson.ReadMapFile(filename)
+	if err == nil {
+		err = state.ValidateMap(gamemap)
 	}
-
-	return nil
+	if err != nil {
+		fmt.Printf("error loading map %s\n", filename)
+		fmt.Println(err)
+		return false
+	}
+	return true
 }
 
 func mapFiles(dirName string) <-chan string {
@@ -186,28 +124,3 @@ func mapFiles(dirName string) <-chan string {
 	}()
 	return fpathchan
 }
-
-func listSurroundingPositions(i, j int) []wits.HexCoord {
-	surrounding := make([]wits.HexCoord, 0)
-
-	neighborTable := [][]int{
-		{-1, -1 + (i % 2)},
-		{-1, 0 + (i % 2)},
-		{0, -1},
-		{0, 1},
-		{1, -1 + (i % 2)},
-		{1, 0 + (i % 2)},
-	}
-
-	for k := 0; k < 6; k += 1 {
-		surrnext := witsjson.NewHexCoord(
-			i+neighborTable[k][0],
-			j+neighborTable[k][1])
-		// Only positive coordinates are valid in the legacy coordinate system.
-		if surrnext.I() < 0 || surrnext.J() < 0 {
-			continue
-		}
-		surrounding = append(surrounding, surrnext)
-	}
-	return surrounding
-}
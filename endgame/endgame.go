@@ -0,0 +1,429 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/endgame/endgame.go
+
+// Package endgame solves positions with few units exactly, by searching every
+// way each turn can be played (alpha-beta over whole turns), and keeps the
+// solutions in a tablebase.
+package endgame
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/eval"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+type Outcome byte
+
+const (
+	UNKNOWN Outcome = iota
+	WIN
+	LOSS
+	DRAW
+)
+
+func (outcome Outcome) String() string {
+	switch outcome {
+	case WIN:
+		return "win"
+	case LOSS:
+		return "loss"
+	case DRAW:
+		return "draw"
+	}
+	return "unknown"
+}
+
+// The solution of a position for the side to move, with the turn to play.  A
+// win or loss is in Depth turns (counting the current turn as the first, and
+// the opponent's turns as well), a draw is when neither side can force a
+// result within the Depth turns searched.  When losing, the turn delays the
+// loss for as long as possible.
+type Result struct {
+	Entry
+	Turn []state.Action
+}
+
+type Config struct {
+	// Positions with more units than this are not solved.
+	MaxUnits int
+
+	// The most turns searched, positions without a result within this many
+	// turns are a DRAW.
+	MaxTurns int
+
+	// The search is abandoned after visiting this many positions (including
+	// those within a turn), unlimited if zero.
+	MaxNodes int
+
+	// Orders the turns, so that the best are searched first.
+	Evaluator eval.Evaluator
+}
+
+func DefaultConfig() Config {
+	return Config{
+		MaxUnits:  4,
+		MaxTurns:  4,
+		MaxNodes:  2_000_000,
+		Evaluator: eval.Default(),
+	}
+}
+
+var (
+	ErrTooManyUnits = errors.New("too many units to solve the position")
+	ErrBudget       = errors.New("search exceeded its budget")
+)
+
+type Solver struct {
+	Config
+	Table *Table
+}
+
+// Creates a solver that stores its solutions in the table, or in a new table
+// if it is nil.
+func NewSolver(config Config, table *Table) *Solver {
+	if table == nil {
+		table = NewTable()
+	}
+	return &Solver{config, table}
+}
+
+// Scores are relative to the side to move, a win (or loss) in the nth turn from
+// the root of the search is WIN_SCORE-n (or n-WIN_SCORE).  Any score beyond the
+// bound is a win or loss.
+const (
+	WIN_SCORE     = 1000
+	WIN_BOUND     = WIN_SCORE - 256
+	infiniteScore = math.MaxInt32
+)
+
+// Solves the position for the side to move, searching deeper (one turn at a
+// time) until a result is found or MaxTurns have been searched.  The search
+// stops at the deadline, if it is not zero.  When the budget runs out the
+// result of the deepest complete search is returned with ErrBudget (which is
+// UNKNOWN if not even one turn was searched).
+//
+// Only two-player matches are solved.  The position is taken as it is, a
+// position seen through the fog of war is solved as if no units were hidden.
+func (solver *Solver) Solve(game *state.GameState, deadline time.Time) (Result, error) {
+	if game.IsOver() {
+		return Result{}, errors.New("the match is over")
+	}
+	if game.PlayerCount() != 2 {
+		return Result{}, fmt.Errorf("only two-player matches are solved, not %d", game.PlayerCount())
+	}
+	units := 0
+	for index := range game.Map().TileCount() {
+		if !game.UnitAt(wits.HexCoordIndex(index)).IsEmpty() {
+			units++
+		}
+	}
+	if units > solver.MaxUnits {
+		return Result{}, ErrTooManyUnits
+	}
+
+	search := &search{
+		Solver:   solver,
+		deadline: deadline,
+		memo:     make(map[memoKey]memoEntry),
+	}
+	turns := search.turns(game, true)
+	if search.aborted {
+		return Result{}, ErrBudget
+	}
+	result := Result{}
+	for depth := 1; depth <= solver.MaxTurns; depth++ {
+		best, score := search.root(turns, depth)
+		if search.aborted {
+			return result, ErrBudget
+		}
+		// Searching the best turn first makes the next iteration cheaper.
+		turns[0], turns[best] = turns[best], turns[0]
+		result = Result{entryFor(score, depth), turns[0].actions}
+		if result.Outcome != DRAW {
+			break
+		}
+	}
+	solver.Table.Store(Hash(game), result.Entry)
+	return result, nil
+}
+
+// The solution for a score that is relative to the position it was found in,
+// searching this many turns deep.
+func entryFor(score, depth int) Entry {
+	switch {
+	case score > WIN_BOUND:
+		return Entry{WIN, uint8(WIN_SCORE - score)}
+	case score < -WIN_BOUND:
+		return Entry{LOSS, uint8(WIN_SCORE + score)}
+	}
+	return Entry{DRAW, uint8(depth)}
+}
+
+// The score of a solution from the tablebase, found this many turns from the
+// root, if it is known for a search of this depth.
+func (entry Entry) score(ply, depth int) (int, bool) {
+	switch entry.Outcome {
+	case WIN:
+		return WIN_SCORE - ply - int(entry.Depth), true
+	case LOSS:
+		return ply + int(entry.Depth) - WIN_SCORE, true
+	case DRAW:
+		return 0, depth <= int(entry.Depth)
+	}
+	return 0, false
+}
+
+type search struct {
+	*Solver
+	deadline time.Time
+	nodes    int
+	aborted  bool
+	memo     map[memoKey]memoEntry
+}
+
+// Transpositions within the search, keyed by the depth they were searched to
+// as well as the position.  Scores are relative to the position.
+type memoKey struct {
+	hash  uint64
+	depth int
+}
+
+type memoEntry struct {
+	score int
+	bound bound
+}
+
+type bound byte
+
+const (
+	EXACT bound = iota
+	LOWER
+	UPPER
+)
+
+// One of the ways that the turn can be played, and the position it leads to.
+type turn struct {
+	actions []state.Action
+	next    *state.GameState
+	order   float64
+}
+
+// Searches the turns of the root position, returning the index of the best
+// turn and its score.
+func (search *search) root(turns []turn, depth int) (int, int) {
+	best, alpha := 0, -infiniteScore
+	for i, turn := range turns {
+		score := search.score(turn, 0, depth, alpha, infiniteScore)
+		if search.aborted {
+			return best, alpha
+		}
+		if score > alpha {
+			best, alpha = i, score
+		}
+	}
+	return best, alpha
+}
+
+// The score of playing the turn for the side to move, from a position this
+// many turns from the root.
+func (search *search) score(turn turn, ply, depth, alpha, beta int) int {
+	if turn.next.IsOver() {
+		mover := turn.next.Current()
+		switch turn.next.ResultFor(mover) {
+		case wits.VICTORY_DESTRUCTION, wits.VICTORY_EXTINCTION, wits.VICTORY_RESIGNATION:
+			return WIN_SCORE - ply - 1
+		case wits.LOSS_DESTRUCTION, wits.LOSS_EXTINCTION, wits.LOSS_RESIGNATION:
+			return ply + 1 - WIN_SCORE
+		}
+		return 0
+	}
+	return -search.negamax(turn.next, ply+1, depth-1, -beta, -alpha)
+}
+
+func (search *search) negamax(game *state.GameState, ply, depth, alpha, beta int) int {
+	if depth == 0 {
+		return 0
+	}
+	hash := Hash(game)
+	if entry, found := search.Table.Lookup(hash); found {
+		if score, known := entry.score(ply, depth); known {
+			return score
+		}
+	}
+	key := memoKey{hash, depth}
+	if entry, found := search.memo[key]; found {
+		score := fromMemo(entry.score, ply)
+		switch entry.bound {
+		case EXACT:
+			return score
+		case LOWER:
+			alpha = max(alpha, score)
+		case UPPER:
+			beta = min(beta, score)
+		}
+		if alpha >= beta {
+			return score
+		}
+	}
+
+	// In the last turn searched only a win matters, any other turn is a draw.
+	turns := search.turns(game, depth > 1)
+	if search.aborted || len(turns) == 0 {
+		return 0
+	}
+	original, best := alpha, -infiniteScore
+	for _, turn := range turns {
+		score := search.score(turn, ply, depth, alpha, beta)
+		if search.aborted {
+			return 0
+		}
+		best = max(best, score)
+		alpha = max(alpha, score)
+		if alpha >= beta {
+			break
+		}
+	}
+
+	entry := memoEntry{toMemo(best, ply), EXACT}
+	switch {
+	case best <= original:
+		entry.bound = UPPER
+	case best >= beta:
+		entry.bound = LOWER
+	default:
+		search.Table.Store(hash, entryFor(entry.score, depth))
+	}
+	search.memo[key] = entry
+	return best
+}
+
+// Converts a score between relative to the root and relative to the position,
+// this many turns from the root.
+func toMemo(score, ply int) int {
+	switch {
+	case score > WIN_BOUND:
+		return score + ply
+	case score < -WIN_BOUND:
+		return score - ply
+	}
+	return score
+}
+
+func fromMemo(score, ply int) int {
+	switch {
+	case score > WIN_BOUND:
+		return score - ply
+	case score < -WIN_BOUND:
+		return score + ply
+	}
+	return score
+}
+
+// Enumerates the distinct positions that the current player's turn can end
+// in, ordered by evaluation.  Once a way of playing the turn wins the match,
+// it is the only turn returned; if not all turns are needed then only a
+// winning turn is returned (if there is one).  The positions within the turn
+// are searched breadth-first, a winning turn has as few actions as possible.
+func (search *search) turns(game *state.GameState, all bool) []turn {
+	mover := game.Current()
+	turns := make([]turn, 0)
+	seen := map[uint64]bool{Hash(game): true}
+	ended := make(map[uint64]bool)
+
+	queue := []turn{{nil, game, 0}}
+	for len(queue) > 0 && !search.exceeded() {
+		current := queue[0]
+		queue = queue[1:]
+		if all || len(current.actions) == 0 {
+			// Ending the turn can win by extinction, even without an action.
+			end := current.next.Clone()
+			end.EndTurn()
+			if hash := Hash(end); !ended[hash] {
+				ended[hash] = true
+				if isWin(end, mover) {
+					return []turn{{current.actions, end, 0}}
+				}
+				if all {
+					turns = append(turns, turn{current.actions, end, 0})
+				}
+			}
+		}
+		for _, action := range current.next.LegalActions() {
+			// Spawned units cannot act and healing does no damage, neither
+			// helps to win within the turn.
+			if !all && (action.Name == witsjson.SPAWN_UNIT || action.Name == witsjson.HEAL_UNIT) {
+				continue
+			}
+			next := current.next.Clone()
+			if next.Apply(action) != nil {
+				continue
+			}
+			played := append(slices.Clip(current.actions), action)
+			if next.IsOver() {
+				if isWin(next, mover) {
+					return []turn{{played, next, 0}}
+				}
+				if all {
+					turns = append(turns, turn{played, next, 0})
+				}
+			} else if hash := Hash(next); !seen[hash] {
+				seen[hash] = true
+				queue = append(queue, turn{played, next, 0})
+			}
+		}
+	}
+	if search.aborted || !all {
+		return turns
+	}
+
+	for i := range turns {
+		if turns[i].next.IsOver() {
+			turns[i].order = math.Inf(-1)
+		} else {
+			turns[i].order = search.Evaluator.Evaluate(turns[i].next, mover)
+		}
+	}
+	slices.SortFunc(turns, func(a, b turn) int {
+		return cmp.Compare(b.order, a.order)
+	})
+	return turns
+}
+
+func isWin(game *state.GameState, team wits.FriendlyEnum) bool {
+	switch game.ResultFor(team) {
+	case wits.VICTORY_DESTRUCTION, wits.VICTORY_EXTINCTION, wits.VICTORY_RESIGNATION:
+		return true
+	}
+	return false
+}
+
+// Counts the position as visited, and whether the search is out of budget.
+func (search *search) exceeded() bool {
+	search.nodes++
+	if (search.MaxNodes > 0 && search.nodes > search.MaxNodes) ||
+		(search.nodes%256 == 0 && !search.deadline.IsZero() && time.Now().After(search.deadline)) {
+		search.aborted = true
+	}
+	return search.aborted
+}
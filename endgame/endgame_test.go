@@ -0,0 +1,225 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/endgame/endgame_test.go
+
+package endgame_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/endgame"
+	"github.com/kevindamm/wits-go/notation"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+const (
+	// A runner next to the opposing base, which has one HP left.
+	WIN_IN_ONE = "oml/solo/peekaboo R2146R1120 fs 5/1 2/0 1 10 - 1:4,11:5"
+
+	// Without any wits, nothing stops the opposing runner next to the base.
+	LOSS_IN_TWO = "oml/solo/peekaboo 1R2158R117 fs 1/5 0/0 1 10 - 1:4,11:5"
+
+	// The runner needs two turns to reach the opposing base.
+	WIN_IN_THREE = "oml/solo/peekaboo R2132R1134 fs 5/1 3/0 1 10 - 1:4,11:5"
+)
+
+func parse(t *testing.T, encoded string) *state.GameState {
+	maps, _, err := witsjson.LoadMapLibrary("../maps/solo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	game, err := notation.Parse(encoded, maps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return game
+}
+
+func solve(t *testing.T, game *state.GameState, config endgame.Config) endgame.Result {
+	result, err := endgame.NewSolver(config, nil).Solve(game, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestSolve_WinInOne(t *testing.T) {
+	game := parse(t, WIN_IN_ONE)
+	result := solve(t, game, endgame.DefaultConfig())
+	if result.Outcome != endgame.WIN || result.Depth != 1 {
+		t.Fatalf("expected a win in 1, got %s in %d", result.Outcome, result.Depth)
+	}
+	// The fastest win is a single attack, without moving first.
+	if len(result.Turn) != 1 {
+		t.Errorf("winning turn has %d actions: %v", len(result.Turn), result.Turn)
+	}
+	for _, action := range result.Turn {
+		if err := game.Apply(action); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if game.ResultFor(wits.FR_SELF) != wits.VICTORY_DESTRUCTION {
+		t.Errorf("winning turn did not win, result %d", game.ResultFor(wits.FR_SELF))
+	}
+}
+
+func TestSolve_LossInTwo(t *testing.T) {
+	result := solve(t, parse(t, LOSS_IN_TWO), endgame.DefaultConfig())
+	if result.Outcome != endgame.LOSS || result.Depth != 2 {
+		t.Errorf("expected a loss in 2, got %s in %d", result.Outcome, result.Depth)
+	}
+}
+
+func TestSolve_WinInThree(t *testing.T) {
+	game := parse(t, WIN_IN_THREE)
+	result := solve(t, game, endgame.DefaultConfig())
+	if result.Outcome != endgame.WIN || result.Depth != 3 {
+		t.Fatalf("expected a win in 3, got %s in %d", result.Outcome, result.Depth)
+	}
+
+	// Not within the horizon of a shallower search.
+	config := endgame.DefaultConfig()
+	config.MaxTurns = 2
+	result = solve(t, game, config)
+	if result.Outcome != endgame.DRAW || result.Depth != 2 {
+		t.Errorf("expected a draw in 2, got %s in %d", result.Outcome, result.Depth)
+	}
+}
+
+func TestSolve_Limits(t *testing.T) {
+	game := parse(t, WIN_IN_THREE)
+	config := endgame.DefaultConfig()
+	config.MaxUnits = 1
+	if _, err := endgame.NewSolver(config, nil).Solve(game, time.Time{}); !errors.Is(err, endgame.ErrTooManyUnits) {
+		t.Errorf("expected ErrTooManyUnits, got %v", err)
+	}
+
+	config = endgame.DefaultConfig()
+	config.MaxNodes = 10
+	result, err := endgame.NewSolver(config, nil).Solve(game, time.Time{})
+	if !errors.Is(err, endgame.ErrBudget) || result.Outcome != endgame.UNKNOWN {
+		t.Errorf("expected an unknown result and ErrBudget, got %s and %v", result.Outcome, err)
+	}
+
+	start := time.Now()
+	_, err = endgame.NewSolver(endgame.DefaultConfig(), nil).Solve(game, start.Add(10*time.Millisecond))
+	if !errors.Is(err, endgame.ErrBudget) {
+		t.Errorf("expected ErrBudget, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("search continued %s past its deadline", elapsed)
+	}
+}
+
+func TestTable(t *testing.T) {
+	game := parse(t, WIN_IN_THREE)
+	table := endgame.NewTable()
+	result, err := endgame.NewSolver(endgame.DefaultConfig(), table).Solve(game, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry, found := table.Lookup(endgame.Hash(game)); !found || entry != result.Entry {
+		t.Errorf("solution %v was not stored, found %v", result.Entry, entry)
+	}
+
+	var buffer bytes.Buffer
+	if _, err := table.WriteTo(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	read, err := endgame.ReadTable(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Len() != table.Len() {
+		t.Errorf("read %d entries, wrote %d", read.Len(), table.Len())
+	}
+	if entry, _ := read.Lookup(endgame.Hash(game)); entry != result.Entry {
+		t.Errorf("read %v, wrote %v", entry, result.Entry)
+	}
+
+	// Draws are replaced by deeper searches, wins and losses are final.
+	table = endgame.NewTable()
+	table.Store(1, endgame.Entry{Outcome: endgame.DRAW, Depth: 3})
+	table.Store(1, endgame.Entry{Outcome: endgame.DRAW, Depth: 2})
+	if entry, _ := table.Lookup(1); entry.Depth != 3 {
+		t.Errorf("draw in 3 replaced by %v", entry)
+	}
+	table.Store(1, endgame.Entry{Outcome: endgame.WIN, Depth: 5})
+	table.Store(1, endgame.Entry{Outcome: endgame.DRAW, Depth: 6})
+	if entry, _ := table.Lookup(1); entry.Outcome != endgame.WIN {
+		t.Errorf("win replaced by %v", entry)
+	}
+}
+
+func TestHash(t *testing.T) {
+	game := parse(t, WIN_IN_THREE)
+	if endgame.Hash(game) != endgame.Hash(game.Clone()) {
+		t.Error("clones hash differently")
+	}
+	passed := game.Clone()
+	passed.EndTurn()
+	if endgame.Hash(game) == endgame.Hash(passed) {
+		t.Error("positions with different sides to move hash the same")
+	}
+}
+
+func TestHash_Rules(t *testing.T) {
+	maps, _, err := witsjson.LoadMapLibrary("../maps/solo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	definition, _ := maps.Find(notation.MapID(WIN_IN_THREE), "")
+	gamemap := state.NewGameMap(definition)
+	races := []wits.UnitRaceEnum{wits.RACE_FEEDBACK, wits.RACE_ADORABLES}
+	hash := func(variant string) uint64 {
+		rules, err := state.ParseVariant([]byte(variant))
+		if err != nil {
+			t.Fatal(err)
+		}
+		game, err := state.NewGameWithRules(&gamemap, races, rules)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return endgame.Hash(game)
+	}
+
+	game, err := state.NewGame(&gamemap, races)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if endgame.Hash(game) != hash(`{"name": "renamed"}`) {
+		t.Error("the same rules under another name hash differently")
+	}
+	if endgame.Hash(game) == hash(`{"name": "fast-heavies", "units": {"HEAVY": {"travel": 3}}}`) {
+		t.Error("the same position under different rules hashes the same")
+	}
+}
+
+func TestEndgameBot(t *testing.T) {
+	game := parse(t, WIN_IN_ONE)
+	player := bot.NewEndgameBot(bot.NewRandomBot(1), endgame.DefaultConfig(), nil)
+	if _, err := bot.PlayTurn(game, player, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if game.ResultFor(wits.FR_SELF) != wits.VICTORY_DESTRUCTION {
+		t.Errorf("endgame bot did not win, result %d", game.ResultFor(wits.FR_SELF))
+	}
+}
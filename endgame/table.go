@@ -0,0 +1,210 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/endgame/table.go
+
+package endgame
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+)
+
+// A tablebase of solved positions, keyed by their Hash.  It is safe for
+// concurrent use, so that bots playing in parallel can share one table.
+type Table struct {
+	mu      sync.RWMutex
+	entries map[uint64]Entry
+}
+
+// The solution of a position, relative to the side to move.  The depth is
+// the number of turns until the match is won or lost, or for a DRAW the number
+// of turns searched without either side being able to force a result.
+type Entry struct {
+	Outcome Outcome
+	Depth   uint8
+}
+
+func NewTable() *Table {
+	return &Table{entries: make(map[uint64]Entry)}
+}
+
+func (table *Table) Lookup(hash uint64) (Entry, bool) {
+	table.mu.RLock()
+	defer table.mu.RUnlock()
+	entry, found := table.entries[hash]
+	return entry, found
+}
+
+// Stores the solution, unless the table already knows more about the position
+// (a win or loss is final, a draw is kept for the deepest search).
+func (table *Table) Store(hash uint64, entry Entry) {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+	if known, found := table.entries[hash]; found {
+		if known.Outcome != DRAW || (entry.Outcome == DRAW && entry.Depth <= known.Depth) {
+			return
+		}
+	}
+	table.entries[hash] = entry
+}
+
+func (table *Table) Len() int {
+	table.mu.RLock()
+	defer table.mu.RUnlock()
+	return len(table.entries)
+}
+
+// Identifies the tablebase file format, followed by the number of entries and
+// the entries in order of their hash (as little-endian hash, outcome, depth).
+// Tables of the earlier format (WITSEGTB) were hashed without the rules.
+const TABLE_MAGIC = "WITSEGT2"
+
+func (table *Table) WriteTo(w io.Writer) (int64, error) {
+	table.mu.RLock()
+	defer table.mu.RUnlock()
+	out := bufio.NewWriter(w)
+	out.WriteString(TABLE_MAGIC)
+	binary.Write(out, binary.LittleEndian, uint64(len(table.entries)))
+	for _, hash := range slices.Sorted(maps.Keys(table.entries)) {
+		entry := table.entries[hash]
+		binary.Write(out, binary.LittleEndian, hash)
+		out.WriteByte(byte(entry.Outcome))
+		out.WriteByte(entry.Depth)
+	}
+	written := int64(len(TABLE_MAGIC) + 8 + 10*len(table.entries))
+	return written, out.Flush()
+}
+
+func (table *Table) WriteFile(filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if _, err := table.WriteTo(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func ReadTable(r io.Reader) (*Table, error) {
+	in := bufio.NewReader(r)
+	magic := make([]byte, len(TABLE_MAGIC))
+	if _, err := io.ReadFull(in, magic); err != nil || string(magic) != TABLE_MAGIC {
+		return nil, errors.New("not an endgame tablebase")
+	}
+	var count uint64
+	if err := binary.Read(in, binary.LittleEndian, &count); err != nil {
+		return nil, err
+	}
+	table := NewTable()
+	record := make([]byte, 10)
+	for range count {
+		if _, err := io.ReadFull(in, record); err != nil {
+			return nil, err
+		}
+		outcome := Outcome(record[8])
+		if outcome == UNKNOWN || outcome > DRAW {
+			return nil, errors.New("invalid outcome in endgame tablebase")
+		}
+		hash := binary.LittleEndian.Uint64(record)
+		table.entries[hash] = Entry{outcome, record[9]}
+	}
+	return table, nil
+}
+
+// Reads the tablebase from the file, or returns an empty table if there is no
+// such file (yet).
+func ReadTableFile(filename string) (*Table, error) {
+	file, err := os.Open(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return NewTable(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ReadTable(file)
+}
+
+// Identifies a position for the tablebase and transposition tables.  Includes
+// the map, the rules, the side to move, the players' resources and the units
+// on the board; the units' moved and acted markers are only included for the
+// side to move, the other side's are reset before they play.  Rules that only
+// differ by name are the same rules.
+func Hash(game *state.GameState) uint64 {
+	hasher := fnv.New64a()
+	gamemap := game.Map()
+	hasher.Write([]byte(gamemap.MapID()))
+	current := game.Current()
+	bytes := make([]byte, 0, 64+3*game.PlayerCount()+4*gamemap.TileCount())
+	bytes = appendRules(bytes, game.Rules())
+	bytes = append(bytes, byte(current))
+	for i := range game.PlayerCount() {
+		player := game.Player(wits.FriendlyEnum(i + 1))
+		bytes = append(bytes, byte(player.Race), byte(player.Wits), byte(player.BaseHP))
+	}
+	for index := range gamemap.TileCount() {
+		at := wits.HexCoordIndex(index)
+		unit := game.UnitAt(at)
+		if unit.IsEmpty() {
+			if game.IsSpawnUsed(at) {
+				bytes = append(bytes, 0xFF)
+			} else {
+				bytes = append(bytes, 0)
+			}
+			continue
+		}
+		flags := byte(0)
+		if unit.IsAlternate() {
+			flags |= 1
+		}
+		if unit.Team() == current {
+			if unit.HasMoved() {
+				flags |= 2
+			}
+			if unit.HasActed() {
+				flags |= 4
+			}
+			if unit.HasAlted() {
+				flags |= 8
+			}
+		}
+		bytes = append(bytes,
+			byte(unit.Class())<<4|byte(unit.Race()), byte(unit.Team())<<4|flags,
+			byte(unit.Health()), byte(game.Parent(at)))
+	}
+	hasher.Write(bytes)
+	return hasher.Sum64()
+}
+
+func appendRules(bytes []byte, rules *state.Ruleset) []byte {
+	for _, stats := range rules.Units {
+		bytes = append(bytes, byte(stats.Cost), byte(stats.Strength),
+			byte(stats.Travel), byte(stats.Health), byte(stats.Range))
+	}
+	return append(bytes, byte(rules.BaseHP), byte(rules.BaseVision),
+		byte(rules.WitsPerTurn), byte(rules.WitsPerBonus), byte(rules.MaxWits),
+		byte(rules.ActionCost), byte(rules.SplashDamage))
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/engine/client.go
+
+package engine
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/notation"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The time an engine has to reply to the handshake, and to exit after quit.
+const HANDSHAKE_TIMEOUT = 5 * time.Second
+
+var ErrEngineClosed = errors.New("engine closed its output")
+
+// A bot that is played by an engine on the other end of the protocol.  The
+// map definitions are looked up in the library when a game begins.
+type Client struct {
+	name  string
+	maps  witsjson.MapLibrary
+	out   io.Writer
+	lines chan string
+
+	gamemap *state.GameMap
+	rules   *state.Ruleset
+	turn    uint
+
+	// The number of turn replies still due from turns that ran out of time,
+	// these are discarded when they arrive.
+	stale int
+	err   error
+}
+
+// Performs the handshake with the engine, which reads from out and writes to
+// in.  The bot's name defaults to this one if the engine does not give one.
+func NewClient(name string, maps witsjson.MapLibrary, in io.Reader, out io.Writer) (*Client, error) {
+	client := &Client{name: name, maps: maps, out: out, lines: make(chan string, 16)}
+	go func() {
+		scanner := bufio.NewScanner(in)
+		scanner.Buffer(make([]byte, 0, 64*1024), MAX_LINE)
+		for scanner.Scan() {
+			client.lines <- strings.TrimSpace(scanner.Text())
+		}
+		close(client.lines)
+	}()
+
+	if err := client.send(CMD_WITS, ""); err != nil {
+		return nil, err
+	}
+	deadline := time.After(HANDSHAKE_TIMEOUT)
+	for {
+		reply, args, err := client.receive(deadline)
+		if err != nil {
+			return nil, fmt.Errorf("engine handshake: %w", err)
+		}
+		if reply == REPLY_ID {
+			if name, found := strings.CutPrefix(args, "name "); found {
+				client.name = name
+			}
+		}
+		if reply == REPLY_WITSOK {
+			return client, nil
+		}
+	}
+}
+
+func (client *Client) Name() string { return client.name }
+
+// The first error in the engine's replies, if any.  Turns end early when
+// there is an error.
+func (client *Client) Err() error { return client.err }
+
+// Sends the position and waits for the engine's turn, within the budget.
+// Turns that the engine does not reply to in time are empty (a pass).
+func (client *Client) Turn(view *state.GameState, budget time.Duration) []state.Action {
+	gamemap := view.Map()
+	if client.gamemap == nil || client.gamemap.MapID() != gamemap.MapID() ||
+		client.rules != view.Rules() || view.Turn() <= client.turn {
+		if err := client.newGame(gamemap, view.Rules()); err != nil {
+			client.fail(err)
+			return nil
+		}
+	}
+	client.turn = view.Turn()
+
+	if err := client.send(CMD_POSITION, notation.Write(view)); err != nil {
+		client.fail(err)
+		return nil
+	}
+	if err := client.send(CMD_GO, fmt.Sprint(budget.Milliseconds())); err != nil {
+		client.fail(err)
+		return nil
+	}
+
+	deadline := time.After(budget + bot.GRACE_PERIOD/2)
+	for {
+		reply, args, err := client.receive(deadline)
+		if err != nil {
+			if errors.Is(err, errDeadline) {
+				client.stale++
+			} else {
+				client.fail(err)
+			}
+			return nil
+		}
+		if reply != REPLY_TURN {
+			continue
+		}
+		if client.stale > 0 {
+			client.stale--
+			continue
+		}
+		return client.resolve(gamemap, args)
+	}
+}
+
+// Tells the engine that a game is starting, on this map and under these rules.
+func (client *Client) newGame(gamemap *state.GameMap, rules *state.Ruleset) error {
+	definition, found := client.maps.Find(gamemap.MapID(), gamemap.MapName())
+	if !found {
+		return fmt.Errorf("map %s not found", gamemap.MapID())
+	}
+	encoded, err := json.Marshal(definition.Definition())
+	if err != nil {
+		return err
+	}
+	encodedRules, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+	if err := client.send(CMD_NEWGAME, ""); err != nil {
+		return err
+	}
+	client.gamemap, client.rules = gamemap, rules
+	if err := client.send(CMD_MAP, string(encoded)); err != nil {
+		return err
+	}
+	return client.send(CMD_RULES, string(encodedRules))
+}
+
+// Converts the engine's turn into actions, up to the first that is invalid.
+func (client *Client) resolve(gamemap *state.GameMap, encoded string) []state.Action {
+	played, err := ParseTurn(encoded)
+	if err != nil {
+		client.fail(err)
+		return nil
+	}
+	actions := make([]state.Action, 0, len(played))
+	for _, action := range played {
+		resolved, err := gamemap.Resolve(action)
+		if err != nil {
+			client.fail(err)
+			break
+		}
+		if resolved.IsPass() {
+			break
+		}
+		actions = append(actions, resolved)
+	}
+	return actions
+}
+
+func (client *Client) fail(err error) {
+	if client.err == nil {
+		client.err = err
+	}
+}
+
+// Tells the engine to quit.
+func (client *Client) Close() error {
+	return client.send(CMD_QUIT, "")
+}
+
+var errDeadline = errors.New("engine did not reply in time")
+
+// Waits for the next line from the engine, skipping info lines.
+func (client *Client) receive(deadline <-chan time.Time) (string, string, error) {
+	for {
+		select {
+		case line, open := <-client.lines:
+			if !open {
+				return "", "", ErrEngineClosed
+			}
+			reply, args, _ := strings.Cut(line, " ")
+			if reply == REPLY_INFO || reply == "" {
+				continue
+			}
+			return reply, args, nil
+		case <-deadline:
+			return "", "", errDeadline
+		}
+	}
+}
+
+func (client *Client) send(command, args string) error {
+	line := command
+	if len(args) > 0 {
+		line += " " + args
+	}
+	_, err := fmt.Fprintln(client.out, line)
+	return err
+}
+
+// An engine running as a subprocess, speaking the protocol on its stdin and
+// stdout.  Its stderr is passed through.
+type Process struct {
+	*Client
+	cmd *exec.Cmd
+}
+
+func StartProcess(maps witsjson.MapLibrary, command string, args ...string) (*Process, error) {
+	cmd := exec.Command(command, args...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	client, err := NewClient(filepath.Base(command), maps, stdout, stdin)
+	if err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		return nil, err
+	}
+	return &Process{client, cmd}, nil
+}
+
+// Tells the engine to quit, killing it if it has not exited in time.
+func (process *Process) Close() error {
+	process.Client.Close()
+	exited := make(chan error, 1)
+	go func() { exited <- process.cmd.Wait() }()
+	select {
+	case err := <-exited:
+		return err
+	case <-time.After(HANDSHAKE_TIMEOUT):
+		process.cmd.Process.Kill()
+		return <-exited
+	}
+}
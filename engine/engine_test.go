@@ -0,0 +1,183 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/engine/engine_test.go
+
+package engine_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/engine"
+	"github.com/kevindamm/wits-go/internal/testutil"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// When run with this variable set, the test binary is a reference engine.
+const ENGINE_ENV = "WITS_TEST_ENGINE"
+
+func TestMain(m *testing.M) {
+	if name := os.Getenv(ENGINE_ENV); len(name) > 0 {
+		err := engine.Serve(os.Stdin, os.Stdout, func() bot.Bot {
+			created, _ := bot.ByName(name, 1)
+			return created
+		})
+		if err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// Connects a client to a server running in this process.
+func connect(t *testing.T, maps witsjson.MapLibrary, newBot func() bot.Bot) *engine.Client {
+	commands, commandWriter := io.Pipe()
+	replies, replyWriter := io.Pipe()
+	go func() {
+		engine.Serve(commands, replyWriter, newBot)
+		replyWriter.Close()
+	}()
+	client, err := engine.NewClient("engine", maps, replies, commandWriter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestClient_PlaysGreedy(t *testing.T) {
+	maps := testutil.LoadMaps(t)
+	client := connect(t, maps, func() bot.Bot { return testutil.NewGreedyBot(1) })
+	if client.Name() != "greedy" {
+		t.Errorf("expected the engine to be named greedy, got %q", client.Name())
+	}
+
+	// Two games in a row, so that the client starts a new game.
+	for _, mapName := range []string{"peekaboo", "glitch"} {
+		game := testutil.NewGame(t, maps, mapName, wits.RACE_FEEDBACK, wits.RACE_VEGGIENAUTS)
+		bot.PlayMatch(game, []bot.Bot{client, bot.NewRandomBot(1)}, time.Minute, 100)
+		if client.Err() != nil {
+			t.Fatal(client.Err())
+		}
+		if game.Result() != wits.VICTORY_DESTRUCTION && game.Result() != wits.VICTORY_EXTINCTION {
+			t.Errorf("expected greedy engine to beat random on %s, result %d", mapName, game.Result())
+		}
+	}
+}
+
+// An engine that never replies to go, and then replies late.
+type slowBot struct{ delay time.Duration }
+
+func (slowBot) Name() string { return "slow" }
+func (bot slowBot) Turn(view *state.GameState, budget time.Duration) []state.Action {
+	time.Sleep(bot.delay)
+	return nil
+}
+
+func TestClient_Timeout(t *testing.T) {
+	maps := testutil.LoadMaps(t)
+	delay := 150 * time.Millisecond
+	client := connect(t, maps, func() bot.Bot { return slowBot{delay} })
+	game := testutil.NewGame(t, maps, "peekaboo", wits.RACE_FEEDBACK, wits.RACE_VEGGIENAUTS)
+	start := time.Now()
+	if actions := client.Turn(game.Clone(), 50*time.Millisecond); len(actions) != 0 {
+		t.Errorf("expected an empty turn, got %v", actions)
+	}
+	if elapsed := time.Since(start); elapsed > delay {
+		t.Errorf("client waited %s for a late reply", elapsed)
+	}
+
+	// The late reply is discarded rather than taken as the next turn.
+	game.EndTurn()
+	client.Turn(game.Clone(), time.Second)
+	if client.Err() != nil {
+		t.Error(client.Err())
+	}
+}
+
+// An engine that keeps the rules of each position it is given.
+type rulesBot struct{ seen *[]*state.Ruleset }
+
+func (rulesBot) Name() string { return "rules" }
+func (bot rulesBot) Turn(view *state.GameState, budget time.Duration) []state.Action {
+	*bot.seen = append(*bot.seen, view.Rules())
+	return nil
+}
+
+func TestClient_Rules(t *testing.T) {
+	maps := testutil.LoadMaps(t)
+	var seen []*state.Ruleset
+	client := connect(t, maps, func() bot.Bot { return rulesBot{&seen} })
+	variant, err := state.ParseVariant([]byte(`{"name": "costly", "action_cost": 2}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	standard := testutil.NewGame(t, maps, "peekaboo", wits.RACE_FEEDBACK, wits.RACE_VEGGIENAUTS)
+	races := []wits.UnitRaceEnum{wits.RACE_FEEDBACK, wits.RACE_VEGGIENAUTS}
+	costly, err := state.NewGameWithRules(standard.Map(), races, variant)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The same map under other rules is a new game for the engine.
+	for _, game := range []*state.GameState{costly, standard} {
+		client.Turn(game.Clone(), time.Second)
+	}
+	if client.Err() != nil {
+		t.Fatal(client.Err())
+	}
+	if len(seen) != 2 || !reflect.DeepEqual(seen[0], variant) ||
+		!reflect.DeepEqual(seen[1], state.LatestRules()) {
+		t.Errorf("expected the engine to play under the game's rules, got %v", seen)
+	}
+
+	var out bytes.Buffer
+	commands := "rules {\"action_cost\": 0}\nrules {\"units\": 3}\n"
+	if err := engine.Serve(strings.NewReader(commands), &out, func() bot.Bot { return bot.NewRandomBot(1) }); err != nil {
+		t.Fatal(err)
+	}
+	if count := strings.Count(out.String(), "info error: invalid rules"); count != 2 {
+		t.Errorf("expected both rules to be rejected, got %q", out.String())
+	}
+}
+
+func TestProcess(t *testing.T) {
+	maps := testutil.LoadMaps(t)
+	t.Setenv(ENGINE_ENV, "random")
+	process, err := engine.StartProcess(maps, os.Args[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	game := testutil.NewGame(t, maps, "peekaboo", wits.RACE_FEEDBACK, wits.RACE_VEGGIENAUTS)
+	turns := bot.PlayMatch(game, []bot.Bot{bot.NewRandomBot(2), process}, time.Second, 20)
+	if process.Err() != nil {
+		t.Error(process.Err())
+	}
+	if len(turns) < 2 {
+		t.Errorf("expected the engine to play, only %d turns", len(turns))
+	}
+	if err := process.Close(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/engine/protocol.go
+
+// Package engine defines a line-oriented text protocol (in the style of UCI
+// for chess engines) for playing with bots that run as a separate process,
+// which may be written in any language.
+//
+// The adapter (see Client and StartProcess) writes commands to the engine's
+// stdin and reads replies from its stdout, one per line:
+//
+//	wits                  engine replies "id name <name>" then "witsok"
+//	isready               engine replies "readyok"
+//	newgame               a new game is starting, forget the previous one
+//	map <json>            the map definition, as found in the map's file
+//	rules <json>          the rules of the game (see state.Ruleset), as they
+//	                      are stored with a match; the latest if not sent
+//	position <position>   the current player's view of the game
+//	go <milliseconds>     engine replies "turn <actions>" within the budget
+//	quit                  the engine exits
+//
+// The turn is a JSON list of actions in RelVar notation, ending with a pass,
+// for example turn [["move", ["ij", 1, 2], ["ij", 2, 2]], ["pass"]].  The
+// engine may also write "info <text>" lines at any time, these are ignored.
+//
+// The position is written in the notation of package notation, as seen by
+// the current player (opposing units in the fog of war are not included).
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+const (
+	CMD_WITS     = "wits"
+	CMD_ISREADY  = "isready"
+	CMD_NEWGAME  = "newgame"
+	CMD_MAP      = "map"
+	CMD_RULES    = "rules"
+	CMD_POSITION = "position"
+	CMD_GO       = "go"
+	CMD_QUIT     = "quit"
+
+	REPLY_ID      = "id"
+	REPLY_WITSOK  = "witsok"
+	REPLY_READYOK = "readyok"
+	REPLY_TURN    = "turn"
+	REPLY_INFO    = "info"
+)
+
+// Encodes the actions as a JSON list of their RelVar encodings.
+func FormatTurn(actions []wits.PlayerAction) string {
+	encoded := make([]string, len(actions))
+	for i, action := range actions {
+		encoded[i] = action.RelVarEncoding()
+	}
+	return "[" + strings.Join(encoded, ", ") + "]"
+}
+
+// Decodes a JSON list of actions in RelVar notation.
+func ParseTurn(encoded string) ([]wits.PlayerAction, error) {
+	var parts []json.RawMessage
+	if err := json.Unmarshal([]byte(encoded), &parts); err != nil {
+		return nil, fmt.Errorf("invalid turn: %w", err)
+	}
+	actions := make([]wits.PlayerAction, len(parts))
+	for i, part := range parts {
+		action, err := witsjson.ParseRelVar(string(part))
+		if err != nil {
+			return nil, fmt.Errorf("action %d: %w", i, err)
+		}
+		actions[i] = action
+	}
+	return actions, nil
+}
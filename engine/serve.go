@@ -0,0 +1,161 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/engine/serve.go
+
+package engine
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/notation"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The longest line that is read, map definitions are sent on a single line.
+const MAX_LINE = 1 << 20
+
+// Speaks the engine's side of the protocol on behalf of a bot, reading
+// commands from the input until it is closed or a quit command is received.
+// A new bot is created for each game.  Errors in the commands are reported as
+// info lines, only errors in reading or writing are returned.
+func Serve(in io.Reader, out io.Writer, newBot func() bot.Bot) error {
+	engine := &server{out: out, bot: newBot(), newBot: newBot}
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), MAX_LINE)
+	for scanner.Scan() {
+		command, args, _ := strings.Cut(strings.TrimSpace(scanner.Text()), " ")
+		if command == CMD_QUIT {
+			return nil
+		}
+		if err := engine.handle(command, args); err != nil {
+			if errors.Is(err, errWrite) {
+				return err
+			}
+			if err := engine.reply(REPLY_INFO, "error: "+err.Error()); err != nil {
+				return err
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+var errWrite = errors.New("engine could not write its reply")
+
+type server struct {
+	out    io.Writer
+	bot    bot.Bot
+	newBot func() bot.Bot
+
+	gamemap  *state.GameMap
+	rules    *state.Ruleset
+	position *state.GameState
+}
+
+func (engine *server) handle(command, args string) error {
+	switch command {
+	case CMD_WITS:
+		if err := engine.reply(REPLY_ID, "name "+engine.bot.Name()); err != nil {
+			return err
+		}
+		return engine.reply(REPLY_WITSOK, "")
+	case CMD_ISREADY:
+		return engine.reply(REPLY_READYOK, "")
+	case CMD_NEWGAME:
+		engine.bot = engine.newBot()
+		engine.gamemap, engine.rules, engine.position = nil, nil, nil
+	case CMD_MAP:
+		var definition witsjson.MapDefinition
+		if err := json.Unmarshal([]byte(args), &definition); err != nil {
+			return fmt.Errorf("invalid map: %w", err)
+		}
+		gamemap := state.NewGameMap(witsjson.NewGameMap(&definition))
+		engine.gamemap, engine.position = &gamemap, nil
+	case CMD_RULES:
+		rules := state.LatestRules()
+		if err := json.Unmarshal([]byte(args), rules); err != nil {
+			return fmt.Errorf("invalid rules: %w", err)
+		}
+		if err := rules.Validate(); err != nil {
+			return fmt.Errorf("invalid rules: %w", err)
+		}
+		engine.rules, engine.position = rules, nil
+	case CMD_POSITION:
+		if engine.gamemap == nil {
+			return fmt.Errorf("position before map")
+		}
+		rules := engine.rules
+		if rules == nil {
+			rules = state.LatestRules()
+		}
+		position, err := notation.ParseOnWithRules(engine.gamemap, args, rules)
+		if err != nil {
+			return err
+		}
+		engine.position = position
+	case CMD_GO:
+		milliseconds, err := strconv.Atoi(strings.TrimSpace(args))
+		if err != nil {
+			return fmt.Errorf("invalid budget %q", args)
+		}
+		return engine.turn(time.Duration(milliseconds) * time.Millisecond)
+	case "":
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+	return nil
+}
+
+// Replies to a go command with the bot's turn.  There is always a reply, so
+// that the adapter is not left waiting, a pass if there is no position.
+func (engine *server) turn(budget time.Duration) error {
+	actions := []wits.PlayerAction{}
+	var err error
+	if engine.position == nil {
+		err = fmt.Errorf("go before position")
+	} else {
+		for _, action := range engine.bot.Turn(engine.position.Clone(), budget) {
+			if action.IsPass() {
+				break
+			}
+			actions = append(actions, engine.gamemap.PlayerAction(action))
+		}
+	}
+	actions = append(actions, wits.PassAction{})
+	if replyErr := engine.reply(REPLY_TURN, FormatTurn(actions)); replyErr != nil {
+		return replyErr
+	}
+	return err
+}
+
+func (engine *server) reply(name, args string) error {
+	line := name
+	if len(args) > 0 {
+		line += " " + args
+	}
+	if _, err := fmt.Fprintln(engine.out, line); err != nil {
+		return fmt.Errorf("%w: %w", errWrite, err)
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	wits "github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/ent/osnmap"
+	"github.com/kevindamm/wits-go/ent/turn"
+)
+
+// Action is the model entity for the Action schema.
+type Action struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// The order of this action within its turn, starting at 0.
+	Sequence int `json:"sequence,omitempty"`
+	// The kind of action, passing (ending the turn) is not recorded.
+	Name action.Name `json:"name,omitempty"`
+	// the class of the acting unit, or of the unit spawned
+	Class wits.UnitClassEnum `json:"class,omitempty"`
+	// the race of the acting (or spawned) unit, identifies the special unit
+	Race wits.UnitRaceEnum `json:"race,omitempty"`
+	// Denormalized from the turn, for indexing.
+	TurnNumber int `json:"turn_number,omitempty"`
+	// position of the acting unit (or spawn tile)
+	AgentI int `json:"agent_i,omitempty"`
+	// AgentJ holds the value of the "agent_j" field.
+	AgentJ int `json:"agent_j,omitempty"`
+	// position of the target or destination, if the action has one
+	TargetI *int `json:"target_i,omitempty"`
+	// TargetJ holds the value of the "target_j" field.
+	TargetJ *int `json:"target_j,omitempty"`
+	// where the target is teleported to, for Teleport actions
+	DestI *int `json:"dest_i,omitempty"`
+	// DestJ holds the value of the "dest_j" field.
+	DestJ *int `json:"dest_j,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ActionQuery when eager-loading is set.
+	Edges           ActionEdges `json:"edges"`
+	osn_map_actions *int
+	turn_actions    *int
+	selectValues    sql.SelectValues
+}
+
+// ActionEdges holds the relations/edges for other nodes in the graph.
+type ActionEdges struct {
+	// Turn holds the value of the turn edge.
+	Turn *Turn `json:"turn,omitempty"`
+	// Map holds the value of the map edge.
+	Map *OsnMap `json:"map,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TurnOrErr returns the Turn value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActionEdges) TurnOrErr() (*Turn, error) {
+	if e.Turn != nil {
+		return e.Turn, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: turn.Label}
+	}
+	return nil, &NotLoadedError{edge: "turn"}
+}
+
+// MapOrErr returns the Map value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActionEdges) MapOrErr() (*OsnMap, error) {
+	if e.Map != nil {
+		return e.Map, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: osnmap.Label}
+	}
+	return nil, &NotLoadedError{edge: "map"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Action) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case action.FieldID, action.FieldSequence, action.FieldClass, action.FieldRace, action.FieldTurnNumber, action.FieldAgentI, action.FieldAgentJ, action.FieldTargetI, action.FieldTargetJ, action.FieldDestI, action.FieldDestJ:
+			values[i] = new(sql.NullInt64)
+		case action.FieldName:
+			values[i] = new(sql.NullString)
+		case action.ForeignKeys[0]: // osn_map_actions
+			values[i] = new(sql.NullInt64)
+		case action.ForeignKeys[1]: // turn_actions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Action fields.
+func (a *Action) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case action.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case action.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				a.Sequence = int(value.Int64)
+			}
+		case action.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				a.Name = action.Name(value.String)
+			}
+		case action.FieldClass:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field class", values[i])
+			} else if value.Valid {
+				a.Class = wits.UnitClassEnum(value.Int64)
+			}
+		case action.FieldRace:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field race", values[i])
+			} else if value.Valid {
+				a.Race = wits.UnitRaceEnum(value.Int64)
+			}
+		case action.FieldTurnNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field turn_number", values[i])
+			} else if value.Valid {
+				a.TurnNumber = int(value.Int64)
+			}
+		case action.FieldAgentI:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_i", values[i])
+			} else if value.Valid {
+				a.AgentI = int(value.Int64)
+			}
+		case action.FieldAgentJ:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_j", values[i])
+			} else if value.Valid {
+				a.AgentJ = int(value.Int64)
+			}
+		case action.FieldTargetI:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_i", values[i])
+			} else if value.Valid {
+				a.TargetI = new(int)
+				*a.TargetI = int(value.Int64)
+			}
+		case action.FieldTargetJ:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_j", values[i])
+			} else if value.Valid {
+				a.TargetJ = new(int)
+				*a.TargetJ = int(value.Int64)
+			}
+		case action.FieldDestI:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dest_i", values[i])
+			} else if value.Valid {
+				a.DestI = new(int)
+				*a.DestI = int(value.Int64)
+			}
+		case action.FieldDestJ:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dest_j", values[i])
+			} else if value.Valid {
+				a.DestJ = new(int)
+				*a.DestJ = int(value.Int64)
+			}
+		case action.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field osn_map_actions", value)
+			} else if value.Valid {
+				a.osn_map_actions = new(int)
+				*a.osn_map_actions = int(value.Int64)
+			}
+		case action.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field turn_actions", value)
+			} else if value.Valid {
+				a.turn_actions = new(int)
+				*a.turn_actions = int(value.Int64)
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Action.
+// This includes values selected through modifiers, order, etc.
+func (a *Action) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QueryTurn queries the "turn" edge of the Action entity.
+func (a *Action) QueryTurn() *TurnQuery {
+	return NewActionClient(a.config).QueryTurn(a)
+}
+
+// QueryMap queries the "map" edge of the Action entity.
+func (a *Action) QueryMap() *OsnMapQuery {
+	return NewActionClient(a.config).QueryMap(a)
+}
+
+// Update returns a builder for updating this Action.
+// Note that you need to call Action.Unwrap() before calling this method if this Action
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Action) Update() *ActionUpdateOne {
+	return NewActionClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Action entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Action) Unwrap() *Action {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Action is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Action) String() string {
+	var builder strings.Builder
+	builder.WriteString("Action(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", a.Sequence))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(fmt.Sprintf("%v", a.Name))
+	builder.WriteString(", ")
+	builder.WriteString("class=")
+	builder.WriteString(fmt.Sprintf("%v", a.Class))
+	builder.WriteString(", ")
+	builder.WriteString("race=")
+	builder.WriteString(fmt.Sprintf("%v", a.Race))
+	builder.WriteString(", ")
+	builder.WriteString("turn_number=")
+	builder.WriteString(fmt.Sprintf("%v", a.TurnNumber))
+	builder.WriteString(", ")
+	builder.WriteString("agent_i=")
+	builder.WriteString(fmt.Sprintf("%v", a.AgentI))
+	builder.WriteString(", ")
+	builder.WriteString("agent_j=")
+	builder.WriteString(fmt.Sprintf("%v", a.AgentJ))
+	builder.WriteString(", ")
+	if v := a.TargetI; v != nil {
+		builder.WriteString("target_i=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.TargetJ; v != nil {
+		builder.WriteString("target_j=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.DestI; v != nil {
+		builder.WriteString("dest_i=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.DestJ; v != nil {
+		builder.WriteString("dest_j=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Actions is a parsable slice of Action.
+type Actions []*Action
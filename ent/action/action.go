@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package action
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the action type in the database.
+	Label = "action"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSequence holds the string denoting the sequence field in the database.
+	FieldSequence = "sequence"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldClass holds the string denoting the class field in the database.
+	FieldClass = "class"
+	// FieldRace holds the string denoting the race field in the database.
+	FieldRace = "race"
+	// FieldTurnNumber holds the string denoting the turn_number field in the database.
+	FieldTurnNumber = "turn_number"
+	// FieldAgentI holds the string denoting the agent_i field in the database.
+	FieldAgentI = "agent_i"
+	// FieldAgentJ holds the string denoting the agent_j field in the database.
+	FieldAgentJ = "agent_j"
+	// FieldTargetI holds the string denoting the target_i field in the database.
+	FieldTargetI = "target_i"
+	// FieldTargetJ holds the string denoting the target_j field in the database.
+	FieldTargetJ = "target_j"
+	// FieldDestI holds the string denoting the dest_i field in the database.
+	FieldDestI = "dest_i"
+	// FieldDestJ holds the string denoting the dest_j field in the database.
+	FieldDestJ = "dest_j"
+	// EdgeTurn holds the string denoting the turn edge name in mutations.
+	EdgeTurn = "turn"
+	// EdgeMap holds the string denoting the map edge name in mutations.
+	EdgeMap = "map"
+	// Table holds the table name of the action in the database.
+	Table = "actions"
+	// TurnTable is the table that holds the turn relation/edge.
+	TurnTable = "actions"
+	// TurnInverseTable is the table name for the Turn entity.
+	// It exists in this package in order to avoid circular dependency with the "turn" package.
+	TurnInverseTable = "turns"
+	// TurnColumn is the table column denoting the turn relation/edge.
+	TurnColumn = "turn_actions"
+	// MapTable is the table that holds the map relation/edge.
+	MapTable = "actions"
+	// MapInverseTable is the table name for the OsnMap entity.
+	// It exists in this package in order to avoid circular dependency with the "osnmap" package.
+	MapInverseTable = "osn_maps"
+	// MapColumn is the table column denoting the map relation/edge.
+	MapColumn = "osn_map_actions"
+)
+
+// Columns holds all SQL columns for action fields.
+var Columns = []string{
+	FieldID,
+	FieldSequence,
+	FieldName,
+	FieldClass,
+	FieldRace,
+	FieldTurnNumber,
+	FieldAgentI,
+	FieldAgentJ,
+	FieldTargetI,
+	FieldTargetJ,
+	FieldDestI,
+	FieldDestJ,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "actions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"osn_map_actions",
+	"turn_actions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SequenceValidator is a validator for the "sequence" field. It is called by the builders before save.
+	SequenceValidator func(int) error
+	// TurnNumberValidator is a validator for the "turn_number" field. It is called by the builders before save.
+	TurnNumberValidator func(int) error
+)
+
+// Name defines the type for the "name" enum field.
+type Name string
+
+// Name values.
+const (
+	NameMoveUnit  Name = "MoveUnit"
+	NameHealUnit  Name = "HealUnit"
+	NameSpawnUnit Name = "SpawnUnit"
+	NameAttack    Name = "Attack"
+	NameCharmUnit Name = "CharmUnit"
+	NameToggleAlt Name = "ToggleAlt"
+	NameTeleport  Name = "Teleport"
+)
+
+func (n Name) String() string {
+	return string(n)
+}
+
+// NameValidator is a validator for the "name" field enum values. It is called by the builders before save.
+func NameValidator(n Name) error {
+	switch n {
+	case NameMoveUnit, NameHealUnit, NameSpawnUnit, NameAttack, NameCharmUnit, NameToggleAlt, NameTeleport:
+		return nil
+	default:
+		return fmt.Errorf("action: invalid enum value for name field: %q", n)
+	}
+}
+
+// OrderOption defines the ordering options for the Action queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySequence orders the results by the sequence field.
+func BySequence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSequence, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByClass orders the results by the class field.
+func ByClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClass, opts...).ToFunc()
+}
+
+// ByRace orders the results by the race field.
+func ByRace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRace, opts...).ToFunc()
+}
+
+// ByTurnNumber orders the results by the turn_number field.
+func ByTurnNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTurnNumber, opts...).ToFunc()
+}
+
+// ByAgentI orders the results by the agent_i field.
+func ByAgentI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentI, opts...).ToFunc()
+}
+
+// ByAgentJ orders the results by the agent_j field.
+func ByAgentJ(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentJ, opts...).ToFunc()
+}
+
+// ByTargetI orders the results by the target_i field.
+func ByTargetI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetI, opts...).ToFunc()
+}
+
+// ByTargetJ orders the results by the target_j field.
+func ByTargetJ(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetJ, opts...).ToFunc()
+}
+
+// ByDestI orders the results by the dest_i field.
+func ByDestI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDestI, opts...).ToFunc()
+}
+
+// ByDestJ orders the results by the dest_j field.
+func ByDestJ(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDestJ, opts...).ToFunc()
+}
+
+// ByTurnField orders the results by turn field.
+func ByTurnField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTurnStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMapField orders the results by map field.
+func ByMapField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMapStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTurnStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TurnInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TurnTable, TurnColumn),
+	)
+}
+func newMapStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MapInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MapTable, MapColumn),
+	)
+}
@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package action
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	wits "github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Action {
+	return predicate.Action(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Action {
+	return predicate.Action(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Action {
+	return predicate.Action(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Action {
+	return predicate.Action(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Action {
+	return predicate.Action(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Action {
+	return predicate.Action(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Action {
+	return predicate.Action(sql.FieldLTE(FieldID, id))
+}
+
+// Sequence applies equality check predicate on the "sequence" field. It's identical to SequenceEQ.
+func Sequence(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldSequence, v))
+}
+
+// Class applies equality check predicate on the "class" field. It's identical to ClassEQ.
+func Class(v wits.UnitClassEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldEQ(FieldClass, vc))
+}
+
+// Race applies equality check predicate on the "race" field. It's identical to RaceEQ.
+func Race(v wits.UnitRaceEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldEQ(FieldRace, vc))
+}
+
+// TurnNumber applies equality check predicate on the "turn_number" field. It's identical to TurnNumberEQ.
+func TurnNumber(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldTurnNumber, v))
+}
+
+// AgentI applies equality check predicate on the "agent_i" field. It's identical to AgentIEQ.
+func AgentI(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldAgentI, v))
+}
+
+// AgentJ applies equality check predicate on the "agent_j" field. It's identical to AgentJEQ.
+func AgentJ(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldAgentJ, v))
+}
+
+// TargetI applies equality check predicate on the "target_i" field. It's identical to TargetIEQ.
+func TargetI(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldTargetI, v))
+}
+
+// TargetJ applies equality check predicate on the "target_j" field. It's identical to TargetJEQ.
+func TargetJ(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldTargetJ, v))
+}
+
+// DestI applies equality check predicate on the "dest_i" field. It's identical to DestIEQ.
+func DestI(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldDestI, v))
+}
+
+// DestJ applies equality check predicate on the "dest_j" field. It's identical to DestJEQ.
+func DestJ(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldDestJ, v))
+}
+
+// SequenceEQ applies the EQ predicate on the "sequence" field.
+func SequenceEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldSequence, v))
+}
+
+// SequenceNEQ applies the NEQ predicate on the "sequence" field.
+func SequenceNEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldNEQ(FieldSequence, v))
+}
+
+// SequenceIn applies the In predicate on the "sequence" field.
+func SequenceIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldIn(FieldSequence, vs...))
+}
+
+// SequenceNotIn applies the NotIn predicate on the "sequence" field.
+func SequenceNotIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldNotIn(FieldSequence, vs...))
+}
+
+// SequenceGT applies the GT predicate on the "sequence" field.
+func SequenceGT(v int) predicate.Action {
+	return predicate.Action(sql.FieldGT(FieldSequence, v))
+}
+
+// SequenceGTE applies the GTE predicate on the "sequence" field.
+func SequenceGTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldGTE(FieldSequence, v))
+}
+
+// SequenceLT applies the LT predicate on the "sequence" field.
+func SequenceLT(v int) predicate.Action {
+	return predicate.Action(sql.FieldLT(FieldSequence, v))
+}
+
+// SequenceLTE applies the LTE predicate on the "sequence" field.
+func SequenceLTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldLTE(FieldSequence, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v Name) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v Name) predicate.Action {
+	return predicate.Action(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...Name) predicate.Action {
+	return predicate.Action(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...Name) predicate.Action {
+	return predicate.Action(sql.FieldNotIn(FieldName, vs...))
+}
+
+// ClassEQ applies the EQ predicate on the "class" field.
+func ClassEQ(v wits.UnitClassEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldEQ(FieldClass, vc))
+}
+
+// ClassNEQ applies the NEQ predicate on the "class" field.
+func ClassNEQ(v wits.UnitClassEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldNEQ(FieldClass, vc))
+}
+
+// ClassIn applies the In predicate on the "class" field.
+func ClassIn(vs ...wits.UnitClassEnum) predicate.Action {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint8(vs[i])
+	}
+	return predicate.Action(sql.FieldIn(FieldClass, v...))
+}
+
+// ClassNotIn applies the NotIn predicate on the "class" field.
+func ClassNotIn(vs ...wits.UnitClassEnum) predicate.Action {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint8(vs[i])
+	}
+	return predicate.Action(sql.FieldNotIn(FieldClass, v...))
+}
+
+// ClassGT applies the GT predicate on the "class" field.
+func ClassGT(v wits.UnitClassEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldGT(FieldClass, vc))
+}
+
+// ClassGTE applies the GTE predicate on the "class" field.
+func ClassGTE(v wits.UnitClassEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldGTE(FieldClass, vc))
+}
+
+// ClassLT applies the LT predicate on the "class" field.
+func ClassLT(v wits.UnitClassEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldLT(FieldClass, vc))
+}
+
+// ClassLTE applies the LTE predicate on the "class" field.
+func ClassLTE(v wits.UnitClassEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldLTE(FieldClass, vc))
+}
+
+// RaceEQ applies the EQ predicate on the "race" field.
+func RaceEQ(v wits.UnitRaceEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldEQ(FieldRace, vc))
+}
+
+// RaceNEQ applies the NEQ predicate on the "race" field.
+func RaceNEQ(v wits.UnitRaceEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldNEQ(FieldRace, vc))
+}
+
+// RaceIn applies the In predicate on the "race" field.
+func RaceIn(vs ...wits.UnitRaceEnum) predicate.Action {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint8(vs[i])
+	}
+	return predicate.Action(sql.FieldIn(FieldRace, v...))
+}
+
+// RaceNotIn applies the NotIn predicate on the "race" field.
+func RaceNotIn(vs ...wits.UnitRaceEnum) predicate.Action {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint8(vs[i])
+	}
+	return predicate.Action(sql.FieldNotIn(FieldRace, v...))
+}
+
+// RaceGT applies the GT predicate on the "race" field.
+func RaceGT(v wits.UnitRaceEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldGT(FieldRace, vc))
+}
+
+// RaceGTE applies the GTE predicate on the "race" field.
+func RaceGTE(v wits.UnitRaceEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldGTE(FieldRace, vc))
+}
+
+// RaceLT applies the LT predicate on the "race" field.
+func RaceLT(v wits.UnitRaceEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldLT(FieldRace, vc))
+}
+
+// RaceLTE applies the LTE predicate on the "race" field.
+func RaceLTE(v wits.UnitRaceEnum) predicate.Action {
+	vc := uint8(v)
+	return predicate.Action(sql.FieldLTE(FieldRace, vc))
+}
+
+// TurnNumberEQ applies the EQ predicate on the "turn_number" field.
+func TurnNumberEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldTurnNumber, v))
+}
+
+// TurnNumberNEQ applies the NEQ predicate on the "turn_number" field.
+func TurnNumberNEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldNEQ(FieldTurnNumber, v))
+}
+
+// TurnNumberIn applies the In predicate on the "turn_number" field.
+func TurnNumberIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldIn(FieldTurnNumber, vs...))
+}
+
+// TurnNumberNotIn applies the NotIn predicate on the "turn_number" field.
+func TurnNumberNotIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldNotIn(FieldTurnNumber, vs...))
+}
+
+// TurnNumberGT applies the GT predicate on the "turn_number" field.
+func TurnNumberGT(v int) predicate.Action {
+	return predicate.Action(sql.FieldGT(FieldTurnNumber, v))
+}
+
+// TurnNumberGTE applies the GTE predicate on the "turn_number" field.
+func TurnNumberGTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldGTE(FieldTurnNumber, v))
+}
+
+// TurnNumberLT applies the LT predicate on the "turn_number" field.
+func TurnNumberLT(v int) predicate.Action {
+	return predicate.Action(sql.FieldLT(FieldTurnNumber, v))
+}
+
+// TurnNumberLTE applies the LTE predicate on the "turn_number" field.
+func TurnNumberLTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldLTE(FieldTurnNumber, v))
+}
+
+// AgentIEQ applies the EQ predicate on the "agent_i" field.
+func AgentIEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldAgentI, v))
+}
+
+// AgentINEQ applies the NEQ predicate on the "agent_i" field.
+func AgentINEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldNEQ(FieldAgentI, v))
+}
+
+// AgentIIn applies the In predicate on the "agent_i" field.
+func AgentIIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldIn(FieldAgentI, vs...))
+}
+
+// AgentINotIn applies the NotIn predicate on the "agent_i" field.
+func AgentINotIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldNotIn(FieldAgentI, vs...))
+}
+
+// AgentIGT applies the GT predicate on the "agent_i" field.
+func AgentIGT(v int) predicate.Action {
+	return predicate.Action(sql.FieldGT(FieldAgentI, v))
+}
+
+// AgentIGTE applies the GTE predicate on the "agent_i" field.
+func AgentIGTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldGTE(FieldAgentI, v))
+}
+
+// AgentILT applies the LT predicate on the "agent_i" field.
+func AgentILT(v int) predicate.Action {
+	return predicate.Action(sql.FieldLT(FieldAgentI, v))
+}
+
+// AgentILTE applies the LTE predicate on the "agent_i" field.
+func AgentILTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldLTE(FieldAgentI, v))
+}
+
+// AgentJEQ applies the EQ predicate on the "agent_j" field.
+func AgentJEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldAgentJ, v))
+}
+
+// AgentJNEQ applies the NEQ predicate on the "agent_j" field.
+func AgentJNEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldNEQ(FieldAgentJ, v))
+}
+
+// AgentJIn applies the In predicate on the "agent_j" field.
+func AgentJIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldIn(FieldAgentJ, vs...))
+}
+
+// AgentJNotIn applies the NotIn predicate on the "agent_j" field.
+func AgentJNotIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldNotIn(FieldAgentJ, vs...))
+}
+
+// AgentJGT applies the GT predicate on the "agent_j" field.
+func AgentJGT(v int) predicate.Action {
+	return predicate.Action(sql.FieldGT(FieldAgentJ, v))
+}
+
+// AgentJGTE applies the GTE predicate on the "agent_j" field.
+func AgentJGTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldGTE(FieldAgentJ, v))
+}
+
+// AgentJLT applies the LT predicate on the "agent_j" field.
+func AgentJLT(v int) predicate.Action {
+	return predicate.Action(sql.FieldLT(FieldAgentJ, v))
+}
+
+// AgentJLTE applies the LTE predicate on the "agent_j" field.
+func AgentJLTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldLTE(FieldAgentJ, v))
+}
+
+// TargetIEQ applies the EQ predicate on the "target_i" field.
+func TargetIEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldTargetI, v))
+}
+
+// TargetINEQ applies the NEQ predicate on the "target_i" field.
+func TargetINEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldNEQ(FieldTargetI, v))
+}
+
+// TargetIIn applies the In predicate on the "target_i" field.
+func TargetIIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldIn(FieldTargetI, vs...))
+}
+
+// TargetINotIn applies the NotIn predicate on the "target_i" field.
+func TargetINotIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldNotIn(FieldTargetI, vs...))
+}
+
+// TargetIGT applies the GT predicate on the "target_i" field.
+func TargetIGT(v int) predicate.Action {
+	return predicate.Action(sql.FieldGT(FieldTargetI, v))
+}
+
+// TargetIGTE applies the GTE predicate on the "target_i" field.
+func TargetIGTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldGTE(FieldTargetI, v))
+}
+
+// TargetILT applies the LT predicate on the "target_i" field.
+func TargetILT(v int) predicate.Action {
+	return predicate.Action(sql.FieldLT(FieldTargetI, v))
+}
+
+// TargetILTE applies the LTE predicate on the "target_i" field.
+func TargetILTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldLTE(FieldTargetI, v))
+}
+
+// TargetIIsNil applies the IsNil predicate on the "target_i" field.
+func TargetIIsNil() predicate.Action {
+	return predicate.Action(sql.FieldIsNull(FieldTargetI))
+}
+
+// TargetINotNil applies the NotNil predicate on the "target_i" field.
+func TargetINotNil() predicate.Action {
+	return predicate.Action(sql.FieldNotNull(FieldTargetI))
+}
+
+// TargetJEQ applies the EQ predicate on the "target_j" field.
+func TargetJEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldTargetJ, v))
+}
+
+// TargetJNEQ applies the NEQ predicate on the "target_j" field.
+func TargetJNEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldNEQ(FieldTargetJ, v))
+}
+
+// TargetJIn applies the In predicate on the "target_j" field.
+func TargetJIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldIn(FieldTargetJ, vs...))
+}
+
+// TargetJNotIn applies the NotIn predicate on the "target_j" field.
+func TargetJNotIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldNotIn(FieldTargetJ, vs...))
+}
+
+// TargetJGT applies the GT predicate on the "target_j" field.
+func TargetJGT(v int) predicate.Action {
+	return predicate.Action(sql.FieldGT(FieldTargetJ, v))
+}
+
+// TargetJGTE applies the GTE predicate on the "target_j" field.
+func TargetJGTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldGTE(FieldTargetJ, v))
+}
+
+// TargetJLT applies the LT predicate on the "target_j" field.
+func TargetJLT(v int) predicate.Action {
+	return predicate.Action(sql.FieldLT(FieldTargetJ, v))
+}
+
+// TargetJLTE applies the LTE predicate on the "target_j" field.
+func TargetJLTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldLTE(FieldTargetJ, v))
+}
+
+// TargetJIsNil applies the IsNil predicate on the "target_j" field.
+func TargetJIsNil() predicate.Action {
+	return predicate.Action(sql.FieldIsNull(FieldTargetJ))
+}
+
+// TargetJNotNil applies the NotNil predicate on the "target_j" field.
+func TargetJNotNil() predicate.Action {
+	return predicate.Action(sql.FieldNotNull(FieldTargetJ))
+}
+
+// DestIEQ applies the EQ predicate on the "dest_i" field.
+func DestIEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldDestI, v))
+}
+
+// DestINEQ applies the NEQ predicate on the "dest_i" field.
+func DestINEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldNEQ(FieldDestI, v))
+}
+
+// DestIIn applies the In predicate on the "dest_i" field.
+func DestIIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldIn(FieldDestI, vs...))
+}
+
+// DestINotIn applies the NotIn predicate on the "dest_i" field.
+func DestINotIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldNotIn(FieldDestI, vs...))
+}
+
+// DestIGT applies the GT predicate on the "dest_i" field.
+func DestIGT(v int) predicate.Action {
+	return predicate.Action(sql.FieldGT(FieldDestI, v))
+}
+
+// DestIGTE applies the GTE predicate on the "dest_i" field.
+func DestIGTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldGTE(FieldDestI, v))
+}
+
+// DestILT applies the LT predicate on the "dest_i" field.
+func DestILT(v int) predicate.Action {
+	return predicate.Action(sql.FieldLT(FieldDestI, v))
+}
+
+// DestILTE applies the LTE predicate on the "dest_i" field.
+func DestILTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldLTE(FieldDestI, v))
+}
+
+// DestIIsNil applies the IsNil predicate on the "dest_i" field.
+func DestIIsNil() predicate.Action {
+	return predicate.Action(sql.FieldIsNull(FieldDestI))
+}
+
+// DestINotNil applies the NotNil predicate on the "dest_i" field.
+func DestINotNil() predicate.Action {
+	return predicate.Action(sql.FieldNotNull(FieldDestI))
+}
+
+// DestJEQ applies the EQ predicate on the "dest_j" field.
+func DestJEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldEQ(FieldDestJ, v))
+}
+
+// DestJNEQ applies the NEQ predicate on the "dest_j" field.
+func DestJNEQ(v int) predicate.Action {
+	return predicate.Action(sql.FieldNEQ(FieldDestJ, v))
+}
+
+// DestJIn applies the In predicate on the "dest_j" field.
+func DestJIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldIn(FieldDestJ, vs...))
+}
+
+// DestJNotIn applies the NotIn predicate on the "dest_j" field.
+func DestJNotIn(vs ...int) predicate.Action {
+	return predicate.Action(sql.FieldNotIn(FieldDestJ, vs...))
+}
+
+// DestJGT applies the GT predicate on the "dest_j" field.
+func DestJGT(v int) predicate.Action {
+	return predicate.Action(sql.FieldGT(FieldDestJ, v))
+}
+
+// DestJGTE applies the GTE predicate on the "dest_j" field.
+func DestJGTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldGTE(FieldDestJ, v))
+}
+
+// DestJLT applies the LT predicate on the "dest_j" field.
+func DestJLT(v int) predicate.Action {
+	return predicate.Action(sql.FieldLT(FieldDestJ, v))
+}
+
+// DestJLTE applies the LTE predicate on the "dest_j" field.
+func DestJLTE(v int) predicate.Action {
+	return predicate.Action(sql.FieldLTE(FieldDestJ, v))
+}
+
+// DestJIsNil applies the IsNil predicate on the "dest_j" field.
+func DestJIsNil() predicate.Action {
+	return predicate.Action(sql.FieldIsNull(FieldDestJ))
+}
+
+// DestJNotNil applies the NotNil predicate on the "dest_j" field.
+func DestJNotNil() predicate.Action {
+	return predicate.Action(sql.FieldNotNull(FieldDestJ))
+}
+
+// HasTurn applies the HasEdge predicate on the "turn" edge.
+func HasTurn() predicate.Action {
+	return predicate.Action(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TurnTable, TurnColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTurnWith applies the HasEdge predicate on the "turn" edge with a given conditions (other predicates).
+func HasTurnWith(preds ...predicate.Turn) predicate.Action {
+	return predicate.Action(func(s *sql.Selector) {
+		step := newTurnStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMap applies the HasEdge predicate on the "map" edge.
+func HasMap() predicate.Action {
+	return predicate.Action(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MapTable, MapColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMapWith applies the HasEdge predicate on the "map" edge with a given conditions (other predicates).
+func HasMapWith(preds ...predicate.OsnMap) predicate.Action {
+	return predicate.Action(func(s *sql.Selector) {
+		step := newMapStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Action) predicate.Action {
+	return predicate.Action(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Action) predicate.Action {
+	return predicate.Action(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Action) predicate.Action {
+	return predicate.Action(sql.NotPredicates(p))
+}
@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	wits "github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/ent/osnmap"
+	"github.com/kevindamm/wits-go/ent/turn"
+)
+
+// ActionCreate is the builder for creating a Action entity.
+type ActionCreate struct {
+	config
+	mutation *ActionMutation
+	hooks    []Hook
+}
+
+// SetSequence sets the "sequence" field.
+func (ac *ActionCreate) SetSequence(i int) *ActionCreate {
+	ac.mutation.SetSequence(i)
+	return ac
+}
+
+// SetName sets the "name" field.
+func (ac *ActionCreate) SetName(a action.Name) *ActionCreate {
+	ac.mutation.SetName(a)
+	return ac
+}
+
+// SetClass sets the "class" field.
+func (ac *ActionCreate) SetClass(wce wits.UnitClassEnum) *ActionCreate {
+	ac.mutation.SetClass(wce)
+	return ac
+}
+
+// SetRace sets the "race" field.
+func (ac *ActionCreate) SetRace(wre wits.UnitRaceEnum) *ActionCreate {
+	ac.mutation.SetRace(wre)
+	return ac
+}
+
+// SetTurnNumber sets the "turn_number" field.
+func (ac *ActionCreate) SetTurnNumber(i int) *ActionCreate {
+	ac.mutation.SetTurnNumber(i)
+	return ac
+}
+
+// SetAgentI sets the "agent_i" field.
+func (ac *ActionCreate) SetAgentI(i int) *ActionCreate {
+	ac.mutation.SetAgentI(i)
+	return ac
+}
+
+// SetAgentJ sets the "agent_j" field.
+func (ac *ActionCreate) SetAgentJ(i int) *ActionCreate {
+	ac.mutation.SetAgentJ(i)
+	return ac
+}
+
+// SetTargetI sets the "target_i" field.
+func (ac *ActionCreate) SetTargetI(i int) *ActionCreate {
+	ac.mutation.SetTargetI(i)
+	return ac
+}
+
+// SetNillableTargetI sets the "target_i" field if the given value is not nil.
+func (ac *ActionCreate) SetNillableTargetI(i *int) *ActionCreate {
+	if i != nil {
+		ac.SetTargetI(*i)
+	}
+	return ac
+}
+
+// SetTargetJ sets the "target_j" field.
+func (ac *ActionCreate) SetTargetJ(i int) *ActionCreate {
+	ac.mutation.SetTargetJ(i)
+	return ac
+}
+
+// SetNillableTargetJ sets the "target_j" field if the given value is not nil.
+func (ac *ActionCreate) SetNillableTargetJ(i *int) *ActionCreate {
+	if i != nil {
+		ac.SetTargetJ(*i)
+	}
+	return ac
+}
+
+// SetDestI sets the "dest_i" field.
+func (ac *ActionCreate) SetDestI(i int) *ActionCreate {
+	ac.mutation.SetDestI(i)
+	return ac
+}
+
+// SetNillableDestI sets the "dest_i" field if the given value is not nil.
+func (ac *ActionCreate) SetNillableDestI(i *int) *ActionCreate {
+	if i != nil {
+		ac.SetDestI(*i)
+	}
+	return ac
+}
+
+// SetDestJ sets the "dest_j" field.
+func (ac *ActionCreate) SetDestJ(i int) *ActionCreate {
+	ac.mutation.SetDestJ(i)
+	return ac
+}
+
+// SetNillableDestJ sets the "dest_j" field if the given value is not nil.
+func (ac *ActionCreate) SetNillableDestJ(i *int) *ActionCreate {
+	if i != nil {
+		ac.SetDestJ(*i)
+	}
+	return ac
+}
+
+// SetTurnID sets the "turn" edge to the Turn entity by ID.
+func (ac *ActionCreate) SetTurnID(id int) *ActionCreate {
+	ac.mutation.SetTurnID(id)
+	return ac
+}
+
+// SetTurn sets the "turn" edge to the Turn entity.
+func (ac *ActionCreate) SetTurn(t *Turn) *ActionCreate {
+	return ac.SetTurnID(t.ID)
+}
+
+// SetMapID sets the "map" edge to the OsnMap entity by ID.
+func (ac *ActionCreate) SetMapID(id int) *ActionCreate {
+	ac.mutation.SetMapID(id)
+	return ac
+}
+
+// SetNillableMapID sets the "map" edge to the OsnMap entity by ID if the given value is not nil.
+func (ac *ActionCreate) SetNillableMapID(id *int) *ActionCreate {
+	if id != nil {
+		ac = ac.SetMapID(*id)
+	}
+	return ac
+}
+
+// SetMap sets the "map" edge to the OsnMap entity.
+func (ac *ActionCreate) SetMap(o *OsnMap) *ActionCreate {
+	return ac.SetMapID(o.ID)
+}
+
+// Mutation returns the ActionMutation object of the builder.
+func (ac *ActionCreate) Mutation() *ActionMutation {
+	return ac.mutation
+}
+
+// Save creates the Action in the database.
+func (ac *ActionCreate) Save(ctx context.Context) (*Action, error) {
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *ActionCreate) SaveX(ctx context.Context) *Action {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *ActionCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *ActionCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *ActionCreate) check() error {
+	if _, ok := ac.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "Action.sequence"`)}
+	}
+	if v, ok := ac.mutation.Sequence(); ok {
+		if err := action.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "Action.sequence": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Action.name"`)}
+	}
+	if v, ok := ac.mutation.Name(); ok {
+		if err := action.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Action.name": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Class(); !ok {
+		return &ValidationError{Name: "class", err: errors.New(`ent: missing required field "Action.class"`)}
+	}
+	if _, ok := ac.mutation.Race(); !ok {
+		return &ValidationError{Name: "race", err: errors.New(`ent: missing required field "Action.race"`)}
+	}
+	if _, ok := ac.mutation.TurnNumber(); !ok {
+		return &ValidationError{Name: "turn_number", err: errors.New(`ent: missing required field "Action.turn_number"`)}
+	}
+	if v, ok := ac.mutation.TurnNumber(); ok {
+		if err := action.TurnNumberValidator(v); err != nil {
+			return &ValidationError{Name: "turn_number", err: fmt.Errorf(`ent: validator failed for field "Action.turn_number": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.AgentI(); !ok {
+		return &ValidationError{Name: "agent_i", err: errors.New(`ent: missing required field "Action.agent_i"`)}
+	}
+	if _, ok := ac.mutation.AgentJ(); !ok {
+		return &ValidationError{Name: "agent_j", err: errors.New(`ent: missing required field "Action.agent_j"`)}
+	}
+	if len(ac.mutation.TurnIDs()) == 0 {
+		return &ValidationError{Name: "turn", err: errors.New(`ent: missing required edge "Action.turn"`)}
+	}
+	return nil
+}
+
+func (ac *ActionCreate) sqlSave(ctx context.Context) (*Action, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *ActionCreate) createSpec() (*Action, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Action{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(action.Table, sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt))
+	)
+	if value, ok := ac.mutation.Sequence(); ok {
+		_spec.SetField(action.FieldSequence, field.TypeInt, value)
+		_node.Sequence = value
+	}
+	if value, ok := ac.mutation.Name(); ok {
+		_spec.SetField(action.FieldName, field.TypeEnum, value)
+		_node.Name = value
+	}
+	if value, ok := ac.mutation.Class(); ok {
+		_spec.SetField(action.FieldClass, field.TypeUint8, value)
+		_node.Class = value
+	}
+	if value, ok := ac.mutation.Race(); ok {
+		_spec.SetField(action.FieldRace, field.TypeUint8, value)
+		_node.Race = value
+	}
+	if value, ok := ac.mutation.TurnNumber(); ok {
+		_spec.SetField(action.FieldTurnNumber, field.TypeInt, value)
+		_node.TurnNumber = value
+	}
+	if value, ok := ac.mutation.AgentI(); ok {
+		_spec.SetField(action.FieldAgentI, field.TypeInt, value)
+		_node.AgentI = value
+	}
+	if value, ok := ac.mutation.AgentJ(); ok {
+		_spec.SetField(action.FieldAgentJ, field.TypeInt, value)
+		_node.AgentJ = value
+	}
+	if value, ok := ac.mutation.TargetI(); ok {
+		_spec.SetField(action.FieldTargetI, field.TypeInt, value)
+		_node.TargetI = &value
+	}
+	if value, ok := ac.mutation.TargetJ(); ok {
+		_spec.SetField(action.FieldTargetJ, field.TypeInt, value)
+		_node.TargetJ = &value
+	}
+	if value, ok := ac.mutation.DestI(); ok {
+		_spec.SetField(action.FieldDestI, field.TypeInt, value)
+		_node.DestI = &value
+	}
+	if value, ok := ac.mutation.DestJ(); ok {
+		_spec.SetField(action.FieldDestJ, field.TypeInt, value)
+		_node.DestJ = &value
+	}
+	if nodes := ac.mutation.TurnIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   action.TurnTable,
+			Columns: []string{action.TurnColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.turn_actions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.MapIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   action.MapTable,
+			Columns: []string{action.MapColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(osnmap.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.osn_map_actions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ActionCreateBulk is the builder for creating many Action entities in bulk.
+type ActionCreateBulk struct {
+	config
+	err      error
+	builders []*ActionCreate
+}
+
+// Save creates the Action entities in the database.
+func (acb *ActionCreateBulk) Save(ctx context.Context) ([]*Action, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Action, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ActionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *ActionCreateBulk) SaveX(ctx context.Context) []*Action {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *ActionCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *ActionCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
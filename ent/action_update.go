@@ -0,0 +1,1076 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	wits "github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/ent/osnmap"
+	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/turn"
+)
+
+// ActionUpdate is the builder for updating Action entities.
+type ActionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ActionMutation
+}
+
+// Where appends a list predicates to the ActionUpdate builder.
+func (au *ActionUpdate) Where(ps ...predicate.Action) *ActionUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetSequence sets the "sequence" field.
+func (au *ActionUpdate) SetSequence(i int) *ActionUpdate {
+	au.mutation.ResetSequence()
+	au.mutation.SetSequence(i)
+	return au
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (au *ActionUpdate) SetNillableSequence(i *int) *ActionUpdate {
+	if i != nil {
+		au.SetSequence(*i)
+	}
+	return au
+}
+
+// AddSequence adds i to the "sequence" field.
+func (au *ActionUpdate) AddSequence(i int) *ActionUpdate {
+	au.mutation.AddSequence(i)
+	return au
+}
+
+// SetName sets the "name" field.
+func (au *ActionUpdate) SetName(a action.Name) *ActionUpdate {
+	au.mutation.SetName(a)
+	return au
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (au *ActionUpdate) SetNillableName(a *action.Name) *ActionUpdate {
+	if a != nil {
+		au.SetName(*a)
+	}
+	return au
+}
+
+// SetClass sets the "class" field.
+func (au *ActionUpdate) SetClass(wce wits.UnitClassEnum) *ActionUpdate {
+	au.mutation.ResetClass()
+	au.mutation.SetClass(wce)
+	return au
+}
+
+// SetNillableClass sets the "class" field if the given value is not nil.
+func (au *ActionUpdate) SetNillableClass(wce *wits.UnitClassEnum) *ActionUpdate {
+	if wce != nil {
+		au.SetClass(*wce)
+	}
+	return au
+}
+
+// AddClass adds wce to the "class" field.
+func (au *ActionUpdate) AddClass(wce wits.UnitClassEnum) *ActionUpdate {
+	au.mutation.AddClass(wce)
+	return au
+}
+
+// SetRace sets the "race" field.
+func (au *ActionUpdate) SetRace(wre wits.UnitRaceEnum) *ActionUpdate {
+	au.mutation.ResetRace()
+	au.mutation.SetRace(wre)
+	return au
+}
+
+// SetNillableRace sets the "race" field if the given value is not nil.
+func (au *ActionUpdate) SetNillableRace(wre *wits.UnitRaceEnum) *ActionUpdate {
+	if wre != nil {
+		au.SetRace(*wre)
+	}
+	return au
+}
+
+// AddRace adds wre to the "race" field.
+func (au *ActionUpdate) AddRace(wre wits.UnitRaceEnum) *ActionUpdate {
+	au.mutation.AddRace(wre)
+	return au
+}
+
+// SetTurnNumber sets the "turn_number" field.
+func (au *ActionUpdate) SetTurnNumber(i int) *ActionUpdate {
+	au.mutation.ResetTurnNumber()
+	au.mutation.SetTurnNumber(i)
+	return au
+}
+
+// SetNillableTurnNumber sets the "turn_number" field if the given value is not nil.
+func (au *ActionUpdate) SetNillableTurnNumber(i *int) *ActionUpdate {
+	if i != nil {
+		au.SetTurnNumber(*i)
+	}
+	return au
+}
+
+// AddTurnNumber adds i to the "turn_number" field.
+func (au *ActionUpdate) AddTurnNumber(i int) *ActionUpdate {
+	au.mutation.AddTurnNumber(i)
+	return au
+}
+
+// SetAgentI sets the "agent_i" field.
+func (au *ActionUpdate) SetAgentI(i int) *ActionUpdate {
+	au.mutation.ResetAgentI()
+	au.mutation.SetAgentI(i)
+	return au
+}
+
+// SetNillableAgentI sets the "agent_i" field if the given value is not nil.
+func (au *ActionUpdate) SetNillableAgentI(i *int) *ActionUpdate {
+	if i != nil {
+		au.SetAgentI(*i)
+	}
+	return au
+}
+
+// AddAgentI adds i to the "agent_i" field.
+func (au *ActionUpdate) AddAgentI(i int) *ActionUpdate {
+	au.mutation.AddAgentI(i)
+	return au
+}
+
+// SetAgentJ sets the "agent_j" field.
+func (au *ActionUpdate) SetAgentJ(i int) *ActionUpdate {
+	au.mutation.ResetAgentJ()
+	au.mutation.SetAgentJ(i)
+	return au
+}
+
+// SetNillableAgentJ sets the "agent_j" field if the given value is not nil.
+func (au *ActionUpdate) SetNillableAgentJ(i *int) *ActionUpdate {
+	if i != nil {
+		au.SetAgentJ(*i)
+	}
+	return au
+}
+
+// AddAgentJ adds i to the "agent_j" field.
+func (au *ActionUpdate) AddAgentJ(i int) *ActionUpdate {
+	au.mutation.AddAgentJ(i)
+	return au
+}
+
+// SetTargetI sets the "target_i" field.
+func (au *ActionUpdate) SetTargetI(i int) *ActionUpdate {
+	au.mutation.ResetTargetI()
+	au.mutation.SetTargetI(i)
+	return au
+}
+
+// SetNillableTargetI sets the "target_i" field if the given value is not nil.
+func (au *ActionUpdate) SetNillableTargetI(i *int) *ActionUpdate {
+	if i != nil {
+		au.SetTargetI(*i)
+	}
+	return au
+}
+
+// AddTargetI adds i to the "target_i" field.
+func (au *ActionUpdate) AddTargetI(i int) *ActionUpdate {
+	au.mutation.AddTargetI(i)
+	return au
+}
+
+// ClearTargetI clears the value of the "target_i" field.
+func (au *ActionUpdate) ClearTargetI() *ActionUpdate {
+	au.mutation.ClearTargetI()
+	return au
+}
+
+// SetTargetJ sets the "target_j" field.
+func (au *ActionUpdate) SetTargetJ(i int) *ActionUpdate {
+	au.mutation.ResetTargetJ()
+	au.mutation.SetTargetJ(i)
+	return au
+}
+
+// SetNillableTargetJ sets the "target_j" field if the given value is not nil.
+func (au *ActionUpdate) SetNillableTargetJ(i *int) *ActionUpdate {
+	if i != nil {
+		au.SetTargetJ(*i)
+	}
+	return au
+}
+
+// AddTargetJ adds i to the "target_j" field.
+func (au *ActionUpdate) AddTargetJ(i int) *ActionUpdate {
+	au.mutation.AddTargetJ(i)
+	return au
+}
+
+// ClearTargetJ clears the value of the "target_j" field.
+func (au *ActionUpdate) ClearTargetJ() *ActionUpdate {
+	au.mutation.ClearTargetJ()
+	return au
+}
+
+// SetDestI sets the "dest_i" field.
+func (au *ActionUpdate) SetDestI(i int) *ActionUpdate {
+	au.mutation.ResetDestI()
+	au.mutation.SetDestI(i)
+	return au
+}
+
+// SetNillableDestI sets the "dest_i" field if the given value is not nil.
+func (au *ActionUpdate) SetNillableDestI(i *int) *ActionUpdate {
+	if i != nil {
+		au.SetDestI(*i)
+	}
+	return au
+}
+
+// AddDestI adds i to the "dest_i" field.
+func (au *ActionUpdate) AddDestI(i int) *ActionUpdate {
+	au.mutation.AddDestI(i)
+	return au
+}
+
+// ClearDestI clears the value of the "dest_i" field.
+func (au *ActionUpdate) ClearDestI() *ActionUpdate {
+	au.mutation.ClearDestI()
+	return au
+}
+
+// SetDestJ sets the "dest_j" field.
+func (au *ActionUpdate) SetDestJ(i int) *ActionUpdate {
+	au.mutation.ResetDestJ()
+	au.mutation.SetDestJ(i)
+	return au
+}
+
+// SetNillableDestJ sets the "dest_j" field if the given value is not nil.
+func (au *ActionUpdate) SetNillableDestJ(i *int) *ActionUpdate {
+	if i != nil {
+		au.SetDestJ(*i)
+	}
+	return au
+}
+
+// AddDestJ adds i to the "dest_j" field.
+func (au *ActionUpdate) AddDestJ(i int) *ActionUpdate {
+	au.mutation.AddDestJ(i)
+	return au
+}
+
+// ClearDestJ clears the value of the "dest_j" field.
+func (au *ActionUpdate) ClearDestJ() *ActionUpdate {
+	au.mutation.ClearDestJ()
+	return au
+}
+
+// SetTurnID sets the "turn" edge to the Turn entity by ID.
+func (au *ActionUpdate) SetTurnID(id int) *ActionUpdate {
+	au.mutation.SetTurnID(id)
+	return au
+}
+
+// SetTurn sets the "turn" edge to the Turn entity.
+func (au *ActionUpdate) SetTurn(t *Turn) *ActionUpdate {
+	return au.SetTurnID(t.ID)
+}
+
+// SetMapID sets the "map" edge to the OsnMap entity by ID.
+func (au *ActionUpdate) SetMapID(id int) *ActionUpdate {
+	au.mutation.SetMapID(id)
+	return au
+}
+
+// SetNillableMapID sets the "map" edge to the OsnMap entity by ID if the given value is not nil.
+func (au *ActionUpdate) SetNillableMapID(id *int) *ActionUpdate {
+	if id != nil {
+		au = au.SetMapID(*id)
+	}
+	return au
+}
+
+// SetMap sets the "map" edge to the OsnMap entity.
+func (au *ActionUpdate) SetMap(o *OsnMap) *ActionUpdate {
+	return au.SetMapID(o.ID)
+}
+
+// Mutation returns the ActionMutation object of the builder.
+func (au *ActionUpdate) Mutation() *ActionMutation {
+	return au.mutation
+}
+
+// ClearTurn clears the "turn" edge to the Turn entity.
+func (au *ActionUpdate) ClearTurn() *ActionUpdate {
+	au.mutation.ClearTurn()
+	return au
+}
+
+// ClearMap clears the "map" edge to the OsnMap entity.
+func (au *ActionUpdate) ClearMap() *ActionUpdate {
+	au.mutation.ClearMap()
+	return au
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *ActionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *ActionUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *ActionUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *ActionUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *ActionUpdate) check() error {
+	if v, ok := au.mutation.Sequence(); ok {
+		if err := action.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "Action.sequence": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Name(); ok {
+		if err := action.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Action.name": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.TurnNumber(); ok {
+		if err := action.TurnNumberValidator(v); err != nil {
+			return &ValidationError{Name: "turn_number", err: fmt.Errorf(`ent: validator failed for field "Action.turn_number": %w`, err)}
+		}
+	}
+	if au.mutation.TurnCleared() && len(au.mutation.TurnIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Action.turn"`)
+	}
+	return nil
+}
+
+func (au *ActionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(action.Table, action.Columns, sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.Sequence(); ok {
+		_spec.SetField(action.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedSequence(); ok {
+		_spec.AddField(action.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.Name(); ok {
+		_spec.SetField(action.FieldName, field.TypeEnum, value)
+	}
+	if value, ok := au.mutation.Class(); ok {
+		_spec.SetField(action.FieldClass, field.TypeUint8, value)
+	}
+	if value, ok := au.mutation.AddedClass(); ok {
+		_spec.AddField(action.FieldClass, field.TypeUint8, value)
+	}
+	if value, ok := au.mutation.Race(); ok {
+		_spec.SetField(action.FieldRace, field.TypeUint8, value)
+	}
+	if value, ok := au.mutation.AddedRace(); ok {
+		_spec.AddField(action.FieldRace, field.TypeUint8, value)
+	}
+	if value, ok := au.mutation.TurnNumber(); ok {
+		_spec.SetField(action.FieldTurnNumber, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedTurnNumber(); ok {
+		_spec.AddField(action.FieldTurnNumber, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AgentI(); ok {
+		_spec.SetField(action.FieldAgentI, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedAgentI(); ok {
+		_spec.AddField(action.FieldAgentI, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AgentJ(); ok {
+		_spec.SetField(action.FieldAgentJ, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedAgentJ(); ok {
+		_spec.AddField(action.FieldAgentJ, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.TargetI(); ok {
+		_spec.SetField(action.FieldTargetI, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedTargetI(); ok {
+		_spec.AddField(action.FieldTargetI, field.TypeInt, value)
+	}
+	if au.mutation.TargetICleared() {
+		_spec.ClearField(action.FieldTargetI, field.TypeInt)
+	}
+	if value, ok := au.mutation.TargetJ(); ok {
+		_spec.SetField(action.FieldTargetJ, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedTargetJ(); ok {
+		_spec.AddField(action.FieldTargetJ, field.TypeInt, value)
+	}
+	if au.mutation.TargetJCleared() {
+		_spec.ClearField(action.FieldTargetJ, field.TypeInt)
+	}
+	if value, ok := au.mutation.DestI(); ok {
+		_spec.SetField(action.FieldDestI, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedDestI(); ok {
+		_spec.AddField(action.FieldDestI, field.TypeInt, value)
+	}
+	if au.mutation.DestICleared() {
+		_spec.ClearField(action.FieldDestI, field.TypeInt)
+	}
+	if value, ok := au.mutation.DestJ(); ok {
+		_spec.SetField(action.FieldDestJ, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedDestJ(); ok {
+		_spec.AddField(action.FieldDestJ, field.TypeInt, value)
+	}
+	if au.mutation.DestJCleared() {
+		_spec.ClearField(action.FieldDestJ, field.TypeInt)
+	}
+	if au.mutation.TurnCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   action.TurnTable,
+			Columns: []string{action.TurnColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.TurnIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   action.TurnTable,
+			Columns: []string{action.TurnColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.MapCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   action.MapTable,
+			Columns: []string{action.MapColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(osnmap.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.MapIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   action.MapTable,
+			Columns: []string{action.MapColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(osnmap.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{action.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// ActionUpdateOne is the builder for updating a single Action entity.
+type ActionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ActionMutation
+}
+
+// SetSequence sets the "sequence" field.
+func (auo *ActionUpdateOne) SetSequence(i int) *ActionUpdateOne {
+	auo.mutation.ResetSequence()
+	auo.mutation.SetSequence(i)
+	return auo
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableSequence(i *int) *ActionUpdateOne {
+	if i != nil {
+		auo.SetSequence(*i)
+	}
+	return auo
+}
+
+// AddSequence adds i to the "sequence" field.
+func (auo *ActionUpdateOne) AddSequence(i int) *ActionUpdateOne {
+	auo.mutation.AddSequence(i)
+	return auo
+}
+
+// SetName sets the "name" field.
+func (auo *ActionUpdateOne) SetName(a action.Name) *ActionUpdateOne {
+	auo.mutation.SetName(a)
+	return auo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableName(a *action.Name) *ActionUpdateOne {
+	if a != nil {
+		auo.SetName(*a)
+	}
+	return auo
+}
+
+// SetClass sets the "class" field.
+func (auo *ActionUpdateOne) SetClass(wce wits.UnitClassEnum) *ActionUpdateOne {
+	auo.mutation.ResetClass()
+	auo.mutation.SetClass(wce)
+	return auo
+}
+
+// SetNillableClass sets the "class" field if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableClass(wce *wits.UnitClassEnum) *ActionUpdateOne {
+	if wce != nil {
+		auo.SetClass(*wce)
+	}
+	return auo
+}
+
+// AddClass adds wce to the "class" field.
+func (auo *ActionUpdateOne) AddClass(wce wits.UnitClassEnum) *ActionUpdateOne {
+	auo.mutation.AddClass(wce)
+	return auo
+}
+
+// SetRace sets the "race" field.
+func (auo *ActionUpdateOne) SetRace(wre wits.UnitRaceEnum) *ActionUpdateOne {
+	auo.mutation.ResetRace()
+	auo.mutation.SetRace(wre)
+	return auo
+}
+
+// SetNillableRace sets the "race" field if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableRace(wre *wits.UnitRaceEnum) *ActionUpdateOne {
+	if wre != nil {
+		auo.SetRace(*wre)
+	}
+	return auo
+}
+
+// AddRace adds wre to the "race" field.
+func (auo *ActionUpdateOne) AddRace(wre wits.UnitRaceEnum) *ActionUpdateOne {
+	auo.mutation.AddRace(wre)
+	return auo
+}
+
+// SetTurnNumber sets the "turn_number" field.
+func (auo *ActionUpdateOne) SetTurnNumber(i int) *ActionUpdateOne {
+	auo.mutation.ResetTurnNumber()
+	auo.mutation.SetTurnNumber(i)
+	return auo
+}
+
+// SetNillableTurnNumber sets the "turn_number" field if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableTurnNumber(i *int) *ActionUpdateOne {
+	if i != nil {
+		auo.SetTurnNumber(*i)
+	}
+	return auo
+}
+
+// AddTurnNumber adds i to the "turn_number" field.
+func (auo *ActionUpdateOne) AddTurnNumber(i int) *ActionUpdateOne {
+	auo.mutation.AddTurnNumber(i)
+	return auo
+}
+
+// SetAgentI sets the "agent_i" field.
+func (auo *ActionUpdateOne) SetAgentI(i int) *ActionUpdateOne {
+	auo.mutation.ResetAgentI()
+	auo.mutation.SetAgentI(i)
+	return auo
+}
+
+// SetNillableAgentI sets the "agent_i" field if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableAgentI(i *int) *ActionUpdateOne {
+	if i != nil {
+		auo.SetAgentI(*i)
+	}
+	return auo
+}
+
+// AddAgentI adds i to the "agent_i" field.
+func (auo *ActionUpdateOne) AddAgentI(i int) *ActionUpdateOne {
+	auo.mutation.AddAgentI(i)
+	return auo
+}
+
+// SetAgentJ sets the "agent_j" field.
+func (auo *ActionUpdateOne) SetAgentJ(i int) *ActionUpdateOne {
+	auo.mutation.ResetAgentJ()
+	auo.mutation.SetAgentJ(i)
+	return auo
+}
+
+// SetNillableAgentJ sets the "agent_j" field if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableAgentJ(i *int) *ActionUpdateOne {
+	if i != nil {
+		auo.SetAgentJ(*i)
+	}
+	return auo
+}
+
+// AddAgentJ adds i to the "agent_j" field.
+func (auo *ActionUpdateOne) AddAgentJ(i int) *ActionUpdateOne {
+	auo.mutation.AddAgentJ(i)
+	return auo
+}
+
+// SetTargetI sets the "target_i" field.
+func (auo *ActionUpdateOne) SetTargetI(i int) *ActionUpdateOne {
+	auo.mutation.ResetTargetI()
+	auo.mutation.SetTargetI(i)
+	return auo
+}
+
+// SetNillableTargetI sets the "target_i" field if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableTargetI(i *int) *ActionUpdateOne {
+	if i != nil {
+		auo.SetTargetI(*i)
+	}
+	return auo
+}
+
+// AddTargetI adds i to the "target_i" field.
+func (auo *ActionUpdateOne) AddTargetI(i int) *ActionUpdateOne {
+	auo.mutation.AddTargetI(i)
+	return auo
+}
+
+// ClearTargetI clears the value of the "target_i" field.
+func (auo *ActionUpdateOne) ClearTargetI() *ActionUpdateOne {
+	auo.mutation.ClearTargetI()
+	return auo
+}
+
+// SetTargetJ sets the "target_j" field.
+func (auo *ActionUpdateOne) SetTargetJ(i int) *ActionUpdateOne {
+	auo.mutation.ResetTargetJ()
+	auo.mutation.SetTargetJ(i)
+	return auo
+}
+
+// SetNillableTargetJ sets the "target_j" field if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableTargetJ(i *int) *ActionUpdateOne {
+	if i != nil {
+		auo.SetTargetJ(*i)
+	}
+	return auo
+}
+
+// AddTargetJ adds i to the "target_j" field.
+func (auo *ActionUpdateOne) AddTargetJ(i int) *ActionUpdateOne {
+	auo.mutation.AddTargetJ(i)
+	return auo
+}
+
+// ClearTargetJ clears the value of the "target_j" field.
+func (auo *ActionUpdateOne) ClearTargetJ() *ActionUpdateOne {
+	auo.mutation.ClearTargetJ()
+	return auo
+}
+
+// SetDestI sets the "dest_i" field.
+func (auo *ActionUpdateOne) SetDestI(i int) *ActionUpdateOne {
+	auo.mutation.ResetDestI()
+	auo.mutation.SetDestI(i)
+	return auo
+}
+
+// SetNillableDestI sets the "dest_i" field if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableDestI(i *int) *ActionUpdateOne {
+	if i != nil {
+		auo.SetDestI(*i)
+	}
+	return auo
+}
+
+// AddDestI adds i to the "dest_i" field.
+func (auo *ActionUpdateOne) AddDestI(i int) *ActionUpdateOne {
+	auo.mutation.AddDestI(i)
+	return auo
+}
+
+// ClearDestI clears the value of the "dest_i" field.
+func (auo *ActionUpdateOne) ClearDestI() *ActionUpdateOne {
+	auo.mutation.ClearDestI()
+	return auo
+}
+
+// SetDestJ sets the "dest_j" field.
+func (auo *ActionUpdateOne) SetDestJ(i int) *ActionUpdateOne {
+	auo.mutation.ResetDestJ()
+	auo.mutation.SetDestJ(i)
+	return auo
+}
+
+// SetNillableDestJ sets the "dest_j" field if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableDestJ(i *int) *ActionUpdateOne {
+	if i != nil {
+		auo.SetDestJ(*i)
+	}
+	return auo
+}
+
+// AddDestJ adds i to the "dest_j" field.
+func (auo *ActionUpdateOne) AddDestJ(i int) *ActionUpdateOne {
+	auo.mutation.AddDestJ(i)
+	return auo
+}
+
+// ClearDestJ clears the value of the "dest_j" field.
+func (auo *ActionUpdateOne) ClearDestJ() *ActionUpdateOne {
+	auo.mutation.ClearDestJ()
+	return auo
+}
+
+// SetTurnID sets the "turn" edge to the Turn entity by ID.
+func (auo *ActionUpdateOne) SetTurnID(id int) *ActionUpdateOne {
+	auo.mutation.SetTurnID(id)
+	return auo
+}
+
+// SetTurn sets the "turn" edge to the Turn entity.
+func (auo *ActionUpdateOne) SetTurn(t *Turn) *ActionUpdateOne {
+	return auo.SetTurnID(t.ID)
+}
+
+// SetMapID sets the "map" edge to the OsnMap entity by ID.
+func (auo *ActionUpdateOne) SetMapID(id int) *ActionUpdateOne {
+	auo.mutation.SetMapID(id)
+	return auo
+}
+
+// SetNillableMapID sets the "map" edge to the OsnMap entity by ID if the given value is not nil.
+func (auo *ActionUpdateOne) SetNillableMapID(id *int) *ActionUpdateOne {
+	if id != nil {
+		auo = auo.SetMapID(*id)
+	}
+	return auo
+}
+
+// SetMap sets the "map" edge to the OsnMap entity.
+func (auo *ActionUpdateOne) SetMap(o *OsnMap) *ActionUpdateOne {
+	return auo.SetMapID(o.ID)
+}
+
+// Mutation returns the ActionMutation object of the builder.
+func (auo *ActionUpdateOne) Mutation() *ActionMutation {
+	return auo.mutation
+}
+
+// ClearTurn clears the "turn" edge to the Turn entity.
+func (auo *ActionUpdateOne) ClearTurn() *ActionUpdateOne {
+	auo.mutation.ClearTurn()
+	return auo
+}
+
+// ClearMap clears the "map" edge to the OsnMap entity.
+func (auo *ActionUpdateOne) ClearMap() *ActionUpdateOne {
+	auo.mutation.ClearMap()
+	return auo
+}
+
+// Where appends a list predicates to the ActionUpdate builder.
+func (auo *ActionUpdateOne) Where(ps ...predicate.Action) *ActionUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *ActionUpdateOne) Select(field string, fields ...string) *ActionUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Action entity.
+func (auo *ActionUpdateOne) Save(ctx context.Context) (*Action, error) {
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *ActionUpdateOne) SaveX(ctx context.Context) *Action {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *ActionUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *ActionUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *ActionUpdateOne) check() error {
+	if v, ok := auo.mutation.Sequence(); ok {
+		if err := action.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "Action.sequence": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Name(); ok {
+		if err := action.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Action.name": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.TurnNumber(); ok {
+		if err := action.TurnNumberValidator(v); err != nil {
+			return &ValidationError{Name: "turn_number", err: fmt.Errorf(`ent: validator failed for field "Action.turn_number": %w`, err)}
+		}
+	}
+	if auo.mutation.TurnCleared() && len(auo.mutation.TurnIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Action.turn"`)
+	}
+	return nil
+}
+
+func (auo *ActionUpdateOne) sqlSave(ctx context.Context) (_node *Action, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(action.Table, action.Columns, sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Action.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, action.FieldID)
+		for _, f := range fields {
+			if !action.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != action.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.Sequence(); ok {
+		_spec.SetField(action.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedSequence(); ok {
+		_spec.AddField(action.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.Name(); ok {
+		_spec.SetField(action.FieldName, field.TypeEnum, value)
+	}
+	if value, ok := auo.mutation.Class(); ok {
+		_spec.SetField(action.FieldClass, field.TypeUint8, value)
+	}
+	if value, ok := auo.mutation.AddedClass(); ok {
+		_spec.AddField(action.FieldClass, field.TypeUint8, value)
+	}
+	if value, ok := auo.mutation.Race(); ok {
+		_spec.SetField(action.FieldRace, field.TypeUint8, value)
+	}
+	if value, ok := auo.mutation.AddedRace(); ok {
+		_spec.AddField(action.FieldRace, field.TypeUint8, value)
+	}
+	if value, ok := auo.mutation.TurnNumber(); ok {
+		_spec.SetField(action.FieldTurnNumber, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedTurnNumber(); ok {
+		_spec.AddField(action.FieldTurnNumber, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AgentI(); ok {
+		_spec.SetField(action.FieldAgentI, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedAgentI(); ok {
+		_spec.AddField(action.FieldAgentI, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AgentJ(); ok {
+		_spec.SetField(action.FieldAgentJ, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedAgentJ(); ok {
+		_spec.AddField(action.FieldAgentJ, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.TargetI(); ok {
+		_spec.SetField(action.FieldTargetI, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedTargetI(); ok {
+		_spec.AddField(action.FieldTargetI, field.TypeInt, value)
+	}
+	if auo.mutation.TargetICleared() {
+		_spec.ClearField(action.FieldTargetI, field.TypeInt)
+	}
+	if value, ok := auo.mutation.TargetJ(); ok {
+		_spec.SetField(action.FieldTargetJ, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedTargetJ(); ok {
+		_spec.AddField(action.FieldTargetJ, field.TypeInt, value)
+	}
+	if auo.mutation.TargetJCleared() {
+		_spec.ClearField(action.FieldTargetJ, field.TypeInt)
+	}
+	if value, ok := auo.mutation.DestI(); ok {
+		_spec.SetField(action.FieldDestI, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedDestI(); ok {
+		_spec.AddField(action.FieldDestI, field.TypeInt, value)
+	}
+	if auo.mutation.DestICleared() {
+		_spec.ClearField(action.FieldDestI, field.TypeInt)
+	}
+	if value, ok := auo.mutation.DestJ(); ok {
+		_spec.SetField(action.FieldDestJ, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedDestJ(); ok {
+		_spec.AddField(action.FieldDestJ, field.TypeInt, value)
+	}
+	if auo.mutation.DestJCleared() {
+		_spec.ClearField(action.FieldDestJ, field.TypeInt)
+	}
+	if auo.mutation.TurnCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   action.TurnTable,
+			Columns: []string{action.TurnColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.TurnIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   action.TurnTable,
+			Columns: []string{action.TurnColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.MapCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   action.MapTable,
+			Columns: []string{action.MapColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(osnmap.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.MapIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   action.MapTable,
+			Columns: []string{action.MapColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(osnmap.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Action{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{action.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -15,10 +15,15 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kevindamm/wits-go/ent/action"
 	"github.com/kevindamm/wits-go/ent/match"
 	"github.com/kevindamm/wits-go/ent/osnmap"
 	"github.com/kevindamm/wits-go/ent/player"
 	"github.com/kevindamm/wits-go/ent/playerrole"
+	"github.com/kevindamm/wits-go/ent/rating"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+	"github.com/kevindamm/wits-go/ent/standing"
+	"github.com/kevindamm/wits-go/ent/turn"
 )
 
 // Client is the client that holds all ent builders.
@@ -26,6 +31,8 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Action is the client for interacting with the Action builders.
+	Action *ActionClient
 	// Match is the client for interacting with the Match builders.
 	Match *MatchClient
 	// OsnMap is the client for interacting with the OsnMap builders.
@@ -34,6 +41,14 @@ type Client struct {
 	Player *PlayerClient
 	// PlayerRole is the client for interacting with the PlayerRole builders.
 	PlayerRole *PlayerRoleClient
+	// Rating is the client for interacting with the Rating builders.
+	Rating *RatingClient
+	// RatingHistory is the client for interacting with the RatingHistory builders.
+	RatingHistory *RatingHistoryClient
+	// Standing is the client for interacting with the Standing builders.
+	Standing *StandingClient
+	// Turn is the client for interacting with the Turn builders.
+	Turn *TurnClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -45,10 +60,15 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Action = NewActionClient(c.config)
 	c.Match = NewMatchClient(c.config)
 	c.OsnMap = NewOsnMapClient(c.config)
 	c.Player = NewPlayerClient(c.config)
 	c.PlayerRole = NewPlayerRoleClient(c.config)
+	c.Rating = NewRatingClient(c.config)
+	c.RatingHistory = NewRatingHistoryClient(c.config)
+	c.Standing = NewStandingClient(c.config)
+	c.Turn = NewTurnClient(c.config)
 }
 
 type (
@@ -139,12 +159,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Match:      NewMatchClient(cfg),
-		OsnMap:     NewOsnMapClient(cfg),
-		Player:     NewPlayerClient(cfg),
-		PlayerRole: NewPlayerRoleClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Action:        NewActionClient(cfg),
+		Match:         NewMatchClient(cfg),
+		OsnMap:        NewOsnMapClient(cfg),
+		Player:        NewPlayerClient(cfg),
+		PlayerRole:    NewPlayerRoleClient(cfg),
+		Rating:        NewRatingClient(cfg),
+		RatingHistory: NewRatingHistoryClient(cfg),
+		Standing:      NewStandingClient(cfg),
+		Turn:          NewTurnClient(cfg),
 	}, nil
 }
 
@@ -162,19 +187,24 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Match:      NewMatchClient(cfg),
-		OsnMap:     NewOsnMapClient(cfg),
-		Player:     NewPlayerClient(cfg),
-		PlayerRole: NewPlayerRoleClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Action:        NewActionClient(cfg),
+		Match:         NewMatchClient(cfg),
+		OsnMap:        NewOsnMapClient(cfg),
+		Player:        NewPlayerClient(cfg),
+		PlayerRole:    NewPlayerRoleClient(cfg),
+		Rating:        NewRatingClient(cfg),
+		RatingHistory: NewRatingHistoryClient(cfg),
+		Standing:      NewStandingClient(cfg),
+		Turn:          NewTurnClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Match.
+//		Action.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -196,24 +226,30 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Match.Use(hooks...)
-	c.OsnMap.Use(hooks...)
-	c.Player.Use(hooks...)
-	c.PlayerRole.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Action, c.Match, c.OsnMap, c.Player, c.PlayerRole, c.Rating, c.RatingHistory,
+		c.Standing, c.Turn,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Match.Intercept(interceptors...)
-	c.OsnMap.Intercept(interceptors...)
-	c.Player.Intercept(interceptors...)
-	c.PlayerRole.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Action, c.Match, c.OsnMap, c.Player, c.PlayerRole, c.Rating, c.RatingHistory,
+		c.Standing, c.Turn,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ActionMutation:
+		return c.Action.mutate(ctx, m)
 	case *MatchMutation:
 		return c.Match.mutate(ctx, m)
 	case *OsnMapMutation:
@@ -222,11 +258,184 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Player.mutate(ctx, m)
 	case *PlayerRoleMutation:
 		return c.PlayerRole.mutate(ctx, m)
+	case *RatingMutation:
+		return c.Rating.mutate(ctx, m)
+	case *RatingHistoryMutation:
+		return c.RatingHistory.mutate(ctx, m)
+	case *StandingMutation:
+		return c.Standing.mutate(ctx, m)
+	case *TurnMutation:
+		return c.Turn.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// ActionClient is a client for the Action schema.
+type ActionClient struct {
+	config
+}
+
+// NewActionClient returns a client for the Action from the given config.
+func NewActionClient(c config) *ActionClient {
+	return &ActionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `action.Hooks(f(g(h())))`.
+func (c *ActionClient) Use(hooks ...Hook) {
+	c.hooks.Action = append(c.hooks.Action, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `action.Intercept(f(g(h())))`.
+func (c *ActionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Action = append(c.inters.Action, interceptors...)
+}
+
+// Create returns a builder for creating a Action entity.
+func (c *ActionClient) Create() *ActionCreate {
+	mutation := newActionMutation(c.config, OpCreate)
+	return &ActionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Action entities.
+func (c *ActionClient) CreateBulk(builders ...*ActionCreate) *ActionCreateBulk {
+	return &ActionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ActionClient) MapCreateBulk(slice any, setFunc func(*ActionCreate, int)) *ActionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ActionCreateBulk{err: fmt.Errorf("calling to ActionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ActionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ActionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Action.
+func (c *ActionClient) Update() *ActionUpdate {
+	mutation := newActionMutation(c.config, OpUpdate)
+	return &ActionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ActionClient) UpdateOne(a *Action) *ActionUpdateOne {
+	mutation := newActionMutation(c.config, OpUpdateOne, withAction(a))
+	return &ActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ActionClient) UpdateOneID(id int) *ActionUpdateOne {
+	mutation := newActionMutation(c.config, OpUpdateOne, withActionID(id))
+	return &ActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Action.
+func (c *ActionClient) Delete() *ActionDelete {
+	mutation := newActionMutation(c.config, OpDelete)
+	return &ActionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ActionClient) DeleteOne(a *Action) *ActionDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ActionClient) DeleteOneID(id int) *ActionDeleteOne {
+	builder := c.Delete().Where(action.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ActionDeleteOne{builder}
+}
+
+// Query returns a query builder for Action.
+func (c *ActionClient) Query() *ActionQuery {
+	return &ActionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Action entity by its id.
+func (c *ActionClient) Get(ctx context.Context, id int) (*Action, error) {
+	return c.Query().Where(action.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ActionClient) GetX(ctx context.Context, id int) *Action {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTurn queries the turn edge of a Action.
+func (c *ActionClient) QueryTurn(a *Action) *TurnQuery {
+	query := (&TurnClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(action.Table, action.FieldID, id),
+			sqlgraph.To(turn.Table, turn.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, action.TurnTable, action.TurnColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMap queries the map edge of a Action.
+func (c *ActionClient) QueryMap(a *Action) *OsnMapQuery {
+	query := (&OsnMapClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(action.Table, action.FieldID, id),
+			sqlgraph.To(osnmap.Table, osnmap.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, action.MapTable, action.MapColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ActionClient) Hooks() []Hook {
+	return c.hooks.Action
+}
+
+// Interceptors returns the client interceptors.
+func (c *ActionClient) Interceptors() []Interceptor {
+	return c.inters.Action
+}
+
+func (c *ActionClient) mutate(ctx context.Context, m *ActionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ActionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ActionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ActionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Action mutation op: %q", m.Op())
+	}
+}
+
 // MatchClient is a client for the Match schema.
 type MatchClient struct {
 	config
@@ -367,6 +576,38 @@ func (c *MatchClient) QueryRoles(m *Match) *PlayerRoleQuery {
 	return query
 }
 
+// QueryTurns queries the turns edge of a Match.
+func (c *MatchClient) QueryTurns(m *Match) *TurnQuery {
+	query := (&TurnClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(match.Table, match.FieldID, id),
+			sqlgraph.To(turn.Table, turn.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, match.TurnsTable, match.TurnsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRatingHistory queries the rating_history edge of a Match.
+func (c *MatchClient) QueryRatingHistory(m *Match) *RatingHistoryQuery {
+	query := (&RatingHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(match.Table, match.FieldID, id),
+			sqlgraph.To(ratinghistory.Table, ratinghistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, match.RatingHistoryTable, match.RatingHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *MatchClient) Hooks() []Hook {
 	return c.hooks.Match
@@ -516,6 +757,22 @@ func (c *OsnMapClient) QueryMatches(om *OsnMap) *MatchQuery {
 	return query
 }
 
+// QueryActions queries the actions edge of a OsnMap.
+func (c *OsnMapClient) QueryActions(om *OsnMap) *ActionQuery {
+	query := (&ActionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := om.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(osnmap.Table, osnmap.FieldID, id),
+			sqlgraph.To(action.Table, action.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, osnmap.ActionsTable, osnmap.ActionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(om.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *OsnMapClient) Hooks() []Hook {
 	return c.hooks.OsnMap
@@ -665,6 +922,54 @@ func (c *PlayerClient) QueryRoles(pl *Player) *PlayerRoleQuery {
 	return query
 }
 
+// QueryRatings queries the ratings edge of a Player.
+func (c *PlayerClient) QueryRatings(pl *Player) *RatingQuery {
+	query := (&RatingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(rating.Table, rating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.RatingsTable, player.RatingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRatingHistory queries the rating_history edge of a Player.
+func (c *PlayerClient) QueryRatingHistory(pl *Player) *RatingHistoryQuery {
+	query := (&RatingHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(ratinghistory.Table, ratinghistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.RatingHistoryTable, player.RatingHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStandings queries the standings edge of a Player.
+func (c *PlayerClient) QueryStandings(pl *Player) *StandingQuery {
+	query := (&StandingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(standing.Table, standing.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.StandingsTable, player.StandingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PlayerClient) Hooks() []Hook {
 	return c.hooks.Player
@@ -855,12 +1160,642 @@ func (c *PlayerRoleClient) mutate(ctx context.Context, m *PlayerRoleMutation) (V
 	}
 }
 
+// RatingClient is a client for the Rating schema.
+type RatingClient struct {
+	config
+}
+
+// NewRatingClient returns a client for the Rating from the given config.
+func NewRatingClient(c config) *RatingClient {
+	return &RatingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rating.Hooks(f(g(h())))`.
+func (c *RatingClient) Use(hooks ...Hook) {
+	c.hooks.Rating = append(c.hooks.Rating, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rating.Intercept(f(g(h())))`.
+func (c *RatingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Rating = append(c.inters.Rating, interceptors...)
+}
+
+// Create returns a builder for creating a Rating entity.
+func (c *RatingClient) Create() *RatingCreate {
+	mutation := newRatingMutation(c.config, OpCreate)
+	return &RatingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Rating entities.
+func (c *RatingClient) CreateBulk(builders ...*RatingCreate) *RatingCreateBulk {
+	return &RatingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RatingClient) MapCreateBulk(slice any, setFunc func(*RatingCreate, int)) *RatingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RatingCreateBulk{err: fmt.Errorf("calling to RatingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RatingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RatingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Rating.
+func (c *RatingClient) Update() *RatingUpdate {
+	mutation := newRatingMutation(c.config, OpUpdate)
+	return &RatingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RatingClient) UpdateOne(r *Rating) *RatingUpdateOne {
+	mutation := newRatingMutation(c.config, OpUpdateOne, withRating(r))
+	return &RatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RatingClient) UpdateOneID(id int) *RatingUpdateOne {
+	mutation := newRatingMutation(c.config, OpUpdateOne, withRatingID(id))
+	return &RatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Rating.
+func (c *RatingClient) Delete() *RatingDelete {
+	mutation := newRatingMutation(c.config, OpDelete)
+	return &RatingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RatingClient) DeleteOne(r *Rating) *RatingDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RatingClient) DeleteOneID(id int) *RatingDeleteOne {
+	builder := c.Delete().Where(rating.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RatingDeleteOne{builder}
+}
+
+// Query returns a query builder for Rating.
+func (c *RatingClient) Query() *RatingQuery {
+	return &RatingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRating},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Rating entity by its id.
+func (c *RatingClient) Get(ctx context.Context, id int) (*Rating, error) {
+	return c.Query().Where(rating.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RatingClient) GetX(ctx context.Context, id int) *Rating {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer queries the player edge of a Rating.
+func (c *RatingClient) QueryPlayer(r *Rating) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rating.Table, rating.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rating.PlayerTable, rating.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RatingClient) Hooks() []Hook {
+	return c.hooks.Rating
+}
+
+// Interceptors returns the client interceptors.
+func (c *RatingClient) Interceptors() []Interceptor {
+	return c.inters.Rating
+}
+
+func (c *RatingClient) mutate(ctx context.Context, m *RatingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RatingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RatingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RatingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Rating mutation op: %q", m.Op())
+	}
+}
+
+// RatingHistoryClient is a client for the RatingHistory schema.
+type RatingHistoryClient struct {
+	config
+}
+
+// NewRatingHistoryClient returns a client for the RatingHistory from the given config.
+func NewRatingHistoryClient(c config) *RatingHistoryClient {
+	return &RatingHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ratinghistory.Hooks(f(g(h())))`.
+func (c *RatingHistoryClient) Use(hooks ...Hook) {
+	c.hooks.RatingHistory = append(c.hooks.RatingHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ratinghistory.Intercept(f(g(h())))`.
+func (c *RatingHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RatingHistory = append(c.inters.RatingHistory, interceptors...)
+}
+
+// Create returns a builder for creating a RatingHistory entity.
+func (c *RatingHistoryClient) Create() *RatingHistoryCreate {
+	mutation := newRatingHistoryMutation(c.config, OpCreate)
+	return &RatingHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RatingHistory entities.
+func (c *RatingHistoryClient) CreateBulk(builders ...*RatingHistoryCreate) *RatingHistoryCreateBulk {
+	return &RatingHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RatingHistoryClient) MapCreateBulk(slice any, setFunc func(*RatingHistoryCreate, int)) *RatingHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RatingHistoryCreateBulk{err: fmt.Errorf("calling to RatingHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RatingHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RatingHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RatingHistory.
+func (c *RatingHistoryClient) Update() *RatingHistoryUpdate {
+	mutation := newRatingHistoryMutation(c.config, OpUpdate)
+	return &RatingHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RatingHistoryClient) UpdateOne(rh *RatingHistory) *RatingHistoryUpdateOne {
+	mutation := newRatingHistoryMutation(c.config, OpUpdateOne, withRatingHistory(rh))
+	return &RatingHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RatingHistoryClient) UpdateOneID(id int) *RatingHistoryUpdateOne {
+	mutation := newRatingHistoryMutation(c.config, OpUpdateOne, withRatingHistoryID(id))
+	return &RatingHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RatingHistory.
+func (c *RatingHistoryClient) Delete() *RatingHistoryDelete {
+	mutation := newRatingHistoryMutation(c.config, OpDelete)
+	return &RatingHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RatingHistoryClient) DeleteOne(rh *RatingHistory) *RatingHistoryDeleteOne {
+	return c.DeleteOneID(rh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RatingHistoryClient) DeleteOneID(id int) *RatingHistoryDeleteOne {
+	builder := c.Delete().Where(ratinghistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RatingHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for RatingHistory.
+func (c *RatingHistoryClient) Query() *RatingHistoryQuery {
+	return &RatingHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRatingHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RatingHistory entity by its id.
+func (c *RatingHistoryClient) Get(ctx context.Context, id int) (*RatingHistory, error) {
+	return c.Query().Where(ratinghistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RatingHistoryClient) GetX(ctx context.Context, id int) *RatingHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer queries the player edge of a RatingHistory.
+func (c *RatingHistoryClient) QueryPlayer(rh *RatingHistory) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ratinghistory.Table, ratinghistory.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ratinghistory.PlayerTable, ratinghistory.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(rh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMatch queries the match edge of a RatingHistory.
+func (c *RatingHistoryClient) QueryMatch(rh *RatingHistory) *MatchQuery {
+	query := (&MatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ratinghistory.Table, ratinghistory.FieldID, id),
+			sqlgraph.To(match.Table, match.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ratinghistory.MatchTable, ratinghistory.MatchColumn),
+		)
+		fromV = sqlgraph.Neighbors(rh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RatingHistoryClient) Hooks() []Hook {
+	return c.hooks.RatingHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *RatingHistoryClient) Interceptors() []Interceptor {
+	return c.inters.RatingHistory
+}
+
+func (c *RatingHistoryClient) mutate(ctx context.Context, m *RatingHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RatingHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RatingHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RatingHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RatingHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RatingHistory mutation op: %q", m.Op())
+	}
+}
+
+// StandingClient is a client for the Standing schema.
+type StandingClient struct {
+	config
+}
+
+// NewStandingClient returns a client for the Standing from the given config.
+func NewStandingClient(c config) *StandingClient {
+	return &StandingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `standing.Hooks(f(g(h())))`.
+func (c *StandingClient) Use(hooks ...Hook) {
+	c.hooks.Standing = append(c.hooks.Standing, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `standing.Intercept(f(g(h())))`.
+func (c *StandingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Standing = append(c.inters.Standing, interceptors...)
+}
+
+// Create returns a builder for creating a Standing entity.
+func (c *StandingClient) Create() *StandingCreate {
+	mutation := newStandingMutation(c.config, OpCreate)
+	return &StandingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Standing entities.
+func (c *StandingClient) CreateBulk(builders ...*StandingCreate) *StandingCreateBulk {
+	return &StandingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StandingClient) MapCreateBulk(slice any, setFunc func(*StandingCreate, int)) *StandingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StandingCreateBulk{err: fmt.Errorf("calling to StandingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StandingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StandingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Standing.
+func (c *StandingClient) Update() *StandingUpdate {
+	mutation := newStandingMutation(c.config, OpUpdate)
+	return &StandingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StandingClient) UpdateOne(s *Standing) *StandingUpdateOne {
+	mutation := newStandingMutation(c.config, OpUpdateOne, withStanding(s))
+	return &StandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StandingClient) UpdateOneID(id int) *StandingUpdateOne {
+	mutation := newStandingMutation(c.config, OpUpdateOne, withStandingID(id))
+	return &StandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Standing.
+func (c *StandingClient) Delete() *StandingDelete {
+	mutation := newStandingMutation(c.config, OpDelete)
+	return &StandingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StandingClient) DeleteOne(s *Standing) *StandingDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StandingClient) DeleteOneID(id int) *StandingDeleteOne {
+	builder := c.Delete().Where(standing.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StandingDeleteOne{builder}
+}
+
+// Query returns a query builder for Standing.
+func (c *StandingClient) Query() *StandingQuery {
+	return &StandingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStanding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Standing entity by its id.
+func (c *StandingClient) Get(ctx context.Context, id int) (*Standing, error) {
+	return c.Query().Where(standing.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StandingClient) GetX(ctx context.Context, id int) *Standing {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer queries the player edge of a Standing.
+func (c *StandingClient) QueryPlayer(s *Standing) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(standing.Table, standing.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, standing.PlayerTable, standing.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StandingClient) Hooks() []Hook {
+	return c.hooks.Standing
+}
+
+// Interceptors returns the client interceptors.
+func (c *StandingClient) Interceptors() []Interceptor {
+	return c.inters.Standing
+}
+
+func (c *StandingClient) mutate(ctx context.Context, m *StandingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StandingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StandingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StandingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StandingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Standing mutation op: %q", m.Op())
+	}
+}
+
+// TurnClient is a client for the Turn schema.
+type TurnClient struct {
+	config
+}
+
+// NewTurnClient returns a client for the Turn from the given config.
+func NewTurnClient(c config) *TurnClient {
+	return &TurnClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `turn.Hooks(f(g(h())))`.
+func (c *TurnClient) Use(hooks ...Hook) {
+	c.hooks.Turn = append(c.hooks.Turn, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `turn.Intercept(f(g(h())))`.
+func (c *TurnClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Turn = append(c.inters.Turn, interceptors...)
+}
+
+// Create returns a builder for creating a Turn entity.
+func (c *TurnClient) Create() *TurnCreate {
+	mutation := newTurnMutation(c.config, OpCreate)
+	return &TurnCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Turn entities.
+func (c *TurnClient) CreateBulk(builders ...*TurnCreate) *TurnCreateBulk {
+	return &TurnCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TurnClient) MapCreateBulk(slice any, setFunc func(*TurnCreate, int)) *TurnCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TurnCreateBulk{err: fmt.Errorf("calling to TurnClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TurnCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TurnCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Turn.
+func (c *TurnClient) Update() *TurnUpdate {
+	mutation := newTurnMutation(c.config, OpUpdate)
+	return &TurnUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TurnClient) UpdateOne(t *Turn) *TurnUpdateOne {
+	mutation := newTurnMutation(c.config, OpUpdateOne, withTurn(t))
+	return &TurnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TurnClient) UpdateOneID(id int) *TurnUpdateOne {
+	mutation := newTurnMutation(c.config, OpUpdateOne, withTurnID(id))
+	return &TurnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Turn.
+func (c *TurnClient) Delete() *TurnDelete {
+	mutation := newTurnMutation(c.config, OpDelete)
+	return &TurnDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TurnClient) DeleteOne(t *Turn) *TurnDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TurnClient) DeleteOneID(id int) *TurnDeleteOne {
+	builder := c.Delete().Where(turn.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TurnDeleteOne{builder}
+}
+
+// Query returns a query builder for Turn.
+func (c *TurnClient) Query() *TurnQuery {
+	return &TurnQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTurn},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Turn entity by its id.
+func (c *TurnClient) Get(ctx context.Context, id int) (*Turn, error) {
+	return c.Query().Where(turn.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TurnClient) GetX(ctx context.Context, id int) *Turn {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMatch queries the match edge of a Turn.
+func (c *TurnClient) QueryMatch(t *Turn) *MatchQuery {
+	query := (&MatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(turn.Table, turn.FieldID, id),
+			sqlgraph.To(match.Table, match.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, turn.MatchTable, turn.MatchColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActions queries the actions edge of a Turn.
+func (c *TurnClient) QueryActions(t *Turn) *ActionQuery {
+	query := (&ActionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(turn.Table, turn.FieldID, id),
+			sqlgraph.To(action.Table, action.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, turn.ActionsTable, turn.ActionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TurnClient) Hooks() []Hook {
+	return c.hooks.Turn
+}
+
+// Interceptors returns the client interceptors.
+func (c *TurnClient) Interceptors() []Interceptor {
+	return c.inters.Turn
+}
+
+func (c *TurnClient) mutate(ctx context.Context, m *TurnMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TurnCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TurnUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TurnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TurnDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Turn mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Match, OsnMap, Player, PlayerRole []ent.Hook
+		Action, Match, OsnMap, Player, PlayerRole, Rating, RatingHistory, Standing,
+		Turn []ent.Hook
 	}
 	inters struct {
-		Match, OsnMap, Player, PlayerRole []ent.Interceptor
+		Action, Match, OsnMap, Player, PlayerRole, Rating, RatingHistory, Standing,
+		Turn []ent.Interceptor
 	}
 )
@@ -12,10 +12,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kevindamm/wits-go/ent/action"
 	"github.com/kevindamm/wits-go/ent/match"
 	"github.com/kevindamm/wits-go/ent/osnmap"
 	"github.com/kevindamm/wits-go/ent/player"
 	"github.com/kevindamm/wits-go/ent/playerrole"
+	"github.com/kevindamm/wits-go/ent/rating"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+	"github.com/kevindamm/wits-go/ent/standing"
+	"github.com/kevindamm/wits-go/ent/turn"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +81,15 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			match.Table:      match.ValidColumn,
-			osnmap.Table:     osnmap.ValidColumn,
-			player.Table:     player.ValidColumn,
-			playerrole.Table: playerrole.ValidColumn,
+			action.Table:        action.ValidColumn,
+			match.Table:         match.ValidColumn,
+			osnmap.Table:        osnmap.ValidColumn,
+			player.Table:        player.ValidColumn,
+			playerrole.Table:    playerrole.ValidColumn,
+			rating.Table:        rating.ValidColumn,
+			ratinghistory.Table: ratinghistory.ValidColumn,
+			standing.Table:      standing.ValidColumn,
+			turn.Table:          turn.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/kevindamm/wits-go/ent/match"
 	"github.com/kevindamm/wits-go/ent/osnmap"
+	"github.com/kevindamm/wits-go/state"
 )
 
 // Match is the model entity for the Match schema.
@@ -22,14 +24,22 @@ type Match struct {
 	MatchHash string `json:"match_hash,omitempty"`
 	// Which runtime version this match was played on.  Latest version is 1603.
 	Version int `json:"version,omitempty"`
+	// The custom variant of the rules the match was played under, if not those of its version.
+	Rules *state.Ruleset `json:"rules,omitempty"`
+	// The content hash of the map definition the match was played on, see OsnMap.content_hash.
+	MapHash string `json:"map_hash,omitempty"`
 	// Zero value also implies non-competitive play.
 	Season int8 `json:"season,omitempty"`
 	// The timestamp when this match was recorded.  Nillable so it is not required in JSON responses.
 	CreatedTs *time.Time `json:"created_ts,omitempty"`
+	// When the match was played, if known.  Matches are rated and ranked in the order they were played, see archive.ByPlayed.
+	PlayedTs *time.Time `json:"played_ts,omitempty"`
 	// TurnCount holds the value of the "turn_count" field.
 	TurnCount int `json:"turn_count,omitempty"`
 	// Where in the fetch/minify/convert process the replay is.  Nillable so that it is not required in JSON responses.
 	FetchStatus *match.FetchStatus `json:"fetch_status,omitempty"`
+	// Why the replay could not advance when its fetch_status is INVALID.
+	InvalidReason string `json:"invalid_reason,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MatchQuery when eager-loading is set.
 	Edges           MatchEdges `json:"edges"`
@@ -43,9 +53,13 @@ type MatchEdges struct {
 	Map *OsnMap `json:"map,omitempty"`
 	// Roles holds the value of the roles edge.
 	Roles []*PlayerRole `json:"roles,omitempty"`
+	// Turns holds the value of the turns edge.
+	Turns []*Turn `json:"turns,omitempty"`
+	// RatingHistory holds the value of the rating_history edge.
+	RatingHistory []*RatingHistory `json:"rating_history,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // MapOrErr returns the Map value or an error if the edge
@@ -68,16 +82,36 @@ func (e MatchEdges) RolesOrErr() ([]*PlayerRole, error) {
 	return nil, &NotLoadedError{edge: "roles"}
 }
 
+// TurnsOrErr returns the Turns value or an error if the edge
+// was not loaded in eager-loading.
+func (e MatchEdges) TurnsOrErr() ([]*Turn, error) {
+	if e.loadedTypes[2] {
+		return e.Turns, nil
+	}
+	return nil, &NotLoadedError{edge: "turns"}
+}
+
+// RatingHistoryOrErr returns the RatingHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e MatchEdges) RatingHistoryOrErr() ([]*RatingHistory, error) {
+	if e.loadedTypes[3] {
+		return e.RatingHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "rating_history"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Match) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case match.FieldRules:
+			values[i] = new([]byte)
 		case match.FieldID, match.FieldVersion, match.FieldSeason, match.FieldTurnCount:
 			values[i] = new(sql.NullInt64)
-		case match.FieldMatchHash, match.FieldFetchStatus:
+		case match.FieldMatchHash, match.FieldMapHash, match.FieldFetchStatus, match.FieldInvalidReason:
 			values[i] = new(sql.NullString)
-		case match.FieldCreatedTs:
+		case match.FieldCreatedTs, match.FieldPlayedTs:
 			values[i] = new(sql.NullTime)
 		case match.ForeignKeys[0]: // osn_map_matches
 			values[i] = new(sql.NullInt64)
@@ -114,6 +148,20 @@ func (m *Match) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.Version = int(value.Int64)
 			}
+		case match.FieldRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.Rules); err != nil {
+					return fmt.Errorf("unmarshal field rules: %w", err)
+				}
+			}
+		case match.FieldMapHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field map_hash", values[i])
+			} else if value.Valid {
+				m.MapHash = value.String
+			}
 		case match.FieldSeason:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field season", values[i])
@@ -127,6 +175,13 @@ func (m *Match) assignValues(columns []string, values []any) error {
 				m.CreatedTs = new(time.Time)
 				*m.CreatedTs = value.Time
 			}
+		case match.FieldPlayedTs:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field played_ts", values[i])
+			} else if value.Valid {
+				m.PlayedTs = new(time.Time)
+				*m.PlayedTs = value.Time
+			}
 		case match.FieldTurnCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field turn_count", values[i])
@@ -140,6 +195,12 @@ func (m *Match) assignValues(columns []string, values []any) error {
 				m.FetchStatus = new(match.FetchStatus)
 				*m.FetchStatus = match.FetchStatus(value.String)
 			}
+		case match.FieldInvalidReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invalid_reason", values[i])
+			} else if value.Valid {
+				m.InvalidReason = value.String
+			}
 		case match.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field osn_map_matches", value)
@@ -170,6 +231,16 @@ func (m *Match) QueryRoles() *PlayerRoleQuery {
 	return NewMatchClient(m.config).QueryRoles(m)
 }
 
+// QueryTurns queries the "turns" edge of the Match entity.
+func (m *Match) QueryTurns() *TurnQuery {
+	return NewMatchClient(m.config).QueryTurns(m)
+}
+
+// QueryRatingHistory queries the "rating_history" edge of the Match entity.
+func (m *Match) QueryRatingHistory() *RatingHistoryQuery {
+	return NewMatchClient(m.config).QueryRatingHistory(m)
+}
+
 // Update returns a builder for updating this Match.
 // Note that you need to call Match.Unwrap() before calling this method if this Match
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -199,6 +270,12 @@ func (m *Match) String() string {
 	builder.WriteString("version=")
 	builder.WriteString(fmt.Sprintf("%v", m.Version))
 	builder.WriteString(", ")
+	builder.WriteString("rules=")
+	builder.WriteString(fmt.Sprintf("%v", m.Rules))
+	builder.WriteString(", ")
+	builder.WriteString("map_hash=")
+	builder.WriteString(m.MapHash)
+	builder.WriteString(", ")
 	builder.WriteString("season=")
 	builder.WriteString(fmt.Sprintf("%v", m.Season))
 	builder.WriteString(", ")
@@ -207,6 +284,11 @@ func (m *Match) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := m.PlayedTs; v != nil {
+		builder.WriteString("played_ts=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("turn_count=")
 	builder.WriteString(fmt.Sprintf("%v", m.TurnCount))
 	builder.WriteString(", ")
@@ -214,6 +296,9 @@ func (m *Match) String() string {
 		builder.WriteString("fetch_status=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("invalid_reason=")
+	builder.WriteString(m.InvalidReason)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -19,18 +19,30 @@ const (
 	FieldMatchHash = "match_hash"
 	// FieldVersion holds the string denoting the version field in the database.
 	FieldVersion = "version"
+	// FieldRules holds the string denoting the rules field in the database.
+	FieldRules = "rules"
+	// FieldMapHash holds the string denoting the map_hash field in the database.
+	FieldMapHash = "map_hash"
 	// FieldSeason holds the string denoting the season field in the database.
 	FieldSeason = "season"
 	// FieldCreatedTs holds the string denoting the created_ts field in the database.
 	FieldCreatedTs = "created_ts"
+	// FieldPlayedTs holds the string denoting the played_ts field in the database.
+	FieldPlayedTs = "played_ts"
 	// FieldTurnCount holds the string denoting the turn_count field in the database.
 	FieldTurnCount = "turn_count"
 	// FieldFetchStatus holds the string denoting the fetch_status field in the database.
 	FieldFetchStatus = "fetch_status"
+	// FieldInvalidReason holds the string denoting the invalid_reason field in the database.
+	FieldInvalidReason = "invalid_reason"
 	// EdgeMap holds the string denoting the map edge name in mutations.
 	EdgeMap = "map"
 	// EdgeRoles holds the string denoting the roles edge name in mutations.
 	EdgeRoles = "roles"
+	// EdgeTurns holds the string denoting the turns edge name in mutations.
+	EdgeTurns = "turns"
+	// EdgeRatingHistory holds the string denoting the rating_history edge name in mutations.
+	EdgeRatingHistory = "rating_history"
 	// Table holds the table name of the match in the database.
 	Table = "matches"
 	// MapTable is the table that holds the map relation/edge.
@@ -45,6 +57,20 @@ const (
 	// RolesInverseTable is the table name for the PlayerRole entity.
 	// It exists in this package in order to avoid circular dependency with the "playerrole" package.
 	RolesInverseTable = "player_roles"
+	// TurnsTable is the table that holds the turns relation/edge.
+	TurnsTable = "turns"
+	// TurnsInverseTable is the table name for the Turn entity.
+	// It exists in this package in order to avoid circular dependency with the "turn" package.
+	TurnsInverseTable = "turns"
+	// TurnsColumn is the table column denoting the turns relation/edge.
+	TurnsColumn = "match_turns"
+	// RatingHistoryTable is the table that holds the rating_history relation/edge.
+	RatingHistoryTable = "rating_histories"
+	// RatingHistoryInverseTable is the table name for the RatingHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "ratinghistory" package.
+	RatingHistoryInverseTable = "rating_histories"
+	// RatingHistoryColumn is the table column denoting the rating_history relation/edge.
+	RatingHistoryColumn = "match_rating_history"
 )
 
 // Columns holds all SQL columns for match fields.
@@ -52,10 +78,14 @@ var Columns = []string{
 	FieldID,
 	FieldMatchHash,
 	FieldVersion,
+	FieldRules,
+	FieldMapHash,
 	FieldSeason,
 	FieldCreatedTs,
+	FieldPlayedTs,
 	FieldTurnCount,
 	FieldFetchStatus,
+	FieldInvalidReason,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "matches"
@@ -144,6 +174,11 @@ func ByVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVersion, opts...).ToFunc()
 }
 
+// ByMapHash orders the results by the map_hash field.
+func ByMapHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMapHash, opts...).ToFunc()
+}
+
 // BySeason orders the results by the season field.
 func BySeason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSeason, opts...).ToFunc()
@@ -154,6 +189,11 @@ func ByCreatedTs(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedTs, opts...).ToFunc()
 }
 
+// ByPlayedTs orders the results by the played_ts field.
+func ByPlayedTs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayedTs, opts...).ToFunc()
+}
+
 // ByTurnCount orders the results by the turn_count field.
 func ByTurnCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTurnCount, opts...).ToFunc()
@@ -164,6 +204,11 @@ func ByFetchStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFetchStatus, opts...).ToFunc()
 }
 
+// ByInvalidReason orders the results by the invalid_reason field.
+func ByInvalidReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvalidReason, opts...).ToFunc()
+}
+
 // ByMapField orders the results by map field.
 func ByMapField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -184,6 +229,34 @@ func ByRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTurnsCount orders the results by turns count.
+func ByTurnsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTurnsStep(), opts...)
+	}
+}
+
+// ByTurns orders the results by turns terms.
+func ByTurns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTurnsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRatingHistoryCount orders the results by rating_history count.
+func ByRatingHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRatingHistoryStep(), opts...)
+	}
+}
+
+// ByRatingHistory orders the results by rating_history terms.
+func ByRatingHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRatingHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMapStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -198,3 +271,17 @@ func newRolesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, RolesTable, RolesPrimaryKey...),
 	)
 }
+func newTurnsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TurnsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TurnsTable, TurnsColumn),
+	)
+}
+func newRatingHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RatingHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RatingHistoryTable, RatingHistoryColumn),
+	)
+}
@@ -65,6 +65,11 @@ func Version(v int) predicate.Match {
 	return predicate.Match(sql.FieldEQ(FieldVersion, v))
 }
 
+// MapHash applies equality check predicate on the "map_hash" field. It's identical to MapHashEQ.
+func MapHash(v string) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldMapHash, v))
+}
+
 // Season applies equality check predicate on the "season" field. It's identical to SeasonEQ.
 func Season(v int8) predicate.Match {
 	return predicate.Match(sql.FieldEQ(FieldSeason, v))
@@ -75,11 +80,21 @@ func CreatedTs(v time.Time) predicate.Match {
 	return predicate.Match(sql.FieldEQ(FieldCreatedTs, v))
 }
 
+// PlayedTs applies equality check predicate on the "played_ts" field. It's identical to PlayedTsEQ.
+func PlayedTs(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldPlayedTs, v))
+}
+
 // TurnCount applies equality check predicate on the "turn_count" field. It's identical to TurnCountEQ.
 func TurnCount(v int) predicate.Match {
 	return predicate.Match(sql.FieldEQ(FieldTurnCount, v))
 }
 
+// InvalidReason applies equality check predicate on the "invalid_reason" field. It's identical to InvalidReasonEQ.
+func InvalidReason(v string) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldInvalidReason, v))
+}
+
 // MatchHashEQ applies the EQ predicate on the "match_hash" field.
 func MatchHashEQ(v string) predicate.Match {
 	return predicate.Match(sql.FieldEQ(FieldMatchHash, v))
@@ -185,6 +200,91 @@ func VersionLTE(v int) predicate.Match {
 	return predicate.Match(sql.FieldLTE(FieldVersion, v))
 }
 
+// RulesIsNil applies the IsNil predicate on the "rules" field.
+func RulesIsNil() predicate.Match {
+	return predicate.Match(sql.FieldIsNull(FieldRules))
+}
+
+// RulesNotNil applies the NotNil predicate on the "rules" field.
+func RulesNotNil() predicate.Match {
+	return predicate.Match(sql.FieldNotNull(FieldRules))
+}
+
+// MapHashEQ applies the EQ predicate on the "map_hash" field.
+func MapHashEQ(v string) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldMapHash, v))
+}
+
+// MapHashNEQ applies the NEQ predicate on the "map_hash" field.
+func MapHashNEQ(v string) predicate.Match {
+	return predicate.Match(sql.FieldNEQ(FieldMapHash, v))
+}
+
+// MapHashIn applies the In predicate on the "map_hash" field.
+func MapHashIn(vs ...string) predicate.Match {
+	return predicate.Match(sql.FieldIn(FieldMapHash, vs...))
+}
+
+// MapHashNotIn applies the NotIn predicate on the "map_hash" field.
+func MapHashNotIn(vs ...string) predicate.Match {
+	return predicate.Match(sql.FieldNotIn(FieldMapHash, vs...))
+}
+
+// MapHashGT applies the GT predicate on the "map_hash" field.
+func MapHashGT(v string) predicate.Match {
+	return predicate.Match(sql.FieldGT(FieldMapHash, v))
+}
+
+// MapHashGTE applies the GTE predicate on the "map_hash" field.
+func MapHashGTE(v string) predicate.Match {
+	return predicate.Match(sql.FieldGTE(FieldMapHash, v))
+}
+
+// MapHashLT applies the LT predicate on the "map_hash" field.
+func MapHashLT(v string) predicate.Match {
+	return predicate.Match(sql.FieldLT(FieldMapHash, v))
+}
+
+// MapHashLTE applies the LTE predicate on the "map_hash" field.
+func MapHashLTE(v string) predicate.Match {
+	return predicate.Match(sql.FieldLTE(FieldMapHash, v))
+}
+
+// MapHashContains applies the Contains predicate on the "map_hash" field.
+func MapHashContains(v string) predicate.Match {
+	return predicate.Match(sql.FieldContains(FieldMapHash, v))
+}
+
+// MapHashHasPrefix applies the HasPrefix predicate on the "map_hash" field.
+func MapHashHasPrefix(v string) predicate.Match {
+	return predicate.Match(sql.FieldHasPrefix(FieldMapHash, v))
+}
+
+// MapHashHasSuffix applies the HasSuffix predicate on the "map_hash" field.
+func MapHashHasSuffix(v string) predicate.Match {
+	return predicate.Match(sql.FieldHasSuffix(FieldMapHash, v))
+}
+
+// MapHashIsNil applies the IsNil predicate on the "map_hash" field.
+func MapHashIsNil() predicate.Match {
+	return predicate.Match(sql.FieldIsNull(FieldMapHash))
+}
+
+// MapHashNotNil applies the NotNil predicate on the "map_hash" field.
+func MapHashNotNil() predicate.Match {
+	return predicate.Match(sql.FieldNotNull(FieldMapHash))
+}
+
+// MapHashEqualFold applies the EqualFold predicate on the "map_hash" field.
+func MapHashEqualFold(v string) predicate.Match {
+	return predicate.Match(sql.FieldEqualFold(FieldMapHash, v))
+}
+
+// MapHashContainsFold applies the ContainsFold predicate on the "map_hash" field.
+func MapHashContainsFold(v string) predicate.Match {
+	return predicate.Match(sql.FieldContainsFold(FieldMapHash, v))
+}
+
 // SeasonEQ applies the EQ predicate on the "season" field.
 func SeasonEQ(v int8) predicate.Match {
 	return predicate.Match(sql.FieldEQ(FieldSeason, v))
@@ -265,6 +365,56 @@ func CreatedTsLTE(v time.Time) predicate.Match {
 	return predicate.Match(sql.FieldLTE(FieldCreatedTs, v))
 }
 
+// PlayedTsEQ applies the EQ predicate on the "played_ts" field.
+func PlayedTsEQ(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldPlayedTs, v))
+}
+
+// PlayedTsNEQ applies the NEQ predicate on the "played_ts" field.
+func PlayedTsNEQ(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldNEQ(FieldPlayedTs, v))
+}
+
+// PlayedTsIn applies the In predicate on the "played_ts" field.
+func PlayedTsIn(vs ...time.Time) predicate.Match {
+	return predicate.Match(sql.FieldIn(FieldPlayedTs, vs...))
+}
+
+// PlayedTsNotIn applies the NotIn predicate on the "played_ts" field.
+func PlayedTsNotIn(vs ...time.Time) predicate.Match {
+	return predicate.Match(sql.FieldNotIn(FieldPlayedTs, vs...))
+}
+
+// PlayedTsGT applies the GT predicate on the "played_ts" field.
+func PlayedTsGT(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldGT(FieldPlayedTs, v))
+}
+
+// PlayedTsGTE applies the GTE predicate on the "played_ts" field.
+func PlayedTsGTE(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldGTE(FieldPlayedTs, v))
+}
+
+// PlayedTsLT applies the LT predicate on the "played_ts" field.
+func PlayedTsLT(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldLT(FieldPlayedTs, v))
+}
+
+// PlayedTsLTE applies the LTE predicate on the "played_ts" field.
+func PlayedTsLTE(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldLTE(FieldPlayedTs, v))
+}
+
+// PlayedTsIsNil applies the IsNil predicate on the "played_ts" field.
+func PlayedTsIsNil() predicate.Match {
+	return predicate.Match(sql.FieldIsNull(FieldPlayedTs))
+}
+
+// PlayedTsNotNil applies the NotNil predicate on the "played_ts" field.
+func PlayedTsNotNil() predicate.Match {
+	return predicate.Match(sql.FieldNotNull(FieldPlayedTs))
+}
+
 // TurnCountEQ applies the EQ predicate on the "turn_count" field.
 func TurnCountEQ(v int) predicate.Match {
 	return predicate.Match(sql.FieldEQ(FieldTurnCount, v))
@@ -325,6 +475,81 @@ func FetchStatusNotIn(vs ...FetchStatus) predicate.Match {
 	return predicate.Match(sql.FieldNotIn(FieldFetchStatus, vs...))
 }
 
+// InvalidReasonEQ applies the EQ predicate on the "invalid_reason" field.
+func InvalidReasonEQ(v string) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldInvalidReason, v))
+}
+
+// InvalidReasonNEQ applies the NEQ predicate on the "invalid_reason" field.
+func InvalidReasonNEQ(v string) predicate.Match {
+	return predicate.Match(sql.FieldNEQ(FieldInvalidReason, v))
+}
+
+// InvalidReasonIn applies the In predicate on the "invalid_reason" field.
+func InvalidReasonIn(vs ...string) predicate.Match {
+	return predicate.Match(sql.FieldIn(FieldInvalidReason, vs...))
+}
+
+// InvalidReasonNotIn applies the NotIn predicate on the "invalid_reason" field.
+func InvalidReasonNotIn(vs ...string) predicate.Match {
+	return predicate.Match(sql.FieldNotIn(FieldInvalidReason, vs...))
+}
+
+// InvalidReasonGT applies the GT predicate on the "invalid_reason" field.
+func InvalidReasonGT(v string) predicate.Match {
+	return predicate.Match(sql.FieldGT(FieldInvalidReason, v))
+}
+
+// InvalidReasonGTE applies the GTE predicate on the "invalid_reason" field.
+func InvalidReasonGTE(v string) predicate.Match {
+	return predicate.Match(sql.FieldGTE(FieldInvalidReason, v))
+}
+
+// InvalidReasonLT applies the LT predicate on the "invalid_reason" field.
+func InvalidReasonLT(v string) predicate.Match {
+	return predicate.Match(sql.FieldLT(FieldInvalidReason, v))
+}
+
+// InvalidReasonLTE applies the LTE predicate on the "invalid_reason" field.
+func InvalidReasonLTE(v string) predicate.Match {
+	return predicate.Match(sql.FieldLTE(FieldInvalidReason, v))
+}
+
+// InvalidReasonContains applies the Contains predicate on the "invalid_reason" field.
+func InvalidReasonContains(v string) predicate.Match {
+	return predicate.Match(sql.FieldContains(FieldInvalidReason, v))
+}
+
+// InvalidReasonHasPrefix applies the HasPrefix predicate on the "invalid_reason" field.
+func InvalidReasonHasPrefix(v string) predicate.Match {
+	return predicate.Match(sql.FieldHasPrefix(FieldInvalidReason, v))
+}
+
+// InvalidReasonHasSuffix applies the HasSuffix predicate on the "invalid_reason" field.
+func InvalidReasonHasSuffix(v string) predicate.Match {
+	return predicate.Match(sql.FieldHasSuffix(FieldInvalidReason, v))
+}
+
+// InvalidReasonIsNil applies the IsNil predicate on the "invalid_reason" field.
+func InvalidReasonIsNil() predicate.Match {
+	return predicate.Match(sql.FieldIsNull(FieldInvalidReason))
+}
+
+// InvalidReasonNotNil applies the NotNil predicate on the "invalid_reason" field.
+func InvalidReasonNotNil() predicate.Match {
+	return predicate.Match(sql.FieldNotNull(FieldInvalidReason))
+}
+
+// InvalidReasonEqualFold applies the EqualFold predicate on the "invalid_reason" field.
+func InvalidReasonEqualFold(v string) predicate.Match {
+	return predicate.Match(sql.FieldEqualFold(FieldInvalidReason, v))
+}
+
+// InvalidReasonContainsFold applies the ContainsFold predicate on the "invalid_reason" field.
+func InvalidReasonContainsFold(v string) predicate.Match {
+	return predicate.Match(sql.FieldContainsFold(FieldInvalidReason, v))
+}
+
 // HasMap applies the HasEdge predicate on the "map" edge.
 func HasMap() predicate.Match {
 	return predicate.Match(func(s *sql.Selector) {
@@ -371,6 +596,52 @@ func HasRolesWith(preds ...predicate.PlayerRole) predicate.Match {
 	})
 }
 
+// HasTurns applies the HasEdge predicate on the "turns" edge.
+func HasTurns() predicate.Match {
+	return predicate.Match(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TurnsTable, TurnsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTurnsWith applies the HasEdge predicate on the "turns" edge with a given conditions (other predicates).
+func HasTurnsWith(preds ...predicate.Turn) predicate.Match {
+	return predicate.Match(func(s *sql.Selector) {
+		step := newTurnsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRatingHistory applies the HasEdge predicate on the "rating_history" edge.
+func HasRatingHistory() predicate.Match {
+	return predicate.Match(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RatingHistoryTable, RatingHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRatingHistoryWith applies the HasEdge predicate on the "rating_history" edge with a given conditions (other predicates).
+func HasRatingHistoryWith(preds ...predicate.RatingHistory) predicate.Match {
+	return predicate.Match(func(s *sql.Selector) {
+		step := newRatingHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Match) predicate.Match {
 	return predicate.Match(sql.AndPredicates(predicates...))
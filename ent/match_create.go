@@ -13,6 +13,9 @@ import (
 	"github.com/kevindamm/wits-go/ent/match"
 	"github.com/kevindamm/wits-go/ent/osnmap"
 	"github.com/kevindamm/wits-go/ent/playerrole"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+	"github.com/kevindamm/wits-go/ent/turn"
+	"github.com/kevindamm/wits-go/state"
 )
 
 // MatchCreate is the builder for creating a Match entity.
@@ -34,6 +37,26 @@ func (mc *MatchCreate) SetVersion(i int) *MatchCreate {
 	return mc
 }
 
+// SetRules sets the "rules" field.
+func (mc *MatchCreate) SetRules(s *state.Ruleset) *MatchCreate {
+	mc.mutation.SetRules(s)
+	return mc
+}
+
+// SetMapHash sets the "map_hash" field.
+func (mc *MatchCreate) SetMapHash(s string) *MatchCreate {
+	mc.mutation.SetMapHash(s)
+	return mc
+}
+
+// SetNillableMapHash sets the "map_hash" field if the given value is not nil.
+func (mc *MatchCreate) SetNillableMapHash(s *string) *MatchCreate {
+	if s != nil {
+		mc.SetMapHash(*s)
+	}
+	return mc
+}
+
 // SetSeason sets the "season" field.
 func (mc *MatchCreate) SetSeason(i int8) *MatchCreate {
 	mc.mutation.SetSeason(i)
@@ -62,6 +85,20 @@ func (mc *MatchCreate) SetNillableCreatedTs(t *time.Time) *MatchCreate {
 	return mc
 }
 
+// SetPlayedTs sets the "played_ts" field.
+func (mc *MatchCreate) SetPlayedTs(t time.Time) *MatchCreate {
+	mc.mutation.SetPlayedTs(t)
+	return mc
+}
+
+// SetNillablePlayedTs sets the "played_ts" field if the given value is not nil.
+func (mc *MatchCreate) SetNillablePlayedTs(t *time.Time) *MatchCreate {
+	if t != nil {
+		mc.SetPlayedTs(*t)
+	}
+	return mc
+}
+
 // SetTurnCount sets the "turn_count" field.
 func (mc *MatchCreate) SetTurnCount(i int) *MatchCreate {
 	mc.mutation.SetTurnCount(i)
@@ -82,6 +119,20 @@ func (mc *MatchCreate) SetNillableFetchStatus(ms *match.FetchStatus) *MatchCreat
 	return mc
 }
 
+// SetInvalidReason sets the "invalid_reason" field.
+func (mc *MatchCreate) SetInvalidReason(s string) *MatchCreate {
+	mc.mutation.SetInvalidReason(s)
+	return mc
+}
+
+// SetNillableInvalidReason sets the "invalid_reason" field if the given value is not nil.
+func (mc *MatchCreate) SetNillableInvalidReason(s *string) *MatchCreate {
+	if s != nil {
+		mc.SetInvalidReason(*s)
+	}
+	return mc
+}
+
 // SetMapID sets the "map" edge to the OsnMap entity by ID.
 func (mc *MatchCreate) SetMapID(id int) *MatchCreate {
 	mc.mutation.SetMapID(id)
@@ -116,6 +167,36 @@ func (mc *MatchCreate) AddRoles(p ...*PlayerRole) *MatchCreate {
 	return mc.AddRoleIDs(ids...)
 }
 
+// AddTurnIDs adds the "turns" edge to the Turn entity by IDs.
+func (mc *MatchCreate) AddTurnIDs(ids ...int) *MatchCreate {
+	mc.mutation.AddTurnIDs(ids...)
+	return mc
+}
+
+// AddTurns adds the "turns" edges to the Turn entity.
+func (mc *MatchCreate) AddTurns(t ...*Turn) *MatchCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mc.AddTurnIDs(ids...)
+}
+
+// AddRatingHistoryIDs adds the "rating_history" edge to the RatingHistory entity by IDs.
+func (mc *MatchCreate) AddRatingHistoryIDs(ids ...int) *MatchCreate {
+	mc.mutation.AddRatingHistoryIDs(ids...)
+	return mc
+}
+
+// AddRatingHistory adds the "rating_history" edges to the RatingHistory entity.
+func (mc *MatchCreate) AddRatingHistory(r ...*RatingHistory) *MatchCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return mc.AddRatingHistoryIDs(ids...)
+}
+
 // Mutation returns the MatchMutation object of the builder.
 func (mc *MatchCreate) Mutation() *MatchMutation {
 	return mc.mutation
@@ -173,6 +254,11 @@ func (mc *MatchCreate) check() error {
 	if _, ok := mc.mutation.Version(); !ok {
 		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Match.version"`)}
 	}
+	if v, ok := mc.mutation.Rules(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rules", err: fmt.Errorf(`ent: validator failed for field "Match.rules": %w`, err)}
+		}
+	}
 	if _, ok := mc.mutation.Season(); !ok {
 		return &ValidationError{Name: "season", err: errors.New(`ent: missing required field "Match.season"`)}
 	}
@@ -224,6 +310,14 @@ func (mc *MatchCreate) createSpec() (*Match, *sqlgraph.CreateSpec) {
 		_spec.SetField(match.FieldVersion, field.TypeInt, value)
 		_node.Version = value
 	}
+	if value, ok := mc.mutation.Rules(); ok {
+		_spec.SetField(match.FieldRules, field.TypeJSON, value)
+		_node.Rules = value
+	}
+	if value, ok := mc.mutation.MapHash(); ok {
+		_spec.SetField(match.FieldMapHash, field.TypeString, value)
+		_node.MapHash = value
+	}
 	if value, ok := mc.mutation.Season(); ok {
 		_spec.SetField(match.FieldSeason, field.TypeInt8, value)
 		_node.Season = value
@@ -232,6 +326,10 @@ func (mc *MatchCreate) createSpec() (*Match, *sqlgraph.CreateSpec) {
 		_spec.SetField(match.FieldCreatedTs, field.TypeTime, value)
 		_node.CreatedTs = &value
 	}
+	if value, ok := mc.mutation.PlayedTs(); ok {
+		_spec.SetField(match.FieldPlayedTs, field.TypeTime, value)
+		_node.PlayedTs = &value
+	}
 	if value, ok := mc.mutation.TurnCount(); ok {
 		_spec.SetField(match.FieldTurnCount, field.TypeInt, value)
 		_node.TurnCount = value
@@ -240,6 +338,10 @@ func (mc *MatchCreate) createSpec() (*Match, *sqlgraph.CreateSpec) {
 		_spec.SetField(match.FieldFetchStatus, field.TypeEnum, value)
 		_node.FetchStatus = &value
 	}
+	if value, ok := mc.mutation.InvalidReason(); ok {
+		_spec.SetField(match.FieldInvalidReason, field.TypeString, value)
+		_node.InvalidReason = value
+	}
 	if nodes := mc.mutation.MapIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -273,6 +375,38 @@ func (mc *MatchCreate) createSpec() (*Match, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mc.mutation.TurnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.TurnsTable,
+			Columns: []string{match.TurnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.RatingHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.RatingHistoryTable,
+			Columns: []string{match.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -16,18 +16,22 @@ import (
 	"github.com/kevindamm/wits-go/ent/osnmap"
 	"github.com/kevindamm/wits-go/ent/playerrole"
 	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+	"github.com/kevindamm/wits-go/ent/turn"
 )
 
 // MatchQuery is the builder for querying Match entities.
 type MatchQuery struct {
 	config
-	ctx        *QueryContext
-	order      []match.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Match
-	withMap    *OsnMapQuery
-	withRoles  *PlayerRoleQuery
-	withFKs    bool
+	ctx               *QueryContext
+	order             []match.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Match
+	withMap           *OsnMapQuery
+	withRoles         *PlayerRoleQuery
+	withTurns         *TurnQuery
+	withRatingHistory *RatingHistoryQuery
+	withFKs           bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +112,50 @@ func (mq *MatchQuery) QueryRoles() *PlayerRoleQuery {
 	return query
 }
 
+// QueryTurns chains the current query on the "turns" edge.
+func (mq *MatchQuery) QueryTurns() *TurnQuery {
+	query := (&TurnClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(match.Table, match.FieldID, selector),
+			sqlgraph.To(turn.Table, turn.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, match.TurnsTable, match.TurnsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRatingHistory chains the current query on the "rating_history" edge.
+func (mq *MatchQuery) QueryRatingHistory() *RatingHistoryQuery {
+	query := (&RatingHistoryClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(match.Table, match.FieldID, selector),
+			sqlgraph.To(ratinghistory.Table, ratinghistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, match.RatingHistoryTable, match.RatingHistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Match entity from the query.
 // Returns a *NotFoundError when no Match was found.
 func (mq *MatchQuery) First(ctx context.Context) (*Match, error) {
@@ -295,13 +343,15 @@ func (mq *MatchQuery) Clone() *MatchQuery {
 		return nil
 	}
 	return &MatchQuery{
-		config:     mq.config,
-		ctx:        mq.ctx.Clone(),
-		order:      append([]match.OrderOption{}, mq.order...),
-		inters:     append([]Interceptor{}, mq.inters...),
-		predicates: append([]predicate.Match{}, mq.predicates...),
-		withMap:    mq.withMap.Clone(),
-		withRoles:  mq.withRoles.Clone(),
+		config:            mq.config,
+		ctx:               mq.ctx.Clone(),
+		order:             append([]match.OrderOption{}, mq.order...),
+		inters:            append([]Interceptor{}, mq.inters...),
+		predicates:        append([]predicate.Match{}, mq.predicates...),
+		withMap:           mq.withMap.Clone(),
+		withRoles:         mq.withRoles.Clone(),
+		withTurns:         mq.withTurns.Clone(),
+		withRatingHistory: mq.withRatingHistory.Clone(),
 		// clone intermediate query.
 		sql:  mq.sql.Clone(),
 		path: mq.path,
@@ -330,6 +380,28 @@ func (mq *MatchQuery) WithRoles(opts ...func(*PlayerRoleQuery)) *MatchQuery {
 	return mq
 }
 
+// WithTurns tells the query-builder to eager-load the nodes that are connected to
+// the "turns" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MatchQuery) WithTurns(opts ...func(*TurnQuery)) *MatchQuery {
+	query := (&TurnClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withTurns = query
+	return mq
+}
+
+// WithRatingHistory tells the query-builder to eager-load the nodes that are connected to
+// the "rating_history" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MatchQuery) WithRatingHistory(opts ...func(*RatingHistoryQuery)) *MatchQuery {
+	query := (&RatingHistoryClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withRatingHistory = query
+	return mq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +481,11 @@ func (mq *MatchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Match,
 		nodes       = []*Match{}
 		withFKs     = mq.withFKs
 		_spec       = mq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			mq.withMap != nil,
 			mq.withRoles != nil,
+			mq.withTurns != nil,
+			mq.withRatingHistory != nil,
 		}
 	)
 	if mq.withMap != nil {
@@ -451,6 +525,20 @@ func (mq *MatchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Match,
 			return nil, err
 		}
 	}
+	if query := mq.withTurns; query != nil {
+		if err := mq.loadTurns(ctx, query, nodes,
+			func(n *Match) { n.Edges.Turns = []*Turn{} },
+			func(n *Match, e *Turn) { n.Edges.Turns = append(n.Edges.Turns, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withRatingHistory; query != nil {
+		if err := mq.loadRatingHistory(ctx, query, nodes,
+			func(n *Match) { n.Edges.RatingHistory = []*RatingHistory{} },
+			func(n *Match, e *RatingHistory) { n.Edges.RatingHistory = append(n.Edges.RatingHistory, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -547,6 +635,68 @@ func (mq *MatchQuery) loadRoles(ctx context.Context, query *PlayerRoleQuery, nod
 	}
 	return nil
 }
+func (mq *MatchQuery) loadTurns(ctx context.Context, query *TurnQuery, nodes []*Match, init func(*Match), assign func(*Match, *Turn)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Match)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Turn(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(match.TurnsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.match_turns
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "match_turns" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "match_turns" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MatchQuery) loadRatingHistory(ctx context.Context, query *RatingHistoryQuery, nodes []*Match, init func(*Match), assign func(*Match, *RatingHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Match)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RatingHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(match.RatingHistoryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.match_rating_history
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "match_rating_history" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "match_rating_history" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (mq *MatchQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mq.querySpec()
@@ -15,6 +15,8 @@ import (
 	"github.com/kevindamm/wits-go/ent/osnmap"
 	"github.com/kevindamm/wits-go/ent/playerrole"
 	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+	"github.com/kevindamm/wits-go/ent/turn"
 )
 
 // MatchUpdate is the builder for updating Match entities.
@@ -30,6 +32,26 @@ func (mu *MatchUpdate) Where(ps ...predicate.Match) *MatchUpdate {
 	return mu
 }
 
+// SetMapHash sets the "map_hash" field.
+func (mu *MatchUpdate) SetMapHash(s string) *MatchUpdate {
+	mu.mutation.SetMapHash(s)
+	return mu
+}
+
+// SetNillableMapHash sets the "map_hash" field if the given value is not nil.
+func (mu *MatchUpdate) SetNillableMapHash(s *string) *MatchUpdate {
+	if s != nil {
+		mu.SetMapHash(*s)
+	}
+	return mu
+}
+
+// ClearMapHash clears the value of the "map_hash" field.
+func (mu *MatchUpdate) ClearMapHash() *MatchUpdate {
+	mu.mutation.ClearMapHash()
+	return mu
+}
+
 // SetSeason sets the "season" field.
 func (mu *MatchUpdate) SetSeason(i int8) *MatchUpdate {
 	mu.mutation.ResetSeason()
@@ -65,6 +87,26 @@ func (mu *MatchUpdate) SetNillableCreatedTs(t *time.Time) *MatchUpdate {
 	return mu
 }
 
+// SetPlayedTs sets the "played_ts" field.
+func (mu *MatchUpdate) SetPlayedTs(t time.Time) *MatchUpdate {
+	mu.mutation.SetPlayedTs(t)
+	return mu
+}
+
+// SetNillablePlayedTs sets the "played_ts" field if the given value is not nil.
+func (mu *MatchUpdate) SetNillablePlayedTs(t *time.Time) *MatchUpdate {
+	if t != nil {
+		mu.SetPlayedTs(*t)
+	}
+	return mu
+}
+
+// ClearPlayedTs clears the value of the "played_ts" field.
+func (mu *MatchUpdate) ClearPlayedTs() *MatchUpdate {
+	mu.mutation.ClearPlayedTs()
+	return mu
+}
+
 // SetTurnCount sets the "turn_count" field.
 func (mu *MatchUpdate) SetTurnCount(i int) *MatchUpdate {
 	mu.mutation.ResetTurnCount()
@@ -100,6 +142,26 @@ func (mu *MatchUpdate) SetNillableFetchStatus(ms *match.FetchStatus) *MatchUpdat
 	return mu
 }
 
+// SetInvalidReason sets the "invalid_reason" field.
+func (mu *MatchUpdate) SetInvalidReason(s string) *MatchUpdate {
+	mu.mutation.SetInvalidReason(s)
+	return mu
+}
+
+// SetNillableInvalidReason sets the "invalid_reason" field if the given value is not nil.
+func (mu *MatchUpdate) SetNillableInvalidReason(s *string) *MatchUpdate {
+	if s != nil {
+		mu.SetInvalidReason(*s)
+	}
+	return mu
+}
+
+// ClearInvalidReason clears the value of the "invalid_reason" field.
+func (mu *MatchUpdate) ClearInvalidReason() *MatchUpdate {
+	mu.mutation.ClearInvalidReason()
+	return mu
+}
+
 // SetMapID sets the "map" edge to the OsnMap entity by ID.
 func (mu *MatchUpdate) SetMapID(id int) *MatchUpdate {
 	mu.mutation.SetMapID(id)
@@ -134,6 +196,36 @@ func (mu *MatchUpdate) AddRoles(p ...*PlayerRole) *MatchUpdate {
 	return mu.AddRoleIDs(ids...)
 }
 
+// AddTurnIDs adds the "turns" edge to the Turn entity by IDs.
+func (mu *MatchUpdate) AddTurnIDs(ids ...int) *MatchUpdate {
+	mu.mutation.AddTurnIDs(ids...)
+	return mu
+}
+
+// AddTurns adds the "turns" edges to the Turn entity.
+func (mu *MatchUpdate) AddTurns(t ...*Turn) *MatchUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.AddTurnIDs(ids...)
+}
+
+// AddRatingHistoryIDs adds the "rating_history" edge to the RatingHistory entity by IDs.
+func (mu *MatchUpdate) AddRatingHistoryIDs(ids ...int) *MatchUpdate {
+	mu.mutation.AddRatingHistoryIDs(ids...)
+	return mu
+}
+
+// AddRatingHistory adds the "rating_history" edges to the RatingHistory entity.
+func (mu *MatchUpdate) AddRatingHistory(r ...*RatingHistory) *MatchUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return mu.AddRatingHistoryIDs(ids...)
+}
+
 // Mutation returns the MatchMutation object of the builder.
 func (mu *MatchUpdate) Mutation() *MatchMutation {
 	return mu.mutation
@@ -166,6 +258,48 @@ func (mu *MatchUpdate) RemoveRoles(p ...*PlayerRole) *MatchUpdate {
 	return mu.RemoveRoleIDs(ids...)
 }
 
+// ClearTurns clears all "turns" edges to the Turn entity.
+func (mu *MatchUpdate) ClearTurns() *MatchUpdate {
+	mu.mutation.ClearTurns()
+	return mu
+}
+
+// RemoveTurnIDs removes the "turns" edge to Turn entities by IDs.
+func (mu *MatchUpdate) RemoveTurnIDs(ids ...int) *MatchUpdate {
+	mu.mutation.RemoveTurnIDs(ids...)
+	return mu
+}
+
+// RemoveTurns removes "turns" edges to Turn entities.
+func (mu *MatchUpdate) RemoveTurns(t ...*Turn) *MatchUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.RemoveTurnIDs(ids...)
+}
+
+// ClearRatingHistory clears all "rating_history" edges to the RatingHistory entity.
+func (mu *MatchUpdate) ClearRatingHistory() *MatchUpdate {
+	mu.mutation.ClearRatingHistory()
+	return mu
+}
+
+// RemoveRatingHistoryIDs removes the "rating_history" edge to RatingHistory entities by IDs.
+func (mu *MatchUpdate) RemoveRatingHistoryIDs(ids ...int) *MatchUpdate {
+	mu.mutation.RemoveRatingHistoryIDs(ids...)
+	return mu
+}
+
+// RemoveRatingHistory removes "rating_history" edges to RatingHistory entities.
+func (mu *MatchUpdate) RemoveRatingHistory(r ...*RatingHistory) *MatchUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return mu.RemoveRatingHistoryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (mu *MatchUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
@@ -215,6 +349,15 @@ func (mu *MatchUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if mu.mutation.RulesCleared() {
+		_spec.ClearField(match.FieldRules, field.TypeJSON)
+	}
+	if value, ok := mu.mutation.MapHash(); ok {
+		_spec.SetField(match.FieldMapHash, field.TypeString, value)
+	}
+	if mu.mutation.MapHashCleared() {
+		_spec.ClearField(match.FieldMapHash, field.TypeString)
+	}
 	if value, ok := mu.mutation.Season(); ok {
 		_spec.SetField(match.FieldSeason, field.TypeInt8, value)
 	}
@@ -224,6 +367,12 @@ func (mu *MatchUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.CreatedTs(); ok {
 		_spec.SetField(match.FieldCreatedTs, field.TypeTime, value)
 	}
+	if value, ok := mu.mutation.PlayedTs(); ok {
+		_spec.SetField(match.FieldPlayedTs, field.TypeTime, value)
+	}
+	if mu.mutation.PlayedTsCleared() {
+		_spec.ClearField(match.FieldPlayedTs, field.TypeTime)
+	}
 	if value, ok := mu.mutation.TurnCount(); ok {
 		_spec.SetField(match.FieldTurnCount, field.TypeInt, value)
 	}
@@ -233,6 +382,12 @@ func (mu *MatchUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.FetchStatus(); ok {
 		_spec.SetField(match.FieldFetchStatus, field.TypeEnum, value)
 	}
+	if value, ok := mu.mutation.InvalidReason(); ok {
+		_spec.SetField(match.FieldInvalidReason, field.TypeString, value)
+	}
+	if mu.mutation.InvalidReasonCleared() {
+		_spec.ClearField(match.FieldInvalidReason, field.TypeString)
+	}
 	if mu.mutation.MapCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -307,6 +462,96 @@ func (mu *MatchUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if mu.mutation.TurnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.TurnsTable,
+			Columns: []string{match.TurnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedTurnsIDs(); len(nodes) > 0 && !mu.mutation.TurnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.TurnsTable,
+			Columns: []string{match.TurnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.TurnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.TurnsTable,
+			Columns: []string{match.TurnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.RatingHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.RatingHistoryTable,
+			Columns: []string{match.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedRatingHistoryIDs(); len(nodes) > 0 && !mu.mutation.RatingHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.RatingHistoryTable,
+			Columns: []string{match.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RatingHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.RatingHistoryTable,
+			Columns: []string{match.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{match.Label}
@@ -327,6 +572,26 @@ type MatchUpdateOne struct {
 	mutation *MatchMutation
 }
 
+// SetMapHash sets the "map_hash" field.
+func (muo *MatchUpdateOne) SetMapHash(s string) *MatchUpdateOne {
+	muo.mutation.SetMapHash(s)
+	return muo
+}
+
+// SetNillableMapHash sets the "map_hash" field if the given value is not nil.
+func (muo *MatchUpdateOne) SetNillableMapHash(s *string) *MatchUpdateOne {
+	if s != nil {
+		muo.SetMapHash(*s)
+	}
+	return muo
+}
+
+// ClearMapHash clears the value of the "map_hash" field.
+func (muo *MatchUpdateOne) ClearMapHash() *MatchUpdateOne {
+	muo.mutation.ClearMapHash()
+	return muo
+}
+
 // SetSeason sets the "season" field.
 func (muo *MatchUpdateOne) SetSeason(i int8) *MatchUpdateOne {
 	muo.mutation.ResetSeason()
@@ -362,6 +627,26 @@ func (muo *MatchUpdateOne) SetNillableCreatedTs(t *time.Time) *MatchUpdateOne {
 	return muo
 }
 
+// SetPlayedTs sets the "played_ts" field.
+func (muo *MatchUpdateOne) SetPlayedTs(t time.Time) *MatchUpdateOne {
+	muo.mutation.SetPlayedTs(t)
+	return muo
+}
+
+// SetNillablePlayedTs sets the "played_ts" field if the given value is not nil.
+func (muo *MatchUpdateOne) SetNillablePlayedTs(t *time.Time) *MatchUpdateOne {
+	if t != nil {
+		muo.SetPlayedTs(*t)
+	}
+	return muo
+}
+
+// ClearPlayedTs clears the value of the "played_ts" field.
+func (muo *MatchUpdateOne) ClearPlayedTs() *MatchUpdateOne {
+	muo.mutation.ClearPlayedTs()
+	return muo
+}
+
 // SetTurnCount sets the "turn_count" field.
 func (muo *MatchUpdateOne) SetTurnCount(i int) *MatchUpdateOne {
 	muo.mutation.ResetTurnCount()
@@ -397,6 +682,26 @@ func (muo *MatchUpdateOne) SetNillableFetchStatus(ms *match.FetchStatus) *MatchU
 	return muo
 }
 
+// SetInvalidReason sets the "invalid_reason" field.
+func (muo *MatchUpdateOne) SetInvalidReason(s string) *MatchUpdateOne {
+	muo.mutation.SetInvalidReason(s)
+	return muo
+}
+
+// SetNillableInvalidReason sets the "invalid_reason" field if the given value is not nil.
+func (muo *MatchUpdateOne) SetNillableInvalidReason(s *string) *MatchUpdateOne {
+	if s != nil {
+		muo.SetInvalidReason(*s)
+	}
+	return muo
+}
+
+// ClearInvalidReason clears the value of the "invalid_reason" field.
+func (muo *MatchUpdateOne) ClearInvalidReason() *MatchUpdateOne {
+	muo.mutation.ClearInvalidReason()
+	return muo
+}
+
 // SetMapID sets the "map" edge to the OsnMap entity by ID.
 func (muo *MatchUpdateOne) SetMapID(id int) *MatchUpdateOne {
 	muo.mutation.SetMapID(id)
@@ -431,6 +736,36 @@ func (muo *MatchUpdateOne) AddRoles(p ...*PlayerRole) *MatchUpdateOne {
 	return muo.AddRoleIDs(ids...)
 }
 
+// AddTurnIDs adds the "turns" edge to the Turn entity by IDs.
+func (muo *MatchUpdateOne) AddTurnIDs(ids ...int) *MatchUpdateOne {
+	muo.mutation.AddTurnIDs(ids...)
+	return muo
+}
+
+// AddTurns adds the "turns" edges to the Turn entity.
+func (muo *MatchUpdateOne) AddTurns(t ...*Turn) *MatchUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.AddTurnIDs(ids...)
+}
+
+// AddRatingHistoryIDs adds the "rating_history" edge to the RatingHistory entity by IDs.
+func (muo *MatchUpdateOne) AddRatingHistoryIDs(ids ...int) *MatchUpdateOne {
+	muo.mutation.AddRatingHistoryIDs(ids...)
+	return muo
+}
+
+// AddRatingHistory adds the "rating_history" edges to the RatingHistory entity.
+func (muo *MatchUpdateOne) AddRatingHistory(r ...*RatingHistory) *MatchUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return muo.AddRatingHistoryIDs(ids...)
+}
+
 // Mutation returns the MatchMutation object of the builder.
 func (muo *MatchUpdateOne) Mutation() *MatchMutation {
 	return muo.mutation
@@ -463,6 +798,48 @@ func (muo *MatchUpdateOne) RemoveRoles(p ...*PlayerRole) *MatchUpdateOne {
 	return muo.RemoveRoleIDs(ids...)
 }
 
+// ClearTurns clears all "turns" edges to the Turn entity.
+func (muo *MatchUpdateOne) ClearTurns() *MatchUpdateOne {
+	muo.mutation.ClearTurns()
+	return muo
+}
+
+// RemoveTurnIDs removes the "turns" edge to Turn entities by IDs.
+func (muo *MatchUpdateOne) RemoveTurnIDs(ids ...int) *MatchUpdateOne {
+	muo.mutation.RemoveTurnIDs(ids...)
+	return muo
+}
+
+// RemoveTurns removes "turns" edges to Turn entities.
+func (muo *MatchUpdateOne) RemoveTurns(t ...*Turn) *MatchUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.RemoveTurnIDs(ids...)
+}
+
+// ClearRatingHistory clears all "rating_history" edges to the RatingHistory entity.
+func (muo *MatchUpdateOne) ClearRatingHistory() *MatchUpdateOne {
+	muo.mutation.ClearRatingHistory()
+	return muo
+}
+
+// RemoveRatingHistoryIDs removes the "rating_history" edge to RatingHistory entities by IDs.
+func (muo *MatchUpdateOne) RemoveRatingHistoryIDs(ids ...int) *MatchUpdateOne {
+	muo.mutation.RemoveRatingHistoryIDs(ids...)
+	return muo
+}
+
+// RemoveRatingHistory removes "rating_history" edges to RatingHistory entities.
+func (muo *MatchUpdateOne) RemoveRatingHistory(r ...*RatingHistory) *MatchUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return muo.RemoveRatingHistoryIDs(ids...)
+}
+
 // Where appends a list predicates to the MatchUpdate builder.
 func (muo *MatchUpdateOne) Where(ps ...predicate.Match) *MatchUpdateOne {
 	muo.mutation.Where(ps...)
@@ -542,6 +919,15 @@ func (muo *MatchUpdateOne) sqlSave(ctx context.Context) (_node *Match, err error
 			}
 		}
 	}
+	if muo.mutation.RulesCleared() {
+		_spec.ClearField(match.FieldRules, field.TypeJSON)
+	}
+	if value, ok := muo.mutation.MapHash(); ok {
+		_spec.SetField(match.FieldMapHash, field.TypeString, value)
+	}
+	if muo.mutation.MapHashCleared() {
+		_spec.ClearField(match.FieldMapHash, field.TypeString)
+	}
 	if value, ok := muo.mutation.Season(); ok {
 		_spec.SetField(match.FieldSeason, field.TypeInt8, value)
 	}
@@ -551,6 +937,12 @@ func (muo *MatchUpdateOne) sqlSave(ctx context.Context) (_node *Match, err error
 	if value, ok := muo.mutation.CreatedTs(); ok {
 		_spec.SetField(match.FieldCreatedTs, field.TypeTime, value)
 	}
+	if value, ok := muo.mutation.PlayedTs(); ok {
+		_spec.SetField(match.FieldPlayedTs, field.TypeTime, value)
+	}
+	if muo.mutation.PlayedTsCleared() {
+		_spec.ClearField(match.FieldPlayedTs, field.TypeTime)
+	}
 	if value, ok := muo.mutation.TurnCount(); ok {
 		_spec.SetField(match.FieldTurnCount, field.TypeInt, value)
 	}
@@ -560,6 +952,12 @@ func (muo *MatchUpdateOne) sqlSave(ctx context.Context) (_node *Match, err error
 	if value, ok := muo.mutation.FetchStatus(); ok {
 		_spec.SetField(match.FieldFetchStatus, field.TypeEnum, value)
 	}
+	if value, ok := muo.mutation.InvalidReason(); ok {
+		_spec.SetField(match.FieldInvalidReason, field.TypeString, value)
+	}
+	if muo.mutation.InvalidReasonCleared() {
+		_spec.ClearField(match.FieldInvalidReason, field.TypeString)
+	}
 	if muo.mutation.MapCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -634,6 +1032,96 @@ func (muo *MatchUpdateOne) sqlSave(ctx context.Context) (_node *Match, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if muo.mutation.TurnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.TurnsTable,
+			Columns: []string{match.TurnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedTurnsIDs(); len(nodes) > 0 && !muo.mutation.TurnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.TurnsTable,
+			Columns: []string{match.TurnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.TurnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.TurnsTable,
+			Columns: []string{match.TurnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.RatingHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.RatingHistoryTable,
+			Columns: []string{match.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedRatingHistoryIDs(); len(nodes) > 0 && !muo.mutation.RatingHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.RatingHistoryTable,
+			Columns: []string{match.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RatingHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   match.RatingHistoryTable,
+			Columns: []string{match.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Match{config: muo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
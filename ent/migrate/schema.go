@@ -8,15 +8,73 @@ import (
 )
 
 var (
+	// ActionsColumns holds the columns for the "actions" table.
+	ActionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sequence", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeEnum, Enums: []string{"MoveUnit", "HealUnit", "SpawnUnit", "Attack", "CharmUnit", "ToggleAlt", "Teleport"}},
+		{Name: "class", Type: field.TypeUint8},
+		{Name: "race", Type: field.TypeUint8},
+		{Name: "turn_number", Type: field.TypeInt},
+		{Name: "agent_i", Type: field.TypeInt},
+		{Name: "agent_j", Type: field.TypeInt},
+		{Name: "target_i", Type: field.TypeInt, Nullable: true},
+		{Name: "target_j", Type: field.TypeInt, Nullable: true},
+		{Name: "dest_i", Type: field.TypeInt, Nullable: true},
+		{Name: "dest_j", Type: field.TypeInt, Nullable: true},
+		{Name: "osn_map_actions", Type: field.TypeInt, Nullable: true},
+		{Name: "turn_actions", Type: field.TypeInt},
+	}
+	// ActionsTable holds the schema information for the "actions" table.
+	ActionsTable = &schema.Table{
+		Name:       "actions",
+		Columns:    ActionsColumns,
+		PrimaryKey: []*schema.Column{ActionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "actions_osn_maps_actions",
+				Columns:    []*schema.Column{ActionsColumns[12]},
+				RefColumns: []*schema.Column{OsnMapsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "actions_turns_actions",
+				Columns:    []*schema.Column{ActionsColumns[13]},
+				RefColumns: []*schema.Column{TurnsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "action_sequence_turn_actions",
+				Unique:  true,
+				Columns: []*schema.Column{ActionsColumns[1], ActionsColumns[13]},
+			},
+			{
+				Name:    "action_class_race_osn_map_actions",
+				Unique:  false,
+				Columns: []*schema.Column{ActionsColumns[3], ActionsColumns[4], ActionsColumns[12]},
+			},
+			{
+				Name:    "action_name_class_turn_number",
+				Unique:  false,
+				Columns: []*schema.Column{ActionsColumns[2], ActionsColumns[3], ActionsColumns[5]},
+			},
+		},
+	}
 	// MatchesColumns holds the columns for the "matches" table.
 	MatchesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "match_hash", Type: field.TypeString, Unique: true},
 		{Name: "version", Type: field.TypeInt},
+		{Name: "rules", Type: field.TypeJSON, Nullable: true},
+		{Name: "map_hash", Type: field.TypeString, Nullable: true},
 		{Name: "season", Type: field.TypeInt8, Default: 0},
 		{Name: "created_ts", Type: field.TypeTime},
+		{Name: "played_ts", Type: field.TypeTime, Nullable: true},
 		{Name: "turn_count", Type: field.TypeInt},
 		{Name: "fetch_status", Type: field.TypeEnum, Enums: []string{"UNKNOWN", "LISTED", "FETCHED", "UNWRAPPED", "CONVERTED", "CANONICAL", "VALIDATED", "INDEXED", "INVALID", "LEGACY"}, Default: "LISTED"},
+		{Name: "invalid_reason", Type: field.TypeString, Nullable: true},
 		{Name: "osn_map_matches", Type: field.TypeInt, Nullable: true},
 	}
 	// MatchesTable holds the schema information for the "matches" table.
@@ -27,7 +85,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "matches_osn_maps_matches",
-				Columns:    []*schema.Column{MatchesColumns[7]},
+				Columns:    []*schema.Column{MatchesColumns[11]},
 				RefColumns: []*schema.Column{OsnMapsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -39,6 +97,16 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "shortname", Type: field.TypeString},
 		{Name: "role_count", Type: field.TypeInt},
+		{Name: "map_id", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true},
+		{Name: "floor_count", Type: field.TypeInt, Default: 0},
+		{Name: "wall_count", Type: field.TypeInt, Default: 0},
+		{Name: "bonus_count", Type: field.TypeInt, Default: 0},
+		{Name: "spawn_count", Type: field.TypeInt, Default: 0},
+		{Name: "symmetry", Type: field.TypeEnum, Enums: []string{"NONE", "ROTATE", "VERTICAL", "HORIZONTAL"}, Default: "NONE"},
+		{Name: "legacy", Type: field.TypeBool, Default: false},
+		{Name: "thumbnail_svg", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "definition", Type: field.TypeJSON, Nullable: true},
 	}
 	// OsnMapsTable holds the schema information for the "osn_maps" table.
 	OsnMapsTable = &schema.Table{
@@ -49,7 +117,7 @@ var (
 	// PlayersColumns holds the columns for the "players" table.
 	PlayersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "gcid", Type: field.TypeString, Unique: true},
+		{Name: "gcid", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
 	}
 	// PlayersTable holds the schema information for the "players" table.
@@ -65,6 +133,8 @@ var (
 		{Name: "role_player", Type: field.TypeInt},
 		{Name: "position", Type: field.TypeInt},
 		{Name: "turn_order", Type: field.TypeInt},
+		{Name: "race", Type: field.TypeUint8, Default: 0},
+		{Name: "result", Type: field.TypeUint8, Default: 0},
 	}
 	// PlayerRolesTable holds the schema information for the "player_roles" table.
 	PlayerRolesTable = &schema.Table{
@@ -77,6 +147,11 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{PlayerRolesColumns[1], PlayerRolesColumns[3]},
 			},
+			{
+				Name:    "playerrole_role_match_turn_order",
+				Unique:  true,
+				Columns: []*schema.Column{PlayerRolesColumns[1], PlayerRolesColumns[4]},
+			},
 			{
 				Name:    "playerrole_role_match_role_player",
 				Unique:  true,
@@ -84,6 +159,147 @@ var (
 			},
 		},
 	}
+	// RatingsColumns holds the columns for the "ratings" table.
+	RatingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"SOLO", "DUOS"}},
+		{Name: "rating", Type: field.TypeFloat64},
+		{Name: "deviation", Type: field.TypeFloat64},
+		{Name: "volatility", Type: field.TypeFloat64},
+		{Name: "games", Type: field.TypeInt, Default: 0},
+		{Name: "updated_ts", Type: field.TypeTime},
+		{Name: "player_ratings", Type: field.TypeInt},
+	}
+	// RatingsTable holds the schema information for the "ratings" table.
+	RatingsTable = &schema.Table{
+		Name:       "ratings",
+		Columns:    RatingsColumns,
+		PrimaryKey: []*schema.Column{RatingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ratings_players_ratings",
+				Columns:    []*schema.Column{RatingsColumns[7]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rating_mode_player_ratings",
+				Unique:  true,
+				Columns: []*schema.Column{RatingsColumns[1], RatingsColumns[7]},
+			},
+			{
+				Name:    "rating_mode_rating",
+				Unique:  false,
+				Columns: []*schema.Column{RatingsColumns[1], RatingsColumns[2]},
+			},
+		},
+	}
+	// RatingHistoriesColumns holds the columns for the "rating_histories" table.
+	RatingHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"SOLO", "DUOS"}},
+		{Name: "rating", Type: field.TypeFloat64},
+		{Name: "deviation", Type: field.TypeFloat64},
+		{Name: "volatility", Type: field.TypeFloat64},
+		{Name: "delta", Type: field.TypeFloat64},
+		{Name: "match_rating_history", Type: field.TypeInt},
+		{Name: "player_rating_history", Type: field.TypeInt},
+	}
+	// RatingHistoriesTable holds the schema information for the "rating_histories" table.
+	RatingHistoriesTable = &schema.Table{
+		Name:       "rating_histories",
+		Columns:    RatingHistoriesColumns,
+		PrimaryKey: []*schema.Column{RatingHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rating_histories_matches_rating_history",
+				Columns:    []*schema.Column{RatingHistoriesColumns[6]},
+				RefColumns: []*schema.Column{MatchesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "rating_histories_players_rating_history",
+				Columns:    []*schema.Column{RatingHistoriesColumns[7]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ratinghistory_mode_player_rating_history",
+				Unique:  false,
+				Columns: []*schema.Column{RatingHistoriesColumns[1], RatingHistoriesColumns[7]},
+			},
+		},
+	}
+	// StandingsColumns holds the columns for the "standings" table.
+	StandingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "season", Type: field.TypeInt8},
+		{Name: "tier", Type: field.TypeEnum, Enums: []string{"Novice", "Intermediate", "Advanced", "Expert"}},
+		{Name: "league", Type: field.TypeInt},
+		{Name: "rank", Type: field.TypeInt},
+		{Name: "points", Type: field.TypeInt, Default: 0},
+		{Name: "wins", Type: field.TypeInt, Default: 0},
+		{Name: "losses", Type: field.TypeInt, Default: 0},
+		{Name: "player_standings", Type: field.TypeInt},
+	}
+	// StandingsTable holds the schema information for the "standings" table.
+	StandingsTable = &schema.Table{
+		Name:       "standings",
+		Columns:    StandingsColumns,
+		PrimaryKey: []*schema.Column{StandingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "standings_players_standings",
+				Columns:    []*schema.Column{StandingsColumns[8]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "standing_season_player_standings",
+				Unique:  true,
+				Columns: []*schema.Column{StandingsColumns[1], StandingsColumns[8]},
+			},
+			{
+				Name:    "standing_season_tier_league_rank",
+				Unique:  false,
+				Columns: []*schema.Column{StandingsColumns[1], StandingsColumns[2], StandingsColumns[3], StandingsColumns[4]},
+			},
+		},
+	}
+	// TurnsColumns holds the columns for the "turns" table.
+	TurnsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "team", Type: field.TypeUint8},
+		{Name: "match_turns", Type: field.TypeInt},
+	}
+	// TurnsTable holds the schema information for the "turns" table.
+	TurnsTable = &schema.Table{
+		Name:       "turns",
+		Columns:    TurnsColumns,
+		PrimaryKey: []*schema.Column{TurnsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "turns_matches_turns",
+				Columns:    []*schema.Column{TurnsColumns[3]},
+				RefColumns: []*schema.Column{MatchesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "turn_number_match_turns",
+				Unique:  true,
+				Columns: []*schema.Column{TurnsColumns[1], TurnsColumns[3]},
+			},
+		},
+	}
 	// PlayerRoleMatchColumns holds the columns for the "player_role_match" table.
 	PlayerRoleMatchColumns = []*schema.Column{
 		{Name: "role_match", Type: field.TypeInt},
@@ -136,17 +352,29 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ActionsTable,
 		MatchesTable,
 		OsnMapsTable,
 		PlayersTable,
 		PlayerRolesTable,
+		RatingsTable,
+		RatingHistoriesTable,
+		StandingsTable,
+		TurnsTable,
 		PlayerRoleMatchTable,
 		PlayerRolePlayersTable,
 	}
 )
 
 func init() {
+	ActionsTable.ForeignKeys[0].RefTable = OsnMapsTable
+	ActionsTable.ForeignKeys[1].RefTable = TurnsTable
 	MatchesTable.ForeignKeys[0].RefTable = OsnMapsTable
+	RatingsTable.ForeignKeys[0].RefTable = PlayersTable
+	RatingHistoriesTable.ForeignKeys[0].RefTable = MatchesTable
+	RatingHistoriesTable.ForeignKeys[1].RefTable = PlayersTable
+	StandingsTable.ForeignKeys[0].RefTable = PlayersTable
+	TurnsTable.ForeignKeys[0].RefTable = MatchesTable
 	PlayerRoleMatchTable.ForeignKeys[0].RefTable = PlayerRolesTable
 	PlayerRoleMatchTable.ForeignKeys[1].RefTable = MatchesTable
 	PlayerRolePlayersTable.ForeignKeys[0].RefTable = PlayerRolesTable
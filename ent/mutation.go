@@ -11,11 +11,19 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	wits "github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent/action"
 	"github.com/kevindamm/wits-go/ent/match"
 	"github.com/kevindamm/wits-go/ent/osnmap"
 	"github.com/kevindamm/wits-go/ent/player"
 	"github.com/kevindamm/wits-go/ent/playerrole"
 	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/rating"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+	"github.com/kevindamm/wits-go/ent/standing"
+	"github.com/kevindamm/wits-go/ent/turn"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
 )
 
 const (
@@ -27,49 +35,65 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeMatch      = "Match"
-	TypeOsnMap     = "OsnMap"
-	TypePlayer     = "Player"
-	TypePlayerRole = "PlayerRole"
+	TypeAction        = "Action"
+	TypeMatch         = "Match"
+	TypeOsnMap        = "OsnMap"
+	TypePlayer        = "Player"
+	TypePlayerRole    = "PlayerRole"
+	TypeRating        = "Rating"
+	TypeRatingHistory = "RatingHistory"
+	TypeStanding      = "Standing"
+	TypeTurn          = "Turn"
 )
 
-// MatchMutation represents an operation that mutates the Match nodes in the graph.
-type MatchMutation struct {
+// ActionMutation represents an operation that mutates the Action nodes in the graph.
+type ActionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	match_hash    *string
-	version       *int
-	addversion    *int
-	season        *int8
-	addseason     *int8
-	created_ts    *time.Time
-	turn_count    *int
-	addturn_count *int
-	fetch_status  *match.FetchStatus
-	clearedFields map[string]struct{}
-	_map          *int
-	cleared_map   bool
-	roles         map[int]struct{}
-	removedroles  map[int]struct{}
-	clearedroles  bool
-	done          bool
-	oldValue      func(context.Context) (*Match, error)
-	predicates    []predicate.Match
+	op             Op
+	typ            string
+	id             *int
+	sequence       *int
+	addsequence    *int
+	name           *action.Name
+	class          *wits.UnitClassEnum
+	addclass       *wits.UnitClassEnum
+	race           *wits.UnitRaceEnum
+	addrace        *wits.UnitRaceEnum
+	turn_number    *int
+	addturn_number *int
+	agent_i        *int
+	addagent_i     *int
+	agent_j        *int
+	addagent_j     *int
+	target_i       *int
+	addtarget_i    *int
+	target_j       *int
+	addtarget_j    *int
+	dest_i         *int
+	adddest_i      *int
+	dest_j         *int
+	adddest_j      *int
+	clearedFields  map[string]struct{}
+	turn           *int
+	clearedturn    bool
+	_map           *int
+	cleared_map    bool
+	done           bool
+	oldValue       func(context.Context) (*Action, error)
+	predicates     []predicate.Action
 }
 
-var _ ent.Mutation = (*MatchMutation)(nil)
+var _ ent.Mutation = (*ActionMutation)(nil)
 
-// matchOption allows management of the mutation configuration using functional options.
-type matchOption func(*MatchMutation)
+// actionOption allows management of the mutation configuration using functional options.
+type actionOption func(*ActionMutation)
 
-// newMatchMutation creates new mutation for the Match entity.
-func newMatchMutation(c config, op Op, opts ...matchOption) *MatchMutation {
-	m := &MatchMutation{
+// newActionMutation creates new mutation for the Action entity.
+func newActionMutation(c config, op Op, opts ...actionOption) *ActionMutation {
+	m := &ActionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMatch,
+		typ:           TypeAction,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -78,20 +102,20 @@ func newMatchMutation(c config, op Op, opts ...matchOption) *MatchMutation {
 	return m
 }
 
-// withMatchID sets the ID field of the mutation.
-func withMatchID(id int) matchOption {
-	return func(m *MatchMutation) {
+// withActionID sets the ID field of the mutation.
+func withActionID(id int) actionOption {
+	return func(m *ActionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Match
+			value *Action
 		)
-		m.oldValue = func(ctx context.Context) (*Match, error) {
+		m.oldValue = func(ctx context.Context) (*Action, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Match.Get(ctx, id)
+					value, err = m.Client().Action.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -100,10 +124,10 @@ func withMatchID(id int) matchOption {
 	}
 }
 
-// withMatch sets the old Match of the mutation.
-func withMatch(node *Match) matchOption {
-	return func(m *MatchMutation) {
-		m.oldValue = func(context.Context) (*Match, error) {
+// withAction sets the old Action of the mutation.
+func withAction(node *Action) actionOption {
+	return func(m *ActionMutation) {
+		m.oldValue = func(context.Context) (*Action, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -112,7 +136,7 @@ func withMatch(node *Match) matchOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MatchMutation) Client() *Client {
+func (m ActionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -120,7 +144,7 @@ func (m MatchMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MatchMutation) Tx() (*Tx, error) {
+func (m ActionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -131,7 +155,7 @@ func (m MatchMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MatchMutation) ID() (id int, exists bool) {
+func (m *ActionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -142,7 +166,7 @@ func (m *MatchMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MatchMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ActionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -151,390 +175,751 @@ func (m *MatchMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Match.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Action.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetMatchHash sets the "match_hash" field.
-func (m *MatchMutation) SetMatchHash(s string) {
-	m.match_hash = &s
+// SetSequence sets the "sequence" field.
+func (m *ActionMutation) SetSequence(i int) {
+	m.sequence = &i
+	m.addsequence = nil
 }
 
-// MatchHash returns the value of the "match_hash" field in the mutation.
-func (m *MatchMutation) MatchHash() (r string, exists bool) {
-	v := m.match_hash
+// Sequence returns the value of the "sequence" field in the mutation.
+func (m *ActionMutation) Sequence() (r int, exists bool) {
+	v := m.sequence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMatchHash returns the old "match_hash" field's value of the Match entity.
-// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// OldSequence returns the old "sequence" field's value of the Action entity.
+// If the Action object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchMutation) OldMatchHash(ctx context.Context) (v string, err error) {
+func (m *ActionMutation) OldSequence(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMatchHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMatchHash requires an ID field in the mutation")
+		return v, errors.New("OldSequence requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMatchHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
 	}
-	return oldValue.MatchHash, nil
+	return oldValue.Sequence, nil
 }
 
-// ResetMatchHash resets all changes to the "match_hash" field.
-func (m *MatchMutation) ResetMatchHash() {
-	m.match_hash = nil
+// AddSequence adds i to the "sequence" field.
+func (m *ActionMutation) AddSequence(i int) {
+	if m.addsequence != nil {
+		*m.addsequence += i
+	} else {
+		m.addsequence = &i
+	}
 }
 
-// SetVersion sets the "version" field.
-func (m *MatchMutation) SetVersion(i int) {
-	m.version = &i
-	m.addversion = nil
+// AddedSequence returns the value that was added to the "sequence" field in this mutation.
+func (m *ActionMutation) AddedSequence() (r int, exists bool) {
+	v := m.addsequence
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *MatchMutation) Version() (r int, exists bool) {
-	v := m.version
+// ResetSequence resets all changes to the "sequence" field.
+func (m *ActionMutation) ResetSequence() {
+	m.sequence = nil
+	m.addsequence = nil
+}
+
+// SetName sets the "name" field.
+func (m *ActionMutation) SetName(a action.Name) {
+	m.name = &a
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ActionMutation) Name() (r action.Name, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the Match entity.
-// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Action entity.
+// If the Action object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchMutation) OldVersion(ctx context.Context) (v int, err error) {
+func (m *ActionMutation) OldName(ctx context.Context) (v action.Name, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Version, nil
+	return oldValue.Name, nil
 }
 
-// AddVersion adds i to the "version" field.
-func (m *MatchMutation) AddVersion(i int) {
-	if m.addversion != nil {
-		*m.addversion += i
+// ResetName resets all changes to the "name" field.
+func (m *ActionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetClass sets the "class" field.
+func (m *ActionMutation) SetClass(wce wits.UnitClassEnum) {
+	m.class = &wce
+	m.addclass = nil
+}
+
+// Class returns the value of the "class" field in the mutation.
+func (m *ActionMutation) Class() (r wits.UnitClassEnum, exists bool) {
+	v := m.class
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClass returns the old "class" field's value of the Action entity.
+// If the Action object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionMutation) OldClass(ctx context.Context) (v wits.UnitClassEnum, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClass is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClass requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClass: %w", err)
+	}
+	return oldValue.Class, nil
+}
+
+// AddClass adds wce to the "class" field.
+func (m *ActionMutation) AddClass(wce wits.UnitClassEnum) {
+	if m.addclass != nil {
+		*m.addclass += wce
 	} else {
-		m.addversion = &i
+		m.addclass = &wce
 	}
 }
 
-// AddedVersion returns the value that was added to the "version" field in this mutation.
-func (m *MatchMutation) AddedVersion() (r int, exists bool) {
-	v := m.addversion
+// AddedClass returns the value that was added to the "class" field in this mutation.
+func (m *ActionMutation) AddedClass() (r wits.UnitClassEnum, exists bool) {
+	v := m.addclass
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *MatchMutation) ResetVersion() {
-	m.version = nil
-	m.addversion = nil
+// ResetClass resets all changes to the "class" field.
+func (m *ActionMutation) ResetClass() {
+	m.class = nil
+	m.addclass = nil
 }
 
-// SetSeason sets the "season" field.
-func (m *MatchMutation) SetSeason(i int8) {
-	m.season = &i
-	m.addseason = nil
+// SetRace sets the "race" field.
+func (m *ActionMutation) SetRace(wre wits.UnitRaceEnum) {
+	m.race = &wre
+	m.addrace = nil
 }
 
-// Season returns the value of the "season" field in the mutation.
-func (m *MatchMutation) Season() (r int8, exists bool) {
-	v := m.season
+// Race returns the value of the "race" field in the mutation.
+func (m *ActionMutation) Race() (r wits.UnitRaceEnum, exists bool) {
+	v := m.race
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeason returns the old "season" field's value of the Match entity.
-// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// OldRace returns the old "race" field's value of the Action entity.
+// If the Action object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchMutation) OldSeason(ctx context.Context) (v int8, err error) {
+func (m *ActionMutation) OldRace(ctx context.Context) (v wits.UnitRaceEnum, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeason is only allowed on UpdateOne operations")
+		return v, errors.New("OldRace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeason requires an ID field in the mutation")
+		return v, errors.New("OldRace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeason: %w", err)
+		return v, fmt.Errorf("querying old value for OldRace: %w", err)
 	}
-	return oldValue.Season, nil
+	return oldValue.Race, nil
 }
 
-// AddSeason adds i to the "season" field.
-func (m *MatchMutation) AddSeason(i int8) {
-	if m.addseason != nil {
-		*m.addseason += i
+// AddRace adds wre to the "race" field.
+func (m *ActionMutation) AddRace(wre wits.UnitRaceEnum) {
+	if m.addrace != nil {
+		*m.addrace += wre
 	} else {
-		m.addseason = &i
+		m.addrace = &wre
 	}
 }
 
-// AddedSeason returns the value that was added to the "season" field in this mutation.
-func (m *MatchMutation) AddedSeason() (r int8, exists bool) {
-	v := m.addseason
+// AddedRace returns the value that was added to the "race" field in this mutation.
+func (m *ActionMutation) AddedRace() (r wits.UnitRaceEnum, exists bool) {
+	v := m.addrace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSeason resets all changes to the "season" field.
-func (m *MatchMutation) ResetSeason() {
-	m.season = nil
-	m.addseason = nil
+// ResetRace resets all changes to the "race" field.
+func (m *ActionMutation) ResetRace() {
+	m.race = nil
+	m.addrace = nil
 }
 
-// SetCreatedTs sets the "created_ts" field.
-func (m *MatchMutation) SetCreatedTs(t time.Time) {
-	m.created_ts = &t
+// SetTurnNumber sets the "turn_number" field.
+func (m *ActionMutation) SetTurnNumber(i int) {
+	m.turn_number = &i
+	m.addturn_number = nil
 }
 
-// CreatedTs returns the value of the "created_ts" field in the mutation.
-func (m *MatchMutation) CreatedTs() (r time.Time, exists bool) {
-	v := m.created_ts
+// TurnNumber returns the value of the "turn_number" field in the mutation.
+func (m *ActionMutation) TurnNumber() (r int, exists bool) {
+	v := m.turn_number
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedTs returns the old "created_ts" field's value of the Match entity.
-// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// OldTurnNumber returns the old "turn_number" field's value of the Action entity.
+// If the Action object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchMutation) OldCreatedTs(ctx context.Context) (v *time.Time, err error) {
+func (m *ActionMutation) OldTurnNumber(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedTs is only allowed on UpdateOne operations")
+		return v, errors.New("OldTurnNumber is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedTs requires an ID field in the mutation")
+		return v, errors.New("OldTurnNumber requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedTs: %w", err)
+		return v, fmt.Errorf("querying old value for OldTurnNumber: %w", err)
 	}
-	return oldValue.CreatedTs, nil
+	return oldValue.TurnNumber, nil
 }
 
-// ResetCreatedTs resets all changes to the "created_ts" field.
-func (m *MatchMutation) ResetCreatedTs() {
-	m.created_ts = nil
+// AddTurnNumber adds i to the "turn_number" field.
+func (m *ActionMutation) AddTurnNumber(i int) {
+	if m.addturn_number != nil {
+		*m.addturn_number += i
+	} else {
+		m.addturn_number = &i
+	}
 }
 
-// SetTurnCount sets the "turn_count" field.
-func (m *MatchMutation) SetTurnCount(i int) {
-	m.turn_count = &i
-	m.addturn_count = nil
+// AddedTurnNumber returns the value that was added to the "turn_number" field in this mutation.
+func (m *ActionMutation) AddedTurnNumber() (r int, exists bool) {
+	v := m.addturn_number
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TurnCount returns the value of the "turn_count" field in the mutation.
-func (m *MatchMutation) TurnCount() (r int, exists bool) {
-	v := m.turn_count
+// ResetTurnNumber resets all changes to the "turn_number" field.
+func (m *ActionMutation) ResetTurnNumber() {
+	m.turn_number = nil
+	m.addturn_number = nil
+}
+
+// SetAgentI sets the "agent_i" field.
+func (m *ActionMutation) SetAgentI(i int) {
+	m.agent_i = &i
+	m.addagent_i = nil
+}
+
+// AgentI returns the value of the "agent_i" field in the mutation.
+func (m *ActionMutation) AgentI() (r int, exists bool) {
+	v := m.agent_i
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTurnCount returns the old "turn_count" field's value of the Match entity.
-// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// OldAgentI returns the old "agent_i" field's value of the Action entity.
+// If the Action object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchMutation) OldTurnCount(ctx context.Context) (v int, err error) {
+func (m *ActionMutation) OldAgentI(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTurnCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldAgentI is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTurnCount requires an ID field in the mutation")
+		return v, errors.New("OldAgentI requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTurnCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldAgentI: %w", err)
 	}
-	return oldValue.TurnCount, nil
+	return oldValue.AgentI, nil
 }
 
-// AddTurnCount adds i to the "turn_count" field.
-func (m *MatchMutation) AddTurnCount(i int) {
-	if m.addturn_count != nil {
-		*m.addturn_count += i
+// AddAgentI adds i to the "agent_i" field.
+func (m *ActionMutation) AddAgentI(i int) {
+	if m.addagent_i != nil {
+		*m.addagent_i += i
 	} else {
-		m.addturn_count = &i
+		m.addagent_i = &i
 	}
 }
 
-// AddedTurnCount returns the value that was added to the "turn_count" field in this mutation.
-func (m *MatchMutation) AddedTurnCount() (r int, exists bool) {
-	v := m.addturn_count
+// AddedAgentI returns the value that was added to the "agent_i" field in this mutation.
+func (m *ActionMutation) AddedAgentI() (r int, exists bool) {
+	v := m.addagent_i
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTurnCount resets all changes to the "turn_count" field.
-func (m *MatchMutation) ResetTurnCount() {
-	m.turn_count = nil
-	m.addturn_count = nil
+// ResetAgentI resets all changes to the "agent_i" field.
+func (m *ActionMutation) ResetAgentI() {
+	m.agent_i = nil
+	m.addagent_i = nil
 }
 
-// SetFetchStatus sets the "fetch_status" field.
-func (m *MatchMutation) SetFetchStatus(ms match.FetchStatus) {
-	m.fetch_status = &ms
+// SetAgentJ sets the "agent_j" field.
+func (m *ActionMutation) SetAgentJ(i int) {
+	m.agent_j = &i
+	m.addagent_j = nil
 }
 
-// FetchStatus returns the value of the "fetch_status" field in the mutation.
-func (m *MatchMutation) FetchStatus() (r match.FetchStatus, exists bool) {
-	v := m.fetch_status
+// AgentJ returns the value of the "agent_j" field in the mutation.
+func (m *ActionMutation) AgentJ() (r int, exists bool) {
+	v := m.agent_j
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFetchStatus returns the old "fetch_status" field's value of the Match entity.
-// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// OldAgentJ returns the old "agent_j" field's value of the Action entity.
+// If the Action object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchMutation) OldFetchStatus(ctx context.Context) (v *match.FetchStatus, err error) {
+func (m *ActionMutation) OldAgentJ(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFetchStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldAgentJ is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFetchStatus requires an ID field in the mutation")
+		return v, errors.New("OldAgentJ requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFetchStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldAgentJ: %w", err)
 	}
-	return oldValue.FetchStatus, nil
+	return oldValue.AgentJ, nil
 }
 
-// ResetFetchStatus resets all changes to the "fetch_status" field.
-func (m *MatchMutation) ResetFetchStatus() {
-	m.fetch_status = nil
+// AddAgentJ adds i to the "agent_j" field.
+func (m *ActionMutation) AddAgentJ(i int) {
+	if m.addagent_j != nil {
+		*m.addagent_j += i
+	} else {
+		m.addagent_j = &i
+	}
 }
 
-// SetMapID sets the "map" edge to the OsnMap entity by id.
-func (m *MatchMutation) SetMapID(id int) {
-	m._map = &id
+// AddedAgentJ returns the value that was added to the "agent_j" field in this mutation.
+func (m *ActionMutation) AddedAgentJ() (r int, exists bool) {
+	v := m.addagent_j
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearMap clears the "map" edge to the OsnMap entity.
-func (m *MatchMutation) ClearMap() {
-	m.cleared_map = true
+// ResetAgentJ resets all changes to the "agent_j" field.
+func (m *ActionMutation) ResetAgentJ() {
+	m.agent_j = nil
+	m.addagent_j = nil
 }
 
-// MapCleared reports if the "map" edge to the OsnMap entity was cleared.
-func (m *MatchMutation) MapCleared() bool {
-	return m.cleared_map
+// SetTargetI sets the "target_i" field.
+func (m *ActionMutation) SetTargetI(i int) {
+	m.target_i = &i
+	m.addtarget_i = nil
 }
 
-// MapID returns the "map" edge ID in the mutation.
-func (m *MatchMutation) MapID() (id int, exists bool) {
-	if m._map != nil {
-		return *m._map, true
+// TargetI returns the value of the "target_i" field in the mutation.
+func (m *ActionMutation) TargetI() (r int, exists bool) {
+	v := m.target_i
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MapIDs returns the "map" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MapID instead. It exists only for internal usage by the builders.
-func (m *MatchMutation) MapIDs() (ids []int) {
-	if id := m._map; id != nil {
-		ids = append(ids, *id)
+// OldTargetI returns the old "target_i" field's value of the Action entity.
+// If the Action object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionMutation) OldTargetI(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetI is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetI: %w", err)
+	}
+	return oldValue.TargetI, nil
 }
 
-// ResetMap resets all changes to the "map" edge.
-func (m *MatchMutation) ResetMap() {
-	m._map = nil
-	m.cleared_map = false
+// AddTargetI adds i to the "target_i" field.
+func (m *ActionMutation) AddTargetI(i int) {
+	if m.addtarget_i != nil {
+		*m.addtarget_i += i
+	} else {
+		m.addtarget_i = &i
+	}
 }
 
-// AddRoleIDs adds the "roles" edge to the PlayerRole entity by ids.
-func (m *MatchMutation) AddRoleIDs(ids ...int) {
-	if m.roles == nil {
-		m.roles = make(map[int]struct{})
+// AddedTargetI returns the value that was added to the "target_i" field in this mutation.
+func (m *ActionMutation) AddedTargetI() (r int, exists bool) {
+	v := m.addtarget_i
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.roles[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// ClearTargetI clears the value of the "target_i" field.
+func (m *ActionMutation) ClearTargetI() {
+	m.target_i = nil
+	m.addtarget_i = nil
+	m.clearedFields[action.FieldTargetI] = struct{}{}
+}
+
+// TargetICleared returns if the "target_i" field was cleared in this mutation.
+func (m *ActionMutation) TargetICleared() bool {
+	_, ok := m.clearedFields[action.FieldTargetI]
+	return ok
+}
+
+// ResetTargetI resets all changes to the "target_i" field.
+func (m *ActionMutation) ResetTargetI() {
+	m.target_i = nil
+	m.addtarget_i = nil
+	delete(m.clearedFields, action.FieldTargetI)
+}
+
+// SetTargetJ sets the "target_j" field.
+func (m *ActionMutation) SetTargetJ(i int) {
+	m.target_j = &i
+	m.addtarget_j = nil
+}
+
+// TargetJ returns the value of the "target_j" field in the mutation.
+func (m *ActionMutation) TargetJ() (r int, exists bool) {
+	v := m.target_j
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearRoles clears the "roles" edge to the PlayerRole entity.
-func (m *MatchMutation) ClearRoles() {
-	m.clearedroles = true
+// OldTargetJ returns the old "target_j" field's value of the Action entity.
+// If the Action object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionMutation) OldTargetJ(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetJ is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetJ requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetJ: %w", err)
+	}
+	return oldValue.TargetJ, nil
 }
 
-// RolesCleared reports if the "roles" edge to the PlayerRole entity was cleared.
-func (m *MatchMutation) RolesCleared() bool {
-	return m.clearedroles
+// AddTargetJ adds i to the "target_j" field.
+func (m *ActionMutation) AddTargetJ(i int) {
+	if m.addtarget_j != nil {
+		*m.addtarget_j += i
+	} else {
+		m.addtarget_j = &i
+	}
 }
 
-// RemoveRoleIDs removes the "roles" edge to the PlayerRole entity by IDs.
-func (m *MatchMutation) RemoveRoleIDs(ids ...int) {
-	if m.removedroles == nil {
-		m.removedroles = make(map[int]struct{})
+// AddedTargetJ returns the value that was added to the "target_j" field in this mutation.
+func (m *ActionMutation) AddedTargetJ() (r int, exists bool) {
+	v := m.addtarget_j
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.roles, ids[i])
-		m.removedroles[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// ClearTargetJ clears the value of the "target_j" field.
+func (m *ActionMutation) ClearTargetJ() {
+	m.target_j = nil
+	m.addtarget_j = nil
+	m.clearedFields[action.FieldTargetJ] = struct{}{}
+}
+
+// TargetJCleared returns if the "target_j" field was cleared in this mutation.
+func (m *ActionMutation) TargetJCleared() bool {
+	_, ok := m.clearedFields[action.FieldTargetJ]
+	return ok
+}
+
+// ResetTargetJ resets all changes to the "target_j" field.
+func (m *ActionMutation) ResetTargetJ() {
+	m.target_j = nil
+	m.addtarget_j = nil
+	delete(m.clearedFields, action.FieldTargetJ)
+}
+
+// SetDestI sets the "dest_i" field.
+func (m *ActionMutation) SetDestI(i int) {
+	m.dest_i = &i
+	m.adddest_i = nil
+}
+
+// DestI returns the value of the "dest_i" field in the mutation.
+func (m *ActionMutation) DestI() (r int, exists bool) {
+	v := m.dest_i
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedRoles returns the removed IDs of the "roles" edge to the PlayerRole entity.
-func (m *MatchMutation) RemovedRolesIDs() (ids []int) {
-	for id := range m.removedroles {
-		ids = append(ids, id)
+// OldDestI returns the old "dest_i" field's value of the Action entity.
+// If the Action object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionMutation) OldDestI(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestI: %w", err)
+	}
+	return oldValue.DestI, nil
+}
+
+// AddDestI adds i to the "dest_i" field.
+func (m *ActionMutation) AddDestI(i int) {
+	if m.adddest_i != nil {
+		*m.adddest_i += i
+	} else {
+		m.adddest_i = &i
+	}
+}
+
+// AddedDestI returns the value that was added to the "dest_i" field in this mutation.
+func (m *ActionMutation) AddedDestI() (r int, exists bool) {
+	v := m.adddest_i
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDestI clears the value of the "dest_i" field.
+func (m *ActionMutation) ClearDestI() {
+	m.dest_i = nil
+	m.adddest_i = nil
+	m.clearedFields[action.FieldDestI] = struct{}{}
+}
+
+// DestICleared returns if the "dest_i" field was cleared in this mutation.
+func (m *ActionMutation) DestICleared() bool {
+	_, ok := m.clearedFields[action.FieldDestI]
+	return ok
+}
+
+// ResetDestI resets all changes to the "dest_i" field.
+func (m *ActionMutation) ResetDestI() {
+	m.dest_i = nil
+	m.adddest_i = nil
+	delete(m.clearedFields, action.FieldDestI)
+}
+
+// SetDestJ sets the "dest_j" field.
+func (m *ActionMutation) SetDestJ(i int) {
+	m.dest_j = &i
+	m.adddest_j = nil
+}
+
+// DestJ returns the value of the "dest_j" field in the mutation.
+func (m *ActionMutation) DestJ() (r int, exists bool) {
+	v := m.dest_j
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDestJ returns the old "dest_j" field's value of the Action entity.
+// If the Action object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionMutation) OldDestJ(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestJ is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestJ requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestJ: %w", err)
+	}
+	return oldValue.DestJ, nil
+}
+
+// AddDestJ adds i to the "dest_j" field.
+func (m *ActionMutation) AddDestJ(i int) {
+	if m.adddest_j != nil {
+		*m.adddest_j += i
+	} else {
+		m.adddest_j = &i
+	}
+}
+
+// AddedDestJ returns the value that was added to the "dest_j" field in this mutation.
+func (m *ActionMutation) AddedDestJ() (r int, exists bool) {
+	v := m.adddest_j
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDestJ clears the value of the "dest_j" field.
+func (m *ActionMutation) ClearDestJ() {
+	m.dest_j = nil
+	m.adddest_j = nil
+	m.clearedFields[action.FieldDestJ] = struct{}{}
+}
+
+// DestJCleared returns if the "dest_j" field was cleared in this mutation.
+func (m *ActionMutation) DestJCleared() bool {
+	_, ok := m.clearedFields[action.FieldDestJ]
+	return ok
+}
+
+// ResetDestJ resets all changes to the "dest_j" field.
+func (m *ActionMutation) ResetDestJ() {
+	m.dest_j = nil
+	m.adddest_j = nil
+	delete(m.clearedFields, action.FieldDestJ)
+}
+
+// SetTurnID sets the "turn" edge to the Turn entity by id.
+func (m *ActionMutation) SetTurnID(id int) {
+	m.turn = &id
+}
+
+// ClearTurn clears the "turn" edge to the Turn entity.
+func (m *ActionMutation) ClearTurn() {
+	m.clearedturn = true
+}
+
+// TurnCleared reports if the "turn" edge to the Turn entity was cleared.
+func (m *ActionMutation) TurnCleared() bool {
+	return m.clearedturn
+}
+
+// TurnID returns the "turn" edge ID in the mutation.
+func (m *ActionMutation) TurnID() (id int, exists bool) {
+	if m.turn != nil {
+		return *m.turn, true
 	}
 	return
 }
 
-// RolesIDs returns the "roles" edge IDs in the mutation.
-func (m *MatchMutation) RolesIDs() (ids []int) {
-	for id := range m.roles {
-		ids = append(ids, id)
+// TurnIDs returns the "turn" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TurnID instead. It exists only for internal usage by the builders.
+func (m *ActionMutation) TurnIDs() (ids []int) {
+	if id := m.turn; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRoles resets all changes to the "roles" edge.
-func (m *MatchMutation) ResetRoles() {
-	m.roles = nil
-	m.clearedroles = false
-	m.removedroles = nil
+// ResetTurn resets all changes to the "turn" edge.
+func (m *ActionMutation) ResetTurn() {
+	m.turn = nil
+	m.clearedturn = false
 }
 
-// Where appends a list predicates to the MatchMutation builder.
-func (m *MatchMutation) Where(ps ...predicate.Match) {
+// SetMapID sets the "map" edge to the OsnMap entity by id.
+func (m *ActionMutation) SetMapID(id int) {
+	m._map = &id
+}
+
+// ClearMap clears the "map" edge to the OsnMap entity.
+func (m *ActionMutation) ClearMap() {
+	m.cleared_map = true
+}
+
+// MapCleared reports if the "map" edge to the OsnMap entity was cleared.
+func (m *ActionMutation) MapCleared() bool {
+	return m.cleared_map
+}
+
+// MapID returns the "map" edge ID in the mutation.
+func (m *ActionMutation) MapID() (id int, exists bool) {
+	if m._map != nil {
+		return *m._map, true
+	}
+	return
+}
+
+// MapIDs returns the "map" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MapID instead. It exists only for internal usage by the builders.
+func (m *ActionMutation) MapIDs() (ids []int) {
+	if id := m._map; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMap resets all changes to the "map" edge.
+func (m *ActionMutation) ResetMap() {
+	m._map = nil
+	m.cleared_map = false
+}
+
+// Where appends a list predicates to the ActionMutation builder.
+func (m *ActionMutation) Where(ps ...predicate.Action) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MatchMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ActionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MatchMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Match, len(ps))
+func (m *ActionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Action, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -542,42 +927,57 @@ func (m *MatchMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MatchMutation) Op() Op {
+func (m *ActionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MatchMutation) SetOp(op Op) {
+func (m *ActionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Match).
-func (m *MatchMutation) Type() string {
+// Type returns the node type of this mutation (Action).
+func (m *ActionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MatchMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.match_hash != nil {
-		fields = append(fields, match.FieldMatchHash)
+func (m *ActionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.sequence != nil {
+		fields = append(fields, action.FieldSequence)
 	}
-	if m.version != nil {
-		fields = append(fields, match.FieldVersion)
+	if m.name != nil {
+		fields = append(fields, action.FieldName)
 	}
-	if m.season != nil {
-		fields = append(fields, match.FieldSeason)
+	if m.class != nil {
+		fields = append(fields, action.FieldClass)
 	}
-	if m.created_ts != nil {
-		fields = append(fields, match.FieldCreatedTs)
+	if m.race != nil {
+		fields = append(fields, action.FieldRace)
 	}
-	if m.turn_count != nil {
-		fields = append(fields, match.FieldTurnCount)
+	if m.turn_number != nil {
+		fields = append(fields, action.FieldTurnNumber)
 	}
-	if m.fetch_status != nil {
-		fields = append(fields, match.FieldFetchStatus)
+	if m.agent_i != nil {
+		fields = append(fields, action.FieldAgentI)
+	}
+	if m.agent_j != nil {
+		fields = append(fields, action.FieldAgentJ)
+	}
+	if m.target_i != nil {
+		fields = append(fields, action.FieldTargetI)
+	}
+	if m.target_j != nil {
+		fields = append(fields, action.FieldTargetJ)
+	}
+	if m.dest_i != nil {
+		fields = append(fields, action.FieldDestI)
+	}
+	if m.dest_j != nil {
+		fields = append(fields, action.FieldDestJ)
 	}
 	return fields
 }
@@ -585,20 +985,30 @@ func (m *MatchMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MatchMutation) Field(name string) (ent.Value, bool) {
+func (m *ActionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case match.FieldMatchHash:
-		return m.MatchHash()
-	case match.FieldVersion:
-		return m.Version()
-	case match.FieldSeason:
-		return m.Season()
-	case match.FieldCreatedTs:
-		return m.CreatedTs()
-	case match.FieldTurnCount:
-		return m.TurnCount()
-	case match.FieldFetchStatus:
-		return m.FetchStatus()
+	case action.FieldSequence:
+		return m.Sequence()
+	case action.FieldName:
+		return m.Name()
+	case action.FieldClass:
+		return m.Class()
+	case action.FieldRace:
+		return m.Race()
+	case action.FieldTurnNumber:
+		return m.TurnNumber()
+	case action.FieldAgentI:
+		return m.AgentI()
+	case action.FieldAgentJ:
+		return m.AgentJ()
+	case action.FieldTargetI:
+		return m.TargetI()
+	case action.FieldTargetJ:
+		return m.TargetJ()
+	case action.FieldDestI:
+		return m.DestI()
+	case action.FieldDestJ:
+		return m.DestJ()
 	}
 	return nil, false
 }
@@ -606,87 +1016,153 @@ func (m *MatchMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MatchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ActionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case match.FieldMatchHash:
-		return m.OldMatchHash(ctx)
-	case match.FieldVersion:
-		return m.OldVersion(ctx)
-	case match.FieldSeason:
-		return m.OldSeason(ctx)
-	case match.FieldCreatedTs:
-		return m.OldCreatedTs(ctx)
-	case match.FieldTurnCount:
-		return m.OldTurnCount(ctx)
-	case match.FieldFetchStatus:
-		return m.OldFetchStatus(ctx)
-	}
-	return nil, fmt.Errorf("unknown Match field %s", name)
+	case action.FieldSequence:
+		return m.OldSequence(ctx)
+	case action.FieldName:
+		return m.OldName(ctx)
+	case action.FieldClass:
+		return m.OldClass(ctx)
+	case action.FieldRace:
+		return m.OldRace(ctx)
+	case action.FieldTurnNumber:
+		return m.OldTurnNumber(ctx)
+	case action.FieldAgentI:
+		return m.OldAgentI(ctx)
+	case action.FieldAgentJ:
+		return m.OldAgentJ(ctx)
+	case action.FieldTargetI:
+		return m.OldTargetI(ctx)
+	case action.FieldTargetJ:
+		return m.OldTargetJ(ctx)
+	case action.FieldDestI:
+		return m.OldDestI(ctx)
+	case action.FieldDestJ:
+		return m.OldDestJ(ctx)
+	}
+	return nil, fmt.Errorf("unknown Action field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MatchMutation) SetField(name string, value ent.Value) error {
+func (m *ActionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case match.FieldMatchHash:
-		v, ok := value.(string)
+	case action.FieldSequence:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMatchHash(v)
+		m.SetSequence(v)
 		return nil
-	case match.FieldVersion:
-		v, ok := value.(int)
+	case action.FieldName:
+		v, ok := value.(action.Name)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetName(v)
 		return nil
-	case match.FieldSeason:
-		v, ok := value.(int8)
+	case action.FieldClass:
+		v, ok := value.(wits.UnitClassEnum)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSeason(v)
+		m.SetClass(v)
 		return nil
-	case match.FieldCreatedTs:
-		v, ok := value.(time.Time)
+	case action.FieldRace:
+		v, ok := value.(wits.UnitRaceEnum)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedTs(v)
+		m.SetRace(v)
 		return nil
-	case match.FieldTurnCount:
+	case action.FieldTurnNumber:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTurnCount(v)
+		m.SetTurnNumber(v)
 		return nil
-	case match.FieldFetchStatus:
-		v, ok := value.(match.FetchStatus)
+	case action.FieldAgentI:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFetchStatus(v)
+		m.SetAgentI(v)
+		return nil
+	case action.FieldAgentJ:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentJ(v)
+		return nil
+	case action.FieldTargetI:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetI(v)
+		return nil
+	case action.FieldTargetJ:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetJ(v)
+		return nil
+	case action.FieldDestI:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestI(v)
+		return nil
+	case action.FieldDestJ:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestJ(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Match field %s", name)
+	return fmt.Errorf("unknown Action field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MatchMutation) AddedFields() []string {
+func (m *ActionMutation) AddedFields() []string {
 	var fields []string
-	if m.addversion != nil {
-		fields = append(fields, match.FieldVersion)
+	if m.addsequence != nil {
+		fields = append(fields, action.FieldSequence)
 	}
-	if m.addseason != nil {
-		fields = append(fields, match.FieldSeason)
+	if m.addclass != nil {
+		fields = append(fields, action.FieldClass)
 	}
-	if m.addturn_count != nil {
-		fields = append(fields, match.FieldTurnCount)
+	if m.addrace != nil {
+		fields = append(fields, action.FieldRace)
+	}
+	if m.addturn_number != nil {
+		fields = append(fields, action.FieldTurnNumber)
+	}
+	if m.addagent_i != nil {
+		fields = append(fields, action.FieldAgentI)
+	}
+	if m.addagent_j != nil {
+		fields = append(fields, action.FieldAgentJ)
+	}
+	if m.addtarget_i != nil {
+		fields = append(fields, action.FieldTargetI)
+	}
+	if m.addtarget_j != nil {
+		fields = append(fields, action.FieldTargetJ)
+	}
+	if m.adddest_i != nil {
+		fields = append(fields, action.FieldDestI)
+	}
+	if m.adddest_j != nil {
+		fields = append(fields, action.FieldDestJ)
 	}
 	return fields
 }
@@ -694,14 +1170,28 @@ func (m *MatchMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MatchMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ActionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case match.FieldVersion:
-		return m.AddedVersion()
-	case match.FieldSeason:
-		return m.AddedSeason()
-	case match.FieldTurnCount:
-		return m.AddedTurnCount()
+	case action.FieldSequence:
+		return m.AddedSequence()
+	case action.FieldClass:
+		return m.AddedClass()
+	case action.FieldRace:
+		return m.AddedRace()
+	case action.FieldTurnNumber:
+		return m.AddedTurnNumber()
+	case action.FieldAgentI:
+		return m.AddedAgentI()
+	case action.FieldAgentJ:
+		return m.AddedAgentJ()
+	case action.FieldTargetI:
+		return m.AddedTargetI()
+	case action.FieldTargetJ:
+		return m.AddedTargetJ()
+	case action.FieldDestI:
+		return m.AddedDestI()
+	case action.FieldDestJ:
+		return m.AddedDestJ()
 	}
 	return nil, false
 }
@@ -709,210 +1199,308 @@ func (m *MatchMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MatchMutation) AddField(name string, value ent.Value) error {
+func (m *ActionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case match.FieldVersion:
+	case action.FieldSequence:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddVersion(v)
+		m.AddSequence(v)
 		return nil
-	case match.FieldSeason:
-		v, ok := value.(int8)
+	case action.FieldClass:
+		v, ok := value.(wits.UnitClassEnum)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSeason(v)
+		m.AddClass(v)
 		return nil
-	case match.FieldTurnCount:
+	case action.FieldRace:
+		v, ok := value.(wits.UnitRaceEnum)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRace(v)
+		return nil
+	case action.FieldTurnNumber:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTurnCount(v)
+		m.AddTurnNumber(v)
+		return nil
+	case action.FieldAgentI:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAgentI(v)
+		return nil
+	case action.FieldAgentJ:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAgentJ(v)
+		return nil
+	case action.FieldTargetI:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetI(v)
+		return nil
+	case action.FieldTargetJ:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetJ(v)
+		return nil
+	case action.FieldDestI:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDestI(v)
+		return nil
+	case action.FieldDestJ:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDestJ(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Match numeric field %s", name)
+	return fmt.Errorf("unknown Action numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MatchMutation) ClearedFields() []string {
-	return nil
+func (m *ActionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(action.FieldTargetI) {
+		fields = append(fields, action.FieldTargetI)
+	}
+	if m.FieldCleared(action.FieldTargetJ) {
+		fields = append(fields, action.FieldTargetJ)
+	}
+	if m.FieldCleared(action.FieldDestI) {
+		fields = append(fields, action.FieldDestI)
+	}
+	if m.FieldCleared(action.FieldDestJ) {
+		fields = append(fields, action.FieldDestJ)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MatchMutation) FieldCleared(name string) bool {
+func (m *ActionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MatchMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Match nullable field %s", name)
+func (m *ActionMutation) ClearField(name string) error {
+	switch name {
+	case action.FieldTargetI:
+		m.ClearTargetI()
+		return nil
+	case action.FieldTargetJ:
+		m.ClearTargetJ()
+		return nil
+	case action.FieldDestI:
+		m.ClearDestI()
+		return nil
+	case action.FieldDestJ:
+		m.ClearDestJ()
+		return nil
+	}
+	return fmt.Errorf("unknown Action nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MatchMutation) ResetField(name string) error {
+func (m *ActionMutation) ResetField(name string) error {
 	switch name {
-	case match.FieldMatchHash:
-		m.ResetMatchHash()
+	case action.FieldSequence:
+		m.ResetSequence()
 		return nil
-	case match.FieldVersion:
-		m.ResetVersion()
+	case action.FieldName:
+		m.ResetName()
 		return nil
-	case match.FieldSeason:
-		m.ResetSeason()
+	case action.FieldClass:
+		m.ResetClass()
 		return nil
-	case match.FieldCreatedTs:
-		m.ResetCreatedTs()
+	case action.FieldRace:
+		m.ResetRace()
 		return nil
-	case match.FieldTurnCount:
-		m.ResetTurnCount()
+	case action.FieldTurnNumber:
+		m.ResetTurnNumber()
 		return nil
-	case match.FieldFetchStatus:
-		m.ResetFetchStatus()
+	case action.FieldAgentI:
+		m.ResetAgentI()
+		return nil
+	case action.FieldAgentJ:
+		m.ResetAgentJ()
+		return nil
+	case action.FieldTargetI:
+		m.ResetTargetI()
+		return nil
+	case action.FieldTargetJ:
+		m.ResetTargetJ()
+		return nil
+	case action.FieldDestI:
+		m.ResetDestI()
+		return nil
+	case action.FieldDestJ:
+		m.ResetDestJ()
 		return nil
 	}
-	return fmt.Errorf("unknown Match field %s", name)
+	return fmt.Errorf("unknown Action field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MatchMutation) AddedEdges() []string {
+func (m *ActionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m._map != nil {
-		edges = append(edges, match.EdgeMap)
+	if m.turn != nil {
+		edges = append(edges, action.EdgeTurn)
 	}
-	if m.roles != nil {
-		edges = append(edges, match.EdgeRoles)
+	if m._map != nil {
+		edges = append(edges, action.EdgeMap)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MatchMutation) AddedIDs(name string) []ent.Value {
+func (m *ActionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case match.EdgeMap:
-		if id := m._map; id != nil {
+	case action.EdgeTurn:
+		if id := m.turn; id != nil {
 			return []ent.Value{*id}
 		}
-	case match.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.roles))
-		for id := range m.roles {
-			ids = append(ids, id)
+	case action.EdgeMap:
+		if id := m._map; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MatchMutation) RemovedEdges() []string {
+func (m *ActionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedroles != nil {
-		edges = append(edges, match.EdgeRoles)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MatchMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case match.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.removedroles))
-		for id := range m.removedroles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ActionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MatchMutation) ClearedEdges() []string {
+func (m *ActionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleared_map {
-		edges = append(edges, match.EdgeMap)
+	if m.clearedturn {
+		edges = append(edges, action.EdgeTurn)
 	}
-	if m.clearedroles {
-		edges = append(edges, match.EdgeRoles)
+	if m.cleared_map {
+		edges = append(edges, action.EdgeMap)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MatchMutation) EdgeCleared(name string) bool {
+func (m *ActionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case match.EdgeMap:
+	case action.EdgeTurn:
+		return m.clearedturn
+	case action.EdgeMap:
 		return m.cleared_map
-	case match.EdgeRoles:
-		return m.clearedroles
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MatchMutation) ClearEdge(name string) error {
+func (m *ActionMutation) ClearEdge(name string) error {
 	switch name {
-	case match.EdgeMap:
+	case action.EdgeTurn:
+		m.ClearTurn()
+		return nil
+	case action.EdgeMap:
 		m.ClearMap()
 		return nil
 	}
-	return fmt.Errorf("unknown Match unique edge %s", name)
+	return fmt.Errorf("unknown Action unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MatchMutation) ResetEdge(name string) error {
+func (m *ActionMutation) ResetEdge(name string) error {
 	switch name {
-	case match.EdgeMap:
-		m.ResetMap()
+	case action.EdgeTurn:
+		m.ResetTurn()
 		return nil
-	case match.EdgeRoles:
-		m.ResetRoles()
+	case action.EdgeMap:
+		m.ResetMap()
 		return nil
 	}
-	return fmt.Errorf("unknown Match edge %s", name)
+	return fmt.Errorf("unknown Action edge %s", name)
 }
 
-// OsnMapMutation represents an operation that mutates the OsnMap nodes in the graph.
-type OsnMapMutation struct {
+// MatchMutation represents an operation that mutates the Match nodes in the graph.
+type MatchMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	name           *string
-	shortname      *string
-	role_count     *int
-	addrole_count  *int
-	clearedFields  map[string]struct{}
-	matches        map[int]struct{}
-	removedmatches map[int]struct{}
-	clearedmatches bool
-	done           bool
-	oldValue       func(context.Context) (*OsnMap, error)
-	predicates     []predicate.OsnMap
+	op                    Op
+	typ                   string
+	id                    *int
+	match_hash            *string
+	version               *int
+	addversion            *int
+	rules                 **state.Ruleset
+	map_hash              *string
+	season                *int8
+	addseason             *int8
+	created_ts            *time.Time
+	played_ts             *time.Time
+	turn_count            *int
+	addturn_count         *int
+	fetch_status          *match.FetchStatus
+	invalid_reason        *string
+	clearedFields         map[string]struct{}
+	_map                  *int
+	cleared_map           bool
+	roles                 map[int]struct{}
+	removedroles          map[int]struct{}
+	clearedroles          bool
+	turns                 map[int]struct{}
+	removedturns          map[int]struct{}
+	clearedturns          bool
+	rating_history        map[int]struct{}
+	removedrating_history map[int]struct{}
+	clearedrating_history bool
+	done                  bool
+	oldValue              func(context.Context) (*Match, error)
+	predicates            []predicate.Match
 }
 
-var _ ent.Mutation = (*OsnMapMutation)(nil)
+var _ ent.Mutation = (*MatchMutation)(nil)
 
-// osnmapOption allows management of the mutation configuration using functional options.
-type osnmapOption func(*OsnMapMutation)
+// matchOption allows management of the mutation configuration using functional options.
+type matchOption func(*MatchMutation)
 
-// newOsnMapMutation creates new mutation for the OsnMap entity.
-func newOsnMapMutation(c config, op Op, opts ...osnmapOption) *OsnMapMutation {
-	m := &OsnMapMutation{
+// newMatchMutation creates new mutation for the Match entity.
+func newMatchMutation(c config, op Op, opts ...matchOption) *MatchMutation {
+	m := &MatchMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOsnMap,
+		typ:           TypeMatch,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -921,20 +1509,20 @@ func newOsnMapMutation(c config, op Op, opts ...osnmapOption) *OsnMapMutation {
 	return m
 }
 
-// withOsnMapID sets the ID field of the mutation.
-func withOsnMapID(id int) osnmapOption {
-	return func(m *OsnMapMutation) {
+// withMatchID sets the ID field of the mutation.
+func withMatchID(id int) matchOption {
+	return func(m *MatchMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OsnMap
+			value *Match
 		)
-		m.oldValue = func(ctx context.Context) (*OsnMap, error) {
+		m.oldValue = func(ctx context.Context) (*Match, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OsnMap.Get(ctx, id)
+					value, err = m.Client().Match.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -943,10 +1531,10 @@ func withOsnMapID(id int) osnmapOption {
 	}
 }
 
-// withOsnMap sets the old OsnMap of the mutation.
-func withOsnMap(node *OsnMap) osnmapOption {
-	return func(m *OsnMapMutation) {
-		m.oldValue = func(context.Context) (*OsnMap, error) {
+// withMatch sets the old Match of the mutation.
+func withMatch(node *Match) matchOption {
+	return func(m *MatchMutation) {
+		m.oldValue = func(context.Context) (*Match, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -955,7 +1543,7 @@ func withOsnMap(node *OsnMap) osnmapOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OsnMapMutation) Client() *Client {
+func (m MatchMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -963,7 +1551,7 @@ func (m OsnMapMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OsnMapMutation) Tx() (*Tx, error) {
+func (m MatchMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -974,7 +1562,7 @@ func (m OsnMapMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OsnMapMutation) ID() (id int, exists bool) {
+func (m *MatchMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -985,7 +1573,7 @@ func (m *OsnMapMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OsnMapMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *MatchMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -994,203 +1582,5571 @@ func (m *OsnMapMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OsnMap.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Match.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *OsnMapMutation) SetName(s string) {
-	m.name = &s
+// SetMatchHash sets the "match_hash" field.
+func (m *MatchMutation) SetMatchHash(s string) {
+	m.match_hash = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *OsnMapMutation) Name() (r string, exists bool) {
-	v := m.name
+// MatchHash returns the value of the "match_hash" field in the mutation.
+func (m *MatchMutation) MatchHash() (r string, exists bool) {
+	v := m.match_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the OsnMap entity.
-// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// OldMatchHash returns the old "match_hash" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldMatchHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMatchHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMatchHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMatchHash: %w", err)
+	}
+	return oldValue.MatchHash, nil
+}
+
+// ResetMatchHash resets all changes to the "match_hash" field.
+func (m *MatchMutation) ResetMatchHash() {
+	m.match_hash = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *MatchMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *MatchMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *MatchMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *MatchMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *MatchMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetRules sets the "rules" field.
+func (m *MatchMutation) SetRules(s *state.Ruleset) {
+	m.rules = &s
+}
+
+// Rules returns the value of the "rules" field in the mutation.
+func (m *MatchMutation) Rules() (r *state.Ruleset, exists bool) {
+	v := m.rules
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRules returns the old "rules" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldRules(ctx context.Context) (v *state.Ruleset, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRules is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRules requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRules: %w", err)
+	}
+	return oldValue.Rules, nil
+}
+
+// ClearRules clears the value of the "rules" field.
+func (m *MatchMutation) ClearRules() {
+	m.rules = nil
+	m.clearedFields[match.FieldRules] = struct{}{}
+}
+
+// RulesCleared returns if the "rules" field was cleared in this mutation.
+func (m *MatchMutation) RulesCleared() bool {
+	_, ok := m.clearedFields[match.FieldRules]
+	return ok
+}
+
+// ResetRules resets all changes to the "rules" field.
+func (m *MatchMutation) ResetRules() {
+	m.rules = nil
+	delete(m.clearedFields, match.FieldRules)
+}
+
+// SetMapHash sets the "map_hash" field.
+func (m *MatchMutation) SetMapHash(s string) {
+	m.map_hash = &s
+}
+
+// MapHash returns the value of the "map_hash" field in the mutation.
+func (m *MatchMutation) MapHash() (r string, exists bool) {
+	v := m.map_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMapHash returns the old "map_hash" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldMapHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMapHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMapHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMapHash: %w", err)
+	}
+	return oldValue.MapHash, nil
+}
+
+// ClearMapHash clears the value of the "map_hash" field.
+func (m *MatchMutation) ClearMapHash() {
+	m.map_hash = nil
+	m.clearedFields[match.FieldMapHash] = struct{}{}
+}
+
+// MapHashCleared returns if the "map_hash" field was cleared in this mutation.
+func (m *MatchMutation) MapHashCleared() bool {
+	_, ok := m.clearedFields[match.FieldMapHash]
+	return ok
+}
+
+// ResetMapHash resets all changes to the "map_hash" field.
+func (m *MatchMutation) ResetMapHash() {
+	m.map_hash = nil
+	delete(m.clearedFields, match.FieldMapHash)
+}
+
+// SetSeason sets the "season" field.
+func (m *MatchMutation) SetSeason(i int8) {
+	m.season = &i
+	m.addseason = nil
+}
+
+// Season returns the value of the "season" field in the mutation.
+func (m *MatchMutation) Season() (r int8, exists bool) {
+	v := m.season
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeason returns the old "season" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldSeason(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeason: %w", err)
+	}
+	return oldValue.Season, nil
+}
+
+// AddSeason adds i to the "season" field.
+func (m *MatchMutation) AddSeason(i int8) {
+	if m.addseason != nil {
+		*m.addseason += i
+	} else {
+		m.addseason = &i
+	}
+}
+
+// AddedSeason returns the value that was added to the "season" field in this mutation.
+func (m *MatchMutation) AddedSeason() (r int8, exists bool) {
+	v := m.addseason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeason resets all changes to the "season" field.
+func (m *MatchMutation) ResetSeason() {
+	m.season = nil
+	m.addseason = nil
+}
+
+// SetCreatedTs sets the "created_ts" field.
+func (m *MatchMutation) SetCreatedTs(t time.Time) {
+	m.created_ts = &t
+}
+
+// CreatedTs returns the value of the "created_ts" field in the mutation.
+func (m *MatchMutation) CreatedTs() (r time.Time, exists bool) {
+	v := m.created_ts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedTs returns the old "created_ts" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldCreatedTs(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedTs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedTs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedTs: %w", err)
+	}
+	return oldValue.CreatedTs, nil
+}
+
+// ResetCreatedTs resets all changes to the "created_ts" field.
+func (m *MatchMutation) ResetCreatedTs() {
+	m.created_ts = nil
+}
+
+// SetPlayedTs sets the "played_ts" field.
+func (m *MatchMutation) SetPlayedTs(t time.Time) {
+	m.played_ts = &t
+}
+
+// PlayedTs returns the value of the "played_ts" field in the mutation.
+func (m *MatchMutation) PlayedTs() (r time.Time, exists bool) {
+	v := m.played_ts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayedTs returns the old "played_ts" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldPlayedTs(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayedTs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayedTs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayedTs: %w", err)
+	}
+	return oldValue.PlayedTs, nil
+}
+
+// ClearPlayedTs clears the value of the "played_ts" field.
+func (m *MatchMutation) ClearPlayedTs() {
+	m.played_ts = nil
+	m.clearedFields[match.FieldPlayedTs] = struct{}{}
+}
+
+// PlayedTsCleared returns if the "played_ts" field was cleared in this mutation.
+func (m *MatchMutation) PlayedTsCleared() bool {
+	_, ok := m.clearedFields[match.FieldPlayedTs]
+	return ok
+}
+
+// ResetPlayedTs resets all changes to the "played_ts" field.
+func (m *MatchMutation) ResetPlayedTs() {
+	m.played_ts = nil
+	delete(m.clearedFields, match.FieldPlayedTs)
+}
+
+// SetTurnCount sets the "turn_count" field.
+func (m *MatchMutation) SetTurnCount(i int) {
+	m.turn_count = &i
+	m.addturn_count = nil
+}
+
+// TurnCount returns the value of the "turn_count" field in the mutation.
+func (m *MatchMutation) TurnCount() (r int, exists bool) {
+	v := m.turn_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTurnCount returns the old "turn_count" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldTurnCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTurnCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTurnCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTurnCount: %w", err)
+	}
+	return oldValue.TurnCount, nil
+}
+
+// AddTurnCount adds i to the "turn_count" field.
+func (m *MatchMutation) AddTurnCount(i int) {
+	if m.addturn_count != nil {
+		*m.addturn_count += i
+	} else {
+		m.addturn_count = &i
+	}
+}
+
+// AddedTurnCount returns the value that was added to the "turn_count" field in this mutation.
+func (m *MatchMutation) AddedTurnCount() (r int, exists bool) {
+	v := m.addturn_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTurnCount resets all changes to the "turn_count" field.
+func (m *MatchMutation) ResetTurnCount() {
+	m.turn_count = nil
+	m.addturn_count = nil
+}
+
+// SetFetchStatus sets the "fetch_status" field.
+func (m *MatchMutation) SetFetchStatus(ms match.FetchStatus) {
+	m.fetch_status = &ms
+}
+
+// FetchStatus returns the value of the "fetch_status" field in the mutation.
+func (m *MatchMutation) FetchStatus() (r match.FetchStatus, exists bool) {
+	v := m.fetch_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFetchStatus returns the old "fetch_status" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldFetchStatus(ctx context.Context) (v *match.FetchStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFetchStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFetchStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFetchStatus: %w", err)
+	}
+	return oldValue.FetchStatus, nil
+}
+
+// ResetFetchStatus resets all changes to the "fetch_status" field.
+func (m *MatchMutation) ResetFetchStatus() {
+	m.fetch_status = nil
+}
+
+// SetInvalidReason sets the "invalid_reason" field.
+func (m *MatchMutation) SetInvalidReason(s string) {
+	m.invalid_reason = &s
+}
+
+// InvalidReason returns the value of the "invalid_reason" field in the mutation.
+func (m *MatchMutation) InvalidReason() (r string, exists bool) {
+	v := m.invalid_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvalidReason returns the old "invalid_reason" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldInvalidReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvalidReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvalidReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvalidReason: %w", err)
+	}
+	return oldValue.InvalidReason, nil
+}
+
+// ClearInvalidReason clears the value of the "invalid_reason" field.
+func (m *MatchMutation) ClearInvalidReason() {
+	m.invalid_reason = nil
+	m.clearedFields[match.FieldInvalidReason] = struct{}{}
+}
+
+// InvalidReasonCleared returns if the "invalid_reason" field was cleared in this mutation.
+func (m *MatchMutation) InvalidReasonCleared() bool {
+	_, ok := m.clearedFields[match.FieldInvalidReason]
+	return ok
+}
+
+// ResetInvalidReason resets all changes to the "invalid_reason" field.
+func (m *MatchMutation) ResetInvalidReason() {
+	m.invalid_reason = nil
+	delete(m.clearedFields, match.FieldInvalidReason)
+}
+
+// SetMapID sets the "map" edge to the OsnMap entity by id.
+func (m *MatchMutation) SetMapID(id int) {
+	m._map = &id
+}
+
+// ClearMap clears the "map" edge to the OsnMap entity.
+func (m *MatchMutation) ClearMap() {
+	m.cleared_map = true
+}
+
+// MapCleared reports if the "map" edge to the OsnMap entity was cleared.
+func (m *MatchMutation) MapCleared() bool {
+	return m.cleared_map
+}
+
+// MapID returns the "map" edge ID in the mutation.
+func (m *MatchMutation) MapID() (id int, exists bool) {
+	if m._map != nil {
+		return *m._map, true
+	}
+	return
+}
+
+// MapIDs returns the "map" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MapID instead. It exists only for internal usage by the builders.
+func (m *MatchMutation) MapIDs() (ids []int) {
+	if id := m._map; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMap resets all changes to the "map" edge.
+func (m *MatchMutation) ResetMap() {
+	m._map = nil
+	m.cleared_map = false
+}
+
+// AddRoleIDs adds the "roles" edge to the PlayerRole entity by ids.
+func (m *MatchMutation) AddRoleIDs(ids ...int) {
+	if m.roles == nil {
+		m.roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoles clears the "roles" edge to the PlayerRole entity.
+func (m *MatchMutation) ClearRoles() {
+	m.clearedroles = true
+}
+
+// RolesCleared reports if the "roles" edge to the PlayerRole entity was cleared.
+func (m *MatchMutation) RolesCleared() bool {
+	return m.clearedroles
+}
+
+// RemoveRoleIDs removes the "roles" edge to the PlayerRole entity by IDs.
+func (m *MatchMutation) RemoveRoleIDs(ids ...int) {
+	if m.removedroles == nil {
+		m.removedroles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.roles, ids[i])
+		m.removedroles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoles returns the removed IDs of the "roles" edge to the PlayerRole entity.
+func (m *MatchMutation) RemovedRolesIDs() (ids []int) {
+	for id := range m.removedroles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RolesIDs returns the "roles" edge IDs in the mutation.
+func (m *MatchMutation) RolesIDs() (ids []int) {
+	for id := range m.roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoles resets all changes to the "roles" edge.
+func (m *MatchMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+	m.removedroles = nil
+}
+
+// AddTurnIDs adds the "turns" edge to the Turn entity by ids.
+func (m *MatchMutation) AddTurnIDs(ids ...int) {
+	if m.turns == nil {
+		m.turns = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.turns[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTurns clears the "turns" edge to the Turn entity.
+func (m *MatchMutation) ClearTurns() {
+	m.clearedturns = true
+}
+
+// TurnsCleared reports if the "turns" edge to the Turn entity was cleared.
+func (m *MatchMutation) TurnsCleared() bool {
+	return m.clearedturns
+}
+
+// RemoveTurnIDs removes the "turns" edge to the Turn entity by IDs.
+func (m *MatchMutation) RemoveTurnIDs(ids ...int) {
+	if m.removedturns == nil {
+		m.removedturns = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.turns, ids[i])
+		m.removedturns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTurns returns the removed IDs of the "turns" edge to the Turn entity.
+func (m *MatchMutation) RemovedTurnsIDs() (ids []int) {
+	for id := range m.removedturns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TurnsIDs returns the "turns" edge IDs in the mutation.
+func (m *MatchMutation) TurnsIDs() (ids []int) {
+	for id := range m.turns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTurns resets all changes to the "turns" edge.
+func (m *MatchMutation) ResetTurns() {
+	m.turns = nil
+	m.clearedturns = false
+	m.removedturns = nil
+}
+
+// AddRatingHistoryIDs adds the "rating_history" edge to the RatingHistory entity by ids.
+func (m *MatchMutation) AddRatingHistoryIDs(ids ...int) {
+	if m.rating_history == nil {
+		m.rating_history = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.rating_history[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRatingHistory clears the "rating_history" edge to the RatingHistory entity.
+func (m *MatchMutation) ClearRatingHistory() {
+	m.clearedrating_history = true
+}
+
+// RatingHistoryCleared reports if the "rating_history" edge to the RatingHistory entity was cleared.
+func (m *MatchMutation) RatingHistoryCleared() bool {
+	return m.clearedrating_history
+}
+
+// RemoveRatingHistoryIDs removes the "rating_history" edge to the RatingHistory entity by IDs.
+func (m *MatchMutation) RemoveRatingHistoryIDs(ids ...int) {
+	if m.removedrating_history == nil {
+		m.removedrating_history = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.rating_history, ids[i])
+		m.removedrating_history[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRatingHistory returns the removed IDs of the "rating_history" edge to the RatingHistory entity.
+func (m *MatchMutation) RemovedRatingHistoryIDs() (ids []int) {
+	for id := range m.removedrating_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RatingHistoryIDs returns the "rating_history" edge IDs in the mutation.
+func (m *MatchMutation) RatingHistoryIDs() (ids []int) {
+	for id := range m.rating_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRatingHistory resets all changes to the "rating_history" edge.
+func (m *MatchMutation) ResetRatingHistory() {
+	m.rating_history = nil
+	m.clearedrating_history = false
+	m.removedrating_history = nil
+}
+
+// Where appends a list predicates to the MatchMutation builder.
+func (m *MatchMutation) Where(ps ...predicate.Match) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MatchMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MatchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Match, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MatchMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MatchMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Match).
+func (m *MatchMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MatchMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.match_hash != nil {
+		fields = append(fields, match.FieldMatchHash)
+	}
+	if m.version != nil {
+		fields = append(fields, match.FieldVersion)
+	}
+	if m.rules != nil {
+		fields = append(fields, match.FieldRules)
+	}
+	if m.map_hash != nil {
+		fields = append(fields, match.FieldMapHash)
+	}
+	if m.season != nil {
+		fields = append(fields, match.FieldSeason)
+	}
+	if m.created_ts != nil {
+		fields = append(fields, match.FieldCreatedTs)
+	}
+	if m.played_ts != nil {
+		fields = append(fields, match.FieldPlayedTs)
+	}
+	if m.turn_count != nil {
+		fields = append(fields, match.FieldTurnCount)
+	}
+	if m.fetch_status != nil {
+		fields = append(fields, match.FieldFetchStatus)
+	}
+	if m.invalid_reason != nil {
+		fields = append(fields, match.FieldInvalidReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MatchMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case match.FieldMatchHash:
+		return m.MatchHash()
+	case match.FieldVersion:
+		return m.Version()
+	case match.FieldRules:
+		return m.Rules()
+	case match.FieldMapHash:
+		return m.MapHash()
+	case match.FieldSeason:
+		return m.Season()
+	case match.FieldCreatedTs:
+		return m.CreatedTs()
+	case match.FieldPlayedTs:
+		return m.PlayedTs()
+	case match.FieldTurnCount:
+		return m.TurnCount()
+	case match.FieldFetchStatus:
+		return m.FetchStatus()
+	case match.FieldInvalidReason:
+		return m.InvalidReason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MatchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case match.FieldMatchHash:
+		return m.OldMatchHash(ctx)
+	case match.FieldVersion:
+		return m.OldVersion(ctx)
+	case match.FieldRules:
+		return m.OldRules(ctx)
+	case match.FieldMapHash:
+		return m.OldMapHash(ctx)
+	case match.FieldSeason:
+		return m.OldSeason(ctx)
+	case match.FieldCreatedTs:
+		return m.OldCreatedTs(ctx)
+	case match.FieldPlayedTs:
+		return m.OldPlayedTs(ctx)
+	case match.FieldTurnCount:
+		return m.OldTurnCount(ctx)
+	case match.FieldFetchStatus:
+		return m.OldFetchStatus(ctx)
+	case match.FieldInvalidReason:
+		return m.OldInvalidReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown Match field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MatchMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case match.FieldMatchHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMatchHash(v)
+		return nil
+	case match.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case match.FieldRules:
+		v, ok := value.(*state.Ruleset)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRules(v)
+		return nil
+	case match.FieldMapHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMapHash(v)
+		return nil
+	case match.FieldSeason:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeason(v)
+		return nil
+	case match.FieldCreatedTs:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedTs(v)
+		return nil
+	case match.FieldPlayedTs:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayedTs(v)
+		return nil
+	case match.FieldTurnCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTurnCount(v)
+		return nil
+	case match.FieldFetchStatus:
+		v, ok := value.(match.FetchStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFetchStatus(v)
+		return nil
+	case match.FieldInvalidReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvalidReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Match field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MatchMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, match.FieldVersion)
+	}
+	if m.addseason != nil {
+		fields = append(fields, match.FieldSeason)
+	}
+	if m.addturn_count != nil {
+		fields = append(fields, match.FieldTurnCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MatchMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case match.FieldVersion:
+		return m.AddedVersion()
+	case match.FieldSeason:
+		return m.AddedSeason()
+	case match.FieldTurnCount:
+		return m.AddedTurnCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MatchMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case match.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case match.FieldSeason:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeason(v)
+		return nil
+	case match.FieldTurnCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTurnCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Match numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MatchMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(match.FieldRules) {
+		fields = append(fields, match.FieldRules)
+	}
+	if m.FieldCleared(match.FieldMapHash) {
+		fields = append(fields, match.FieldMapHash)
+	}
+	if m.FieldCleared(match.FieldPlayedTs) {
+		fields = append(fields, match.FieldPlayedTs)
+	}
+	if m.FieldCleared(match.FieldInvalidReason) {
+		fields = append(fields, match.FieldInvalidReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MatchMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MatchMutation) ClearField(name string) error {
+	switch name {
+	case match.FieldRules:
+		m.ClearRules()
+		return nil
+	case match.FieldMapHash:
+		m.ClearMapHash()
+		return nil
+	case match.FieldPlayedTs:
+		m.ClearPlayedTs()
+		return nil
+	case match.FieldInvalidReason:
+		m.ClearInvalidReason()
+		return nil
+	}
+	return fmt.Errorf("unknown Match nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MatchMutation) ResetField(name string) error {
+	switch name {
+	case match.FieldMatchHash:
+		m.ResetMatchHash()
+		return nil
+	case match.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case match.FieldRules:
+		m.ResetRules()
+		return nil
+	case match.FieldMapHash:
+		m.ResetMapHash()
+		return nil
+	case match.FieldSeason:
+		m.ResetSeason()
+		return nil
+	case match.FieldCreatedTs:
+		m.ResetCreatedTs()
+		return nil
+	case match.FieldPlayedTs:
+		m.ResetPlayedTs()
+		return nil
+	case match.FieldTurnCount:
+		m.ResetTurnCount()
+		return nil
+	case match.FieldFetchStatus:
+		m.ResetFetchStatus()
+		return nil
+	case match.FieldInvalidReason:
+		m.ResetInvalidReason()
+		return nil
+	}
+	return fmt.Errorf("unknown Match field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MatchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m._map != nil {
+		edges = append(edges, match.EdgeMap)
+	}
+	if m.roles != nil {
+		edges = append(edges, match.EdgeRoles)
+	}
+	if m.turns != nil {
+		edges = append(edges, match.EdgeTurns)
+	}
+	if m.rating_history != nil {
+		edges = append(edges, match.EdgeRatingHistory)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MatchMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case match.EdgeMap:
+		if id := m._map; id != nil {
+			return []ent.Value{*id}
+		}
+	case match.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.roles))
+		for id := range m.roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case match.EdgeTurns:
+		ids := make([]ent.Value, 0, len(m.turns))
+		for id := range m.turns {
+			ids = append(ids, id)
+		}
+		return ids
+	case match.EdgeRatingHistory:
+		ids := make([]ent.Value, 0, len(m.rating_history))
+		for id := range m.rating_history {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MatchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedroles != nil {
+		edges = append(edges, match.EdgeRoles)
+	}
+	if m.removedturns != nil {
+		edges = append(edges, match.EdgeTurns)
+	}
+	if m.removedrating_history != nil {
+		edges = append(edges, match.EdgeRatingHistory)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MatchMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case match.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.removedroles))
+		for id := range m.removedroles {
+			ids = append(ids, id)
+		}
+		return ids
+	case match.EdgeTurns:
+		ids := make([]ent.Value, 0, len(m.removedturns))
+		for id := range m.removedturns {
+			ids = append(ids, id)
+		}
+		return ids
+	case match.EdgeRatingHistory:
+		ids := make([]ent.Value, 0, len(m.removedrating_history))
+		for id := range m.removedrating_history {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MatchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleared_map {
+		edges = append(edges, match.EdgeMap)
+	}
+	if m.clearedroles {
+		edges = append(edges, match.EdgeRoles)
+	}
+	if m.clearedturns {
+		edges = append(edges, match.EdgeTurns)
+	}
+	if m.clearedrating_history {
+		edges = append(edges, match.EdgeRatingHistory)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MatchMutation) EdgeCleared(name string) bool {
+	switch name {
+	case match.EdgeMap:
+		return m.cleared_map
+	case match.EdgeRoles:
+		return m.clearedroles
+	case match.EdgeTurns:
+		return m.clearedturns
+	case match.EdgeRatingHistory:
+		return m.clearedrating_history
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MatchMutation) ClearEdge(name string) error {
+	switch name {
+	case match.EdgeMap:
+		m.ClearMap()
+		return nil
+	}
+	return fmt.Errorf("unknown Match unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MatchMutation) ResetEdge(name string) error {
+	switch name {
+	case match.EdgeMap:
+		m.ResetMap()
+		return nil
+	case match.EdgeRoles:
+		m.ResetRoles()
+		return nil
+	case match.EdgeTurns:
+		m.ResetTurns()
+		return nil
+	case match.EdgeRatingHistory:
+		m.ResetRatingHistory()
+		return nil
+	}
+	return fmt.Errorf("unknown Match edge %s", name)
+}
+
+// OsnMapMutation represents an operation that mutates the OsnMap nodes in the graph.
+type OsnMapMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	name           *string
+	shortname      *string
+	role_count     *int
+	addrole_count  *int
+	map_id         *string
+	content_hash   *string
+	floor_count    *int
+	addfloor_count *int
+	wall_count     *int
+	addwall_count  *int
+	bonus_count    *int
+	addbonus_count *int
+	spawn_count    *int
+	addspawn_count *int
+	symmetry       *osnmap.Symmetry
+	legacy         *bool
+	thumbnail_svg  *string
+	definition     **witsjson.MapDefinition
+	clearedFields  map[string]struct{}
+	matches        map[int]struct{}
+	removedmatches map[int]struct{}
+	clearedmatches bool
+	actions        map[int]struct{}
+	removedactions map[int]struct{}
+	clearedactions bool
+	done           bool
+	oldValue       func(context.Context) (*OsnMap, error)
+	predicates     []predicate.OsnMap
+}
+
+var _ ent.Mutation = (*OsnMapMutation)(nil)
+
+// osnmapOption allows management of the mutation configuration using functional options.
+type osnmapOption func(*OsnMapMutation)
+
+// newOsnMapMutation creates new mutation for the OsnMap entity.
+func newOsnMapMutation(c config, op Op, opts ...osnmapOption) *OsnMapMutation {
+	m := &OsnMapMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOsnMap,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOsnMapID sets the ID field of the mutation.
+func withOsnMapID(id int) osnmapOption {
+	return func(m *OsnMapMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OsnMap
+		)
+		m.oldValue = func(ctx context.Context) (*OsnMap, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OsnMap.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOsnMap sets the old OsnMap of the mutation.
+func withOsnMap(node *OsnMap) osnmapOption {
+	return func(m *OsnMapMutation) {
+		m.oldValue = func(context.Context) (*OsnMap, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OsnMapMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OsnMapMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OsnMapMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OsnMapMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OsnMap.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *OsnMapMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OsnMapMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
 func (m *OsnMapMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OsnMapMutation) ResetName() {
+	m.name = nil
+}
+
+// SetShortname sets the "shortname" field.
+func (m *OsnMapMutation) SetShortname(s string) {
+	m.shortname = &s
+}
+
+// Shortname returns the value of the "shortname" field in the mutation.
+func (m *OsnMapMutation) Shortname() (r string, exists bool) {
+	v := m.shortname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShortname returns the old "shortname" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldShortname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShortname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShortname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShortname: %w", err)
+	}
+	return oldValue.Shortname, nil
+}
+
+// ResetShortname resets all changes to the "shortname" field.
+func (m *OsnMapMutation) ResetShortname() {
+	m.shortname = nil
+}
+
+// SetRoleCount sets the "role_count" field.
+func (m *OsnMapMutation) SetRoleCount(i int) {
+	m.role_count = &i
+	m.addrole_count = nil
+}
+
+// RoleCount returns the value of the "role_count" field in the mutation.
+func (m *OsnMapMutation) RoleCount() (r int, exists bool) {
+	v := m.role_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleCount returns the old "role_count" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldRoleCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleCount: %w", err)
+	}
+	return oldValue.RoleCount, nil
+}
+
+// AddRoleCount adds i to the "role_count" field.
+func (m *OsnMapMutation) AddRoleCount(i int) {
+	if m.addrole_count != nil {
+		*m.addrole_count += i
+	} else {
+		m.addrole_count = &i
+	}
+}
+
+// AddedRoleCount returns the value that was added to the "role_count" field in this mutation.
+func (m *OsnMapMutation) AddedRoleCount() (r int, exists bool) {
+	v := m.addrole_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRoleCount resets all changes to the "role_count" field.
+func (m *OsnMapMutation) ResetRoleCount() {
+	m.role_count = nil
+	m.addrole_count = nil
+}
+
+// SetMapID sets the "map_id" field.
+func (m *OsnMapMutation) SetMapID(s string) {
+	m.map_id = &s
+}
+
+// MapID returns the value of the "map_id" field in the mutation.
+func (m *OsnMapMutation) MapID() (r string, exists bool) {
+	v := m.map_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMapID returns the old "map_id" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldMapID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMapID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMapID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMapID: %w", err)
+	}
+	return oldValue.MapID, nil
+}
+
+// ClearMapID clears the value of the "map_id" field.
+func (m *OsnMapMutation) ClearMapID() {
+	m.map_id = nil
+	m.clearedFields[osnmap.FieldMapID] = struct{}{}
+}
+
+// MapIDCleared returns if the "map_id" field was cleared in this mutation.
+func (m *OsnMapMutation) MapIDCleared() bool {
+	_, ok := m.clearedFields[osnmap.FieldMapID]
+	return ok
+}
+
+// ResetMapID resets all changes to the "map_id" field.
+func (m *OsnMapMutation) ResetMapID() {
+	m.map_id = nil
+	delete(m.clearedFields, osnmap.FieldMapID)
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *OsnMapMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *OsnMapMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *OsnMapMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[osnmap.FieldContentHash] = struct{}{}
+}
+
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *OsnMapMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[osnmap.FieldContentHash]
+	return ok
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *OsnMapMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, osnmap.FieldContentHash)
+}
+
+// SetFloorCount sets the "floor_count" field.
+func (m *OsnMapMutation) SetFloorCount(i int) {
+	m.floor_count = &i
+	m.addfloor_count = nil
+}
+
+// FloorCount returns the value of the "floor_count" field in the mutation.
+func (m *OsnMapMutation) FloorCount() (r int, exists bool) {
+	v := m.floor_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFloorCount returns the old "floor_count" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldFloorCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFloorCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFloorCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFloorCount: %w", err)
+	}
+	return oldValue.FloorCount, nil
+}
+
+// AddFloorCount adds i to the "floor_count" field.
+func (m *OsnMapMutation) AddFloorCount(i int) {
+	if m.addfloor_count != nil {
+		*m.addfloor_count += i
+	} else {
+		m.addfloor_count = &i
+	}
+}
+
+// AddedFloorCount returns the value that was added to the "floor_count" field in this mutation.
+func (m *OsnMapMutation) AddedFloorCount() (r int, exists bool) {
+	v := m.addfloor_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFloorCount resets all changes to the "floor_count" field.
+func (m *OsnMapMutation) ResetFloorCount() {
+	m.floor_count = nil
+	m.addfloor_count = nil
+}
+
+// SetWallCount sets the "wall_count" field.
+func (m *OsnMapMutation) SetWallCount(i int) {
+	m.wall_count = &i
+	m.addwall_count = nil
+}
+
+// WallCount returns the value of the "wall_count" field in the mutation.
+func (m *OsnMapMutation) WallCount() (r int, exists bool) {
+	v := m.wall_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWallCount returns the old "wall_count" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldWallCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWallCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWallCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWallCount: %w", err)
+	}
+	return oldValue.WallCount, nil
+}
+
+// AddWallCount adds i to the "wall_count" field.
+func (m *OsnMapMutation) AddWallCount(i int) {
+	if m.addwall_count != nil {
+		*m.addwall_count += i
+	} else {
+		m.addwall_count = &i
+	}
+}
+
+// AddedWallCount returns the value that was added to the "wall_count" field in this mutation.
+func (m *OsnMapMutation) AddedWallCount() (r int, exists bool) {
+	v := m.addwall_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWallCount resets all changes to the "wall_count" field.
+func (m *OsnMapMutation) ResetWallCount() {
+	m.wall_count = nil
+	m.addwall_count = nil
+}
+
+// SetBonusCount sets the "bonus_count" field.
+func (m *OsnMapMutation) SetBonusCount(i int) {
+	m.bonus_count = &i
+	m.addbonus_count = nil
+}
+
+// BonusCount returns the value of the "bonus_count" field in the mutation.
+func (m *OsnMapMutation) BonusCount() (r int, exists bool) {
+	v := m.bonus_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBonusCount returns the old "bonus_count" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldBonusCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBonusCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBonusCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBonusCount: %w", err)
+	}
+	return oldValue.BonusCount, nil
+}
+
+// AddBonusCount adds i to the "bonus_count" field.
+func (m *OsnMapMutation) AddBonusCount(i int) {
+	if m.addbonus_count != nil {
+		*m.addbonus_count += i
+	} else {
+		m.addbonus_count = &i
+	}
+}
+
+// AddedBonusCount returns the value that was added to the "bonus_count" field in this mutation.
+func (m *OsnMapMutation) AddedBonusCount() (r int, exists bool) {
+	v := m.addbonus_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBonusCount resets all changes to the "bonus_count" field.
+func (m *OsnMapMutation) ResetBonusCount() {
+	m.bonus_count = nil
+	m.addbonus_count = nil
+}
+
+// SetSpawnCount sets the "spawn_count" field.
+func (m *OsnMapMutation) SetSpawnCount(i int) {
+	m.spawn_count = &i
+	m.addspawn_count = nil
+}
+
+// SpawnCount returns the value of the "spawn_count" field in the mutation.
+func (m *OsnMapMutation) SpawnCount() (r int, exists bool) {
+	v := m.spawn_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpawnCount returns the old "spawn_count" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldSpawnCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpawnCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpawnCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpawnCount: %w", err)
+	}
+	return oldValue.SpawnCount, nil
+}
+
+// AddSpawnCount adds i to the "spawn_count" field.
+func (m *OsnMapMutation) AddSpawnCount(i int) {
+	if m.addspawn_count != nil {
+		*m.addspawn_count += i
+	} else {
+		m.addspawn_count = &i
+	}
+}
+
+// AddedSpawnCount returns the value that was added to the "spawn_count" field in this mutation.
+func (m *OsnMapMutation) AddedSpawnCount() (r int, exists bool) {
+	v := m.addspawn_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpawnCount resets all changes to the "spawn_count" field.
+func (m *OsnMapMutation) ResetSpawnCount() {
+	m.spawn_count = nil
+	m.addspawn_count = nil
+}
+
+// SetSymmetry sets the "symmetry" field.
+func (m *OsnMapMutation) SetSymmetry(o osnmap.Symmetry) {
+	m.symmetry = &o
+}
+
+// Symmetry returns the value of the "symmetry" field in the mutation.
+func (m *OsnMapMutation) Symmetry() (r osnmap.Symmetry, exists bool) {
+	v := m.symmetry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymmetry returns the old "symmetry" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldSymmetry(ctx context.Context) (v osnmap.Symmetry, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymmetry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymmetry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymmetry: %w", err)
+	}
+	return oldValue.Symmetry, nil
+}
+
+// ResetSymmetry resets all changes to the "symmetry" field.
+func (m *OsnMapMutation) ResetSymmetry() {
+	m.symmetry = nil
+}
+
+// SetLegacy sets the "legacy" field.
+func (m *OsnMapMutation) SetLegacy(b bool) {
+	m.legacy = &b
+}
+
+// Legacy returns the value of the "legacy" field in the mutation.
+func (m *OsnMapMutation) Legacy() (r bool, exists bool) {
+	v := m.legacy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegacy returns the old "legacy" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldLegacy(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegacy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegacy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegacy: %w", err)
+	}
+	return oldValue.Legacy, nil
+}
+
+// ResetLegacy resets all changes to the "legacy" field.
+func (m *OsnMapMutation) ResetLegacy() {
+	m.legacy = nil
+}
+
+// SetThumbnailSvg sets the "thumbnail_svg" field.
+func (m *OsnMapMutation) SetThumbnailSvg(s string) {
+	m.thumbnail_svg = &s
+}
+
+// ThumbnailSvg returns the value of the "thumbnail_svg" field in the mutation.
+func (m *OsnMapMutation) ThumbnailSvg() (r string, exists bool) {
+	v := m.thumbnail_svg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailSvg returns the old "thumbnail_svg" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldThumbnailSvg(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailSvg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailSvg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailSvg: %w", err)
+	}
+	return oldValue.ThumbnailSvg, nil
+}
+
+// ClearThumbnailSvg clears the value of the "thumbnail_svg" field.
+func (m *OsnMapMutation) ClearThumbnailSvg() {
+	m.thumbnail_svg = nil
+	m.clearedFields[osnmap.FieldThumbnailSvg] = struct{}{}
+}
+
+// ThumbnailSvgCleared returns if the "thumbnail_svg" field was cleared in this mutation.
+func (m *OsnMapMutation) ThumbnailSvgCleared() bool {
+	_, ok := m.clearedFields[osnmap.FieldThumbnailSvg]
+	return ok
+}
+
+// ResetThumbnailSvg resets all changes to the "thumbnail_svg" field.
+func (m *OsnMapMutation) ResetThumbnailSvg() {
+	m.thumbnail_svg = nil
+	delete(m.clearedFields, osnmap.FieldThumbnailSvg)
+}
+
+// SetDefinition sets the "definition" field.
+func (m *OsnMapMutation) SetDefinition(wd *witsjson.MapDefinition) {
+	m.definition = &wd
+}
+
+// Definition returns the value of the "definition" field in the mutation.
+func (m *OsnMapMutation) Definition() (r *witsjson.MapDefinition, exists bool) {
+	v := m.definition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefinition returns the old "definition" field's value of the OsnMap entity.
+// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OsnMapMutation) OldDefinition(ctx context.Context) (v *witsjson.MapDefinition, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefinition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefinition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefinition: %w", err)
+	}
+	return oldValue.Definition, nil
+}
+
+// ClearDefinition clears the value of the "definition" field.
+func (m *OsnMapMutation) ClearDefinition() {
+	m.definition = nil
+	m.clearedFields[osnmap.FieldDefinition] = struct{}{}
+}
+
+// DefinitionCleared returns if the "definition" field was cleared in this mutation.
+func (m *OsnMapMutation) DefinitionCleared() bool {
+	_, ok := m.clearedFields[osnmap.FieldDefinition]
+	return ok
+}
+
+// ResetDefinition resets all changes to the "definition" field.
+func (m *OsnMapMutation) ResetDefinition() {
+	m.definition = nil
+	delete(m.clearedFields, osnmap.FieldDefinition)
+}
+
+// AddMatchIDs adds the "matches" edge to the Match entity by ids.
+func (m *OsnMapMutation) AddMatchIDs(ids ...int) {
+	if m.matches == nil {
+		m.matches = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.matches[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMatches clears the "matches" edge to the Match entity.
+func (m *OsnMapMutation) ClearMatches() {
+	m.clearedmatches = true
+}
+
+// MatchesCleared reports if the "matches" edge to the Match entity was cleared.
+func (m *OsnMapMutation) MatchesCleared() bool {
+	return m.clearedmatches
+}
+
+// RemoveMatchIDs removes the "matches" edge to the Match entity by IDs.
+func (m *OsnMapMutation) RemoveMatchIDs(ids ...int) {
+	if m.removedmatches == nil {
+		m.removedmatches = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.matches, ids[i])
+		m.removedmatches[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMatches returns the removed IDs of the "matches" edge to the Match entity.
+func (m *OsnMapMutation) RemovedMatchesIDs() (ids []int) {
+	for id := range m.removedmatches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MatchesIDs returns the "matches" edge IDs in the mutation.
+func (m *OsnMapMutation) MatchesIDs() (ids []int) {
+	for id := range m.matches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMatches resets all changes to the "matches" edge.
+func (m *OsnMapMutation) ResetMatches() {
+	m.matches = nil
+	m.clearedmatches = false
+	m.removedmatches = nil
+}
+
+// AddActionIDs adds the "actions" edge to the Action entity by ids.
+func (m *OsnMapMutation) AddActionIDs(ids ...int) {
+	if m.actions == nil {
+		m.actions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.actions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActions clears the "actions" edge to the Action entity.
+func (m *OsnMapMutation) ClearActions() {
+	m.clearedactions = true
+}
+
+// ActionsCleared reports if the "actions" edge to the Action entity was cleared.
+func (m *OsnMapMutation) ActionsCleared() bool {
+	return m.clearedactions
+}
+
+// RemoveActionIDs removes the "actions" edge to the Action entity by IDs.
+func (m *OsnMapMutation) RemoveActionIDs(ids ...int) {
+	if m.removedactions == nil {
+		m.removedactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.actions, ids[i])
+		m.removedactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActions returns the removed IDs of the "actions" edge to the Action entity.
+func (m *OsnMapMutation) RemovedActionsIDs() (ids []int) {
+	for id := range m.removedactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActionsIDs returns the "actions" edge IDs in the mutation.
+func (m *OsnMapMutation) ActionsIDs() (ids []int) {
+	for id := range m.actions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActions resets all changes to the "actions" edge.
+func (m *OsnMapMutation) ResetActions() {
+	m.actions = nil
+	m.clearedactions = false
+	m.removedactions = nil
+}
+
+// Where appends a list predicates to the OsnMapMutation builder.
+func (m *OsnMapMutation) Where(ps ...predicate.OsnMap) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OsnMapMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OsnMapMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OsnMap, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OsnMapMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OsnMapMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OsnMap).
+func (m *OsnMapMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OsnMapMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.name != nil {
+		fields = append(fields, osnmap.FieldName)
+	}
+	if m.shortname != nil {
+		fields = append(fields, osnmap.FieldShortname)
+	}
+	if m.role_count != nil {
+		fields = append(fields, osnmap.FieldRoleCount)
+	}
+	if m.map_id != nil {
+		fields = append(fields, osnmap.FieldMapID)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, osnmap.FieldContentHash)
+	}
+	if m.floor_count != nil {
+		fields = append(fields, osnmap.FieldFloorCount)
+	}
+	if m.wall_count != nil {
+		fields = append(fields, osnmap.FieldWallCount)
+	}
+	if m.bonus_count != nil {
+		fields = append(fields, osnmap.FieldBonusCount)
+	}
+	if m.spawn_count != nil {
+		fields = append(fields, osnmap.FieldSpawnCount)
+	}
+	if m.symmetry != nil {
+		fields = append(fields, osnmap.FieldSymmetry)
+	}
+	if m.legacy != nil {
+		fields = append(fields, osnmap.FieldLegacy)
+	}
+	if m.thumbnail_svg != nil {
+		fields = append(fields, osnmap.FieldThumbnailSvg)
+	}
+	if m.definition != nil {
+		fields = append(fields, osnmap.FieldDefinition)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OsnMapMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case osnmap.FieldName:
+		return m.Name()
+	case osnmap.FieldShortname:
+		return m.Shortname()
+	case osnmap.FieldRoleCount:
+		return m.RoleCount()
+	case osnmap.FieldMapID:
+		return m.MapID()
+	case osnmap.FieldContentHash:
+		return m.ContentHash()
+	case osnmap.FieldFloorCount:
+		return m.FloorCount()
+	case osnmap.FieldWallCount:
+		return m.WallCount()
+	case osnmap.FieldBonusCount:
+		return m.BonusCount()
+	case osnmap.FieldSpawnCount:
+		return m.SpawnCount()
+	case osnmap.FieldSymmetry:
+		return m.Symmetry()
+	case osnmap.FieldLegacy:
+		return m.Legacy()
+	case osnmap.FieldThumbnailSvg:
+		return m.ThumbnailSvg()
+	case osnmap.FieldDefinition:
+		return m.Definition()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OsnMapMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case osnmap.FieldName:
+		return m.OldName(ctx)
+	case osnmap.FieldShortname:
+		return m.OldShortname(ctx)
+	case osnmap.FieldRoleCount:
+		return m.OldRoleCount(ctx)
+	case osnmap.FieldMapID:
+		return m.OldMapID(ctx)
+	case osnmap.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case osnmap.FieldFloorCount:
+		return m.OldFloorCount(ctx)
+	case osnmap.FieldWallCount:
+		return m.OldWallCount(ctx)
+	case osnmap.FieldBonusCount:
+		return m.OldBonusCount(ctx)
+	case osnmap.FieldSpawnCount:
+		return m.OldSpawnCount(ctx)
+	case osnmap.FieldSymmetry:
+		return m.OldSymmetry(ctx)
+	case osnmap.FieldLegacy:
+		return m.OldLegacy(ctx)
+	case osnmap.FieldThumbnailSvg:
+		return m.OldThumbnailSvg(ctx)
+	case osnmap.FieldDefinition:
+		return m.OldDefinition(ctx)
+	}
+	return nil, fmt.Errorf("unknown OsnMap field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OsnMapMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case osnmap.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case osnmap.FieldShortname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShortname(v)
+		return nil
+	case osnmap.FieldRoleCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleCount(v)
+		return nil
+	case osnmap.FieldMapID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMapID(v)
+		return nil
+	case osnmap.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case osnmap.FieldFloorCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFloorCount(v)
+		return nil
+	case osnmap.FieldWallCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWallCount(v)
+		return nil
+	case osnmap.FieldBonusCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBonusCount(v)
+		return nil
+	case osnmap.FieldSpawnCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpawnCount(v)
+		return nil
+	case osnmap.FieldSymmetry:
+		v, ok := value.(osnmap.Symmetry)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymmetry(v)
+		return nil
+	case osnmap.FieldLegacy:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegacy(v)
+		return nil
+	case osnmap.FieldThumbnailSvg:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailSvg(v)
+		return nil
+	case osnmap.FieldDefinition:
+		v, ok := value.(*witsjson.MapDefinition)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefinition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OsnMap field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OsnMapMutation) AddedFields() []string {
+	var fields []string
+	if m.addrole_count != nil {
+		fields = append(fields, osnmap.FieldRoleCount)
+	}
+	if m.addfloor_count != nil {
+		fields = append(fields, osnmap.FieldFloorCount)
+	}
+	if m.addwall_count != nil {
+		fields = append(fields, osnmap.FieldWallCount)
+	}
+	if m.addbonus_count != nil {
+		fields = append(fields, osnmap.FieldBonusCount)
+	}
+	if m.addspawn_count != nil {
+		fields = append(fields, osnmap.FieldSpawnCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OsnMapMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case osnmap.FieldRoleCount:
+		return m.AddedRoleCount()
+	case osnmap.FieldFloorCount:
+		return m.AddedFloorCount()
+	case osnmap.FieldWallCount:
+		return m.AddedWallCount()
+	case osnmap.FieldBonusCount:
+		return m.AddedBonusCount()
+	case osnmap.FieldSpawnCount:
+		return m.AddedSpawnCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OsnMapMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case osnmap.FieldRoleCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoleCount(v)
+		return nil
+	case osnmap.FieldFloorCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFloorCount(v)
+		return nil
+	case osnmap.FieldWallCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWallCount(v)
+		return nil
+	case osnmap.FieldBonusCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBonusCount(v)
+		return nil
+	case osnmap.FieldSpawnCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpawnCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OsnMap numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OsnMapMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(osnmap.FieldMapID) {
+		fields = append(fields, osnmap.FieldMapID)
+	}
+	if m.FieldCleared(osnmap.FieldContentHash) {
+		fields = append(fields, osnmap.FieldContentHash)
+	}
+	if m.FieldCleared(osnmap.FieldThumbnailSvg) {
+		fields = append(fields, osnmap.FieldThumbnailSvg)
+	}
+	if m.FieldCleared(osnmap.FieldDefinition) {
+		fields = append(fields, osnmap.FieldDefinition)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OsnMapMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OsnMapMutation) ClearField(name string) error {
+	switch name {
+	case osnmap.FieldMapID:
+		m.ClearMapID()
+		return nil
+	case osnmap.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	case osnmap.FieldThumbnailSvg:
+		m.ClearThumbnailSvg()
+		return nil
+	case osnmap.FieldDefinition:
+		m.ClearDefinition()
+		return nil
+	}
+	return fmt.Errorf("unknown OsnMap nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OsnMapMutation) ResetField(name string) error {
+	switch name {
+	case osnmap.FieldName:
+		m.ResetName()
+		return nil
+	case osnmap.FieldShortname:
+		m.ResetShortname()
+		return nil
+	case osnmap.FieldRoleCount:
+		m.ResetRoleCount()
+		return nil
+	case osnmap.FieldMapID:
+		m.ResetMapID()
+		return nil
+	case osnmap.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case osnmap.FieldFloorCount:
+		m.ResetFloorCount()
+		return nil
+	case osnmap.FieldWallCount:
+		m.ResetWallCount()
+		return nil
+	case osnmap.FieldBonusCount:
+		m.ResetBonusCount()
+		return nil
+	case osnmap.FieldSpawnCount:
+		m.ResetSpawnCount()
+		return nil
+	case osnmap.FieldSymmetry:
+		m.ResetSymmetry()
+		return nil
+	case osnmap.FieldLegacy:
+		m.ResetLegacy()
+		return nil
+	case osnmap.FieldThumbnailSvg:
+		m.ResetThumbnailSvg()
+		return nil
+	case osnmap.FieldDefinition:
+		m.ResetDefinition()
+		return nil
+	}
+	return fmt.Errorf("unknown OsnMap field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OsnMapMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.matches != nil {
+		edges = append(edges, osnmap.EdgeMatches)
+	}
+	if m.actions != nil {
+		edges = append(edges, osnmap.EdgeActions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OsnMapMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case osnmap.EdgeMatches:
+		ids := make([]ent.Value, 0, len(m.matches))
+		for id := range m.matches {
+			ids = append(ids, id)
+		}
+		return ids
+	case osnmap.EdgeActions:
+		ids := make([]ent.Value, 0, len(m.actions))
+		for id := range m.actions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OsnMapMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmatches != nil {
+		edges = append(edges, osnmap.EdgeMatches)
+	}
+	if m.removedactions != nil {
+		edges = append(edges, osnmap.EdgeActions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OsnMapMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case osnmap.EdgeMatches:
+		ids := make([]ent.Value, 0, len(m.removedmatches))
+		for id := range m.removedmatches {
+			ids = append(ids, id)
+		}
+		return ids
+	case osnmap.EdgeActions:
+		ids := make([]ent.Value, 0, len(m.removedactions))
+		for id := range m.removedactions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OsnMapMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmatches {
+		edges = append(edges, osnmap.EdgeMatches)
+	}
+	if m.clearedactions {
+		edges = append(edges, osnmap.EdgeActions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OsnMapMutation) EdgeCleared(name string) bool {
+	switch name {
+	case osnmap.EdgeMatches:
+		return m.clearedmatches
+	case osnmap.EdgeActions:
+		return m.clearedactions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OsnMapMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OsnMap unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OsnMapMutation) ResetEdge(name string) error {
+	switch name {
+	case osnmap.EdgeMatches:
+		m.ResetMatches()
+		return nil
+	case osnmap.EdgeActions:
+		m.ResetActions()
+		return nil
+	}
+	return fmt.Errorf("unknown OsnMap edge %s", name)
+}
+
+// PlayerMutation represents an operation that mutates the Player nodes in the graph.
+type PlayerMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	gcid                  *string
+	name                  *string
+	clearedFields         map[string]struct{}
+	roles                 map[int]struct{}
+	removedroles          map[int]struct{}
+	clearedroles          bool
+	ratings               map[int]struct{}
+	removedratings        map[int]struct{}
+	clearedratings        bool
+	rating_history        map[int]struct{}
+	removedrating_history map[int]struct{}
+	clearedrating_history bool
+	standings             map[int]struct{}
+	removedstandings      map[int]struct{}
+	clearedstandings      bool
+	done                  bool
+	oldValue              func(context.Context) (*Player, error)
+	predicates            []predicate.Player
+}
+
+var _ ent.Mutation = (*PlayerMutation)(nil)
+
+// playerOption allows management of the mutation configuration using functional options.
+type playerOption func(*PlayerMutation)
+
+// newPlayerMutation creates new mutation for the Player entity.
+func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
+	m := &PlayerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlayer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlayerID sets the ID field of the mutation.
+func withPlayerID(id int) playerOption {
+	return func(m *PlayerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Player
+		)
+		m.oldValue = func(ctx context.Context) (*Player, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Player.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlayer sets the old Player of the mutation.
+func withPlayer(node *Player) playerOption {
+	return func(m *PlayerMutation) {
+		m.oldValue = func(context.Context) (*Player, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlayerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlayerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlayerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlayerMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Player.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGcid sets the "gcid" field.
+func (m *PlayerMutation) SetGcid(s string) {
+	m.gcid = &s
+}
+
+// Gcid returns the value of the "gcid" field in the mutation.
+func (m *PlayerMutation) Gcid() (r string, exists bool) {
+	v := m.gcid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGcid returns the old "gcid" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldGcid(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGcid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGcid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGcid: %w", err)
+	}
+	return oldValue.Gcid, nil
+}
+
+// ClearGcid clears the value of the "gcid" field.
+func (m *PlayerMutation) ClearGcid() {
+	m.gcid = nil
+	m.clearedFields[player.FieldGcid] = struct{}{}
+}
+
+// GcidCleared returns if the "gcid" field was cleared in this mutation.
+func (m *PlayerMutation) GcidCleared() bool {
+	_, ok := m.clearedFields[player.FieldGcid]
+	return ok
+}
+
+// ResetGcid resets all changes to the "gcid" field.
+func (m *PlayerMutation) ResetGcid() {
+	m.gcid = nil
+	delete(m.clearedFields, player.FieldGcid)
+}
+
+// SetName sets the "name" field.
+func (m *PlayerMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlayerMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlayerMutation) ResetName() {
+	m.name = nil
+}
+
+// AddRoleIDs adds the "roles" edge to the PlayerRole entity by ids.
+func (m *PlayerMutation) AddRoleIDs(ids ...int) {
+	if m.roles == nil {
+		m.roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoles clears the "roles" edge to the PlayerRole entity.
+func (m *PlayerMutation) ClearRoles() {
+	m.clearedroles = true
+}
+
+// RolesCleared reports if the "roles" edge to the PlayerRole entity was cleared.
+func (m *PlayerMutation) RolesCleared() bool {
+	return m.clearedroles
+}
+
+// RemoveRoleIDs removes the "roles" edge to the PlayerRole entity by IDs.
+func (m *PlayerMutation) RemoveRoleIDs(ids ...int) {
+	if m.removedroles == nil {
+		m.removedroles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.roles, ids[i])
+		m.removedroles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoles returns the removed IDs of the "roles" edge to the PlayerRole entity.
+func (m *PlayerMutation) RemovedRolesIDs() (ids []int) {
+	for id := range m.removedroles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RolesIDs returns the "roles" edge IDs in the mutation.
+func (m *PlayerMutation) RolesIDs() (ids []int) {
+	for id := range m.roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoles resets all changes to the "roles" edge.
+func (m *PlayerMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+	m.removedroles = nil
+}
+
+// AddRatingIDs adds the "ratings" edge to the Rating entity by ids.
+func (m *PlayerMutation) AddRatingIDs(ids ...int) {
+	if m.ratings == nil {
+		m.ratings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.ratings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRatings clears the "ratings" edge to the Rating entity.
+func (m *PlayerMutation) ClearRatings() {
+	m.clearedratings = true
+}
+
+// RatingsCleared reports if the "ratings" edge to the Rating entity was cleared.
+func (m *PlayerMutation) RatingsCleared() bool {
+	return m.clearedratings
+}
+
+// RemoveRatingIDs removes the "ratings" edge to the Rating entity by IDs.
+func (m *PlayerMutation) RemoveRatingIDs(ids ...int) {
+	if m.removedratings == nil {
+		m.removedratings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.ratings, ids[i])
+		m.removedratings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRatings returns the removed IDs of the "ratings" edge to the Rating entity.
+func (m *PlayerMutation) RemovedRatingsIDs() (ids []int) {
+	for id := range m.removedratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RatingsIDs returns the "ratings" edge IDs in the mutation.
+func (m *PlayerMutation) RatingsIDs() (ids []int) {
+	for id := range m.ratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRatings resets all changes to the "ratings" edge.
+func (m *PlayerMutation) ResetRatings() {
+	m.ratings = nil
+	m.clearedratings = false
+	m.removedratings = nil
+}
+
+// AddRatingHistoryIDs adds the "rating_history" edge to the RatingHistory entity by ids.
+func (m *PlayerMutation) AddRatingHistoryIDs(ids ...int) {
+	if m.rating_history == nil {
+		m.rating_history = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.rating_history[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRatingHistory clears the "rating_history" edge to the RatingHistory entity.
+func (m *PlayerMutation) ClearRatingHistory() {
+	m.clearedrating_history = true
+}
+
+// RatingHistoryCleared reports if the "rating_history" edge to the RatingHistory entity was cleared.
+func (m *PlayerMutation) RatingHistoryCleared() bool {
+	return m.clearedrating_history
+}
+
+// RemoveRatingHistoryIDs removes the "rating_history" edge to the RatingHistory entity by IDs.
+func (m *PlayerMutation) RemoveRatingHistoryIDs(ids ...int) {
+	if m.removedrating_history == nil {
+		m.removedrating_history = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.rating_history, ids[i])
+		m.removedrating_history[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRatingHistory returns the removed IDs of the "rating_history" edge to the RatingHistory entity.
+func (m *PlayerMutation) RemovedRatingHistoryIDs() (ids []int) {
+	for id := range m.removedrating_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RatingHistoryIDs returns the "rating_history" edge IDs in the mutation.
+func (m *PlayerMutation) RatingHistoryIDs() (ids []int) {
+	for id := range m.rating_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRatingHistory resets all changes to the "rating_history" edge.
+func (m *PlayerMutation) ResetRatingHistory() {
+	m.rating_history = nil
+	m.clearedrating_history = false
+	m.removedrating_history = nil
+}
+
+// AddStandingIDs adds the "standings" edge to the Standing entity by ids.
+func (m *PlayerMutation) AddStandingIDs(ids ...int) {
+	if m.standings == nil {
+		m.standings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.standings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStandings clears the "standings" edge to the Standing entity.
+func (m *PlayerMutation) ClearStandings() {
+	m.clearedstandings = true
+}
+
+// StandingsCleared reports if the "standings" edge to the Standing entity was cleared.
+func (m *PlayerMutation) StandingsCleared() bool {
+	return m.clearedstandings
+}
+
+// RemoveStandingIDs removes the "standings" edge to the Standing entity by IDs.
+func (m *PlayerMutation) RemoveStandingIDs(ids ...int) {
+	if m.removedstandings == nil {
+		m.removedstandings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.standings, ids[i])
+		m.removedstandings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStandings returns the removed IDs of the "standings" edge to the Standing entity.
+func (m *PlayerMutation) RemovedStandingsIDs() (ids []int) {
+	for id := range m.removedstandings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StandingsIDs returns the "standings" edge IDs in the mutation.
+func (m *PlayerMutation) StandingsIDs() (ids []int) {
+	for id := range m.standings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStandings resets all changes to the "standings" edge.
+func (m *PlayerMutation) ResetStandings() {
+	m.standings = nil
+	m.clearedstandings = false
+	m.removedstandings = nil
+}
+
+// Where appends a list predicates to the PlayerMutation builder.
+func (m *PlayerMutation) Where(ps ...predicate.Player) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlayerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlayerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Player, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlayerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlayerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Player).
+func (m *PlayerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlayerMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.gcid != nil {
+		fields = append(fields, player.FieldGcid)
+	}
+	if m.name != nil {
+		fields = append(fields, player.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case player.FieldGcid:
+		return m.Gcid()
+	case player.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlayerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case player.FieldGcid:
+		return m.OldGcid(ctx)
+	case player.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Player field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case player.FieldGcid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGcid(v)
+		return nil
+	case player.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlayerMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Player numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlayerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(player.FieldGcid) {
+		fields = append(fields, player.FieldGcid)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlayerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlayerMutation) ClearField(name string) error {
+	switch name {
+	case player.FieldGcid:
+		m.ClearGcid()
+		return nil
+	}
+	return fmt.Errorf("unknown Player nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlayerMutation) ResetField(name string) error {
+	switch name {
+	case player.FieldGcid:
+		m.ResetGcid()
+		return nil
+	case player.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlayerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.roles != nil {
+		edges = append(edges, player.EdgeRoles)
+	}
+	if m.ratings != nil {
+		edges = append(edges, player.EdgeRatings)
+	}
+	if m.rating_history != nil {
+		edges = append(edges, player.EdgeRatingHistory)
+	}
+	if m.standings != nil {
+		edges = append(edges, player.EdgeStandings)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case player.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.roles))
+		for id := range m.roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeRatings:
+		ids := make([]ent.Value, 0, len(m.ratings))
+		for id := range m.ratings {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeRatingHistory:
+		ids := make([]ent.Value, 0, len(m.rating_history))
+		for id := range m.rating_history {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeStandings:
+		ids := make([]ent.Value, 0, len(m.standings))
+		for id := range m.standings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlayerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedroles != nil {
+		edges = append(edges, player.EdgeRoles)
+	}
+	if m.removedratings != nil {
+		edges = append(edges, player.EdgeRatings)
+	}
+	if m.removedrating_history != nil {
+		edges = append(edges, player.EdgeRatingHistory)
+	}
+	if m.removedstandings != nil {
+		edges = append(edges, player.EdgeStandings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlayerMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case player.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.removedroles))
+		for id := range m.removedroles {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeRatings:
+		ids := make([]ent.Value, 0, len(m.removedratings))
+		for id := range m.removedratings {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeRatingHistory:
+		ids := make([]ent.Value, 0, len(m.removedrating_history))
+		for id := range m.removedrating_history {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeStandings:
+		ids := make([]ent.Value, 0, len(m.removedstandings))
+		for id := range m.removedstandings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlayerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedroles {
+		edges = append(edges, player.EdgeRoles)
+	}
+	if m.clearedratings {
+		edges = append(edges, player.EdgeRatings)
+	}
+	if m.clearedrating_history {
+		edges = append(edges, player.EdgeRatingHistory)
+	}
+	if m.clearedstandings {
+		edges = append(edges, player.EdgeStandings)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlayerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case player.EdgeRoles:
+		return m.clearedroles
+	case player.EdgeRatings:
+		return m.clearedratings
+	case player.EdgeRatingHistory:
+		return m.clearedrating_history
+	case player.EdgeStandings:
+		return m.clearedstandings
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlayerMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Player unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlayerMutation) ResetEdge(name string) error {
+	switch name {
+	case player.EdgeRoles:
+		m.ResetRoles()
+		return nil
+	case player.EdgeRatings:
+		m.ResetRatings()
+		return nil
+	case player.EdgeRatingHistory:
+		m.ResetRatingHistory()
+		return nil
+	case player.EdgeStandings:
+		m.ResetStandings()
+		return nil
+	}
+	return fmt.Errorf("unknown Player edge %s", name)
+}
+
+// PlayerRoleMutation represents an operation that mutates the PlayerRole nodes in the graph.
+type PlayerRoleMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	match_id       *int
+	addmatch_id    *int
+	player_id      *int
+	addplayer_id   *int
+	position       *int
+	addposition    *int
+	turn_order     *int
+	addturn_order  *int
+	race           *wits.UnitRaceEnum
+	addrace        *wits.UnitRaceEnum
+	result         *wits.TerminalStatus
+	addresult      *wits.TerminalStatus
+	clearedFields  map[string]struct{}
+	match          map[int]struct{}
+	removedmatch   map[int]struct{}
+	clearedmatch   bool
+	players        map[int]struct{}
+	removedplayers map[int]struct{}
+	clearedplayers bool
+	done           bool
+	oldValue       func(context.Context) (*PlayerRole, error)
+	predicates     []predicate.PlayerRole
+}
+
+var _ ent.Mutation = (*PlayerRoleMutation)(nil)
+
+// playerroleOption allows management of the mutation configuration using functional options.
+type playerroleOption func(*PlayerRoleMutation)
+
+// newPlayerRoleMutation creates new mutation for the PlayerRole entity.
+func newPlayerRoleMutation(c config, op Op, opts ...playerroleOption) *PlayerRoleMutation {
+	m := &PlayerRoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlayerRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlayerRoleID sets the ID field of the mutation.
+func withPlayerRoleID(id int) playerroleOption {
+	return func(m *PlayerRoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PlayerRole
+		)
+		m.oldValue = func(ctx context.Context) (*PlayerRole, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PlayerRole.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlayerRole sets the old PlayerRole of the mutation.
+func withPlayerRole(node *PlayerRole) playerroleOption {
+	return func(m *PlayerRoleMutation) {
+		m.oldValue = func(context.Context) (*PlayerRole, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlayerRoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlayerRoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlayerRoleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlayerRoleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PlayerRole.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMatchID sets the "match_id" field.
+func (m *PlayerRoleMutation) SetMatchID(i int) {
+	m.match_id = &i
+	m.addmatch_id = nil
+}
+
+// MatchID returns the value of the "match_id" field in the mutation.
+func (m *PlayerRoleMutation) MatchID() (r int, exists bool) {
+	v := m.match_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMatchID returns the old "match_id" field's value of the PlayerRole entity.
+// If the PlayerRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerRoleMutation) OldMatchID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMatchID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMatchID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMatchID: %w", err)
+	}
+	return oldValue.MatchID, nil
+}
+
+// AddMatchID adds i to the "match_id" field.
+func (m *PlayerRoleMutation) AddMatchID(i int) {
+	if m.addmatch_id != nil {
+		*m.addmatch_id += i
+	} else {
+		m.addmatch_id = &i
+	}
+}
+
+// AddedMatchID returns the value that was added to the "match_id" field in this mutation.
+func (m *PlayerRoleMutation) AddedMatchID() (r int, exists bool) {
+	v := m.addmatch_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMatchID resets all changes to the "match_id" field.
+func (m *PlayerRoleMutation) ResetMatchID() {
+	m.match_id = nil
+	m.addmatch_id = nil
+}
+
+// SetPlayerID sets the "player_id" field.
+func (m *PlayerRoleMutation) SetPlayerID(i int) {
+	m.player_id = &i
+	m.addplayer_id = nil
+}
+
+// PlayerID returns the value of the "player_id" field in the mutation.
+func (m *PlayerRoleMutation) PlayerID() (r int, exists bool) {
+	v := m.player_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayerID returns the old "player_id" field's value of the PlayerRole entity.
+// If the PlayerRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerRoleMutation) OldPlayerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayerID: %w", err)
+	}
+	return oldValue.PlayerID, nil
+}
+
+// AddPlayerID adds i to the "player_id" field.
+func (m *PlayerRoleMutation) AddPlayerID(i int) {
+	if m.addplayer_id != nil {
+		*m.addplayer_id += i
+	} else {
+		m.addplayer_id = &i
+	}
+}
+
+// AddedPlayerID returns the value that was added to the "player_id" field in this mutation.
+func (m *PlayerRoleMutation) AddedPlayerID() (r int, exists bool) {
+	v := m.addplayer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPlayerID resets all changes to the "player_id" field.
+func (m *PlayerRoleMutation) ResetPlayerID() {
+	m.player_id = nil
+	m.addplayer_id = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *PlayerRoleMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *PlayerRoleMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the PlayerRole entity.
+// If the PlayerRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerRoleMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *PlayerRoleMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *PlayerRoleMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *PlayerRoleMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetTurnOrder sets the "turn_order" field.
+func (m *PlayerRoleMutation) SetTurnOrder(i int) {
+	m.turn_order = &i
+	m.addturn_order = nil
+}
+
+// TurnOrder returns the value of the "turn_order" field in the mutation.
+func (m *PlayerRoleMutation) TurnOrder() (r int, exists bool) {
+	v := m.turn_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTurnOrder returns the old "turn_order" field's value of the PlayerRole entity.
+// If the PlayerRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerRoleMutation) OldTurnOrder(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTurnOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTurnOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTurnOrder: %w", err)
+	}
+	return oldValue.TurnOrder, nil
+}
+
+// AddTurnOrder adds i to the "turn_order" field.
+func (m *PlayerRoleMutation) AddTurnOrder(i int) {
+	if m.addturn_order != nil {
+		*m.addturn_order += i
+	} else {
+		m.addturn_order = &i
+	}
+}
+
+// AddedTurnOrder returns the value that was added to the "turn_order" field in this mutation.
+func (m *PlayerRoleMutation) AddedTurnOrder() (r int, exists bool) {
+	v := m.addturn_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTurnOrder resets all changes to the "turn_order" field.
+func (m *PlayerRoleMutation) ResetTurnOrder() {
+	m.turn_order = nil
+	m.addturn_order = nil
+}
+
+// SetRace sets the "race" field.
+func (m *PlayerRoleMutation) SetRace(wre wits.UnitRaceEnum) {
+	m.race = &wre
+	m.addrace = nil
+}
+
+// Race returns the value of the "race" field in the mutation.
+func (m *PlayerRoleMutation) Race() (r wits.UnitRaceEnum, exists bool) {
+	v := m.race
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRace returns the old "race" field's value of the PlayerRole entity.
+// If the PlayerRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerRoleMutation) OldRace(ctx context.Context) (v wits.UnitRaceEnum, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRace: %w", err)
+	}
+	return oldValue.Race, nil
+}
+
+// AddRace adds wre to the "race" field.
+func (m *PlayerRoleMutation) AddRace(wre wits.UnitRaceEnum) {
+	if m.addrace != nil {
+		*m.addrace += wre
+	} else {
+		m.addrace = &wre
+	}
+}
+
+// AddedRace returns the value that was added to the "race" field in this mutation.
+func (m *PlayerRoleMutation) AddedRace() (r wits.UnitRaceEnum, exists bool) {
+	v := m.addrace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRace resets all changes to the "race" field.
+func (m *PlayerRoleMutation) ResetRace() {
+	m.race = nil
+	m.addrace = nil
+}
+
+// SetResult sets the "result" field.
+func (m *PlayerRoleMutation) SetResult(ws wits.TerminalStatus) {
+	m.result = &ws
+	m.addresult = nil
+}
+
+// Result returns the value of the "result" field in the mutation.
+func (m *PlayerRoleMutation) Result() (r wits.TerminalStatus, exists bool) {
+	v := m.result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResult returns the old "result" field's value of the PlayerRole entity.
+// If the PlayerRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerRoleMutation) OldResult(ctx context.Context) (v wits.TerminalStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
+}
+
+// AddResult adds ws to the "result" field.
+func (m *PlayerRoleMutation) AddResult(ws wits.TerminalStatus) {
+	if m.addresult != nil {
+		*m.addresult += ws
+	} else {
+		m.addresult = &ws
+	}
+}
+
+// AddedResult returns the value that was added to the "result" field in this mutation.
+func (m *PlayerRoleMutation) AddedResult() (r wits.TerminalStatus, exists bool) {
+	v := m.addresult
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResult resets all changes to the "result" field.
+func (m *PlayerRoleMutation) ResetResult() {
+	m.result = nil
+	m.addresult = nil
+}
+
+// AddMatchIDs adds the "match" edge to the Match entity by ids.
+func (m *PlayerRoleMutation) AddMatchIDs(ids ...int) {
+	if m.match == nil {
+		m.match = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.match[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMatch clears the "match" edge to the Match entity.
+func (m *PlayerRoleMutation) ClearMatch() {
+	m.clearedmatch = true
+}
+
+// MatchCleared reports if the "match" edge to the Match entity was cleared.
+func (m *PlayerRoleMutation) MatchCleared() bool {
+	return m.clearedmatch
+}
+
+// RemoveMatchIDs removes the "match" edge to the Match entity by IDs.
+func (m *PlayerRoleMutation) RemoveMatchIDs(ids ...int) {
+	if m.removedmatch == nil {
+		m.removedmatch = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.match, ids[i])
+		m.removedmatch[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMatch returns the removed IDs of the "match" edge to the Match entity.
+func (m *PlayerRoleMutation) RemovedMatchIDs() (ids []int) {
+	for id := range m.removedmatch {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MatchIDs returns the "match" edge IDs in the mutation.
+func (m *PlayerRoleMutation) MatchIDs() (ids []int) {
+	for id := range m.match {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMatch resets all changes to the "match" edge.
+func (m *PlayerRoleMutation) ResetMatch() {
+	m.match = nil
+	m.clearedmatch = false
+	m.removedmatch = nil
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by ids.
+func (m *PlayerRoleMutation) AddPlayerIDs(ids ...int) {
+	if m.players == nil {
+		m.players = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.players[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPlayers clears the "players" edge to the Player entity.
+func (m *PlayerRoleMutation) ClearPlayers() {
+	m.clearedplayers = true
+}
+
+// PlayersCleared reports if the "players" edge to the Player entity was cleared.
+func (m *PlayerRoleMutation) PlayersCleared() bool {
+	return m.clearedplayers
+}
+
+// RemovePlayerIDs removes the "players" edge to the Player entity by IDs.
+func (m *PlayerRoleMutation) RemovePlayerIDs(ids ...int) {
+	if m.removedplayers == nil {
+		m.removedplayers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.players, ids[i])
+		m.removedplayers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPlayers returns the removed IDs of the "players" edge to the Player entity.
+func (m *PlayerRoleMutation) RemovedPlayersIDs() (ids []int) {
+	for id := range m.removedplayers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PlayersIDs returns the "players" edge IDs in the mutation.
+func (m *PlayerRoleMutation) PlayersIDs() (ids []int) {
+	for id := range m.players {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPlayers resets all changes to the "players" edge.
+func (m *PlayerRoleMutation) ResetPlayers() {
+	m.players = nil
+	m.clearedplayers = false
+	m.removedplayers = nil
+}
+
+// Where appends a list predicates to the PlayerRoleMutation builder.
+func (m *PlayerRoleMutation) Where(ps ...predicate.PlayerRole) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlayerRoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlayerRoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PlayerRole, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlayerRoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlayerRoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PlayerRole).
+func (m *PlayerRoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlayerRoleMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.match_id != nil {
+		fields = append(fields, playerrole.FieldMatchID)
+	}
+	if m.player_id != nil {
+		fields = append(fields, playerrole.FieldPlayerID)
+	}
+	if m.position != nil {
+		fields = append(fields, playerrole.FieldPosition)
+	}
+	if m.turn_order != nil {
+		fields = append(fields, playerrole.FieldTurnOrder)
+	}
+	if m.race != nil {
+		fields = append(fields, playerrole.FieldRace)
+	}
+	if m.result != nil {
+		fields = append(fields, playerrole.FieldResult)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlayerRoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case playerrole.FieldMatchID:
+		return m.MatchID()
+	case playerrole.FieldPlayerID:
+		return m.PlayerID()
+	case playerrole.FieldPosition:
+		return m.Position()
+	case playerrole.FieldTurnOrder:
+		return m.TurnOrder()
+	case playerrole.FieldRace:
+		return m.Race()
+	case playerrole.FieldResult:
+		return m.Result()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlayerRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case playerrole.FieldMatchID:
+		return m.OldMatchID(ctx)
+	case playerrole.FieldPlayerID:
+		return m.OldPlayerID(ctx)
+	case playerrole.FieldPosition:
+		return m.OldPosition(ctx)
+	case playerrole.FieldTurnOrder:
+		return m.OldTurnOrder(ctx)
+	case playerrole.FieldRace:
+		return m.OldRace(ctx)
+	case playerrole.FieldResult:
+		return m.OldResult(ctx)
+	}
+	return nil, fmt.Errorf("unknown PlayerRole field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerRoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case playerrole.FieldMatchID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMatchID(v)
+		return nil
+	case playerrole.FieldPlayerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerID(v)
+		return nil
+	case playerrole.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case playerrole.FieldTurnOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTurnOrder(v)
+		return nil
+	case playerrole.FieldRace:
+		v, ok := value.(wits.UnitRaceEnum)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRace(v)
+		return nil
+	case playerrole.FieldResult:
+		v, ok := value.(wits.TerminalStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResult(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PlayerRole field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlayerRoleMutation) AddedFields() []string {
+	var fields []string
+	if m.addmatch_id != nil {
+		fields = append(fields, playerrole.FieldMatchID)
+	}
+	if m.addplayer_id != nil {
+		fields = append(fields, playerrole.FieldPlayerID)
+	}
+	if m.addposition != nil {
+		fields = append(fields, playerrole.FieldPosition)
+	}
+	if m.addturn_order != nil {
+		fields = append(fields, playerrole.FieldTurnOrder)
+	}
+	if m.addrace != nil {
+		fields = append(fields, playerrole.FieldRace)
+	}
+	if m.addresult != nil {
+		fields = append(fields, playerrole.FieldResult)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlayerRoleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case playerrole.FieldMatchID:
+		return m.AddedMatchID()
+	case playerrole.FieldPlayerID:
+		return m.AddedPlayerID()
+	case playerrole.FieldPosition:
+		return m.AddedPosition()
+	case playerrole.FieldTurnOrder:
+		return m.AddedTurnOrder()
+	case playerrole.FieldRace:
+		return m.AddedRace()
+	case playerrole.FieldResult:
+		return m.AddedResult()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerRoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case playerrole.FieldMatchID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMatchID(v)
+		return nil
+	case playerrole.FieldPlayerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlayerID(v)
+		return nil
+	case playerrole.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	case playerrole.FieldTurnOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTurnOrder(v)
+		return nil
+	case playerrole.FieldRace:
+		v, ok := value.(wits.UnitRaceEnum)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRace(v)
+		return nil
+	case playerrole.FieldResult:
+		v, ok := value.(wits.TerminalStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResult(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PlayerRole numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlayerRoleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlayerRoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlayerRoleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PlayerRole nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlayerRoleMutation) ResetField(name string) error {
+	switch name {
+	case playerrole.FieldMatchID:
+		m.ResetMatchID()
+		return nil
+	case playerrole.FieldPlayerID:
+		m.ResetPlayerID()
+		return nil
+	case playerrole.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case playerrole.FieldTurnOrder:
+		m.ResetTurnOrder()
+		return nil
+	case playerrole.FieldRace:
+		m.ResetRace()
+		return nil
+	case playerrole.FieldResult:
+		m.ResetResult()
+		return nil
+	}
+	return fmt.Errorf("unknown PlayerRole field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlayerRoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.match != nil {
+		edges = append(edges, playerrole.EdgeMatch)
+	}
+	if m.players != nil {
+		edges = append(edges, playerrole.EdgePlayers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlayerRoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case playerrole.EdgeMatch:
+		ids := make([]ent.Value, 0, len(m.match))
+		for id := range m.match {
+			ids = append(ids, id)
+		}
+		return ids
+	case playerrole.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.players))
+		for id := range m.players {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlayerRoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmatch != nil {
+		edges = append(edges, playerrole.EdgeMatch)
+	}
+	if m.removedplayers != nil {
+		edges = append(edges, playerrole.EdgePlayers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlayerRoleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case playerrole.EdgeMatch:
+		ids := make([]ent.Value, 0, len(m.removedmatch))
+		for id := range m.removedmatch {
+			ids = append(ids, id)
+		}
+		return ids
+	case playerrole.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.removedplayers))
+		for id := range m.removedplayers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlayerRoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmatch {
+		edges = append(edges, playerrole.EdgeMatch)
+	}
+	if m.clearedplayers {
+		edges = append(edges, playerrole.EdgePlayers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlayerRoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case playerrole.EdgeMatch:
+		return m.clearedmatch
+	case playerrole.EdgePlayers:
+		return m.clearedplayers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlayerRoleMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PlayerRole unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlayerRoleMutation) ResetEdge(name string) error {
+	switch name {
+	case playerrole.EdgeMatch:
+		m.ResetMatch()
+		return nil
+	case playerrole.EdgePlayers:
+		m.ResetPlayers()
+		return nil
+	}
+	return fmt.Errorf("unknown PlayerRole edge %s", name)
+}
+
+// RatingMutation represents an operation that mutates the Rating nodes in the graph.
+type RatingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	mode          *rating.Mode
+	rating        *float64
+	addrating     *float64
+	deviation     *float64
+	adddeviation  *float64
+	volatility    *float64
+	addvolatility *float64
+	games         *int
+	addgames      *int
+	updated_ts    *time.Time
+	clearedFields map[string]struct{}
+	player        *int
+	clearedplayer bool
+	done          bool
+	oldValue      func(context.Context) (*Rating, error)
+	predicates    []predicate.Rating
+}
+
+var _ ent.Mutation = (*RatingMutation)(nil)
+
+// ratingOption allows management of the mutation configuration using functional options.
+type ratingOption func(*RatingMutation)
+
+// newRatingMutation creates new mutation for the Rating entity.
+func newRatingMutation(c config, op Op, opts ...ratingOption) *RatingMutation {
+	m := &RatingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRating,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRatingID sets the ID field of the mutation.
+func withRatingID(id int) ratingOption {
+	return func(m *RatingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Rating
+		)
+		m.oldValue = func(ctx context.Context) (*Rating, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Rating.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRating sets the old Rating of the mutation.
+func withRating(node *Rating) ratingOption {
+	return func(m *RatingMutation) {
+		m.oldValue = func(context.Context) (*Rating, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RatingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RatingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RatingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RatingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Rating.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMode sets the "mode" field.
+func (m *RatingMutation) SetMode(r rating.Mode) {
+	m.mode = &r
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *RatingMutation) Mode() (r rating.Mode, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the Rating entity.
+// If the Rating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingMutation) OldMode(ctx context.Context) (v rating.Mode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *RatingMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetRating sets the "rating" field.
+func (m *RatingMutation) SetRating(f float64) {
+	m.rating = &f
+	m.addrating = nil
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *RatingMutation) Rating() (r float64, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the Rating entity.
+// If the Rating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingMutation) OldRating(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	}
+	return oldValue.Rating, nil
+}
+
+// AddRating adds f to the "rating" field.
+func (m *RatingMutation) AddRating(f float64) {
+	if m.addrating != nil {
+		*m.addrating += f
+	} else {
+		m.addrating = &f
+	}
+}
+
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *RatingMutation) AddedRating() (r float64, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRating resets all changes to the "rating" field.
+func (m *RatingMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+}
+
+// SetDeviation sets the "deviation" field.
+func (m *RatingMutation) SetDeviation(f float64) {
+	m.deviation = &f
+	m.adddeviation = nil
+}
+
+// Deviation returns the value of the "deviation" field in the mutation.
+func (m *RatingMutation) Deviation() (r float64, exists bool) {
+	v := m.deviation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviation returns the old "deviation" field's value of the Rating entity.
+// If the Rating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingMutation) OldDeviation(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviation: %w", err)
+	}
+	return oldValue.Deviation, nil
+}
+
+// AddDeviation adds f to the "deviation" field.
+func (m *RatingMutation) AddDeviation(f float64) {
+	if m.adddeviation != nil {
+		*m.adddeviation += f
+	} else {
+		m.adddeviation = &f
+	}
+}
+
+// AddedDeviation returns the value that was added to the "deviation" field in this mutation.
+func (m *RatingMutation) AddedDeviation() (r float64, exists bool) {
+	v := m.adddeviation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeviation resets all changes to the "deviation" field.
+func (m *RatingMutation) ResetDeviation() {
+	m.deviation = nil
+	m.adddeviation = nil
+}
+
+// SetVolatility sets the "volatility" field.
+func (m *RatingMutation) SetVolatility(f float64) {
+	m.volatility = &f
+	m.addvolatility = nil
+}
+
+// Volatility returns the value of the "volatility" field in the mutation.
+func (m *RatingMutation) Volatility() (r float64, exists bool) {
+	v := m.volatility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVolatility returns the old "volatility" field's value of the Rating entity.
+// If the Rating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingMutation) OldVolatility(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolatility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolatility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolatility: %w", err)
+	}
+	return oldValue.Volatility, nil
+}
+
+// AddVolatility adds f to the "volatility" field.
+func (m *RatingMutation) AddVolatility(f float64) {
+	if m.addvolatility != nil {
+		*m.addvolatility += f
+	} else {
+		m.addvolatility = &f
+	}
+}
+
+// AddedVolatility returns the value that was added to the "volatility" field in this mutation.
+func (m *RatingMutation) AddedVolatility() (r float64, exists bool) {
+	v := m.addvolatility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVolatility resets all changes to the "volatility" field.
+func (m *RatingMutation) ResetVolatility() {
+	m.volatility = nil
+	m.addvolatility = nil
+}
+
+// SetGames sets the "games" field.
+func (m *RatingMutation) SetGames(i int) {
+	m.games = &i
+	m.addgames = nil
+}
+
+// Games returns the value of the "games" field in the mutation.
+func (m *RatingMutation) Games() (r int, exists bool) {
+	v := m.games
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGames returns the old "games" field's value of the Rating entity.
+// If the Rating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingMutation) OldGames(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGames is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGames requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGames: %w", err)
+	}
+	return oldValue.Games, nil
+}
+
+// AddGames adds i to the "games" field.
+func (m *RatingMutation) AddGames(i int) {
+	if m.addgames != nil {
+		*m.addgames += i
+	} else {
+		m.addgames = &i
+	}
+}
+
+// AddedGames returns the value that was added to the "games" field in this mutation.
+func (m *RatingMutation) AddedGames() (r int, exists bool) {
+	v := m.addgames
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGames resets all changes to the "games" field.
+func (m *RatingMutation) ResetGames() {
+	m.games = nil
+	m.addgames = nil
+}
+
+// SetUpdatedTs sets the "updated_ts" field.
+func (m *RatingMutation) SetUpdatedTs(t time.Time) {
+	m.updated_ts = &t
+}
+
+// UpdatedTs returns the value of the "updated_ts" field in the mutation.
+func (m *RatingMutation) UpdatedTs() (r time.Time, exists bool) {
+	v := m.updated_ts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedTs returns the old "updated_ts" field's value of the Rating entity.
+// If the Rating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingMutation) OldUpdatedTs(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedTs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedTs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedTs: %w", err)
+	}
+	return oldValue.UpdatedTs, nil
+}
+
+// ResetUpdatedTs resets all changes to the "updated_ts" field.
+func (m *RatingMutation) ResetUpdatedTs() {
+	m.updated_ts = nil
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by id.
+func (m *RatingMutation) SetPlayerID(id int) {
+	m.player = &id
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (m *RatingMutation) ClearPlayer() {
+	m.clearedplayer = true
+}
+
+// PlayerCleared reports if the "player" edge to the Player entity was cleared.
+func (m *RatingMutation) PlayerCleared() bool {
+	return m.clearedplayer
+}
+
+// PlayerID returns the "player" edge ID in the mutation.
+func (m *RatingMutation) PlayerID() (id int, exists bool) {
+	if m.player != nil {
+		return *m.player, true
+	}
+	return
+}
+
+// PlayerIDs returns the "player" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlayerID instead. It exists only for internal usage by the builders.
+func (m *RatingMutation) PlayerIDs() (ids []int) {
+	if id := m.player; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlayer resets all changes to the "player" edge.
+func (m *RatingMutation) ResetPlayer() {
+	m.player = nil
+	m.clearedplayer = false
+}
+
+// Where appends a list predicates to the RatingMutation builder.
+func (m *RatingMutation) Where(ps ...predicate.Rating) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RatingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RatingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Rating, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RatingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RatingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Rating).
+func (m *RatingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RatingMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.mode != nil {
+		fields = append(fields, rating.FieldMode)
+	}
+	if m.rating != nil {
+		fields = append(fields, rating.FieldRating)
+	}
+	if m.deviation != nil {
+		fields = append(fields, rating.FieldDeviation)
+	}
+	if m.volatility != nil {
+		fields = append(fields, rating.FieldVolatility)
+	}
+	if m.games != nil {
+		fields = append(fields, rating.FieldGames)
+	}
+	if m.updated_ts != nil {
+		fields = append(fields, rating.FieldUpdatedTs)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RatingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rating.FieldMode:
+		return m.Mode()
+	case rating.FieldRating:
+		return m.Rating()
+	case rating.FieldDeviation:
+		return m.Deviation()
+	case rating.FieldVolatility:
+		return m.Volatility()
+	case rating.FieldGames:
+		return m.Games()
+	case rating.FieldUpdatedTs:
+		return m.UpdatedTs()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RatingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rating.FieldMode:
+		return m.OldMode(ctx)
+	case rating.FieldRating:
+		return m.OldRating(ctx)
+	case rating.FieldDeviation:
+		return m.OldDeviation(ctx)
+	case rating.FieldVolatility:
+		return m.OldVolatility(ctx)
+	case rating.FieldGames:
+		return m.OldGames(ctx)
+	case rating.FieldUpdatedTs:
+		return m.OldUpdatedTs(ctx)
+	}
+	return nil, fmt.Errorf("unknown Rating field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RatingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rating.FieldMode:
+		v, ok := value.(rating.Mode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case rating.FieldRating:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case rating.FieldDeviation:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviation(v)
+		return nil
+	case rating.FieldVolatility:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolatility(v)
+		return nil
+	case rating.FieldGames:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGames(v)
+		return nil
+	case rating.FieldUpdatedTs:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedTs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rating field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RatingMutation) AddedFields() []string {
+	var fields []string
+	if m.addrating != nil {
+		fields = append(fields, rating.FieldRating)
+	}
+	if m.adddeviation != nil {
+		fields = append(fields, rating.FieldDeviation)
+	}
+	if m.addvolatility != nil {
+		fields = append(fields, rating.FieldVolatility)
+	}
+	if m.addgames != nil {
+		fields = append(fields, rating.FieldGames)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RatingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rating.FieldRating:
+		return m.AddedRating()
+	case rating.FieldDeviation:
+		return m.AddedDeviation()
+	case rating.FieldVolatility:
+		return m.AddedVolatility()
+	case rating.FieldGames:
+		return m.AddedGames()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RatingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rating.FieldRating:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRating(v)
+		return nil
+	case rating.FieldDeviation:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeviation(v)
+		return nil
+	case rating.FieldVolatility:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVolatility(v)
+		return nil
+	case rating.FieldGames:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGames(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rating numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RatingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RatingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RatingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Rating nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RatingMutation) ResetField(name string) error {
+	switch name {
+	case rating.FieldMode:
+		m.ResetMode()
+		return nil
+	case rating.FieldRating:
+		m.ResetRating()
+		return nil
+	case rating.FieldDeviation:
+		m.ResetDeviation()
+		return nil
+	case rating.FieldVolatility:
+		m.ResetVolatility()
+		return nil
+	case rating.FieldGames:
+		m.ResetGames()
+		return nil
+	case rating.FieldUpdatedTs:
+		m.ResetUpdatedTs()
+		return nil
+	}
+	return fmt.Errorf("unknown Rating field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RatingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.player != nil {
+		edges = append(edges, rating.EdgePlayer)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RatingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rating.EdgePlayer:
+		if id := m.player; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RatingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RatingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RatingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplayer {
+		edges = append(edges, rating.EdgePlayer)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RatingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rating.EdgePlayer:
+		return m.clearedplayer
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RatingMutation) ClearEdge(name string) error {
+	switch name {
+	case rating.EdgePlayer:
+		m.ClearPlayer()
+		return nil
+	}
+	return fmt.Errorf("unknown Rating unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RatingMutation) ResetEdge(name string) error {
+	switch name {
+	case rating.EdgePlayer:
+		m.ResetPlayer()
+		return nil
+	}
+	return fmt.Errorf("unknown Rating edge %s", name)
+}
+
+// RatingHistoryMutation represents an operation that mutates the RatingHistory nodes in the graph.
+type RatingHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	mode          *ratinghistory.Mode
+	rating        *float64
+	addrating     *float64
+	deviation     *float64
+	adddeviation  *float64
+	volatility    *float64
+	addvolatility *float64
+	delta         *float64
+	adddelta      *float64
+	clearedFields map[string]struct{}
+	player        *int
+	clearedplayer bool
+	match         *int
+	clearedmatch  bool
+	done          bool
+	oldValue      func(context.Context) (*RatingHistory, error)
+	predicates    []predicate.RatingHistory
+}
+
+var _ ent.Mutation = (*RatingHistoryMutation)(nil)
+
+// ratinghistoryOption allows management of the mutation configuration using functional options.
+type ratinghistoryOption func(*RatingHistoryMutation)
+
+// newRatingHistoryMutation creates new mutation for the RatingHistory entity.
+func newRatingHistoryMutation(c config, op Op, opts ...ratinghistoryOption) *RatingHistoryMutation {
+	m := &RatingHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRatingHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRatingHistoryID sets the ID field of the mutation.
+func withRatingHistoryID(id int) ratinghistoryOption {
+	return func(m *RatingHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RatingHistory
+		)
+		m.oldValue = func(ctx context.Context) (*RatingHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RatingHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRatingHistory sets the old RatingHistory of the mutation.
+func withRatingHistory(node *RatingHistory) ratinghistoryOption {
+	return func(m *RatingHistoryMutation) {
+		m.oldValue = func(context.Context) (*RatingHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RatingHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RatingHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RatingHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RatingHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RatingHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMode sets the "mode" field.
+func (m *RatingHistoryMutation) SetMode(r ratinghistory.Mode) {
+	m.mode = &r
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *RatingHistoryMutation) Mode() (r ratinghistory.Mode, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the RatingHistory entity.
+// If the RatingHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingHistoryMutation) OldMode(ctx context.Context) (v ratinghistory.Mode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *RatingHistoryMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetRating sets the "rating" field.
+func (m *RatingHistoryMutation) SetRating(f float64) {
+	m.rating = &f
+	m.addrating = nil
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *RatingHistoryMutation) Rating() (r float64, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the RatingHistory entity.
+// If the RatingHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingHistoryMutation) OldRating(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldRating requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Rating, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *OsnMapMutation) ResetName() {
-	m.name = nil
+// AddRating adds f to the "rating" field.
+func (m *RatingHistoryMutation) AddRating(f float64) {
+	if m.addrating != nil {
+		*m.addrating += f
+	} else {
+		m.addrating = &f
+	}
 }
 
-// SetShortname sets the "shortname" field.
-func (m *OsnMapMutation) SetShortname(s string) {
-	m.shortname = &s
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *RatingHistoryMutation) AddedRating() (r float64, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Shortname returns the value of the "shortname" field in the mutation.
-func (m *OsnMapMutation) Shortname() (r string, exists bool) {
-	v := m.shortname
+// ResetRating resets all changes to the "rating" field.
+func (m *RatingHistoryMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+}
+
+// SetDeviation sets the "deviation" field.
+func (m *RatingHistoryMutation) SetDeviation(f float64) {
+	m.deviation = &f
+	m.adddeviation = nil
+}
+
+// Deviation returns the value of the "deviation" field in the mutation.
+func (m *RatingHistoryMutation) Deviation() (r float64, exists bool) {
+	v := m.deviation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldShortname returns the old "shortname" field's value of the OsnMap entity.
-// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// OldDeviation returns the old "deviation" field's value of the RatingHistory entity.
+// If the RatingHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OsnMapMutation) OldShortname(ctx context.Context) (v string, err error) {
+func (m *RatingHistoryMutation) OldDeviation(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShortname is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeviation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShortname requires an ID field in the mutation")
+		return v, errors.New("OldDeviation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShortname: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviation: %w", err)
 	}
-	return oldValue.Shortname, nil
+	return oldValue.Deviation, nil
 }
 
-// ResetShortname resets all changes to the "shortname" field.
-func (m *OsnMapMutation) ResetShortname() {
-	m.shortname = nil
+// AddDeviation adds f to the "deviation" field.
+func (m *RatingHistoryMutation) AddDeviation(f float64) {
+	if m.adddeviation != nil {
+		*m.adddeviation += f
+	} else {
+		m.adddeviation = &f
+	}
 }
 
-// SetRoleCount sets the "role_count" field.
-func (m *OsnMapMutation) SetRoleCount(i int) {
-	m.role_count = &i
-	m.addrole_count = nil
+// AddedDeviation returns the value that was added to the "deviation" field in this mutation.
+func (m *RatingHistoryMutation) AddedDeviation() (r float64, exists bool) {
+	v := m.adddeviation
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RoleCount returns the value of the "role_count" field in the mutation.
-func (m *OsnMapMutation) RoleCount() (r int, exists bool) {
-	v := m.role_count
+// ResetDeviation resets all changes to the "deviation" field.
+func (m *RatingHistoryMutation) ResetDeviation() {
+	m.deviation = nil
+	m.adddeviation = nil
+}
+
+// SetVolatility sets the "volatility" field.
+func (m *RatingHistoryMutation) SetVolatility(f float64) {
+	m.volatility = &f
+	m.addvolatility = nil
+}
+
+// Volatility returns the value of the "volatility" field in the mutation.
+func (m *RatingHistoryMutation) Volatility() (r float64, exists bool) {
+	v := m.volatility
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoleCount returns the old "role_count" field's value of the OsnMap entity.
-// If the OsnMap object wasn't provided to the builder, the object is fetched from the database.
+// OldVolatility returns the old "volatility" field's value of the RatingHistory entity.
+// If the RatingHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OsnMapMutation) OldRoleCount(ctx context.Context) (v int, err error) {
+func (m *RatingHistoryMutation) OldVolatility(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoleCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldVolatility is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoleCount requires an ID field in the mutation")
+		return v, errors.New("OldVolatility requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoleCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldVolatility: %w", err)
 	}
-	return oldValue.RoleCount, nil
+	return oldValue.Volatility, nil
 }
 
-// AddRoleCount adds i to the "role_count" field.
-func (m *OsnMapMutation) AddRoleCount(i int) {
-	if m.addrole_count != nil {
-		*m.addrole_count += i
+// AddVolatility adds f to the "volatility" field.
+func (m *RatingHistoryMutation) AddVolatility(f float64) {
+	if m.addvolatility != nil {
+		*m.addvolatility += f
 	} else {
-		m.addrole_count = &i
+		m.addvolatility = &f
 	}
 }
 
-// AddedRoleCount returns the value that was added to the "role_count" field in this mutation.
-func (m *OsnMapMutation) AddedRoleCount() (r int, exists bool) {
-	v := m.addrole_count
+// AddedVolatility returns the value that was added to the "volatility" field in this mutation.
+func (m *RatingHistoryMutation) AddedVolatility() (r float64, exists bool) {
+	v := m.addvolatility
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRoleCount resets all changes to the "role_count" field.
-func (m *OsnMapMutation) ResetRoleCount() {
-	m.role_count = nil
-	m.addrole_count = nil
+// ResetVolatility resets all changes to the "volatility" field.
+func (m *RatingHistoryMutation) ResetVolatility() {
+	m.volatility = nil
+	m.addvolatility = nil
 }
 
-// AddMatchIDs adds the "matches" edge to the Match entity by ids.
-func (m *OsnMapMutation) AddMatchIDs(ids ...int) {
-	if m.matches == nil {
-		m.matches = make(map[int]struct{})
+// SetDelta sets the "delta" field.
+func (m *RatingHistoryMutation) SetDelta(f float64) {
+	m.delta = &f
+	m.adddelta = nil
+}
+
+// Delta returns the value of the "delta" field in the mutation.
+func (m *RatingHistoryMutation) Delta() (r float64, exists bool) {
+	v := m.delta
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.matches[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldDelta returns the old "delta" field's value of the RatingHistory entity.
+// If the RatingHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingHistoryMutation) OldDelta(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDelta is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDelta requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDelta: %w", err)
 	}
+	return oldValue.Delta, nil
 }
 
-// ClearMatches clears the "matches" edge to the Match entity.
-func (m *OsnMapMutation) ClearMatches() {
-	m.clearedmatches = true
+// AddDelta adds f to the "delta" field.
+func (m *RatingHistoryMutation) AddDelta(f float64) {
+	if m.adddelta != nil {
+		*m.adddelta += f
+	} else {
+		m.adddelta = &f
+	}
 }
 
-// MatchesCleared reports if the "matches" edge to the Match entity was cleared.
-func (m *OsnMapMutation) MatchesCleared() bool {
-	return m.clearedmatches
+// AddedDelta returns the value that was added to the "delta" field in this mutation.
+func (m *RatingHistoryMutation) AddedDelta() (r float64, exists bool) {
+	v := m.adddelta
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveMatchIDs removes the "matches" edge to the Match entity by IDs.
-func (m *OsnMapMutation) RemoveMatchIDs(ids ...int) {
-	if m.removedmatches == nil {
-		m.removedmatches = make(map[int]struct{})
+// ResetDelta resets all changes to the "delta" field.
+func (m *RatingHistoryMutation) ResetDelta() {
+	m.delta = nil
+	m.adddelta = nil
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by id.
+func (m *RatingHistoryMutation) SetPlayerID(id int) {
+	m.player = &id
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (m *RatingHistoryMutation) ClearPlayer() {
+	m.clearedplayer = true
+}
+
+// PlayerCleared reports if the "player" edge to the Player entity was cleared.
+func (m *RatingHistoryMutation) PlayerCleared() bool {
+	return m.clearedplayer
+}
+
+// PlayerID returns the "player" edge ID in the mutation.
+func (m *RatingHistoryMutation) PlayerID() (id int, exists bool) {
+	if m.player != nil {
+		return *m.player, true
 	}
-	for i := range ids {
-		delete(m.matches, ids[i])
-		m.removedmatches[ids[i]] = struct{}{}
+	return
+}
+
+// PlayerIDs returns the "player" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlayerID instead. It exists only for internal usage by the builders.
+func (m *RatingHistoryMutation) PlayerIDs() (ids []int) {
+	if id := m.player; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// RemovedMatches returns the removed IDs of the "matches" edge to the Match entity.
-func (m *OsnMapMutation) RemovedMatchesIDs() (ids []int) {
-	for id := range m.removedmatches {
-		ids = append(ids, id)
+// ResetPlayer resets all changes to the "player" edge.
+func (m *RatingHistoryMutation) ResetPlayer() {
+	m.player = nil
+	m.clearedplayer = false
+}
+
+// SetMatchID sets the "match" edge to the Match entity by id.
+func (m *RatingHistoryMutation) SetMatchID(id int) {
+	m.match = &id
+}
+
+// ClearMatch clears the "match" edge to the Match entity.
+func (m *RatingHistoryMutation) ClearMatch() {
+	m.clearedmatch = true
+}
+
+// MatchCleared reports if the "match" edge to the Match entity was cleared.
+func (m *RatingHistoryMutation) MatchCleared() bool {
+	return m.clearedmatch
+}
+
+// MatchID returns the "match" edge ID in the mutation.
+func (m *RatingHistoryMutation) MatchID() (id int, exists bool) {
+	if m.match != nil {
+		return *m.match, true
 	}
 	return
 }
 
-// MatchesIDs returns the "matches" edge IDs in the mutation.
-func (m *OsnMapMutation) MatchesIDs() (ids []int) {
-	for id := range m.matches {
-		ids = append(ids, id)
+// MatchIDs returns the "match" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MatchID instead. It exists only for internal usage by the builders.
+func (m *RatingHistoryMutation) MatchIDs() (ids []int) {
+	if id := m.match; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMatches resets all changes to the "matches" edge.
-func (m *OsnMapMutation) ResetMatches() {
-	m.matches = nil
-	m.clearedmatches = false
-	m.removedmatches = nil
+// ResetMatch resets all changes to the "match" edge.
+func (m *RatingHistoryMutation) ResetMatch() {
+	m.match = nil
+	m.clearedmatch = false
 }
 
-// Where appends a list predicates to the OsnMapMutation builder.
-func (m *OsnMapMutation) Where(ps ...predicate.OsnMap) {
+// Where appends a list predicates to the RatingHistoryMutation builder.
+func (m *RatingHistoryMutation) Where(ps ...predicate.RatingHistory) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OsnMapMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RatingHistoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OsnMapMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.OsnMap, len(ps))
+func (m *RatingHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RatingHistory, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1198,33 +7154,39 @@ func (m *OsnMapMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OsnMapMutation) Op() Op {
+func (m *RatingHistoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OsnMapMutation) SetOp(op Op) {
+func (m *RatingHistoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (OsnMap).
-func (m *OsnMapMutation) Type() string {
+// Type returns the node type of this mutation (RatingHistory).
+func (m *RatingHistoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OsnMapMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.name != nil {
-		fields = append(fields, osnmap.FieldName)
+func (m *RatingHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.mode != nil {
+		fields = append(fields, ratinghistory.FieldMode)
 	}
-	if m.shortname != nil {
-		fields = append(fields, osnmap.FieldShortname)
+	if m.rating != nil {
+		fields = append(fields, ratinghistory.FieldRating)
 	}
-	if m.role_count != nil {
-		fields = append(fields, osnmap.FieldRoleCount)
+	if m.deviation != nil {
+		fields = append(fields, ratinghistory.FieldDeviation)
+	}
+	if m.volatility != nil {
+		fields = append(fields, ratinghistory.FieldVolatility)
+	}
+	if m.delta != nil {
+		fields = append(fields, ratinghistory.FieldDelta)
 	}
 	return fields
 }
@@ -1232,14 +7194,18 @@ func (m *OsnMapMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OsnMapMutation) Field(name string) (ent.Value, bool) {
+func (m *RatingHistoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case osnmap.FieldName:
-		return m.Name()
-	case osnmap.FieldShortname:
-		return m.Shortname()
-	case osnmap.FieldRoleCount:
-		return m.RoleCount()
+	case ratinghistory.FieldMode:
+		return m.Mode()
+	case ratinghistory.FieldRating:
+		return m.Rating()
+	case ratinghistory.FieldDeviation:
+		return m.Deviation()
+	case ratinghistory.FieldVolatility:
+		return m.Volatility()
+	case ratinghistory.FieldDelta:
+		return m.Delta()
 	}
 	return nil, false
 }
@@ -1247,54 +7213,81 @@ func (m *OsnMapMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OsnMapMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RatingHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case osnmap.FieldName:
-		return m.OldName(ctx)
-	case osnmap.FieldShortname:
-		return m.OldShortname(ctx)
-	case osnmap.FieldRoleCount:
-		return m.OldRoleCount(ctx)
+	case ratinghistory.FieldMode:
+		return m.OldMode(ctx)
+	case ratinghistory.FieldRating:
+		return m.OldRating(ctx)
+	case ratinghistory.FieldDeviation:
+		return m.OldDeviation(ctx)
+	case ratinghistory.FieldVolatility:
+		return m.OldVolatility(ctx)
+	case ratinghistory.FieldDelta:
+		return m.OldDelta(ctx)
 	}
-	return nil, fmt.Errorf("unknown OsnMap field %s", name)
+	return nil, fmt.Errorf("unknown RatingHistory field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OsnMapMutation) SetField(name string, value ent.Value) error {
+func (m *RatingHistoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case osnmap.FieldName:
-		v, ok := value.(string)
+	case ratinghistory.FieldMode:
+		v, ok := value.(ratinghistory.Mode)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetMode(v)
 		return nil
-	case osnmap.FieldShortname:
-		v, ok := value.(string)
+	case ratinghistory.FieldRating:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetShortname(v)
+		m.SetRating(v)
 		return nil
-	case osnmap.FieldRoleCount:
-		v, ok := value.(int)
+	case ratinghistory.FieldDeviation:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoleCount(v)
+		m.SetDeviation(v)
+		return nil
+	case ratinghistory.FieldVolatility:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolatility(v)
+		return nil
+	case ratinghistory.FieldDelta:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDelta(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OsnMap field %s", name)
+	return fmt.Errorf("unknown RatingHistory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OsnMapMutation) AddedFields() []string {
+func (m *RatingHistoryMutation) AddedFields() []string {
 	var fields []string
-	if m.addrole_count != nil {
-		fields = append(fields, osnmap.FieldRoleCount)
+	if m.addrating != nil {
+		fields = append(fields, ratinghistory.FieldRating)
+	}
+	if m.adddeviation != nil {
+		fields = append(fields, ratinghistory.FieldDeviation)
+	}
+	if m.addvolatility != nil {
+		fields = append(fields, ratinghistory.FieldVolatility)
+	}
+	if m.adddelta != nil {
+		fields = append(fields, ratinghistory.FieldDelta)
 	}
 	return fields
 }
@@ -1302,10 +7295,16 @@ func (m *OsnMapMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OsnMapMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RatingHistoryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case osnmap.FieldRoleCount:
-		return m.AddedRoleCount()
+	case ratinghistory.FieldRating:
+		return m.AddedRating()
+	case ratinghistory.FieldDeviation:
+		return m.AddedDeviation()
+	case ratinghistory.FieldVolatility:
+		return m.AddedVolatility()
+	case ratinghistory.FieldDelta:
+		return m.AddedDelta()
 	}
 	return nil, false
 }
@@ -1313,167 +7312,212 @@ func (m *OsnMapMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OsnMapMutation) AddField(name string, value ent.Value) error {
+func (m *RatingHistoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case osnmap.FieldRoleCount:
-		v, ok := value.(int)
+	case ratinghistory.FieldRating:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRoleCount(v)
+		m.AddRating(v)
+		return nil
+	case ratinghistory.FieldDeviation:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeviation(v)
+		return nil
+	case ratinghistory.FieldVolatility:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVolatility(v)
+		return nil
+	case ratinghistory.FieldDelta:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDelta(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OsnMap numeric field %s", name)
+	return fmt.Errorf("unknown RatingHistory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OsnMapMutation) ClearedFields() []string {
+func (m *RatingHistoryMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OsnMapMutation) FieldCleared(name string) bool {
+func (m *RatingHistoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OsnMapMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown OsnMap nullable field %s", name)
+func (m *RatingHistoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RatingHistory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OsnMapMutation) ResetField(name string) error {
+func (m *RatingHistoryMutation) ResetField(name string) error {
 	switch name {
-	case osnmap.FieldName:
-		m.ResetName()
+	case ratinghistory.FieldMode:
+		m.ResetMode()
+		return nil
+	case ratinghistory.FieldRating:
+		m.ResetRating()
 		return nil
-	case osnmap.FieldShortname:
-		m.ResetShortname()
+	case ratinghistory.FieldDeviation:
+		m.ResetDeviation()
 		return nil
-	case osnmap.FieldRoleCount:
-		m.ResetRoleCount()
+	case ratinghistory.FieldVolatility:
+		m.ResetVolatility()
+		return nil
+	case ratinghistory.FieldDelta:
+		m.ResetDelta()
 		return nil
 	}
-	return fmt.Errorf("unknown OsnMap field %s", name)
+	return fmt.Errorf("unknown RatingHistory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OsnMapMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.matches != nil {
-		edges = append(edges, osnmap.EdgeMatches)
+func (m *RatingHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.player != nil {
+		edges = append(edges, ratinghistory.EdgePlayer)
+	}
+	if m.match != nil {
+		edges = append(edges, ratinghistory.EdgeMatch)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OsnMapMutation) AddedIDs(name string) []ent.Value {
+func (m *RatingHistoryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case osnmap.EdgeMatches:
-		ids := make([]ent.Value, 0, len(m.matches))
-		for id := range m.matches {
-			ids = append(ids, id)
+	case ratinghistory.EdgePlayer:
+		if id := m.player; id != nil {
+			return []ent.Value{*id}
+		}
+	case ratinghistory.EdgeMatch:
+		if id := m.match; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OsnMapMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedmatches != nil {
-		edges = append(edges, osnmap.EdgeMatches)
-	}
+func (m *RatingHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OsnMapMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case osnmap.EdgeMatches:
-		ids := make([]ent.Value, 0, len(m.removedmatches))
-		for id := range m.removedmatches {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RatingHistoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OsnMapMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmatches {
-		edges = append(edges, osnmap.EdgeMatches)
+func (m *RatingHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedplayer {
+		edges = append(edges, ratinghistory.EdgePlayer)
+	}
+	if m.clearedmatch {
+		edges = append(edges, ratinghistory.EdgeMatch)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OsnMapMutation) EdgeCleared(name string) bool {
+func (m *RatingHistoryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case osnmap.EdgeMatches:
-		return m.clearedmatches
+	case ratinghistory.EdgePlayer:
+		return m.clearedplayer
+	case ratinghistory.EdgeMatch:
+		return m.clearedmatch
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OsnMapMutation) ClearEdge(name string) error {
+func (m *RatingHistoryMutation) ClearEdge(name string) error {
 	switch name {
+	case ratinghistory.EdgePlayer:
+		m.ClearPlayer()
+		return nil
+	case ratinghistory.EdgeMatch:
+		m.ClearMatch()
+		return nil
 	}
-	return fmt.Errorf("unknown OsnMap unique edge %s", name)
+	return fmt.Errorf("unknown RatingHistory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OsnMapMutation) ResetEdge(name string) error {
+func (m *RatingHistoryMutation) ResetEdge(name string) error {
 	switch name {
-	case osnmap.EdgeMatches:
-		m.ResetMatches()
+	case ratinghistory.EdgePlayer:
+		m.ResetPlayer()
+		return nil
+	case ratinghistory.EdgeMatch:
+		m.ResetMatch()
 		return nil
 	}
-	return fmt.Errorf("unknown OsnMap edge %s", name)
+	return fmt.Errorf("unknown RatingHistory edge %s", name)
 }
 
-// PlayerMutation represents an operation that mutates the Player nodes in the graph.
-type PlayerMutation struct {
+// StandingMutation represents an operation that mutates the Standing nodes in the graph.
+type StandingMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	gcid          *string
-	name          *string
+	season        *int8
+	addseason     *int8
+	tier          *standing.Tier
+	league        *int
+	addleague     *int
+	rank          *int
+	addrank       *int
+	points        *int
+	addpoints     *int
+	wins          *int
+	addwins       *int
+	losses        *int
+	addlosses     *int
 	clearedFields map[string]struct{}
-	roles         map[int]struct{}
-	removedroles  map[int]struct{}
-	clearedroles  bool
+	player        *int
+	clearedplayer bool
 	done          bool
-	oldValue      func(context.Context) (*Player, error)
-	predicates    []predicate.Player
+	oldValue      func(context.Context) (*Standing, error)
+	predicates    []predicate.Standing
 }
 
-var _ ent.Mutation = (*PlayerMutation)(nil)
+var _ ent.Mutation = (*StandingMutation)(nil)
 
-// playerOption allows management of the mutation configuration using functional options.
-type playerOption func(*PlayerMutation)
+// standingOption allows management of the mutation configuration using functional options.
+type standingOption func(*StandingMutation)
 
-// newPlayerMutation creates new mutation for the Player entity.
-func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
-	m := &PlayerMutation{
+// newStandingMutation creates new mutation for the Standing entity.
+func newStandingMutation(c config, op Op, opts ...standingOption) *StandingMutation {
+	m := &StandingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePlayer,
+		typ:           TypeStanding,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1482,20 +7526,20 @@ func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
 	return m
 }
 
-// withPlayerID sets the ID field of the mutation.
-func withPlayerID(id int) playerOption {
-	return func(m *PlayerMutation) {
+// withStandingID sets the ID field of the mutation.
+func withStandingID(id int) standingOption {
+	return func(m *StandingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Player
+			value *Standing
 		)
-		m.oldValue = func(ctx context.Context) (*Player, error) {
+		m.oldValue = func(ctx context.Context) (*Standing, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Player.Get(ctx, id)
+					value, err = m.Client().Standing.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1504,198 +7548,483 @@ func withPlayerID(id int) playerOption {
 	}
 }
 
-// withPlayer sets the old Player of the mutation.
-func withPlayer(node *Player) playerOption {
-	return func(m *PlayerMutation) {
-		m.oldValue = func(context.Context) (*Player, error) {
+// withStanding sets the old Standing of the mutation.
+func withStanding(node *Standing) standingOption {
+	return func(m *StandingMutation) {
+		m.oldValue = func(context.Context) (*Standing, error) {
 			return node, nil
 		}
 		m.id = &node.ID
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PlayerMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StandingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StandingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StandingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StandingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Standing.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSeason sets the "season" field.
+func (m *StandingMutation) SetSeason(i int8) {
+	m.season = &i
+	m.addseason = nil
+}
+
+// Season returns the value of the "season" field in the mutation.
+func (m *StandingMutation) Season() (r int8, exists bool) {
+	v := m.season
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeason returns the old "season" field's value of the Standing entity.
+// If the Standing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StandingMutation) OldSeason(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeason: %w", err)
+	}
+	return oldValue.Season, nil
+}
+
+// AddSeason adds i to the "season" field.
+func (m *StandingMutation) AddSeason(i int8) {
+	if m.addseason != nil {
+		*m.addseason += i
+	} else {
+		m.addseason = &i
+	}
+}
+
+// AddedSeason returns the value that was added to the "season" field in this mutation.
+func (m *StandingMutation) AddedSeason() (r int8, exists bool) {
+	v := m.addseason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeason resets all changes to the "season" field.
+func (m *StandingMutation) ResetSeason() {
+	m.season = nil
+	m.addseason = nil
+}
+
+// SetTier sets the "tier" field.
+func (m *StandingMutation) SetTier(s standing.Tier) {
+	m.tier = &s
+}
+
+// Tier returns the value of the "tier" field in the mutation.
+func (m *StandingMutation) Tier() (r standing.Tier, exists bool) {
+	v := m.tier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTier returns the old "tier" field's value of the Standing entity.
+// If the Standing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StandingMutation) OldTier(ctx context.Context) (v standing.Tier, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
+	}
+	return oldValue.Tier, nil
+}
+
+// ResetTier resets all changes to the "tier" field.
+func (m *StandingMutation) ResetTier() {
+	m.tier = nil
+}
+
+// SetLeague sets the "league" field.
+func (m *StandingMutation) SetLeague(i int) {
+	m.league = &i
+	m.addleague = nil
+}
+
+// League returns the value of the "league" field in the mutation.
+func (m *StandingMutation) League() (r int, exists bool) {
+	v := m.league
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeague returns the old "league" field's value of the Standing entity.
+// If the Standing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StandingMutation) OldLeague(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeague is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeague requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeague: %w", err)
+	}
+	return oldValue.League, nil
+}
+
+// AddLeague adds i to the "league" field.
+func (m *StandingMutation) AddLeague(i int) {
+	if m.addleague != nil {
+		*m.addleague += i
+	} else {
+		m.addleague = &i
+	}
+}
+
+// AddedLeague returns the value that was added to the "league" field in this mutation.
+func (m *StandingMutation) AddedLeague() (r int, exists bool) {
+	v := m.addleague
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLeague resets all changes to the "league" field.
+func (m *StandingMutation) ResetLeague() {
+	m.league = nil
+	m.addleague = nil
+}
+
+// SetRank sets the "rank" field.
+func (m *StandingMutation) SetRank(i int) {
+	m.rank = &i
+	m.addrank = nil
+}
+
+// Rank returns the value of the "rank" field in the mutation.
+func (m *StandingMutation) Rank() (r int, exists bool) {
+	v := m.rank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRank returns the old "rank" field's value of the Standing entity.
+// If the Standing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StandingMutation) OldRank(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRank is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRank requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRank: %w", err)
+	}
+	return oldValue.Rank, nil
+}
+
+// AddRank adds i to the "rank" field.
+func (m *StandingMutation) AddRank(i int) {
+	if m.addrank != nil {
+		*m.addrank += i
+	} else {
+		m.addrank = &i
+	}
+}
+
+// AddedRank returns the value that was added to the "rank" field in this mutation.
+func (m *StandingMutation) AddedRank() (r int, exists bool) {
+	v := m.addrank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRank resets all changes to the "rank" field.
+func (m *StandingMutation) ResetRank() {
+	m.rank = nil
+	m.addrank = nil
+}
+
+// SetPoints sets the "points" field.
+func (m *StandingMutation) SetPoints(i int) {
+	m.points = &i
+	m.addpoints = nil
+}
+
+// Points returns the value of the "points" field in the mutation.
+func (m *StandingMutation) Points() (r int, exists bool) {
+	v := m.points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPoints returns the old "points" field's value of the Standing entity.
+// If the Standing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StandingMutation) OldPoints(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoints: %w", err)
+	}
+	return oldValue.Points, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PlayerMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddPoints adds i to the "points" field.
+func (m *StandingMutation) AddPoints(i int) {
+	if m.addpoints != nil {
+		*m.addpoints += i
+	} else {
+		m.addpoints = &i
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PlayerMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedPoints returns the value that was added to the "points" field in this mutation.
+func (m *StandingMutation) AddedPoints() (r int, exists bool) {
+	v := m.addpoints
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PlayerMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Player.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetPoints resets all changes to the "points" field.
+func (m *StandingMutation) ResetPoints() {
+	m.points = nil
+	m.addpoints = nil
 }
 
-// SetGcid sets the "gcid" field.
-func (m *PlayerMutation) SetGcid(s string) {
-	m.gcid = &s
+// SetWins sets the "wins" field.
+func (m *StandingMutation) SetWins(i int) {
+	m.wins = &i
+	m.addwins = nil
 }
 
-// Gcid returns the value of the "gcid" field in the mutation.
-func (m *PlayerMutation) Gcid() (r string, exists bool) {
-	v := m.gcid
+// Wins returns the value of the "wins" field in the mutation.
+func (m *StandingMutation) Wins() (r int, exists bool) {
+	v := m.wins
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGcid returns the old "gcid" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldWins returns the old "wins" field's value of the Standing entity.
+// If the Standing object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldGcid(ctx context.Context) (v *string, err error) {
+func (m *StandingMutation) OldWins(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGcid is only allowed on UpdateOne operations")
+		return v, errors.New("OldWins is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGcid requires an ID field in the mutation")
+		return v, errors.New("OldWins requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGcid: %w", err)
+		return v, fmt.Errorf("querying old value for OldWins: %w", err)
 	}
-	return oldValue.Gcid, nil
+	return oldValue.Wins, nil
 }
 
-// ResetGcid resets all changes to the "gcid" field.
-func (m *PlayerMutation) ResetGcid() {
-	m.gcid = nil
+// AddWins adds i to the "wins" field.
+func (m *StandingMutation) AddWins(i int) {
+	if m.addwins != nil {
+		*m.addwins += i
+	} else {
+		m.addwins = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *PlayerMutation) SetName(s string) {
-	m.name = &s
+// AddedWins returns the value that was added to the "wins" field in this mutation.
+func (m *StandingMutation) AddedWins() (r int, exists bool) {
+	v := m.addwins
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PlayerMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetWins resets all changes to the "wins" field.
+func (m *StandingMutation) ResetWins() {
+	m.wins = nil
+	m.addwins = nil
+}
+
+// SetLosses sets the "losses" field.
+func (m *StandingMutation) SetLosses(i int) {
+	m.losses = &i
+	m.addlosses = nil
+}
+
+// Losses returns the value of the "losses" field in the mutation.
+func (m *StandingMutation) Losses() (r int, exists bool) {
+	v := m.losses
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldLosses returns the old "losses" field's value of the Standing entity.
+// If the Standing object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *StandingMutation) OldLosses(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldLosses is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldLosses requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldLosses: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Losses, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PlayerMutation) ResetName() {
-	m.name = nil
+// AddLosses adds i to the "losses" field.
+func (m *StandingMutation) AddLosses(i int) {
+	if m.addlosses != nil {
+		*m.addlosses += i
+	} else {
+		m.addlosses = &i
+	}
 }
 
-// AddRoleIDs adds the "roles" edge to the PlayerRole entity by ids.
-func (m *PlayerMutation) AddRoleIDs(ids ...int) {
-	if m.roles == nil {
-		m.roles = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.roles[ids[i]] = struct{}{}
+// AddedLosses returns the value that was added to the "losses" field in this mutation.
+func (m *StandingMutation) AddedLosses() (r int, exists bool) {
+	v := m.addlosses
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearRoles clears the "roles" edge to the PlayerRole entity.
-func (m *PlayerMutation) ClearRoles() {
-	m.clearedroles = true
+// ResetLosses resets all changes to the "losses" field.
+func (m *StandingMutation) ResetLosses() {
+	m.losses = nil
+	m.addlosses = nil
 }
 
-// RolesCleared reports if the "roles" edge to the PlayerRole entity was cleared.
-func (m *PlayerMutation) RolesCleared() bool {
-	return m.clearedroles
+// SetPlayerID sets the "player" edge to the Player entity by id.
+func (m *StandingMutation) SetPlayerID(id int) {
+	m.player = &id
 }
 
-// RemoveRoleIDs removes the "roles" edge to the PlayerRole entity by IDs.
-func (m *PlayerMutation) RemoveRoleIDs(ids ...int) {
-	if m.removedroles == nil {
-		m.removedroles = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.roles, ids[i])
-		m.removedroles[ids[i]] = struct{}{}
-	}
+// ClearPlayer clears the "player" edge to the Player entity.
+func (m *StandingMutation) ClearPlayer() {
+	m.clearedplayer = true
 }
 
-// RemovedRoles returns the removed IDs of the "roles" edge to the PlayerRole entity.
-func (m *PlayerMutation) RemovedRolesIDs() (ids []int) {
-	for id := range m.removedroles {
-		ids = append(ids, id)
+// PlayerCleared reports if the "player" edge to the Player entity was cleared.
+func (m *StandingMutation) PlayerCleared() bool {
+	return m.clearedplayer
+}
+
+// PlayerID returns the "player" edge ID in the mutation.
+func (m *StandingMutation) PlayerID() (id int, exists bool) {
+	if m.player != nil {
+		return *m.player, true
 	}
 	return
 }
 
-// RolesIDs returns the "roles" edge IDs in the mutation.
-func (m *PlayerMutation) RolesIDs() (ids []int) {
-	for id := range m.roles {
-		ids = append(ids, id)
+// PlayerIDs returns the "player" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlayerID instead. It exists only for internal usage by the builders.
+func (m *StandingMutation) PlayerIDs() (ids []int) {
+	if id := m.player; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRoles resets all changes to the "roles" edge.
-func (m *PlayerMutation) ResetRoles() {
-	m.roles = nil
-	m.clearedroles = false
-	m.removedroles = nil
+// ResetPlayer resets all changes to the "player" edge.
+func (m *StandingMutation) ResetPlayer() {
+	m.player = nil
+	m.clearedplayer = false
 }
 
-// Where appends a list predicates to the PlayerMutation builder.
-func (m *PlayerMutation) Where(ps ...predicate.Player) {
+// Where appends a list predicates to the StandingMutation builder.
+func (m *StandingMutation) Where(ps ...predicate.Standing) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PlayerMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StandingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PlayerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Player, len(ps))
+func (m *StandingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Standing, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1703,30 +8032,45 @@ func (m *PlayerMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PlayerMutation) Op() Op {
+func (m *StandingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PlayerMutation) SetOp(op Op) {
+func (m *StandingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Player).
-func (m *PlayerMutation) Type() string {
+// Type returns the node type of this mutation (Standing).
+func (m *StandingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PlayerMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.gcid != nil {
-		fields = append(fields, player.FieldGcid)
+func (m *StandingMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.season != nil {
+		fields = append(fields, standing.FieldSeason)
 	}
-	if m.name != nil {
-		fields = append(fields, player.FieldName)
+	if m.tier != nil {
+		fields = append(fields, standing.FieldTier)
+	}
+	if m.league != nil {
+		fields = append(fields, standing.FieldLeague)
+	}
+	if m.rank != nil {
+		fields = append(fields, standing.FieldRank)
+	}
+	if m.points != nil {
+		fields = append(fields, standing.FieldPoints)
+	}
+	if m.wins != nil {
+		fields = append(fields, standing.FieldWins)
+	}
+	if m.losses != nil {
+		fields = append(fields, standing.FieldLosses)
 	}
 	return fields
 }
@@ -1734,12 +8078,22 @@ func (m *PlayerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
+func (m *StandingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case player.FieldGcid:
-		return m.Gcid()
-	case player.FieldName:
-		return m.Name()
+	case standing.FieldSeason:
+		return m.Season()
+	case standing.FieldTier:
+		return m.Tier()
+	case standing.FieldLeague:
+		return m.League()
+	case standing.FieldRank:
+		return m.Rank()
+	case standing.FieldPoints:
+		return m.Points()
+	case standing.FieldWins:
+		return m.Wins()
+	case standing.FieldLosses:
+		return m.Losses()
 	}
 	return nil, false
 }
@@ -1747,215 +8101,335 @@ func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PlayerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StandingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case player.FieldGcid:
-		return m.OldGcid(ctx)
-	case player.FieldName:
-		return m.OldName(ctx)
-	}
-	return nil, fmt.Errorf("unknown Player field %s", name)
+	case standing.FieldSeason:
+		return m.OldSeason(ctx)
+	case standing.FieldTier:
+		return m.OldTier(ctx)
+	case standing.FieldLeague:
+		return m.OldLeague(ctx)
+	case standing.FieldRank:
+		return m.OldRank(ctx)
+	case standing.FieldPoints:
+		return m.OldPoints(ctx)
+	case standing.FieldWins:
+		return m.OldWins(ctx)
+	case standing.FieldLosses:
+		return m.OldLosses(ctx)
+	}
+	return nil, fmt.Errorf("unknown Standing field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlayerMutation) SetField(name string, value ent.Value) error {
+func (m *StandingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case player.FieldGcid:
-		v, ok := value.(string)
+	case standing.FieldSeason:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeason(v)
+		return nil
+	case standing.FieldTier:
+		v, ok := value.(standing.Tier)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTier(v)
+		return nil
+	case standing.FieldLeague:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeague(v)
+		return nil
+	case standing.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRank(v)
+		return nil
+	case standing.FieldPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoints(v)
+		return nil
+	case standing.FieldWins:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWins(v)
+		return nil
+	case standing.FieldLosses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLosses(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Standing field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StandingMutation) AddedFields() []string {
+	var fields []string
+	if m.addseason != nil {
+		fields = append(fields, standing.FieldSeason)
+	}
+	if m.addleague != nil {
+		fields = append(fields, standing.FieldLeague)
+	}
+	if m.addrank != nil {
+		fields = append(fields, standing.FieldRank)
+	}
+	if m.addpoints != nil {
+		fields = append(fields, standing.FieldPoints)
+	}
+	if m.addwins != nil {
+		fields = append(fields, standing.FieldWins)
+	}
+	if m.addlosses != nil {
+		fields = append(fields, standing.FieldLosses)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StandingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case standing.FieldSeason:
+		return m.AddedSeason()
+	case standing.FieldLeague:
+		return m.AddedLeague()
+	case standing.FieldRank:
+		return m.AddedRank()
+	case standing.FieldPoints:
+		return m.AddedPoints()
+	case standing.FieldWins:
+		return m.AddedWins()
+	case standing.FieldLosses:
+		return m.AddedLosses()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StandingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case standing.FieldSeason:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeason(v)
+		return nil
+	case standing.FieldLeague:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLeague(v)
+		return nil
+	case standing.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRank(v)
+		return nil
+	case standing.FieldPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPoints(v)
+		return nil
+	case standing.FieldWins:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGcid(v)
+		m.AddWins(v)
 		return nil
-	case player.FieldName:
-		v, ok := value.(string)
+	case standing.FieldLosses:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.AddLosses(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Player field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PlayerMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PlayerMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Player numeric field %s", name)
+	return fmt.Errorf("unknown Standing numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PlayerMutation) ClearedFields() []string {
+func (m *StandingMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PlayerMutation) FieldCleared(name string) bool {
+func (m *StandingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PlayerMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Player nullable field %s", name)
+func (m *StandingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Standing nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PlayerMutation) ResetField(name string) error {
+func (m *StandingMutation) ResetField(name string) error {
 	switch name {
-	case player.FieldGcid:
-		m.ResetGcid()
+	case standing.FieldSeason:
+		m.ResetSeason()
 		return nil
-	case player.FieldName:
-		m.ResetName()
+	case standing.FieldTier:
+		m.ResetTier()
+		return nil
+	case standing.FieldLeague:
+		m.ResetLeague()
+		return nil
+	case standing.FieldRank:
+		m.ResetRank()
+		return nil
+	case standing.FieldPoints:
+		m.ResetPoints()
+		return nil
+	case standing.FieldWins:
+		m.ResetWins()
+		return nil
+	case standing.FieldLosses:
+		m.ResetLosses()
 		return nil
 	}
-	return fmt.Errorf("unknown Player field %s", name)
+	return fmt.Errorf("unknown Standing field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PlayerMutation) AddedEdges() []string {
+func (m *StandingMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.roles != nil {
-		edges = append(edges, player.EdgeRoles)
+	if m.player != nil {
+		edges = append(edges, standing.EdgePlayer)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
+func (m *StandingMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case player.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.roles))
-		for id := range m.roles {
-			ids = append(ids, id)
+	case standing.EdgePlayer:
+		if id := m.player; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PlayerMutation) RemovedEdges() []string {
+func (m *StandingMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedroles != nil {
-		edges = append(edges, player.EdgeRoles)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PlayerMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case player.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.removedroles))
-		for id := range m.removedroles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *StandingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PlayerMutation) ClearedEdges() []string {
+func (m *StandingMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedroles {
-		edges = append(edges, player.EdgeRoles)
+	if m.clearedplayer {
+		edges = append(edges, standing.EdgePlayer)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PlayerMutation) EdgeCleared(name string) bool {
+func (m *StandingMutation) EdgeCleared(name string) bool {
 	switch name {
-	case player.EdgeRoles:
-		return m.clearedroles
+	case standing.EdgePlayer:
+		return m.clearedplayer
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PlayerMutation) ClearEdge(name string) error {
+func (m *StandingMutation) ClearEdge(name string) error {
 	switch name {
+	case standing.EdgePlayer:
+		m.ClearPlayer()
+		return nil
 	}
-	return fmt.Errorf("unknown Player unique edge %s", name)
+	return fmt.Errorf("unknown Standing unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PlayerMutation) ResetEdge(name string) error {
+func (m *StandingMutation) ResetEdge(name string) error {
 	switch name {
-	case player.EdgeRoles:
-		m.ResetRoles()
+	case standing.EdgePlayer:
+		m.ResetPlayer()
 		return nil
 	}
-	return fmt.Errorf("unknown Player edge %s", name)
+	return fmt.Errorf("unknown Standing edge %s", name)
 }
 
-// PlayerRoleMutation represents an operation that mutates the PlayerRole nodes in the graph.
-type PlayerRoleMutation struct {
+// TurnMutation represents an operation that mutates the Turn nodes in the graph.
+type TurnMutation struct {
 	config
 	op             Op
 	typ            string
 	id             *int
-	match_id       *int
-	addmatch_id    *int
-	player_id      *int
-	addplayer_id   *int
-	position       *int
-	addposition    *int
-	turn_order     *int
-	addturn_order  *int
+	number         *int
+	addnumber      *int
+	team           *wits.FriendlyEnum
+	addteam        *wits.FriendlyEnum
 	clearedFields  map[string]struct{}
-	match          map[int]struct{}
-	removedmatch   map[int]struct{}
+	match          *int
 	clearedmatch   bool
-	players        map[int]struct{}
-	removedplayers map[int]struct{}
-	clearedplayers bool
+	actions        map[int]struct{}
+	removedactions map[int]struct{}
+	clearedactions bool
 	done           bool
-	oldValue       func(context.Context) (*PlayerRole, error)
-	predicates     []predicate.PlayerRole
+	oldValue       func(context.Context) (*Turn, error)
+	predicates     []predicate.Turn
 }
 
-var _ ent.Mutation = (*PlayerRoleMutation)(nil)
+var _ ent.Mutation = (*TurnMutation)(nil)
 
-// playerroleOption allows management of the mutation configuration using functional options.
-type playerroleOption func(*PlayerRoleMutation)
+// turnOption allows management of the mutation configuration using functional options.
+type turnOption func(*TurnMutation)
 
-// newPlayerRoleMutation creates new mutation for the PlayerRole entity.
-func newPlayerRoleMutation(c config, op Op, opts ...playerroleOption) *PlayerRoleMutation {
-	m := &PlayerRoleMutation{
+// newTurnMutation creates new mutation for the Turn entity.
+func newTurnMutation(c config, op Op, opts ...turnOption) *TurnMutation {
+	m := &TurnMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePlayerRole,
+		typ:           TypeTurn,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1964,20 +8438,20 @@ func newPlayerRoleMutation(c config, op Op, opts ...playerroleOption) *PlayerRol
 	return m
 }
 
-// withPlayerRoleID sets the ID field of the mutation.
-func withPlayerRoleID(id int) playerroleOption {
-	return func(m *PlayerRoleMutation) {
+// withTurnID sets the ID field of the mutation.
+func withTurnID(id int) turnOption {
+	return func(m *TurnMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PlayerRole
+			value *Turn
 		)
-		m.oldValue = func(ctx context.Context) (*PlayerRole, error) {
+		m.oldValue = func(ctx context.Context) (*Turn, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PlayerRole.Get(ctx, id)
+					value, err = m.Client().Turn.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1986,10 +8460,10 @@ func withPlayerRoleID(id int) playerroleOption {
 	}
 }
 
-// withPlayerRole sets the old PlayerRole of the mutation.
-func withPlayerRole(node *PlayerRole) playerroleOption {
-	return func(m *PlayerRoleMutation) {
-		m.oldValue = func(context.Context) (*PlayerRole, error) {
+// withTurn sets the old Turn of the mutation.
+func withTurn(node *Turn) turnOption {
+	return func(m *TurnMutation) {
+		m.oldValue = func(context.Context) (*Turn, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1998,7 +8472,7 @@ func withPlayerRole(node *PlayerRole) playerroleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PlayerRoleMutation) Client() *Client {
+func (m TurnMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2006,7 +8480,7 @@ func (m PlayerRoleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PlayerRoleMutation) Tx() (*Tx, error) {
+func (m TurnMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2017,7 +8491,7 @@ func (m PlayerRoleMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PlayerRoleMutation) ID() (id int, exists bool) {
+func (m *TurnMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2028,7 +8502,7 @@ func (m *PlayerRoleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PlayerRoleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TurnMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2037,353 +8511,226 @@ func (m *PlayerRoleMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PlayerRole.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Turn.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetMatchID sets the "match_id" field.
-func (m *PlayerRoleMutation) SetMatchID(i int) {
-	m.match_id = &i
-	m.addmatch_id = nil
-}
-
-// MatchID returns the value of the "match_id" field in the mutation.
-func (m *PlayerRoleMutation) MatchID() (r int, exists bool) {
-	v := m.match_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldMatchID returns the old "match_id" field's value of the PlayerRole entity.
-// If the PlayerRole object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerRoleMutation) OldMatchID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMatchID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMatchID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMatchID: %w", err)
-	}
-	return oldValue.MatchID, nil
-}
-
-// AddMatchID adds i to the "match_id" field.
-func (m *PlayerRoleMutation) AddMatchID(i int) {
-	if m.addmatch_id != nil {
-		*m.addmatch_id += i
-	} else {
-		m.addmatch_id = &i
-	}
-}
-
-// AddedMatchID returns the value that was added to the "match_id" field in this mutation.
-func (m *PlayerRoleMutation) AddedMatchID() (r int, exists bool) {
-	v := m.addmatch_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetMatchID resets all changes to the "match_id" field.
-func (m *PlayerRoleMutation) ResetMatchID() {
-	m.match_id = nil
-	m.addmatch_id = nil
-}
-
-// SetPlayerID sets the "player_id" field.
-func (m *PlayerRoleMutation) SetPlayerID(i int) {
-	m.player_id = &i
-	m.addplayer_id = nil
-}
-
-// PlayerID returns the value of the "player_id" field in the mutation.
-func (m *PlayerRoleMutation) PlayerID() (r int, exists bool) {
-	v := m.player_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPlayerID returns the old "player_id" field's value of the PlayerRole entity.
-// If the PlayerRole object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerRoleMutation) OldPlayerID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlayerID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlayerID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlayerID: %w", err)
-	}
-	return oldValue.PlayerID, nil
-}
-
-// AddPlayerID adds i to the "player_id" field.
-func (m *PlayerRoleMutation) AddPlayerID(i int) {
-	if m.addplayer_id != nil {
-		*m.addplayer_id += i
-	} else {
-		m.addplayer_id = &i
-	}
-}
-
-// AddedPlayerID returns the value that was added to the "player_id" field in this mutation.
-func (m *PlayerRoleMutation) AddedPlayerID() (r int, exists bool) {
-	v := m.addplayer_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetPlayerID resets all changes to the "player_id" field.
-func (m *PlayerRoleMutation) ResetPlayerID() {
-	m.player_id = nil
-	m.addplayer_id = nil
-}
-
-// SetPosition sets the "position" field.
-func (m *PlayerRoleMutation) SetPosition(i int) {
-	m.position = &i
-	m.addposition = nil
+// SetNumber sets the "number" field.
+func (m *TurnMutation) SetNumber(i int) {
+	m.number = &i
+	m.addnumber = nil
 }
 
-// Position returns the value of the "position" field in the mutation.
-func (m *PlayerRoleMutation) Position() (r int, exists bool) {
-	v := m.position
+// Number returns the value of the "number" field in the mutation.
+func (m *TurnMutation) Number() (r int, exists bool) {
+	v := m.number
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPosition returns the old "position" field's value of the PlayerRole entity.
-// If the PlayerRole object wasn't provided to the builder, the object is fetched from the database.
+// OldNumber returns the old "number" field's value of the Turn entity.
+// If the Turn object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerRoleMutation) OldPosition(ctx context.Context) (v int, err error) {
+func (m *TurnMutation) OldNumber(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPosition requires an ID field in the mutation")
+		return v, errors.New("OldNumber requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
 	}
-	return oldValue.Position, nil
+	return oldValue.Number, nil
 }
 
-// AddPosition adds i to the "position" field.
-func (m *PlayerRoleMutation) AddPosition(i int) {
-	if m.addposition != nil {
-		*m.addposition += i
+// AddNumber adds i to the "number" field.
+func (m *TurnMutation) AddNumber(i int) {
+	if m.addnumber != nil {
+		*m.addnumber += i
 	} else {
-		m.addposition = &i
+		m.addnumber = &i
 	}
 }
-
-// AddedPosition returns the value that was added to the "position" field in this mutation.
-func (m *PlayerRoleMutation) AddedPosition() (r int, exists bool) {
-	v := m.addposition
+
+// AddedNumber returns the value that was added to the "number" field in this mutation.
+func (m *TurnMutation) AddedNumber() (r int, exists bool) {
+	v := m.addnumber
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPosition resets all changes to the "position" field.
-func (m *PlayerRoleMutation) ResetPosition() {
-	m.position = nil
-	m.addposition = nil
+// ResetNumber resets all changes to the "number" field.
+func (m *TurnMutation) ResetNumber() {
+	m.number = nil
+	m.addnumber = nil
 }
 
-// SetTurnOrder sets the "turn_order" field.
-func (m *PlayerRoleMutation) SetTurnOrder(i int) {
-	m.turn_order = &i
-	m.addturn_order = nil
+// SetTeam sets the "team" field.
+func (m *TurnMutation) SetTeam(we wits.FriendlyEnum) {
+	m.team = &we
+	m.addteam = nil
 }
 
-// TurnOrder returns the value of the "turn_order" field in the mutation.
-func (m *PlayerRoleMutation) TurnOrder() (r int, exists bool) {
-	v := m.turn_order
+// Team returns the value of the "team" field in the mutation.
+func (m *TurnMutation) Team() (r wits.FriendlyEnum, exists bool) {
+	v := m.team
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTurnOrder returns the old "turn_order" field's value of the PlayerRole entity.
-// If the PlayerRole object wasn't provided to the builder, the object is fetched from the database.
+// OldTeam returns the old "team" field's value of the Turn entity.
+// If the Turn object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerRoleMutation) OldTurnOrder(ctx context.Context) (v int, err error) {
+func (m *TurnMutation) OldTeam(ctx context.Context) (v wits.FriendlyEnum, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTurnOrder is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeam is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTurnOrder requires an ID field in the mutation")
+		return v, errors.New("OldTeam requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTurnOrder: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeam: %w", err)
 	}
-	return oldValue.TurnOrder, nil
+	return oldValue.Team, nil
 }
 
-// AddTurnOrder adds i to the "turn_order" field.
-func (m *PlayerRoleMutation) AddTurnOrder(i int) {
-	if m.addturn_order != nil {
-		*m.addturn_order += i
+// AddTeam adds we to the "team" field.
+func (m *TurnMutation) AddTeam(we wits.FriendlyEnum) {
+	if m.addteam != nil {
+		*m.addteam += we
 	} else {
-		m.addturn_order = &i
+		m.addteam = &we
 	}
 }
 
-// AddedTurnOrder returns the value that was added to the "turn_order" field in this mutation.
-func (m *PlayerRoleMutation) AddedTurnOrder() (r int, exists bool) {
-	v := m.addturn_order
+// AddedTeam returns the value that was added to the "team" field in this mutation.
+func (m *TurnMutation) AddedTeam() (r wits.FriendlyEnum, exists bool) {
+	v := m.addteam
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTurnOrder resets all changes to the "turn_order" field.
-func (m *PlayerRoleMutation) ResetTurnOrder() {
-	m.turn_order = nil
-	m.addturn_order = nil
+// ResetTeam resets all changes to the "team" field.
+func (m *TurnMutation) ResetTeam() {
+	m.team = nil
+	m.addteam = nil
 }
 
-// AddMatchIDs adds the "match" edge to the Match entity by ids.
-func (m *PlayerRoleMutation) AddMatchIDs(ids ...int) {
-	if m.match == nil {
-		m.match = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.match[ids[i]] = struct{}{}
-	}
+// SetMatchID sets the "match" edge to the Match entity by id.
+func (m *TurnMutation) SetMatchID(id int) {
+	m.match = &id
 }
 
 // ClearMatch clears the "match" edge to the Match entity.
-func (m *PlayerRoleMutation) ClearMatch() {
+func (m *TurnMutation) ClearMatch() {
 	m.clearedmatch = true
 }
 
 // MatchCleared reports if the "match" edge to the Match entity was cleared.
-func (m *PlayerRoleMutation) MatchCleared() bool {
+func (m *TurnMutation) MatchCleared() bool {
 	return m.clearedmatch
 }
 
-// RemoveMatchIDs removes the "match" edge to the Match entity by IDs.
-func (m *PlayerRoleMutation) RemoveMatchIDs(ids ...int) {
-	if m.removedmatch == nil {
-		m.removedmatch = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.match, ids[i])
-		m.removedmatch[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedMatch returns the removed IDs of the "match" edge to the Match entity.
-func (m *PlayerRoleMutation) RemovedMatchIDs() (ids []int) {
-	for id := range m.removedmatch {
-		ids = append(ids, id)
+// MatchID returns the "match" edge ID in the mutation.
+func (m *TurnMutation) MatchID() (id int, exists bool) {
+	if m.match != nil {
+		return *m.match, true
 	}
 	return
 }
 
 // MatchIDs returns the "match" edge IDs in the mutation.
-func (m *PlayerRoleMutation) MatchIDs() (ids []int) {
-	for id := range m.match {
-		ids = append(ids, id)
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MatchID instead. It exists only for internal usage by the builders.
+func (m *TurnMutation) MatchIDs() (ids []int) {
+	if id := m.match; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
 // ResetMatch resets all changes to the "match" edge.
-func (m *PlayerRoleMutation) ResetMatch() {
+func (m *TurnMutation) ResetMatch() {
 	m.match = nil
 	m.clearedmatch = false
-	m.removedmatch = nil
 }
 
-// AddPlayerIDs adds the "players" edge to the Player entity by ids.
-func (m *PlayerRoleMutation) AddPlayerIDs(ids ...int) {
-	if m.players == nil {
-		m.players = make(map[int]struct{})
+// AddActionIDs adds the "actions" edge to the Action entity by ids.
+func (m *TurnMutation) AddActionIDs(ids ...int) {
+	if m.actions == nil {
+		m.actions = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.players[ids[i]] = struct{}{}
+		m.actions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPlayers clears the "players" edge to the Player entity.
-func (m *PlayerRoleMutation) ClearPlayers() {
-	m.clearedplayers = true
+// ClearActions clears the "actions" edge to the Action entity.
+func (m *TurnMutation) ClearActions() {
+	m.clearedactions = true
 }
 
-// PlayersCleared reports if the "players" edge to the Player entity was cleared.
-func (m *PlayerRoleMutation) PlayersCleared() bool {
-	return m.clearedplayers
+// ActionsCleared reports if the "actions" edge to the Action entity was cleared.
+func (m *TurnMutation) ActionsCleared() bool {
+	return m.clearedactions
 }
 
-// RemovePlayerIDs removes the "players" edge to the Player entity by IDs.
-func (m *PlayerRoleMutation) RemovePlayerIDs(ids ...int) {
-	if m.removedplayers == nil {
-		m.removedplayers = make(map[int]struct{})
+// RemoveActionIDs removes the "actions" edge to the Action entity by IDs.
+func (m *TurnMutation) RemoveActionIDs(ids ...int) {
+	if m.removedactions == nil {
+		m.removedactions = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.players, ids[i])
-		m.removedplayers[ids[i]] = struct{}{}
+		delete(m.actions, ids[i])
+		m.removedactions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPlayers returns the removed IDs of the "players" edge to the Player entity.
-func (m *PlayerRoleMutation) RemovedPlayersIDs() (ids []int) {
-	for id := range m.removedplayers {
+// RemovedActions returns the removed IDs of the "actions" edge to the Action entity.
+func (m *TurnMutation) RemovedActionsIDs() (ids []int) {
+	for id := range m.removedactions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PlayersIDs returns the "players" edge IDs in the mutation.
-func (m *PlayerRoleMutation) PlayersIDs() (ids []int) {
-	for id := range m.players {
+// ActionsIDs returns the "actions" edge IDs in the mutation.
+func (m *TurnMutation) ActionsIDs() (ids []int) {
+	for id := range m.actions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPlayers resets all changes to the "players" edge.
-func (m *PlayerRoleMutation) ResetPlayers() {
-	m.players = nil
-	m.clearedplayers = false
-	m.removedplayers = nil
+// ResetActions resets all changes to the "actions" edge.
+func (m *TurnMutation) ResetActions() {
+	m.actions = nil
+	m.clearedactions = false
+	m.removedactions = nil
 }
 
-// Where appends a list predicates to the PlayerRoleMutation builder.
-func (m *PlayerRoleMutation) Where(ps ...predicate.PlayerRole) {
+// Where appends a list predicates to the TurnMutation builder.
+func (m *TurnMutation) Where(ps ...predicate.Turn) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PlayerRoleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TurnMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PlayerRoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PlayerRole, len(ps))
+func (m *TurnMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Turn, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2391,36 +8738,30 @@ func (m *PlayerRoleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PlayerRoleMutation) Op() Op {
+func (m *TurnMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PlayerRoleMutation) SetOp(op Op) {
+func (m *TurnMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PlayerRole).
-func (m *PlayerRoleMutation) Type() string {
+// Type returns the node type of this mutation (Turn).
+func (m *TurnMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PlayerRoleMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.match_id != nil {
-		fields = append(fields, playerrole.FieldMatchID)
-	}
-	if m.player_id != nil {
-		fields = append(fields, playerrole.FieldPlayerID)
-	}
-	if m.position != nil {
-		fields = append(fields, playerrole.FieldPosition)
+func (m *TurnMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.number != nil {
+		fields = append(fields, turn.FieldNumber)
 	}
-	if m.turn_order != nil {
-		fields = append(fields, playerrole.FieldTurnOrder)
+	if m.team != nil {
+		fields = append(fields, turn.FieldTeam)
 	}
 	return fields
 }
@@ -2428,16 +8769,12 @@ func (m *PlayerRoleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PlayerRoleMutation) Field(name string) (ent.Value, bool) {
+func (m *TurnMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case playerrole.FieldMatchID:
-		return m.MatchID()
-	case playerrole.FieldPlayerID:
-		return m.PlayerID()
-	case playerrole.FieldPosition:
-		return m.Position()
-	case playerrole.FieldTurnOrder:
-		return m.TurnOrder()
+	case turn.FieldNumber:
+		return m.Number()
+	case turn.FieldTeam:
+		return m.Team()
 	}
 	return nil, false
 }
@@ -2445,72 +8782,48 @@ func (m *PlayerRoleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PlayerRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TurnMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case playerrole.FieldMatchID:
-		return m.OldMatchID(ctx)
-	case playerrole.FieldPlayerID:
-		return m.OldPlayerID(ctx)
-	case playerrole.FieldPosition:
-		return m.OldPosition(ctx)
-	case playerrole.FieldTurnOrder:
-		return m.OldTurnOrder(ctx)
+	case turn.FieldNumber:
+		return m.OldNumber(ctx)
+	case turn.FieldTeam:
+		return m.OldTeam(ctx)
 	}
-	return nil, fmt.Errorf("unknown PlayerRole field %s", name)
+	return nil, fmt.Errorf("unknown Turn field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlayerRoleMutation) SetField(name string, value ent.Value) error {
+func (m *TurnMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case playerrole.FieldMatchID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMatchID(v)
-		return nil
-	case playerrole.FieldPlayerID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlayerID(v)
-		return nil
-	case playerrole.FieldPosition:
+	case turn.FieldNumber:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPosition(v)
+		m.SetNumber(v)
 		return nil
-	case playerrole.FieldTurnOrder:
-		v, ok := value.(int)
+	case turn.FieldTeam:
+		v, ok := value.(wits.FriendlyEnum)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTurnOrder(v)
+		m.SetTeam(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PlayerRole field %s", name)
+	return fmt.Errorf("unknown Turn field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PlayerRoleMutation) AddedFields() []string {
+func (m *TurnMutation) AddedFields() []string {
 	var fields []string
-	if m.addmatch_id != nil {
-		fields = append(fields, playerrole.FieldMatchID)
-	}
-	if m.addplayer_id != nil {
-		fields = append(fields, playerrole.FieldPlayerID)
-	}
-	if m.addposition != nil {
-		fields = append(fields, playerrole.FieldPosition)
+	if m.addnumber != nil {
+		fields = append(fields, turn.FieldNumber)
 	}
-	if m.addturn_order != nil {
-		fields = append(fields, playerrole.FieldTurnOrder)
+	if m.addteam != nil {
+		fields = append(fields, turn.FieldTeam)
 	}
 	return fields
 }
@@ -2518,16 +8831,12 @@ func (m *PlayerRoleMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PlayerRoleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TurnMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case playerrole.FieldMatchID:
-		return m.AddedMatchID()
-	case playerrole.FieldPlayerID:
-		return m.AddedPlayerID()
-	case playerrole.FieldPosition:
-		return m.AddedPosition()
-	case playerrole.FieldTurnOrder:
-		return m.AddedTurnOrder()
+	case turn.FieldNumber:
+		return m.AddedNumber()
+	case turn.FieldTeam:
+		return m.AddedTeam()
 	}
 	return nil, false
 }
@@ -2535,104 +8844,82 @@ func (m *PlayerRoleMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlayerRoleMutation) AddField(name string, value ent.Value) error {
+func (m *TurnMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case playerrole.FieldMatchID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMatchID(v)
-		return nil
-	case playerrole.FieldPlayerID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPlayerID(v)
-		return nil
-	case playerrole.FieldPosition:
+	case turn.FieldNumber:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPosition(v)
+		m.AddNumber(v)
 		return nil
-	case playerrole.FieldTurnOrder:
-		v, ok := value.(int)
+	case turn.FieldTeam:
+		v, ok := value.(wits.FriendlyEnum)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTurnOrder(v)
+		m.AddTeam(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PlayerRole numeric field %s", name)
+	return fmt.Errorf("unknown Turn numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PlayerRoleMutation) ClearedFields() []string {
+func (m *TurnMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PlayerRoleMutation) FieldCleared(name string) bool {
+func (m *TurnMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PlayerRoleMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PlayerRole nullable field %s", name)
+func (m *TurnMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Turn nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PlayerRoleMutation) ResetField(name string) error {
+func (m *TurnMutation) ResetField(name string) error {
 	switch name {
-	case playerrole.FieldMatchID:
-		m.ResetMatchID()
-		return nil
-	case playerrole.FieldPlayerID:
-		m.ResetPlayerID()
+	case turn.FieldNumber:
+		m.ResetNumber()
 		return nil
-	case playerrole.FieldPosition:
-		m.ResetPosition()
-		return nil
-	case playerrole.FieldTurnOrder:
-		m.ResetTurnOrder()
+	case turn.FieldTeam:
+		m.ResetTeam()
 		return nil
 	}
-	return fmt.Errorf("unknown PlayerRole field %s", name)
+	return fmt.Errorf("unknown Turn field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PlayerRoleMutation) AddedEdges() []string {
+func (m *TurnMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.match != nil {
-		edges = append(edges, playerrole.EdgeMatch)
+		edges = append(edges, turn.EdgeMatch)
 	}
-	if m.players != nil {
-		edges = append(edges, playerrole.EdgePlayers)
+	if m.actions != nil {
+		edges = append(edges, turn.EdgeActions)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PlayerRoleMutation) AddedIDs(name string) []ent.Value {
+func (m *TurnMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case playerrole.EdgeMatch:
-		ids := make([]ent.Value, 0, len(m.match))
-		for id := range m.match {
-			ids = append(ids, id)
+	case turn.EdgeMatch:
+		if id := m.match; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case playerrole.EdgePlayers:
-		ids := make([]ent.Value, 0, len(m.players))
-		for id := range m.players {
+	case turn.EdgeActions:
+		ids := make([]ent.Value, 0, len(m.actions))
+		for id := range m.actions {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2641,30 +8928,21 @@ func (m *PlayerRoleMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PlayerRoleMutation) RemovedEdges() []string {
+func (m *TurnMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedmatch != nil {
-		edges = append(edges, playerrole.EdgeMatch)
-	}
-	if m.removedplayers != nil {
-		edges = append(edges, playerrole.EdgePlayers)
+	if m.removedactions != nil {
+		edges = append(edges, turn.EdgeActions)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PlayerRoleMutation) RemovedIDs(name string) []ent.Value {
+func (m *TurnMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case playerrole.EdgeMatch:
-		ids := make([]ent.Value, 0, len(m.removedmatch))
-		for id := range m.removedmatch {
-			ids = append(ids, id)
-		}
-		return ids
-	case playerrole.EdgePlayers:
-		ids := make([]ent.Value, 0, len(m.removedplayers))
-		for id := range m.removedplayers {
+	case turn.EdgeActions:
+		ids := make([]ent.Value, 0, len(m.removedactions))
+		for id := range m.removedactions {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2673,47 +8951,50 @@ func (m *PlayerRoleMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PlayerRoleMutation) ClearedEdges() []string {
+func (m *TurnMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.clearedmatch {
-		edges = append(edges, playerrole.EdgeMatch)
+		edges = append(edges, turn.EdgeMatch)
 	}
-	if m.clearedplayers {
-		edges = append(edges, playerrole.EdgePlayers)
+	if m.clearedactions {
+		edges = append(edges, turn.EdgeActions)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PlayerRoleMutation) EdgeCleared(name string) bool {
+func (m *TurnMutation) EdgeCleared(name string) bool {
 	switch name {
-	case playerrole.EdgeMatch:
+	case turn.EdgeMatch:
 		return m.clearedmatch
-	case playerrole.EdgePlayers:
-		return m.clearedplayers
+	case turn.EdgeActions:
+		return m.clearedactions
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PlayerRoleMutation) ClearEdge(name string) error {
+func (m *TurnMutation) ClearEdge(name string) error {
 	switch name {
+	case turn.EdgeMatch:
+		m.ClearMatch()
+		return nil
 	}
-	return fmt.Errorf("unknown PlayerRole unique edge %s", name)
+	return fmt.Errorf("unknown Turn unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PlayerRoleMutation) ResetEdge(name string) error {
+func (m *TurnMutation) ResetEdge(name string) error {
 	switch name {
-	case playerrole.EdgeMatch:
+	case turn.EdgeMatch:
 		m.ResetMatch()
 		return nil
-	case playerrole.EdgePlayers:
-		m.ResetPlayers()
+	case turn.EdgeActions:
+		m.ResetActions()
 		return nil
 	}
-	return fmt.Errorf("unknown PlayerRole edge %s", name)
+	return fmt.Errorf("unknown Turn edge %s", name)
 }
@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/kevindamm/wits-go/ent/osnmap"
+	"github.com/kevindamm/wits-go/witsjson"
 )
 
 // OsnMap is the model entity for the OsnMap schema.
@@ -22,6 +24,26 @@ type OsnMap struct {
 	Shortname string `json:"shortname,omitempty"`
 	// RoleCount holds the value of the "role_count" field.
 	RoleCount int `json:"role_count,omitempty"`
+	// The full map ID (as in oml/solo/peekaboo), the shortname is its last segment.
+	MapID string `json:"map_id,omitempty"`
+	// Hex-encoded SHA-256 of the formatted definition, it changes whenever the map does.
+	ContentHash string `json:"content_hash,omitempty"`
+	// FloorCount holds the value of the "floor_count" field.
+	FloorCount int `json:"floor_count,omitempty"`
+	// WallCount holds the value of the "wall_count" field.
+	WallCount int `json:"wall_count,omitempty"`
+	// BonusCount holds the value of the "bonus_count" field.
+	BonusCount int `json:"bonus_count,omitempty"`
+	// SpawnCount holds the value of the "spawn_count" field.
+	SpawnCount int `json:"spawn_count,omitempty"`
+	// The symmetry declared by the definition, a half-turn rotation or a reflection.
+	Symmetry osnmap.Symmetry `json:"symmetry,omitempty"`
+	// Whether the definition is in the legacy (column and row) coordinates.
+	Legacy bool `json:"legacy,omitempty"`
+	// A small drawing of the map, served separately from the map's JSON.
+	ThumbnailSvg string `json:"-"`
+	// The full map definition, as in the map's JSON file.
+	Definition *witsjson.MapDefinition `json:"definition,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OsnMapQuery when eager-loading is set.
 	Edges        OsnMapEdges `json:"edges"`
@@ -32,9 +54,11 @@ type OsnMap struct {
 type OsnMapEdges struct {
 	// Matches holds the value of the matches edge.
 	Matches []*Match `json:"matches,omitempty"`
+	// Actions holds the value of the actions edge.
+	Actions []*Action `json:"actions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // MatchesOrErr returns the Matches value or an error if the edge
@@ -46,14 +70,27 @@ func (e OsnMapEdges) MatchesOrErr() ([]*Match, error) {
 	return nil, &NotLoadedError{edge: "matches"}
 }
 
+// ActionsOrErr returns the Actions value or an error if the edge
+// was not loaded in eager-loading.
+func (e OsnMapEdges) ActionsOrErr() ([]*Action, error) {
+	if e.loadedTypes[1] {
+		return e.Actions, nil
+	}
+	return nil, &NotLoadedError{edge: "actions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*OsnMap) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case osnmap.FieldID, osnmap.FieldRoleCount:
+		case osnmap.FieldDefinition:
+			values[i] = new([]byte)
+		case osnmap.FieldLegacy:
+			values[i] = new(sql.NullBool)
+		case osnmap.FieldID, osnmap.FieldRoleCount, osnmap.FieldFloorCount, osnmap.FieldWallCount, osnmap.FieldBonusCount, osnmap.FieldSpawnCount:
 			values[i] = new(sql.NullInt64)
-		case osnmap.FieldName, osnmap.FieldShortname:
+		case osnmap.FieldName, osnmap.FieldShortname, osnmap.FieldMapID, osnmap.FieldContentHash, osnmap.FieldSymmetry, osnmap.FieldThumbnailSvg:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -94,6 +131,68 @@ func (om *OsnMap) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				om.RoleCount = int(value.Int64)
 			}
+		case osnmap.FieldMapID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field map_id", values[i])
+			} else if value.Valid {
+				om.MapID = value.String
+			}
+		case osnmap.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				om.ContentHash = value.String
+			}
+		case osnmap.FieldFloorCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field floor_count", values[i])
+			} else if value.Valid {
+				om.FloorCount = int(value.Int64)
+			}
+		case osnmap.FieldWallCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wall_count", values[i])
+			} else if value.Valid {
+				om.WallCount = int(value.Int64)
+			}
+		case osnmap.FieldBonusCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bonus_count", values[i])
+			} else if value.Valid {
+				om.BonusCount = int(value.Int64)
+			}
+		case osnmap.FieldSpawnCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field spawn_count", values[i])
+			} else if value.Valid {
+				om.SpawnCount = int(value.Int64)
+			}
+		case osnmap.FieldSymmetry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symmetry", values[i])
+			} else if value.Valid {
+				om.Symmetry = osnmap.Symmetry(value.String)
+			}
+		case osnmap.FieldLegacy:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field legacy", values[i])
+			} else if value.Valid {
+				om.Legacy = value.Bool
+			}
+		case osnmap.FieldThumbnailSvg:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_svg", values[i])
+			} else if value.Valid {
+				om.ThumbnailSvg = value.String
+			}
+		case osnmap.FieldDefinition:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field definition", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &om.Definition); err != nil {
+					return fmt.Errorf("unmarshal field definition: %w", err)
+				}
+			}
 		default:
 			om.selectValues.Set(columns[i], values[i])
 		}
@@ -112,6 +211,11 @@ func (om *OsnMap) QueryMatches() *MatchQuery {
 	return NewOsnMapClient(om.config).QueryMatches(om)
 }
 
+// QueryActions queries the "actions" edge of the OsnMap entity.
+func (om *OsnMap) QueryActions() *ActionQuery {
+	return NewOsnMapClient(om.config).QueryActions(om)
+}
+
 // Update returns a builder for updating this OsnMap.
 // Note that you need to call OsnMap.Unwrap() before calling this method if this OsnMap
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -143,6 +247,36 @@ func (om *OsnMap) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("role_count=")
 	builder.WriteString(fmt.Sprintf("%v", om.RoleCount))
+	builder.WriteString(", ")
+	builder.WriteString("map_id=")
+	builder.WriteString(om.MapID)
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(om.ContentHash)
+	builder.WriteString(", ")
+	builder.WriteString("floor_count=")
+	builder.WriteString(fmt.Sprintf("%v", om.FloorCount))
+	builder.WriteString(", ")
+	builder.WriteString("wall_count=")
+	builder.WriteString(fmt.Sprintf("%v", om.WallCount))
+	builder.WriteString(", ")
+	builder.WriteString("bonus_count=")
+	builder.WriteString(fmt.Sprintf("%v", om.BonusCount))
+	builder.WriteString(", ")
+	builder.WriteString("spawn_count=")
+	builder.WriteString(fmt.Sprintf("%v", om.SpawnCount))
+	builder.WriteString(", ")
+	builder.WriteString("symmetry=")
+	builder.WriteString(fmt.Sprintf("%v", om.Symmetry))
+	builder.WriteString(", ")
+	builder.WriteString("legacy=")
+	builder.WriteString(fmt.Sprintf("%v", om.Legacy))
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_svg=")
+	builder.WriteString(om.ThumbnailSvg)
+	builder.WriteString(", ")
+	builder.WriteString("definition=")
+	builder.WriteString(fmt.Sprintf("%v", om.Definition))
 	builder.WriteByte(')')
 	return builder.String()
 }
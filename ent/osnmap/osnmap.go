@@ -3,6 +3,8 @@
 package osnmap
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -18,8 +20,30 @@ const (
 	FieldShortname = "shortname"
 	// FieldRoleCount holds the string denoting the role_count field in the database.
 	FieldRoleCount = "role_count"
+	// FieldMapID holds the string denoting the map_id field in the database.
+	FieldMapID = "map_id"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldFloorCount holds the string denoting the floor_count field in the database.
+	FieldFloorCount = "floor_count"
+	// FieldWallCount holds the string denoting the wall_count field in the database.
+	FieldWallCount = "wall_count"
+	// FieldBonusCount holds the string denoting the bonus_count field in the database.
+	FieldBonusCount = "bonus_count"
+	// FieldSpawnCount holds the string denoting the spawn_count field in the database.
+	FieldSpawnCount = "spawn_count"
+	// FieldSymmetry holds the string denoting the symmetry field in the database.
+	FieldSymmetry = "symmetry"
+	// FieldLegacy holds the string denoting the legacy field in the database.
+	FieldLegacy = "legacy"
+	// FieldThumbnailSvg holds the string denoting the thumbnail_svg field in the database.
+	FieldThumbnailSvg = "thumbnail_svg"
+	// FieldDefinition holds the string denoting the definition field in the database.
+	FieldDefinition = "definition"
 	// EdgeMatches holds the string denoting the matches edge name in mutations.
 	EdgeMatches = "matches"
+	// EdgeActions holds the string denoting the actions edge name in mutations.
+	EdgeActions = "actions"
 	// Table holds the table name of the osnmap in the database.
 	Table = "osn_maps"
 	// MatchesTable is the table that holds the matches relation/edge.
@@ -29,6 +53,13 @@ const (
 	MatchesInverseTable = "matches"
 	// MatchesColumn is the table column denoting the matches relation/edge.
 	MatchesColumn = "osn_map_matches"
+	// ActionsTable is the table that holds the actions relation/edge.
+	ActionsTable = "actions"
+	// ActionsInverseTable is the table name for the Action entity.
+	// It exists in this package in order to avoid circular dependency with the "action" package.
+	ActionsInverseTable = "actions"
+	// ActionsColumn is the table column denoting the actions relation/edge.
+	ActionsColumn = "osn_map_actions"
 )
 
 // Columns holds all SQL columns for osnmap fields.
@@ -37,6 +68,16 @@ var Columns = []string{
 	FieldName,
 	FieldShortname,
 	FieldRoleCount,
+	FieldMapID,
+	FieldContentHash,
+	FieldFloorCount,
+	FieldWallCount,
+	FieldBonusCount,
+	FieldSpawnCount,
+	FieldSymmetry,
+	FieldLegacy,
+	FieldThumbnailSvg,
+	FieldDefinition,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -52,8 +93,54 @@ func ValidColumn(column string) bool {
 var (
 	// RoleCountValidator is a validator for the "role_count" field. It is called by the builders before save.
 	RoleCountValidator func(int) error
+	// DefaultFloorCount holds the default value on creation for the "floor_count" field.
+	DefaultFloorCount int
+	// FloorCountValidator is a validator for the "floor_count" field. It is called by the builders before save.
+	FloorCountValidator func(int) error
+	// DefaultWallCount holds the default value on creation for the "wall_count" field.
+	DefaultWallCount int
+	// WallCountValidator is a validator for the "wall_count" field. It is called by the builders before save.
+	WallCountValidator func(int) error
+	// DefaultBonusCount holds the default value on creation for the "bonus_count" field.
+	DefaultBonusCount int
+	// BonusCountValidator is a validator for the "bonus_count" field. It is called by the builders before save.
+	BonusCountValidator func(int) error
+	// DefaultSpawnCount holds the default value on creation for the "spawn_count" field.
+	DefaultSpawnCount int
+	// SpawnCountValidator is a validator for the "spawn_count" field. It is called by the builders before save.
+	SpawnCountValidator func(int) error
+	// DefaultLegacy holds the default value on creation for the "legacy" field.
+	DefaultLegacy bool
+)
+
+// Symmetry defines the type for the "symmetry" enum field.
+type Symmetry string
+
+// SymmetryNONE is the default value of the Symmetry enum.
+const DefaultSymmetry = SymmetryNONE
+
+// Symmetry values.
+const (
+	SymmetryNONE       Symmetry = "NONE"
+	SymmetryROTATE     Symmetry = "ROTATE"
+	SymmetryVERTICAL   Symmetry = "VERTICAL"
+	SymmetryHORIZONTAL Symmetry = "HORIZONTAL"
 )
 
+func (s Symmetry) String() string {
+	return string(s)
+}
+
+// SymmetryValidator is a validator for the "symmetry" field enum values. It is called by the builders before save.
+func SymmetryValidator(s Symmetry) error {
+	switch s {
+	case SymmetryNONE, SymmetryROTATE, SymmetryVERTICAL, SymmetryHORIZONTAL:
+		return nil
+	default:
+		return fmt.Errorf("osnmap: invalid enum value for symmetry field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the OsnMap queries.
 type OrderOption func(*sql.Selector)
 
@@ -77,6 +164,51 @@ func ByRoleCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRoleCount, opts...).ToFunc()
 }
 
+// ByMapID orders the results by the map_id field.
+func ByMapID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMapID, opts...).ToFunc()
+}
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByFloorCount orders the results by the floor_count field.
+func ByFloorCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFloorCount, opts...).ToFunc()
+}
+
+// ByWallCount orders the results by the wall_count field.
+func ByWallCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWallCount, opts...).ToFunc()
+}
+
+// ByBonusCount orders the results by the bonus_count field.
+func ByBonusCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBonusCount, opts...).ToFunc()
+}
+
+// BySpawnCount orders the results by the spawn_count field.
+func BySpawnCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpawnCount, opts...).ToFunc()
+}
+
+// BySymmetry orders the results by the symmetry field.
+func BySymmetry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymmetry, opts...).ToFunc()
+}
+
+// ByLegacy orders the results by the legacy field.
+func ByLegacy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLegacy, opts...).ToFunc()
+}
+
+// ByThumbnailSvg orders the results by the thumbnail_svg field.
+func ByThumbnailSvg(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThumbnailSvg, opts...).ToFunc()
+}
+
 // ByMatchesCount orders the results by matches count.
 func ByMatchesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -90,6 +222,20 @@ func ByMatches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMatchesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByActionsCount orders the results by actions count.
+func ByActionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActionsStep(), opts...)
+	}
+}
+
+// ByActions orders the results by actions terms.
+func ByActions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMatchesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -97,3 +243,10 @@ func newMatchesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MatchesTable, MatchesColumn),
 	)
 }
+func newActionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ActionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ActionsTable, ActionsColumn),
+	)
+}
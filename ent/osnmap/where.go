@@ -68,6 +68,46 @@ func RoleCount(v int) predicate.OsnMap {
 	return predicate.OsnMap(sql.FieldEQ(FieldRoleCount, v))
 }
 
+// MapID applies equality check predicate on the "map_id" field. It's identical to MapIDEQ.
+func MapID(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldMapID, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldContentHash, v))
+}
+
+// FloorCount applies equality check predicate on the "floor_count" field. It's identical to FloorCountEQ.
+func FloorCount(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldFloorCount, v))
+}
+
+// WallCount applies equality check predicate on the "wall_count" field. It's identical to WallCountEQ.
+func WallCount(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldWallCount, v))
+}
+
+// BonusCount applies equality check predicate on the "bonus_count" field. It's identical to BonusCountEQ.
+func BonusCount(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldBonusCount, v))
+}
+
+// SpawnCount applies equality check predicate on the "spawn_count" field. It's identical to SpawnCountEQ.
+func SpawnCount(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldSpawnCount, v))
+}
+
+// Legacy applies equality check predicate on the "legacy" field. It's identical to LegacyEQ.
+func Legacy(v bool) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldLegacy, v))
+}
+
+// ThumbnailSvg applies equality check predicate on the "thumbnail_svg" field. It's identical to ThumbnailSvgEQ.
+func ThumbnailSvg(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldThumbnailSvg, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.OsnMap {
 	return predicate.OsnMap(sql.FieldEQ(FieldName, v))
@@ -238,6 +278,431 @@ func RoleCountLTE(v int) predicate.OsnMap {
 	return predicate.OsnMap(sql.FieldLTE(FieldRoleCount, v))
 }
 
+// MapIDEQ applies the EQ predicate on the "map_id" field.
+func MapIDEQ(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldMapID, v))
+}
+
+// MapIDNEQ applies the NEQ predicate on the "map_id" field.
+func MapIDNEQ(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNEQ(FieldMapID, v))
+}
+
+// MapIDIn applies the In predicate on the "map_id" field.
+func MapIDIn(vs ...string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIn(FieldMapID, vs...))
+}
+
+// MapIDNotIn applies the NotIn predicate on the "map_id" field.
+func MapIDNotIn(vs ...string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotIn(FieldMapID, vs...))
+}
+
+// MapIDGT applies the GT predicate on the "map_id" field.
+func MapIDGT(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGT(FieldMapID, v))
+}
+
+// MapIDGTE applies the GTE predicate on the "map_id" field.
+func MapIDGTE(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGTE(FieldMapID, v))
+}
+
+// MapIDLT applies the LT predicate on the "map_id" field.
+func MapIDLT(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLT(FieldMapID, v))
+}
+
+// MapIDLTE applies the LTE predicate on the "map_id" field.
+func MapIDLTE(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLTE(FieldMapID, v))
+}
+
+// MapIDContains applies the Contains predicate on the "map_id" field.
+func MapIDContains(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldContains(FieldMapID, v))
+}
+
+// MapIDHasPrefix applies the HasPrefix predicate on the "map_id" field.
+func MapIDHasPrefix(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldHasPrefix(FieldMapID, v))
+}
+
+// MapIDHasSuffix applies the HasSuffix predicate on the "map_id" field.
+func MapIDHasSuffix(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldHasSuffix(FieldMapID, v))
+}
+
+// MapIDIsNil applies the IsNil predicate on the "map_id" field.
+func MapIDIsNil() predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIsNull(FieldMapID))
+}
+
+// MapIDNotNil applies the NotNil predicate on the "map_id" field.
+func MapIDNotNil() predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotNull(FieldMapID))
+}
+
+// MapIDEqualFold applies the EqualFold predicate on the "map_id" field.
+func MapIDEqualFold(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEqualFold(FieldMapID, v))
+}
+
+// MapIDContainsFold applies the ContainsFold predicate on the "map_id" field.
+func MapIDContainsFold(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldContainsFold(FieldMapID, v))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashIsNil applies the IsNil predicate on the "content_hash" field.
+func ContentHashIsNil() predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIsNull(FieldContentHash))
+}
+
+// ContentHashNotNil applies the NotNil predicate on the "content_hash" field.
+func ContentHashNotNil() predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotNull(FieldContentHash))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// FloorCountEQ applies the EQ predicate on the "floor_count" field.
+func FloorCountEQ(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldFloorCount, v))
+}
+
+// FloorCountNEQ applies the NEQ predicate on the "floor_count" field.
+func FloorCountNEQ(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNEQ(FieldFloorCount, v))
+}
+
+// FloorCountIn applies the In predicate on the "floor_count" field.
+func FloorCountIn(vs ...int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIn(FieldFloorCount, vs...))
+}
+
+// FloorCountNotIn applies the NotIn predicate on the "floor_count" field.
+func FloorCountNotIn(vs ...int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotIn(FieldFloorCount, vs...))
+}
+
+// FloorCountGT applies the GT predicate on the "floor_count" field.
+func FloorCountGT(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGT(FieldFloorCount, v))
+}
+
+// FloorCountGTE applies the GTE predicate on the "floor_count" field.
+func FloorCountGTE(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGTE(FieldFloorCount, v))
+}
+
+// FloorCountLT applies the LT predicate on the "floor_count" field.
+func FloorCountLT(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLT(FieldFloorCount, v))
+}
+
+// FloorCountLTE applies the LTE predicate on the "floor_count" field.
+func FloorCountLTE(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLTE(FieldFloorCount, v))
+}
+
+// WallCountEQ applies the EQ predicate on the "wall_count" field.
+func WallCountEQ(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldWallCount, v))
+}
+
+// WallCountNEQ applies the NEQ predicate on the "wall_count" field.
+func WallCountNEQ(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNEQ(FieldWallCount, v))
+}
+
+// WallCountIn applies the In predicate on the "wall_count" field.
+func WallCountIn(vs ...int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIn(FieldWallCount, vs...))
+}
+
+// WallCountNotIn applies the NotIn predicate on the "wall_count" field.
+func WallCountNotIn(vs ...int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotIn(FieldWallCount, vs...))
+}
+
+// WallCountGT applies the GT predicate on the "wall_count" field.
+func WallCountGT(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGT(FieldWallCount, v))
+}
+
+// WallCountGTE applies the GTE predicate on the "wall_count" field.
+func WallCountGTE(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGTE(FieldWallCount, v))
+}
+
+// WallCountLT applies the LT predicate on the "wall_count" field.
+func WallCountLT(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLT(FieldWallCount, v))
+}
+
+// WallCountLTE applies the LTE predicate on the "wall_count" field.
+func WallCountLTE(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLTE(FieldWallCount, v))
+}
+
+// BonusCountEQ applies the EQ predicate on the "bonus_count" field.
+func BonusCountEQ(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldBonusCount, v))
+}
+
+// BonusCountNEQ applies the NEQ predicate on the "bonus_count" field.
+func BonusCountNEQ(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNEQ(FieldBonusCount, v))
+}
+
+// BonusCountIn applies the In predicate on the "bonus_count" field.
+func BonusCountIn(vs ...int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIn(FieldBonusCount, vs...))
+}
+
+// BonusCountNotIn applies the NotIn predicate on the "bonus_count" field.
+func BonusCountNotIn(vs ...int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotIn(FieldBonusCount, vs...))
+}
+
+// BonusCountGT applies the GT predicate on the "bonus_count" field.
+func BonusCountGT(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGT(FieldBonusCount, v))
+}
+
+// BonusCountGTE applies the GTE predicate on the "bonus_count" field.
+func BonusCountGTE(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGTE(FieldBonusCount, v))
+}
+
+// BonusCountLT applies the LT predicate on the "bonus_count" field.
+func BonusCountLT(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLT(FieldBonusCount, v))
+}
+
+// BonusCountLTE applies the LTE predicate on the "bonus_count" field.
+func BonusCountLTE(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLTE(FieldBonusCount, v))
+}
+
+// SpawnCountEQ applies the EQ predicate on the "spawn_count" field.
+func SpawnCountEQ(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldSpawnCount, v))
+}
+
+// SpawnCountNEQ applies the NEQ predicate on the "spawn_count" field.
+func SpawnCountNEQ(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNEQ(FieldSpawnCount, v))
+}
+
+// SpawnCountIn applies the In predicate on the "spawn_count" field.
+func SpawnCountIn(vs ...int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIn(FieldSpawnCount, vs...))
+}
+
+// SpawnCountNotIn applies the NotIn predicate on the "spawn_count" field.
+func SpawnCountNotIn(vs ...int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotIn(FieldSpawnCount, vs...))
+}
+
+// SpawnCountGT applies the GT predicate on the "spawn_count" field.
+func SpawnCountGT(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGT(FieldSpawnCount, v))
+}
+
+// SpawnCountGTE applies the GTE predicate on the "spawn_count" field.
+func SpawnCountGTE(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGTE(FieldSpawnCount, v))
+}
+
+// SpawnCountLT applies the LT predicate on the "spawn_count" field.
+func SpawnCountLT(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLT(FieldSpawnCount, v))
+}
+
+// SpawnCountLTE applies the LTE predicate on the "spawn_count" field.
+func SpawnCountLTE(v int) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLTE(FieldSpawnCount, v))
+}
+
+// SymmetryEQ applies the EQ predicate on the "symmetry" field.
+func SymmetryEQ(v Symmetry) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldSymmetry, v))
+}
+
+// SymmetryNEQ applies the NEQ predicate on the "symmetry" field.
+func SymmetryNEQ(v Symmetry) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNEQ(FieldSymmetry, v))
+}
+
+// SymmetryIn applies the In predicate on the "symmetry" field.
+func SymmetryIn(vs ...Symmetry) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIn(FieldSymmetry, vs...))
+}
+
+// SymmetryNotIn applies the NotIn predicate on the "symmetry" field.
+func SymmetryNotIn(vs ...Symmetry) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotIn(FieldSymmetry, vs...))
+}
+
+// LegacyEQ applies the EQ predicate on the "legacy" field.
+func LegacyEQ(v bool) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldLegacy, v))
+}
+
+// LegacyNEQ applies the NEQ predicate on the "legacy" field.
+func LegacyNEQ(v bool) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNEQ(FieldLegacy, v))
+}
+
+// ThumbnailSvgEQ applies the EQ predicate on the "thumbnail_svg" field.
+func ThumbnailSvgEQ(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEQ(FieldThumbnailSvg, v))
+}
+
+// ThumbnailSvgNEQ applies the NEQ predicate on the "thumbnail_svg" field.
+func ThumbnailSvgNEQ(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNEQ(FieldThumbnailSvg, v))
+}
+
+// ThumbnailSvgIn applies the In predicate on the "thumbnail_svg" field.
+func ThumbnailSvgIn(vs ...string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIn(FieldThumbnailSvg, vs...))
+}
+
+// ThumbnailSvgNotIn applies the NotIn predicate on the "thumbnail_svg" field.
+func ThumbnailSvgNotIn(vs ...string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotIn(FieldThumbnailSvg, vs...))
+}
+
+// ThumbnailSvgGT applies the GT predicate on the "thumbnail_svg" field.
+func ThumbnailSvgGT(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGT(FieldThumbnailSvg, v))
+}
+
+// ThumbnailSvgGTE applies the GTE predicate on the "thumbnail_svg" field.
+func ThumbnailSvgGTE(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldGTE(FieldThumbnailSvg, v))
+}
+
+// ThumbnailSvgLT applies the LT predicate on the "thumbnail_svg" field.
+func ThumbnailSvgLT(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLT(FieldThumbnailSvg, v))
+}
+
+// ThumbnailSvgLTE applies the LTE predicate on the "thumbnail_svg" field.
+func ThumbnailSvgLTE(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldLTE(FieldThumbnailSvg, v))
+}
+
+// ThumbnailSvgContains applies the Contains predicate on the "thumbnail_svg" field.
+func ThumbnailSvgContains(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldContains(FieldThumbnailSvg, v))
+}
+
+// ThumbnailSvgHasPrefix applies the HasPrefix predicate on the "thumbnail_svg" field.
+func ThumbnailSvgHasPrefix(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldHasPrefix(FieldThumbnailSvg, v))
+}
+
+// ThumbnailSvgHasSuffix applies the HasSuffix predicate on the "thumbnail_svg" field.
+func ThumbnailSvgHasSuffix(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldHasSuffix(FieldThumbnailSvg, v))
+}
+
+// ThumbnailSvgIsNil applies the IsNil predicate on the "thumbnail_svg" field.
+func ThumbnailSvgIsNil() predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIsNull(FieldThumbnailSvg))
+}
+
+// ThumbnailSvgNotNil applies the NotNil predicate on the "thumbnail_svg" field.
+func ThumbnailSvgNotNil() predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotNull(FieldThumbnailSvg))
+}
+
+// ThumbnailSvgEqualFold applies the EqualFold predicate on the "thumbnail_svg" field.
+func ThumbnailSvgEqualFold(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldEqualFold(FieldThumbnailSvg, v))
+}
+
+// ThumbnailSvgContainsFold applies the ContainsFold predicate on the "thumbnail_svg" field.
+func ThumbnailSvgContainsFold(v string) predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldContainsFold(FieldThumbnailSvg, v))
+}
+
+// DefinitionIsNil applies the IsNil predicate on the "definition" field.
+func DefinitionIsNil() predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldIsNull(FieldDefinition))
+}
+
+// DefinitionNotNil applies the NotNil predicate on the "definition" field.
+func DefinitionNotNil() predicate.OsnMap {
+	return predicate.OsnMap(sql.FieldNotNull(FieldDefinition))
+}
+
 // HasMatches applies the HasEdge predicate on the "matches" edge.
 func HasMatches() predicate.OsnMap {
 	return predicate.OsnMap(func(s *sql.Selector) {
@@ -261,6 +726,29 @@ func HasMatchesWith(preds ...predicate.Match) predicate.OsnMap {
 	})
 }
 
+// HasActions applies the HasEdge predicate on the "actions" edge.
+func HasActions() predicate.OsnMap {
+	return predicate.OsnMap(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActionsTable, ActionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActionsWith applies the HasEdge predicate on the "actions" edge with a given conditions (other predicates).
+func HasActionsWith(preds ...predicate.Action) predicate.OsnMap {
+	return predicate.OsnMap(func(s *sql.Selector) {
+		step := newActionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OsnMap) predicate.OsnMap {
 	return predicate.OsnMap(sql.AndPredicates(predicates...))
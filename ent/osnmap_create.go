@@ -9,8 +9,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/ent/action"
 	"github.com/kevindamm/wits-go/ent/match"
 	"github.com/kevindamm/wits-go/ent/osnmap"
+	"github.com/kevindamm/wits-go/witsjson"
 )
 
 // OsnMapCreate is the builder for creating a OsnMap entity.
@@ -38,6 +40,138 @@ func (omc *OsnMapCreate) SetRoleCount(i int) *OsnMapCreate {
 	return omc
 }
 
+// SetMapID sets the "map_id" field.
+func (omc *OsnMapCreate) SetMapID(s string) *OsnMapCreate {
+	omc.mutation.SetMapID(s)
+	return omc
+}
+
+// SetNillableMapID sets the "map_id" field if the given value is not nil.
+func (omc *OsnMapCreate) SetNillableMapID(s *string) *OsnMapCreate {
+	if s != nil {
+		omc.SetMapID(*s)
+	}
+	return omc
+}
+
+// SetContentHash sets the "content_hash" field.
+func (omc *OsnMapCreate) SetContentHash(s string) *OsnMapCreate {
+	omc.mutation.SetContentHash(s)
+	return omc
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (omc *OsnMapCreate) SetNillableContentHash(s *string) *OsnMapCreate {
+	if s != nil {
+		omc.SetContentHash(*s)
+	}
+	return omc
+}
+
+// SetFloorCount sets the "floor_count" field.
+func (omc *OsnMapCreate) SetFloorCount(i int) *OsnMapCreate {
+	omc.mutation.SetFloorCount(i)
+	return omc
+}
+
+// SetNillableFloorCount sets the "floor_count" field if the given value is not nil.
+func (omc *OsnMapCreate) SetNillableFloorCount(i *int) *OsnMapCreate {
+	if i != nil {
+		omc.SetFloorCount(*i)
+	}
+	return omc
+}
+
+// SetWallCount sets the "wall_count" field.
+func (omc *OsnMapCreate) SetWallCount(i int) *OsnMapCreate {
+	omc.mutation.SetWallCount(i)
+	return omc
+}
+
+// SetNillableWallCount sets the "wall_count" field if the given value is not nil.
+func (omc *OsnMapCreate) SetNillableWallCount(i *int) *OsnMapCreate {
+	if i != nil {
+		omc.SetWallCount(*i)
+	}
+	return omc
+}
+
+// SetBonusCount sets the "bonus_count" field.
+func (omc *OsnMapCreate) SetBonusCount(i int) *OsnMapCreate {
+	omc.mutation.SetBonusCount(i)
+	return omc
+}
+
+// SetNillableBonusCount sets the "bonus_count" field if the given value is not nil.
+func (omc *OsnMapCreate) SetNillableBonusCount(i *int) *OsnMapCreate {
+	if i != nil {
+		omc.SetBonusCount(*i)
+	}
+	return omc
+}
+
+// SetSpawnCount sets the "spawn_count" field.
+func (omc *OsnMapCreate) SetSpawnCount(i int) *OsnMapCreate {
+	omc.mutation.SetSpawnCount(i)
+	return omc
+}
+
+// SetNillableSpawnCount sets the "spawn_count" field if the given value is not nil.
+func (omc *OsnMapCreate) SetNillableSpawnCount(i *int) *OsnMapCreate {
+	if i != nil {
+		omc.SetSpawnCount(*i)
+	}
+	return omc
+}
+
+// SetSymmetry sets the "symmetry" field.
+func (omc *OsnMapCreate) SetSymmetry(o osnmap.Symmetry) *OsnMapCreate {
+	omc.mutation.SetSymmetry(o)
+	return omc
+}
+
+// SetNillableSymmetry sets the "symmetry" field if the given value is not nil.
+func (omc *OsnMapCreate) SetNillableSymmetry(o *osnmap.Symmetry) *OsnMapCreate {
+	if o != nil {
+		omc.SetSymmetry(*o)
+	}
+	return omc
+}
+
+// SetLegacy sets the "legacy" field.
+func (omc *OsnMapCreate) SetLegacy(b bool) *OsnMapCreate {
+	omc.mutation.SetLegacy(b)
+	return omc
+}
+
+// SetNillableLegacy sets the "legacy" field if the given value is not nil.
+func (omc *OsnMapCreate) SetNillableLegacy(b *bool) *OsnMapCreate {
+	if b != nil {
+		omc.SetLegacy(*b)
+	}
+	return omc
+}
+
+// SetThumbnailSvg sets the "thumbnail_svg" field.
+func (omc *OsnMapCreate) SetThumbnailSvg(s string) *OsnMapCreate {
+	omc.mutation.SetThumbnailSvg(s)
+	return omc
+}
+
+// SetNillableThumbnailSvg sets the "thumbnail_svg" field if the given value is not nil.
+func (omc *OsnMapCreate) SetNillableThumbnailSvg(s *string) *OsnMapCreate {
+	if s != nil {
+		omc.SetThumbnailSvg(*s)
+	}
+	return omc
+}
+
+// SetDefinition sets the "definition" field.
+func (omc *OsnMapCreate) SetDefinition(wd *witsjson.MapDefinition) *OsnMapCreate {
+	omc.mutation.SetDefinition(wd)
+	return omc
+}
+
 // AddMatchIDs adds the "matches" edge to the Match entity by IDs.
 func (omc *OsnMapCreate) AddMatchIDs(ids ...int) *OsnMapCreate {
 	omc.mutation.AddMatchIDs(ids...)
@@ -53,6 +187,21 @@ func (omc *OsnMapCreate) AddMatches(m ...*Match) *OsnMapCreate {
 	return omc.AddMatchIDs(ids...)
 }
 
+// AddActionIDs adds the "actions" edge to the Action entity by IDs.
+func (omc *OsnMapCreate) AddActionIDs(ids ...int) *OsnMapCreate {
+	omc.mutation.AddActionIDs(ids...)
+	return omc
+}
+
+// AddActions adds the "actions" edges to the Action entity.
+func (omc *OsnMapCreate) AddActions(a ...*Action) *OsnMapCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return omc.AddActionIDs(ids...)
+}
+
 // Mutation returns the OsnMapMutation object of the builder.
 func (omc *OsnMapCreate) Mutation() *OsnMapMutation {
 	return omc.mutation
@@ -60,6 +209,7 @@ func (omc *OsnMapCreate) Mutation() *OsnMapMutation {
 
 // Save creates the OsnMap in the database.
 func (omc *OsnMapCreate) Save(ctx context.Context) (*OsnMap, error) {
+	omc.defaults()
 	return withHooks(ctx, omc.sqlSave, omc.mutation, omc.hooks)
 }
 
@@ -85,6 +235,34 @@ func (omc *OsnMapCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (omc *OsnMapCreate) defaults() {
+	if _, ok := omc.mutation.FloorCount(); !ok {
+		v := osnmap.DefaultFloorCount
+		omc.mutation.SetFloorCount(v)
+	}
+	if _, ok := omc.mutation.WallCount(); !ok {
+		v := osnmap.DefaultWallCount
+		omc.mutation.SetWallCount(v)
+	}
+	if _, ok := omc.mutation.BonusCount(); !ok {
+		v := osnmap.DefaultBonusCount
+		omc.mutation.SetBonusCount(v)
+	}
+	if _, ok := omc.mutation.SpawnCount(); !ok {
+		v := osnmap.DefaultSpawnCount
+		omc.mutation.SetSpawnCount(v)
+	}
+	if _, ok := omc.mutation.Symmetry(); !ok {
+		v := osnmap.DefaultSymmetry
+		omc.mutation.SetSymmetry(v)
+	}
+	if _, ok := omc.mutation.Legacy(); !ok {
+		v := osnmap.DefaultLegacy
+		omc.mutation.SetLegacy(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (omc *OsnMapCreate) check() error {
 	if _, ok := omc.mutation.Name(); !ok {
@@ -101,6 +279,49 @@ func (omc *OsnMapCreate) check() error {
 			return &ValidationError{Name: "role_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.role_count": %w`, err)}
 		}
 	}
+	if _, ok := omc.mutation.FloorCount(); !ok {
+		return &ValidationError{Name: "floor_count", err: errors.New(`ent: missing required field "OsnMap.floor_count"`)}
+	}
+	if v, ok := omc.mutation.FloorCount(); ok {
+		if err := osnmap.FloorCountValidator(v); err != nil {
+			return &ValidationError{Name: "floor_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.floor_count": %w`, err)}
+		}
+	}
+	if _, ok := omc.mutation.WallCount(); !ok {
+		return &ValidationError{Name: "wall_count", err: errors.New(`ent: missing required field "OsnMap.wall_count"`)}
+	}
+	if v, ok := omc.mutation.WallCount(); ok {
+		if err := osnmap.WallCountValidator(v); err != nil {
+			return &ValidationError{Name: "wall_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.wall_count": %w`, err)}
+		}
+	}
+	if _, ok := omc.mutation.BonusCount(); !ok {
+		return &ValidationError{Name: "bonus_count", err: errors.New(`ent: missing required field "OsnMap.bonus_count"`)}
+	}
+	if v, ok := omc.mutation.BonusCount(); ok {
+		if err := osnmap.BonusCountValidator(v); err != nil {
+			return &ValidationError{Name: "bonus_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.bonus_count": %w`, err)}
+		}
+	}
+	if _, ok := omc.mutation.SpawnCount(); !ok {
+		return &ValidationError{Name: "spawn_count", err: errors.New(`ent: missing required field "OsnMap.spawn_count"`)}
+	}
+	if v, ok := omc.mutation.SpawnCount(); ok {
+		if err := osnmap.SpawnCountValidator(v); err != nil {
+			return &ValidationError{Name: "spawn_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.spawn_count": %w`, err)}
+		}
+	}
+	if _, ok := omc.mutation.Symmetry(); !ok {
+		return &ValidationError{Name: "symmetry", err: errors.New(`ent: missing required field "OsnMap.symmetry"`)}
+	}
+	if v, ok := omc.mutation.Symmetry(); ok {
+		if err := osnmap.SymmetryValidator(v); err != nil {
+			return &ValidationError{Name: "symmetry", err: fmt.Errorf(`ent: validator failed for field "OsnMap.symmetry": %w`, err)}
+		}
+	}
+	if _, ok := omc.mutation.Legacy(); !ok {
+		return &ValidationError{Name: "legacy", err: errors.New(`ent: missing required field "OsnMap.legacy"`)}
+	}
 	return nil
 }
 
@@ -139,6 +360,46 @@ func (omc *OsnMapCreate) createSpec() (*OsnMap, *sqlgraph.CreateSpec) {
 		_spec.SetField(osnmap.FieldRoleCount, field.TypeInt, value)
 		_node.RoleCount = value
 	}
+	if value, ok := omc.mutation.MapID(); ok {
+		_spec.SetField(osnmap.FieldMapID, field.TypeString, value)
+		_node.MapID = value
+	}
+	if value, ok := omc.mutation.ContentHash(); ok {
+		_spec.SetField(osnmap.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
+	if value, ok := omc.mutation.FloorCount(); ok {
+		_spec.SetField(osnmap.FieldFloorCount, field.TypeInt, value)
+		_node.FloorCount = value
+	}
+	if value, ok := omc.mutation.WallCount(); ok {
+		_spec.SetField(osnmap.FieldWallCount, field.TypeInt, value)
+		_node.WallCount = value
+	}
+	if value, ok := omc.mutation.BonusCount(); ok {
+		_spec.SetField(osnmap.FieldBonusCount, field.TypeInt, value)
+		_node.BonusCount = value
+	}
+	if value, ok := omc.mutation.SpawnCount(); ok {
+		_spec.SetField(osnmap.FieldSpawnCount, field.TypeInt, value)
+		_node.SpawnCount = value
+	}
+	if value, ok := omc.mutation.Symmetry(); ok {
+		_spec.SetField(osnmap.FieldSymmetry, field.TypeEnum, value)
+		_node.Symmetry = value
+	}
+	if value, ok := omc.mutation.Legacy(); ok {
+		_spec.SetField(osnmap.FieldLegacy, field.TypeBool, value)
+		_node.Legacy = value
+	}
+	if value, ok := omc.mutation.ThumbnailSvg(); ok {
+		_spec.SetField(osnmap.FieldThumbnailSvg, field.TypeString, value)
+		_node.ThumbnailSvg = value
+	}
+	if value, ok := omc.mutation.Definition(); ok {
+		_spec.SetField(osnmap.FieldDefinition, field.TypeJSON, value)
+		_node.Definition = value
+	}
 	if nodes := omc.mutation.MatchesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -155,6 +416,22 @@ func (omc *OsnMapCreate) createSpec() (*OsnMap, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := omc.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   osnmap.ActionsTable,
+			Columns: []string{osnmap.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -176,6 +453,7 @@ func (omcb *OsnMapCreateBulk) Save(ctx context.Context) ([]*OsnMap, error) {
 	for i := range omcb.builders {
 		func(i int, root context.Context) {
 			builder := omcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*OsnMapMutation)
 				if !ok {
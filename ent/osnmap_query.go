@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/ent/action"
 	"github.com/kevindamm/wits-go/ent/match"
 	"github.com/kevindamm/wits-go/ent/osnmap"
 	"github.com/kevindamm/wits-go/ent/predicate"
@@ -25,6 +26,7 @@ type OsnMapQuery struct {
 	inters      []Interceptor
 	predicates  []predicate.OsnMap
 	withMatches *MatchQuery
+	withActions *ActionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +85,28 @@ func (omq *OsnMapQuery) QueryMatches() *MatchQuery {
 	return query
 }
 
+// QueryActions chains the current query on the "actions" edge.
+func (omq *OsnMapQuery) QueryActions() *ActionQuery {
+	query := (&ActionClient{config: omq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := omq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := omq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(osnmap.Table, osnmap.FieldID, selector),
+			sqlgraph.To(action.Table, action.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, osnmap.ActionsTable, osnmap.ActionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(omq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first OsnMap entity from the query.
 // Returns a *NotFoundError when no OsnMap was found.
 func (omq *OsnMapQuery) First(ctx context.Context) (*OsnMap, error) {
@@ -276,6 +300,7 @@ func (omq *OsnMapQuery) Clone() *OsnMapQuery {
 		inters:      append([]Interceptor{}, omq.inters...),
 		predicates:  append([]predicate.OsnMap{}, omq.predicates...),
 		withMatches: omq.withMatches.Clone(),
+		withActions: omq.withActions.Clone(),
 		// clone intermediate query.
 		sql:  omq.sql.Clone(),
 		path: omq.path,
@@ -293,6 +318,17 @@ func (omq *OsnMapQuery) WithMatches(opts ...func(*MatchQuery)) *OsnMapQuery {
 	return omq
 }
 
+// WithActions tells the query-builder to eager-load the nodes that are connected to
+// the "actions" edge. The optional arguments are used to configure the query builder of the edge.
+func (omq *OsnMapQuery) WithActions(opts ...func(*ActionQuery)) *OsnMapQuery {
+	query := (&ActionClient{config: omq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	omq.withActions = query
+	return omq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +407,9 @@ func (omq *OsnMapQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OsnM
 	var (
 		nodes       = []*OsnMap{}
 		_spec       = omq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			omq.withMatches != nil,
+			omq.withActions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +437,13 @@ func (omq *OsnMapQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OsnM
 			return nil, err
 		}
 	}
+	if query := omq.withActions; query != nil {
+		if err := omq.loadActions(ctx, query, nodes,
+			func(n *OsnMap) { n.Edges.Actions = []*Action{} },
+			func(n *OsnMap, e *Action) { n.Edges.Actions = append(n.Edges.Actions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -434,6 +478,37 @@ func (omq *OsnMapQuery) loadMatches(ctx context.Context, query *MatchQuery, node
 	}
 	return nil
 }
+func (omq *OsnMapQuery) loadActions(ctx context.Context, query *ActionQuery, nodes []*OsnMap, init func(*OsnMap), assign func(*OsnMap, *Action)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*OsnMap)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Action(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(osnmap.ActionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.osn_map_actions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "osn_map_actions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "osn_map_actions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (omq *OsnMapQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := omq.querySpec()
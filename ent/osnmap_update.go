@@ -10,9 +10,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/ent/action"
 	"github.com/kevindamm/wits-go/ent/match"
 	"github.com/kevindamm/wits-go/ent/osnmap"
 	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/witsjson"
 )
 
 // OsnMapUpdate is the builder for updating OsnMap entities.
@@ -77,6 +79,190 @@ func (omu *OsnMapUpdate) AddRoleCount(i int) *OsnMapUpdate {
 	return omu
 }
 
+// SetMapID sets the "map_id" field.
+func (omu *OsnMapUpdate) SetMapID(s string) *OsnMapUpdate {
+	omu.mutation.SetMapID(s)
+	return omu
+}
+
+// SetNillableMapID sets the "map_id" field if the given value is not nil.
+func (omu *OsnMapUpdate) SetNillableMapID(s *string) *OsnMapUpdate {
+	if s != nil {
+		omu.SetMapID(*s)
+	}
+	return omu
+}
+
+// ClearMapID clears the value of the "map_id" field.
+func (omu *OsnMapUpdate) ClearMapID() *OsnMapUpdate {
+	omu.mutation.ClearMapID()
+	return omu
+}
+
+// SetContentHash sets the "content_hash" field.
+func (omu *OsnMapUpdate) SetContentHash(s string) *OsnMapUpdate {
+	omu.mutation.SetContentHash(s)
+	return omu
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (omu *OsnMapUpdate) SetNillableContentHash(s *string) *OsnMapUpdate {
+	if s != nil {
+		omu.SetContentHash(*s)
+	}
+	return omu
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (omu *OsnMapUpdate) ClearContentHash() *OsnMapUpdate {
+	omu.mutation.ClearContentHash()
+	return omu
+}
+
+// SetFloorCount sets the "floor_count" field.
+func (omu *OsnMapUpdate) SetFloorCount(i int) *OsnMapUpdate {
+	omu.mutation.ResetFloorCount()
+	omu.mutation.SetFloorCount(i)
+	return omu
+}
+
+// SetNillableFloorCount sets the "floor_count" field if the given value is not nil.
+func (omu *OsnMapUpdate) SetNillableFloorCount(i *int) *OsnMapUpdate {
+	if i != nil {
+		omu.SetFloorCount(*i)
+	}
+	return omu
+}
+
+// AddFloorCount adds i to the "floor_count" field.
+func (omu *OsnMapUpdate) AddFloorCount(i int) *OsnMapUpdate {
+	omu.mutation.AddFloorCount(i)
+	return omu
+}
+
+// SetWallCount sets the "wall_count" field.
+func (omu *OsnMapUpdate) SetWallCount(i int) *OsnMapUpdate {
+	omu.mutation.ResetWallCount()
+	omu.mutation.SetWallCount(i)
+	return omu
+}
+
+// SetNillableWallCount sets the "wall_count" field if the given value is not nil.
+func (omu *OsnMapUpdate) SetNillableWallCount(i *int) *OsnMapUpdate {
+	if i != nil {
+		omu.SetWallCount(*i)
+	}
+	return omu
+}
+
+// AddWallCount adds i to the "wall_count" field.
+func (omu *OsnMapUpdate) AddWallCount(i int) *OsnMapUpdate {
+	omu.mutation.AddWallCount(i)
+	return omu
+}
+
+// SetBonusCount sets the "bonus_count" field.
+func (omu *OsnMapUpdate) SetBonusCount(i int) *OsnMapUpdate {
+	omu.mutation.ResetBonusCount()
+	omu.mutation.SetBonusCount(i)
+	return omu
+}
+
+// SetNillableBonusCount sets the "bonus_count" field if the given value is not nil.
+func (omu *OsnMapUpdate) SetNillableBonusCount(i *int) *OsnMapUpdate {
+	if i != nil {
+		omu.SetBonusCount(*i)
+	}
+	return omu
+}
+
+// AddBonusCount adds i to the "bonus_count" field.
+func (omu *OsnMapUpdate) AddBonusCount(i int) *OsnMapUpdate {
+	omu.mutation.AddBonusCount(i)
+	return omu
+}
+
+// SetSpawnCount sets the "spawn_count" field.
+func (omu *OsnMapUpdate) SetSpawnCount(i int) *OsnMapUpdate {
+	omu.mutation.ResetSpawnCount()
+	omu.mutation.SetSpawnCount(i)
+	return omu
+}
+
+// SetNillableSpawnCount sets the "spawn_count" field if the given value is not nil.
+func (omu *OsnMapUpdate) SetNillableSpawnCount(i *int) *OsnMapUpdate {
+	if i != nil {
+		omu.SetSpawnCount(*i)
+	}
+	return omu
+}
+
+// AddSpawnCount adds i to the "spawn_count" field.
+func (omu *OsnMapUpdate) AddSpawnCount(i int) *OsnMapUpdate {
+	omu.mutation.AddSpawnCount(i)
+	return omu
+}
+
+// SetSymmetry sets the "symmetry" field.
+func (omu *OsnMapUpdate) SetSymmetry(o osnmap.Symmetry) *OsnMapUpdate {
+	omu.mutation.SetSymmetry(o)
+	return omu
+}
+
+// SetNillableSymmetry sets the "symmetry" field if the given value is not nil.
+func (omu *OsnMapUpdate) SetNillableSymmetry(o *osnmap.Symmetry) *OsnMapUpdate {
+	if o != nil {
+		omu.SetSymmetry(*o)
+	}
+	return omu
+}
+
+// SetLegacy sets the "legacy" field.
+func (omu *OsnMapUpdate) SetLegacy(b bool) *OsnMapUpdate {
+	omu.mutation.SetLegacy(b)
+	return omu
+}
+
+// SetNillableLegacy sets the "legacy" field if the given value is not nil.
+func (omu *OsnMapUpdate) SetNillableLegacy(b *bool) *OsnMapUpdate {
+	if b != nil {
+		omu.SetLegacy(*b)
+	}
+	return omu
+}
+
+// SetThumbnailSvg sets the "thumbnail_svg" field.
+func (omu *OsnMapUpdate) SetThumbnailSvg(s string) *OsnMapUpdate {
+	omu.mutation.SetThumbnailSvg(s)
+	return omu
+}
+
+// SetNillableThumbnailSvg sets the "thumbnail_svg" field if the given value is not nil.
+func (omu *OsnMapUpdate) SetNillableThumbnailSvg(s *string) *OsnMapUpdate {
+	if s != nil {
+		omu.SetThumbnailSvg(*s)
+	}
+	return omu
+}
+
+// ClearThumbnailSvg clears the value of the "thumbnail_svg" field.
+func (omu *OsnMapUpdate) ClearThumbnailSvg() *OsnMapUpdate {
+	omu.mutation.ClearThumbnailSvg()
+	return omu
+}
+
+// SetDefinition sets the "definition" field.
+func (omu *OsnMapUpdate) SetDefinition(wd *witsjson.MapDefinition) *OsnMapUpdate {
+	omu.mutation.SetDefinition(wd)
+	return omu
+}
+
+// ClearDefinition clears the value of the "definition" field.
+func (omu *OsnMapUpdate) ClearDefinition() *OsnMapUpdate {
+	omu.mutation.ClearDefinition()
+	return omu
+}
+
 // AddMatchIDs adds the "matches" edge to the Match entity by IDs.
 func (omu *OsnMapUpdate) AddMatchIDs(ids ...int) *OsnMapUpdate {
 	omu.mutation.AddMatchIDs(ids...)
@@ -92,6 +278,21 @@ func (omu *OsnMapUpdate) AddMatches(m ...*Match) *OsnMapUpdate {
 	return omu.AddMatchIDs(ids...)
 }
 
+// AddActionIDs adds the "actions" edge to the Action entity by IDs.
+func (omu *OsnMapUpdate) AddActionIDs(ids ...int) *OsnMapUpdate {
+	omu.mutation.AddActionIDs(ids...)
+	return omu
+}
+
+// AddActions adds the "actions" edges to the Action entity.
+func (omu *OsnMapUpdate) AddActions(a ...*Action) *OsnMapUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return omu.AddActionIDs(ids...)
+}
+
 // Mutation returns the OsnMapMutation object of the builder.
 func (omu *OsnMapUpdate) Mutation() *OsnMapMutation {
 	return omu.mutation
@@ -118,6 +319,27 @@ func (omu *OsnMapUpdate) RemoveMatches(m ...*Match) *OsnMapUpdate {
 	return omu.RemoveMatchIDs(ids...)
 }
 
+// ClearActions clears all "actions" edges to the Action entity.
+func (omu *OsnMapUpdate) ClearActions() *OsnMapUpdate {
+	omu.mutation.ClearActions()
+	return omu
+}
+
+// RemoveActionIDs removes the "actions" edge to Action entities by IDs.
+func (omu *OsnMapUpdate) RemoveActionIDs(ids ...int) *OsnMapUpdate {
+	omu.mutation.RemoveActionIDs(ids...)
+	return omu
+}
+
+// RemoveActions removes "actions" edges to Action entities.
+func (omu *OsnMapUpdate) RemoveActions(a ...*Action) *OsnMapUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return omu.RemoveActionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (omu *OsnMapUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, omu.sqlSave, omu.mutation, omu.hooks)
@@ -152,6 +374,31 @@ func (omu *OsnMapUpdate) check() error {
 			return &ValidationError{Name: "role_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.role_count": %w`, err)}
 		}
 	}
+	if v, ok := omu.mutation.FloorCount(); ok {
+		if err := osnmap.FloorCountValidator(v); err != nil {
+			return &ValidationError{Name: "floor_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.floor_count": %w`, err)}
+		}
+	}
+	if v, ok := omu.mutation.WallCount(); ok {
+		if err := osnmap.WallCountValidator(v); err != nil {
+			return &ValidationError{Name: "wall_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.wall_count": %w`, err)}
+		}
+	}
+	if v, ok := omu.mutation.BonusCount(); ok {
+		if err := osnmap.BonusCountValidator(v); err != nil {
+			return &ValidationError{Name: "bonus_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.bonus_count": %w`, err)}
+		}
+	}
+	if v, ok := omu.mutation.SpawnCount(); ok {
+		if err := osnmap.SpawnCountValidator(v); err != nil {
+			return &ValidationError{Name: "spawn_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.spawn_count": %w`, err)}
+		}
+	}
+	if v, ok := omu.mutation.Symmetry(); ok {
+		if err := osnmap.SymmetryValidator(v); err != nil {
+			return &ValidationError{Name: "symmetry", err: fmt.Errorf(`ent: validator failed for field "OsnMap.symmetry": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -179,6 +426,60 @@ func (omu *OsnMapUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := omu.mutation.AddedRoleCount(); ok {
 		_spec.AddField(osnmap.FieldRoleCount, field.TypeInt, value)
 	}
+	if value, ok := omu.mutation.MapID(); ok {
+		_spec.SetField(osnmap.FieldMapID, field.TypeString, value)
+	}
+	if omu.mutation.MapIDCleared() {
+		_spec.ClearField(osnmap.FieldMapID, field.TypeString)
+	}
+	if value, ok := omu.mutation.ContentHash(); ok {
+		_spec.SetField(osnmap.FieldContentHash, field.TypeString, value)
+	}
+	if omu.mutation.ContentHashCleared() {
+		_spec.ClearField(osnmap.FieldContentHash, field.TypeString)
+	}
+	if value, ok := omu.mutation.FloorCount(); ok {
+		_spec.SetField(osnmap.FieldFloorCount, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.AddedFloorCount(); ok {
+		_spec.AddField(osnmap.FieldFloorCount, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.WallCount(); ok {
+		_spec.SetField(osnmap.FieldWallCount, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.AddedWallCount(); ok {
+		_spec.AddField(osnmap.FieldWallCount, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.BonusCount(); ok {
+		_spec.SetField(osnmap.FieldBonusCount, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.AddedBonusCount(); ok {
+		_spec.AddField(osnmap.FieldBonusCount, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.SpawnCount(); ok {
+		_spec.SetField(osnmap.FieldSpawnCount, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.AddedSpawnCount(); ok {
+		_spec.AddField(osnmap.FieldSpawnCount, field.TypeInt, value)
+	}
+	if value, ok := omu.mutation.Symmetry(); ok {
+		_spec.SetField(osnmap.FieldSymmetry, field.TypeEnum, value)
+	}
+	if value, ok := omu.mutation.Legacy(); ok {
+		_spec.SetField(osnmap.FieldLegacy, field.TypeBool, value)
+	}
+	if value, ok := omu.mutation.ThumbnailSvg(); ok {
+		_spec.SetField(osnmap.FieldThumbnailSvg, field.TypeString, value)
+	}
+	if omu.mutation.ThumbnailSvgCleared() {
+		_spec.ClearField(osnmap.FieldThumbnailSvg, field.TypeString)
+	}
+	if value, ok := omu.mutation.Definition(); ok {
+		_spec.SetField(osnmap.FieldDefinition, field.TypeJSON, value)
+	}
+	if omu.mutation.DefinitionCleared() {
+		_spec.ClearField(osnmap.FieldDefinition, field.TypeJSON)
+	}
 	if omu.mutation.MatchesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -224,6 +525,51 @@ func (omu *OsnMapUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if omu.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   osnmap.ActionsTable,
+			Columns: []string{osnmap.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := omu.mutation.RemovedActionsIDs(); len(nodes) > 0 && !omu.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   osnmap.ActionsTable,
+			Columns: []string{osnmap.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := omu.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   osnmap.ActionsTable,
+			Columns: []string{osnmap.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, omu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{osnmap.Label}
@@ -293,6 +639,190 @@ func (omuo *OsnMapUpdateOne) AddRoleCount(i int) *OsnMapUpdateOne {
 	return omuo
 }
 
+// SetMapID sets the "map_id" field.
+func (omuo *OsnMapUpdateOne) SetMapID(s string) *OsnMapUpdateOne {
+	omuo.mutation.SetMapID(s)
+	return omuo
+}
+
+// SetNillableMapID sets the "map_id" field if the given value is not nil.
+func (omuo *OsnMapUpdateOne) SetNillableMapID(s *string) *OsnMapUpdateOne {
+	if s != nil {
+		omuo.SetMapID(*s)
+	}
+	return omuo
+}
+
+// ClearMapID clears the value of the "map_id" field.
+func (omuo *OsnMapUpdateOne) ClearMapID() *OsnMapUpdateOne {
+	omuo.mutation.ClearMapID()
+	return omuo
+}
+
+// SetContentHash sets the "content_hash" field.
+func (omuo *OsnMapUpdateOne) SetContentHash(s string) *OsnMapUpdateOne {
+	omuo.mutation.SetContentHash(s)
+	return omuo
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (omuo *OsnMapUpdateOne) SetNillableContentHash(s *string) *OsnMapUpdateOne {
+	if s != nil {
+		omuo.SetContentHash(*s)
+	}
+	return omuo
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (omuo *OsnMapUpdateOne) ClearContentHash() *OsnMapUpdateOne {
+	omuo.mutation.ClearContentHash()
+	return omuo
+}
+
+// SetFloorCount sets the "floor_count" field.
+func (omuo *OsnMapUpdateOne) SetFloorCount(i int) *OsnMapUpdateOne {
+	omuo.mutation.ResetFloorCount()
+	omuo.mutation.SetFloorCount(i)
+	return omuo
+}
+
+// SetNillableFloorCount sets the "floor_count" field if the given value is not nil.
+func (omuo *OsnMapUpdateOne) SetNillableFloorCount(i *int) *OsnMapUpdateOne {
+	if i != nil {
+		omuo.SetFloorCount(*i)
+	}
+	return omuo
+}
+
+// AddFloorCount adds i to the "floor_count" field.
+func (omuo *OsnMapUpdateOne) AddFloorCount(i int) *OsnMapUpdateOne {
+	omuo.mutation.AddFloorCount(i)
+	return omuo
+}
+
+// SetWallCount sets the "wall_count" field.
+func (omuo *OsnMapUpdateOne) SetWallCount(i int) *OsnMapUpdateOne {
+	omuo.mutation.ResetWallCount()
+	omuo.mutation.SetWallCount(i)
+	return omuo
+}
+
+// SetNillableWallCount sets the "wall_count" field if the given value is not nil.
+func (omuo *OsnMapUpdateOne) SetNillableWallCount(i *int) *OsnMapUpdateOne {
+	if i != nil {
+		omuo.SetWallCount(*i)
+	}
+	return omuo
+}
+
+// AddWallCount adds i to the "wall_count" field.
+func (omuo *OsnMapUpdateOne) AddWallCount(i int) *OsnMapUpdateOne {
+	omuo.mutation.AddWallCount(i)
+	return omuo
+}
+
+// SetBonusCount sets the "bonus_count" field.
+func (omuo *OsnMapUpdateOne) SetBonusCount(i int) *OsnMapUpdateOne {
+	omuo.mutation.ResetBonusCount()
+	omuo.mutation.SetBonusCount(i)
+	return omuo
+}
+
+// SetNillableBonusCount sets the "bonus_count" field if the given value is not nil.
+func (omuo *OsnMapUpdateOne) SetNillableBonusCount(i *int) *OsnMapUpdateOne {
+	if i != nil {
+		omuo.SetBonusCount(*i)
+	}
+	return omuo
+}
+
+// AddBonusCount adds i to the "bonus_count" field.
+func (omuo *OsnMapUpdateOne) AddBonusCount(i int) *OsnMapUpdateOne {
+	omuo.mutation.AddBonusCount(i)
+	return omuo
+}
+
+// SetSpawnCount sets the "spawn_count" field.
+func (omuo *OsnMapUpdateOne) SetSpawnCount(i int) *OsnMapUpdateOne {
+	omuo.mutation.ResetSpawnCount()
+	omuo.mutation.SetSpawnCount(i)
+	return omuo
+}
+
+// SetNillableSpawnCount sets the "spawn_count" field if the given value is not nil.
+func (omuo *OsnMapUpdateOne) SetNillableSpawnCount(i *int) *OsnMapUpdateOne {
+	if i != nil {
+		omuo.SetSpawnCount(*i)
+	}
+	return omuo
+}
+
+// AddSpawnCount adds i to the "spawn_count" field.
+func (omuo *OsnMapUpdateOne) AddSpawnCount(i int) *OsnMapUpdateOne {
+	omuo.mutation.AddSpawnCount(i)
+	return omuo
+}
+
+// SetSymmetry sets the "symmetry" field.
+func (omuo *OsnMapUpdateOne) SetSymmetry(o osnmap.Symmetry) *OsnMapUpdateOne {
+	omuo.mutation.SetSymmetry(o)
+	return omuo
+}
+
+// SetNillableSymmetry sets the "symmetry" field if the given value is not nil.
+func (omuo *OsnMapUpdateOne) SetNillableSymmetry(o *osnmap.Symmetry) *OsnMapUpdateOne {
+	if o != nil {
+		omuo.SetSymmetry(*o)
+	}
+	return omuo
+}
+
+// SetLegacy sets the "legacy" field.
+func (omuo *OsnMapUpdateOne) SetLegacy(b bool) *OsnMapUpdateOne {
+	omuo.mutation.SetLegacy(b)
+	return omuo
+}
+
+// SetNillableLegacy sets the "legacy" field if the given value is not nil.
+func (omuo *OsnMapUpdateOne) SetNillableLegacy(b *bool) *OsnMapUpdateOne {
+	if b != nil {
+		omuo.SetLegacy(*b)
+	}
+	return omuo
+}
+
+// SetThumbnailSvg sets the "thumbnail_svg" field.
+func (omuo *OsnMapUpdateOne) SetThumbnailSvg(s string) *OsnMapUpdateOne {
+	omuo.mutation.SetThumbnailSvg(s)
+	return omuo
+}
+
+// SetNillableThumbnailSvg sets the "thumbnail_svg" field if the given value is not nil.
+func (omuo *OsnMapUpdateOne) SetNillableThumbnailSvg(s *string) *OsnMapUpdateOne {
+	if s != nil {
+		omuo.SetThumbnailSvg(*s)
+	}
+	return omuo
+}
+
+// ClearThumbnailSvg clears the value of the "thumbnail_svg" field.
+func (omuo *OsnMapUpdateOne) ClearThumbnailSvg() *OsnMapUpdateOne {
+	omuo.mutation.ClearThumbnailSvg()
+	return omuo
+}
+
+// SetDefinition sets the "definition" field.
+func (omuo *OsnMapUpdateOne) SetDefinition(wd *witsjson.MapDefinition) *OsnMapUpdateOne {
+	omuo.mutation.SetDefinition(wd)
+	return omuo
+}
+
+// ClearDefinition clears the value of the "definition" field.
+func (omuo *OsnMapUpdateOne) ClearDefinition() *OsnMapUpdateOne {
+	omuo.mutation.ClearDefinition()
+	return omuo
+}
+
 // AddMatchIDs adds the "matches" edge to the Match entity by IDs.
 func (omuo *OsnMapUpdateOne) AddMatchIDs(ids ...int) *OsnMapUpdateOne {
 	omuo.mutation.AddMatchIDs(ids...)
@@ -308,6 +838,21 @@ func (omuo *OsnMapUpdateOne) AddMatches(m ...*Match) *OsnMapUpdateOne {
 	return omuo.AddMatchIDs(ids...)
 }
 
+// AddActionIDs adds the "actions" edge to the Action entity by IDs.
+func (omuo *OsnMapUpdateOne) AddActionIDs(ids ...int) *OsnMapUpdateOne {
+	omuo.mutation.AddActionIDs(ids...)
+	return omuo
+}
+
+// AddActions adds the "actions" edges to the Action entity.
+func (omuo *OsnMapUpdateOne) AddActions(a ...*Action) *OsnMapUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return omuo.AddActionIDs(ids...)
+}
+
 // Mutation returns the OsnMapMutation object of the builder.
 func (omuo *OsnMapUpdateOne) Mutation() *OsnMapMutation {
 	return omuo.mutation
@@ -334,6 +879,27 @@ func (omuo *OsnMapUpdateOne) RemoveMatches(m ...*Match) *OsnMapUpdateOne {
 	return omuo.RemoveMatchIDs(ids...)
 }
 
+// ClearActions clears all "actions" edges to the Action entity.
+func (omuo *OsnMapUpdateOne) ClearActions() *OsnMapUpdateOne {
+	omuo.mutation.ClearActions()
+	return omuo
+}
+
+// RemoveActionIDs removes the "actions" edge to Action entities by IDs.
+func (omuo *OsnMapUpdateOne) RemoveActionIDs(ids ...int) *OsnMapUpdateOne {
+	omuo.mutation.RemoveActionIDs(ids...)
+	return omuo
+}
+
+// RemoveActions removes "actions" edges to Action entities.
+func (omuo *OsnMapUpdateOne) RemoveActions(a ...*Action) *OsnMapUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return omuo.RemoveActionIDs(ids...)
+}
+
 // Where appends a list predicates to the OsnMapUpdate builder.
 func (omuo *OsnMapUpdateOne) Where(ps ...predicate.OsnMap) *OsnMapUpdateOne {
 	omuo.mutation.Where(ps...)
@@ -381,6 +947,31 @@ func (omuo *OsnMapUpdateOne) check() error {
 			return &ValidationError{Name: "role_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.role_count": %w`, err)}
 		}
 	}
+	if v, ok := omuo.mutation.FloorCount(); ok {
+		if err := osnmap.FloorCountValidator(v); err != nil {
+			return &ValidationError{Name: "floor_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.floor_count": %w`, err)}
+		}
+	}
+	if v, ok := omuo.mutation.WallCount(); ok {
+		if err := osnmap.WallCountValidator(v); err != nil {
+			return &ValidationError{Name: "wall_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.wall_count": %w`, err)}
+		}
+	}
+	if v, ok := omuo.mutation.BonusCount(); ok {
+		if err := osnmap.BonusCountValidator(v); err != nil {
+			return &ValidationError{Name: "bonus_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.bonus_count": %w`, err)}
+		}
+	}
+	if v, ok := omuo.mutation.SpawnCount(); ok {
+		if err := osnmap.SpawnCountValidator(v); err != nil {
+			return &ValidationError{Name: "spawn_count", err: fmt.Errorf(`ent: validator failed for field "OsnMap.spawn_count": %w`, err)}
+		}
+	}
+	if v, ok := omuo.mutation.Symmetry(); ok {
+		if err := osnmap.SymmetryValidator(v); err != nil {
+			return &ValidationError{Name: "symmetry", err: fmt.Errorf(`ent: validator failed for field "OsnMap.symmetry": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -425,6 +1016,60 @@ func (omuo *OsnMapUpdateOne) sqlSave(ctx context.Context) (_node *OsnMap, err er
 	if value, ok := omuo.mutation.AddedRoleCount(); ok {
 		_spec.AddField(osnmap.FieldRoleCount, field.TypeInt, value)
 	}
+	if value, ok := omuo.mutation.MapID(); ok {
+		_spec.SetField(osnmap.FieldMapID, field.TypeString, value)
+	}
+	if omuo.mutation.MapIDCleared() {
+		_spec.ClearField(osnmap.FieldMapID, field.TypeString)
+	}
+	if value, ok := omuo.mutation.ContentHash(); ok {
+		_spec.SetField(osnmap.FieldContentHash, field.TypeString, value)
+	}
+	if omuo.mutation.ContentHashCleared() {
+		_spec.ClearField(osnmap.FieldContentHash, field.TypeString)
+	}
+	if value, ok := omuo.mutation.FloorCount(); ok {
+		_spec.SetField(osnmap.FieldFloorCount, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.AddedFloorCount(); ok {
+		_spec.AddField(osnmap.FieldFloorCount, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.WallCount(); ok {
+		_spec.SetField(osnmap.FieldWallCount, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.AddedWallCount(); ok {
+		_spec.AddField(osnmap.FieldWallCount, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.BonusCount(); ok {
+		_spec.SetField(osnmap.FieldBonusCount, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.AddedBonusCount(); ok {
+		_spec.AddField(osnmap.FieldBonusCount, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.SpawnCount(); ok {
+		_spec.SetField(osnmap.FieldSpawnCount, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.AddedSpawnCount(); ok {
+		_spec.AddField(osnmap.FieldSpawnCount, field.TypeInt, value)
+	}
+	if value, ok := omuo.mutation.Symmetry(); ok {
+		_spec.SetField(osnmap.FieldSymmetry, field.TypeEnum, value)
+	}
+	if value, ok := omuo.mutation.Legacy(); ok {
+		_spec.SetField(osnmap.FieldLegacy, field.TypeBool, value)
+	}
+	if value, ok := omuo.mutation.ThumbnailSvg(); ok {
+		_spec.SetField(osnmap.FieldThumbnailSvg, field.TypeString, value)
+	}
+	if omuo.mutation.ThumbnailSvgCleared() {
+		_spec.ClearField(osnmap.FieldThumbnailSvg, field.TypeString)
+	}
+	if value, ok := omuo.mutation.Definition(); ok {
+		_spec.SetField(osnmap.FieldDefinition, field.TypeJSON, value)
+	}
+	if omuo.mutation.DefinitionCleared() {
+		_spec.ClearField(osnmap.FieldDefinition, field.TypeJSON)
+	}
 	if omuo.mutation.MatchesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -470,6 +1115,51 @@ func (omuo *OsnMapUpdateOne) sqlSave(ctx context.Context) (_node *OsnMap, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if omuo.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   osnmap.ActionsTable,
+			Columns: []string{osnmap.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := omuo.mutation.RemovedActionsIDs(); len(nodes) > 0 && !omuo.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   osnmap.ActionsTable,
+			Columns: []string{osnmap.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := omuo.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   osnmap.ActionsTable,
+			Columns: []string{osnmap.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &OsnMap{config: omuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
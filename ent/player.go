@@ -30,9 +30,15 @@ type Player struct {
 type PlayerEdges struct {
 	// Roles holds the value of the roles edge.
 	Roles []*PlayerRole `json:"roles,omitempty"`
+	// Ratings holds the value of the ratings edge.
+	Ratings []*Rating `json:"ratings,omitempty"`
+	// RatingHistory holds the value of the rating_history edge.
+	RatingHistory []*RatingHistory `json:"rating_history,omitempty"`
+	// Standings holds the value of the standings edge.
+	Standings []*Standing `json:"standings,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // RolesOrErr returns the Roles value or an error if the edge
@@ -44,6 +50,33 @@ func (e PlayerEdges) RolesOrErr() ([]*PlayerRole, error) {
 	return nil, &NotLoadedError{edge: "roles"}
 }
 
+// RatingsOrErr returns the Ratings value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) RatingsOrErr() ([]*Rating, error) {
+	if e.loadedTypes[1] {
+		return e.Ratings, nil
+	}
+	return nil, &NotLoadedError{edge: "ratings"}
+}
+
+// RatingHistoryOrErr returns the RatingHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) RatingHistoryOrErr() ([]*RatingHistory, error) {
+	if e.loadedTypes[2] {
+		return e.RatingHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "rating_history"}
+}
+
+// StandingsOrErr returns the Standings value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) StandingsOrErr() ([]*Standing, error) {
+	if e.loadedTypes[3] {
+		return e.Standings, nil
+	}
+	return nil, &NotLoadedError{edge: "standings"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Player) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -105,6 +138,21 @@ func (pl *Player) QueryRoles() *PlayerRoleQuery {
 	return NewPlayerClient(pl.config).QueryRoles(pl)
 }
 
+// QueryRatings queries the "ratings" edge of the Player entity.
+func (pl *Player) QueryRatings() *RatingQuery {
+	return NewPlayerClient(pl.config).QueryRatings(pl)
+}
+
+// QueryRatingHistory queries the "rating_history" edge of the Player entity.
+func (pl *Player) QueryRatingHistory() *RatingHistoryQuery {
+	return NewPlayerClient(pl.config).QueryRatingHistory(pl)
+}
+
+// QueryStandings queries the "standings" edge of the Player entity.
+func (pl *Player) QueryStandings() *StandingQuery {
+	return NewPlayerClient(pl.config).QueryStandings(pl)
+}
+
 // Update returns a builder for updating this Player.
 // Note that you need to call Player.Unwrap() before calling this method if this Player
 // was returned from a transaction, and the transaction was committed or rolled back.
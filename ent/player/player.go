@@ -18,6 +18,12 @@ const (
 	FieldName = "name"
 	// EdgeRoles holds the string denoting the roles edge name in mutations.
 	EdgeRoles = "roles"
+	// EdgeRatings holds the string denoting the ratings edge name in mutations.
+	EdgeRatings = "ratings"
+	// EdgeRatingHistory holds the string denoting the rating_history edge name in mutations.
+	EdgeRatingHistory = "rating_history"
+	// EdgeStandings holds the string denoting the standings edge name in mutations.
+	EdgeStandings = "standings"
 	// Table holds the table name of the player in the database.
 	Table = "players"
 	// RolesTable is the table that holds the roles relation/edge. The primary key declared below.
@@ -25,6 +31,27 @@ const (
 	// RolesInverseTable is the table name for the PlayerRole entity.
 	// It exists in this package in order to avoid circular dependency with the "playerrole" package.
 	RolesInverseTable = "player_roles"
+	// RatingsTable is the table that holds the ratings relation/edge.
+	RatingsTable = "ratings"
+	// RatingsInverseTable is the table name for the Rating entity.
+	// It exists in this package in order to avoid circular dependency with the "rating" package.
+	RatingsInverseTable = "ratings"
+	// RatingsColumn is the table column denoting the ratings relation/edge.
+	RatingsColumn = "player_ratings"
+	// RatingHistoryTable is the table that holds the rating_history relation/edge.
+	RatingHistoryTable = "rating_histories"
+	// RatingHistoryInverseTable is the table name for the RatingHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "ratinghistory" package.
+	RatingHistoryInverseTable = "rating_histories"
+	// RatingHistoryColumn is the table column denoting the rating_history relation/edge.
+	RatingHistoryColumn = "player_rating_history"
+	// StandingsTable is the table that holds the standings relation/edge.
+	StandingsTable = "standings"
+	// StandingsInverseTable is the table name for the Standing entity.
+	// It exists in this package in order to avoid circular dependency with the "standing" package.
+	StandingsInverseTable = "standings"
+	// StandingsColumn is the table column denoting the standings relation/edge.
+	StandingsColumn = "player_standings"
 )
 
 // Columns holds all SQL columns for player fields.
@@ -81,6 +108,48 @@ func ByRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRatingsCount orders the results by ratings count.
+func ByRatingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRatingsStep(), opts...)
+	}
+}
+
+// ByRatings orders the results by ratings terms.
+func ByRatings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRatingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRatingHistoryCount orders the results by rating_history count.
+func ByRatingHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRatingHistoryStep(), opts...)
+	}
+}
+
+// ByRatingHistory orders the results by rating_history terms.
+func ByRatingHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRatingHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStandingsCount orders the results by standings count.
+func ByStandingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStandingsStep(), opts...)
+	}
+}
+
+// ByStandings orders the results by standings terms.
+func ByStandings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStandingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRolesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -88,3 +157,24 @@ func newRolesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, RolesTable, RolesPrimaryKey...),
 	)
 }
+func newRatingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RatingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RatingsTable, RatingsColumn),
+	)
+}
+func newRatingHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RatingHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RatingHistoryTable, RatingHistoryColumn),
+	)
+}
+func newStandingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StandingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StandingsTable, StandingsColumn),
+	)
+}
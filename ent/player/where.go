@@ -118,6 +118,16 @@ func GcidHasSuffix(v string) predicate.Player {
 	return predicate.Player(sql.FieldHasSuffix(FieldGcid, v))
 }
 
+// GcidIsNil applies the IsNil predicate on the "gcid" field.
+func GcidIsNil() predicate.Player {
+	return predicate.Player(sql.FieldIsNull(FieldGcid))
+}
+
+// GcidNotNil applies the NotNil predicate on the "gcid" field.
+func GcidNotNil() predicate.Player {
+	return predicate.Player(sql.FieldNotNull(FieldGcid))
+}
+
 // GcidEqualFold applies the EqualFold predicate on the "gcid" field.
 func GcidEqualFold(v string) predicate.Player {
 	return predicate.Player(sql.FieldEqualFold(FieldGcid, v))
@@ -216,6 +226,75 @@ func HasRolesWith(preds ...predicate.PlayerRole) predicate.Player {
 	})
 }
 
+// HasRatings applies the HasEdge predicate on the "ratings" edge.
+func HasRatings() predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RatingsTable, RatingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRatingsWith applies the HasEdge predicate on the "ratings" edge with a given conditions (other predicates).
+func HasRatingsWith(preds ...predicate.Rating) predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := newRatingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRatingHistory applies the HasEdge predicate on the "rating_history" edge.
+func HasRatingHistory() predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RatingHistoryTable, RatingHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRatingHistoryWith applies the HasEdge predicate on the "rating_history" edge with a given conditions (other predicates).
+func HasRatingHistoryWith(preds ...predicate.RatingHistory) predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := newRatingHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStandings applies the HasEdge predicate on the "standings" edge.
+func HasStandings() predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StandingsTable, StandingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStandingsWith applies the HasEdge predicate on the "standings" edge with a given conditions (other predicates).
+func HasStandingsWith(preds ...predicate.Standing) predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := newStandingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Player) predicate.Player {
 	return predicate.Player(sql.AndPredicates(predicates...))
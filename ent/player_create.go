@@ -11,6 +11,9 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/kevindamm/wits-go/ent/player"
 	"github.com/kevindamm/wits-go/ent/playerrole"
+	"github.com/kevindamm/wits-go/ent/rating"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+	"github.com/kevindamm/wits-go/ent/standing"
 )
 
 // PlayerCreate is the builder for creating a Player entity.
@@ -26,6 +29,14 @@ func (pc *PlayerCreate) SetGcid(s string) *PlayerCreate {
 	return pc
 }
 
+// SetNillableGcid sets the "gcid" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableGcid(s *string) *PlayerCreate {
+	if s != nil {
+		pc.SetGcid(*s)
+	}
+	return pc
+}
+
 // SetName sets the "name" field.
 func (pc *PlayerCreate) SetName(s string) *PlayerCreate {
 	pc.mutation.SetName(s)
@@ -47,6 +58,51 @@ func (pc *PlayerCreate) AddRoles(p ...*PlayerRole) *PlayerCreate {
 	return pc.AddRoleIDs(ids...)
 }
 
+// AddRatingIDs adds the "ratings" edge to the Rating entity by IDs.
+func (pc *PlayerCreate) AddRatingIDs(ids ...int) *PlayerCreate {
+	pc.mutation.AddRatingIDs(ids...)
+	return pc
+}
+
+// AddRatings adds the "ratings" edges to the Rating entity.
+func (pc *PlayerCreate) AddRatings(r ...*Rating) *PlayerCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddRatingIDs(ids...)
+}
+
+// AddRatingHistoryIDs adds the "rating_history" edge to the RatingHistory entity by IDs.
+func (pc *PlayerCreate) AddRatingHistoryIDs(ids ...int) *PlayerCreate {
+	pc.mutation.AddRatingHistoryIDs(ids...)
+	return pc
+}
+
+// AddRatingHistory adds the "rating_history" edges to the RatingHistory entity.
+func (pc *PlayerCreate) AddRatingHistory(r ...*RatingHistory) *PlayerCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddRatingHistoryIDs(ids...)
+}
+
+// AddStandingIDs adds the "standings" edge to the Standing entity by IDs.
+func (pc *PlayerCreate) AddStandingIDs(ids ...int) *PlayerCreate {
+	pc.mutation.AddStandingIDs(ids...)
+	return pc
+}
+
+// AddStandings adds the "standings" edges to the Standing entity.
+func (pc *PlayerCreate) AddStandings(s ...*Standing) *PlayerCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pc.AddStandingIDs(ids...)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (pc *PlayerCreate) Mutation() *PlayerMutation {
 	return pc.mutation
@@ -81,9 +137,6 @@ func (pc *PlayerCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (pc *PlayerCreate) check() error {
-	if _, ok := pc.mutation.Gcid(); !ok {
-		return &ValidationError{Name: "gcid", err: errors.New(`ent: missing required field "Player.gcid"`)}
-	}
 	if _, ok := pc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Player.name"`)}
 	}
@@ -137,6 +190,54 @@ func (pc *PlayerCreate) createSpec() (*Player, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.RatingHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingHistoryTable,
+			Columns: []string{player.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.StandingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.StandingsTable,
+			Columns: []string{player.StandingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(standing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -15,16 +15,22 @@ import (
 	"github.com/kevindamm/wits-go/ent/player"
 	"github.com/kevindamm/wits-go/ent/playerrole"
 	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/rating"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+	"github.com/kevindamm/wits-go/ent/standing"
 )
 
 // PlayerQuery is the builder for querying Player entities.
 type PlayerQuery struct {
 	config
-	ctx        *QueryContext
-	order      []player.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Player
-	withRoles  *PlayerRoleQuery
+	ctx               *QueryContext
+	order             []player.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Player
+	withRoles         *PlayerRoleQuery
+	withRatings       *RatingQuery
+	withRatingHistory *RatingHistoryQuery
+	withStandings     *StandingQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +89,72 @@ func (pq *PlayerQuery) QueryRoles() *PlayerRoleQuery {
 	return query
 }
 
+// QueryRatings chains the current query on the "ratings" edge.
+func (pq *PlayerQuery) QueryRatings() *RatingQuery {
+	query := (&RatingClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, selector),
+			sqlgraph.To(rating.Table, rating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.RatingsTable, player.RatingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRatingHistory chains the current query on the "rating_history" edge.
+func (pq *PlayerQuery) QueryRatingHistory() *RatingHistoryQuery {
+	query := (&RatingHistoryClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, selector),
+			sqlgraph.To(ratinghistory.Table, ratinghistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.RatingHistoryTable, player.RatingHistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStandings chains the current query on the "standings" edge.
+func (pq *PlayerQuery) QueryStandings() *StandingQuery {
+	query := (&StandingClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, selector),
+			sqlgraph.To(standing.Table, standing.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.StandingsTable, player.StandingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Player entity from the query.
 // Returns a *NotFoundError when no Player was found.
 func (pq *PlayerQuery) First(ctx context.Context) (*Player, error) {
@@ -270,12 +342,15 @@ func (pq *PlayerQuery) Clone() *PlayerQuery {
 		return nil
 	}
 	return &PlayerQuery{
-		config:     pq.config,
-		ctx:        pq.ctx.Clone(),
-		order:      append([]player.OrderOption{}, pq.order...),
-		inters:     append([]Interceptor{}, pq.inters...),
-		predicates: append([]predicate.Player{}, pq.predicates...),
-		withRoles:  pq.withRoles.Clone(),
+		config:            pq.config,
+		ctx:               pq.ctx.Clone(),
+		order:             append([]player.OrderOption{}, pq.order...),
+		inters:            append([]Interceptor{}, pq.inters...),
+		predicates:        append([]predicate.Player{}, pq.predicates...),
+		withRoles:         pq.withRoles.Clone(),
+		withRatings:       pq.withRatings.Clone(),
+		withRatingHistory: pq.withRatingHistory.Clone(),
+		withStandings:     pq.withStandings.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -293,6 +368,39 @@ func (pq *PlayerQuery) WithRoles(opts ...func(*PlayerRoleQuery)) *PlayerQuery {
 	return pq
 }
 
+// WithRatings tells the query-builder to eager-load the nodes that are connected to
+// the "ratings" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PlayerQuery) WithRatings(opts ...func(*RatingQuery)) *PlayerQuery {
+	query := (&RatingClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withRatings = query
+	return pq
+}
+
+// WithRatingHistory tells the query-builder to eager-load the nodes that are connected to
+// the "rating_history" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PlayerQuery) WithRatingHistory(opts ...func(*RatingHistoryQuery)) *PlayerQuery {
+	query := (&RatingHistoryClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withRatingHistory = query
+	return pq
+}
+
+// WithStandings tells the query-builder to eager-load the nodes that are connected to
+// the "standings" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PlayerQuery) WithStandings(opts ...func(*StandingQuery)) *PlayerQuery {
+	query := (&StandingClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withStandings = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +479,11 @@ func (pq *PlayerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Playe
 	var (
 		nodes       = []*Player{}
 		_spec       = pq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			pq.withRoles != nil,
+			pq.withRatings != nil,
+			pq.withRatingHistory != nil,
+			pq.withStandings != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +511,27 @@ func (pq *PlayerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Playe
 			return nil, err
 		}
 	}
+	if query := pq.withRatings; query != nil {
+		if err := pq.loadRatings(ctx, query, nodes,
+			func(n *Player) { n.Edges.Ratings = []*Rating{} },
+			func(n *Player, e *Rating) { n.Edges.Ratings = append(n.Edges.Ratings, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withRatingHistory; query != nil {
+		if err := pq.loadRatingHistory(ctx, query, nodes,
+			func(n *Player) { n.Edges.RatingHistory = []*RatingHistory{} },
+			func(n *Player, e *RatingHistory) { n.Edges.RatingHistory = append(n.Edges.RatingHistory, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withStandings; query != nil {
+		if err := pq.loadStandings(ctx, query, nodes,
+			func(n *Player) { n.Edges.Standings = []*Standing{} },
+			func(n *Player, e *Standing) { n.Edges.Standings = append(n.Edges.Standings, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -464,6 +596,99 @@ func (pq *PlayerQuery) loadRoles(ctx context.Context, query *PlayerRoleQuery, no
 	}
 	return nil
 }
+func (pq *PlayerQuery) loadRatings(ctx context.Context, query *RatingQuery, nodes []*Player, init func(*Player), assign func(*Player, *Rating)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Player)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Rating(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(player.RatingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.player_ratings
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "player_ratings" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "player_ratings" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *PlayerQuery) loadRatingHistory(ctx context.Context, query *RatingHistoryQuery, nodes []*Player, init func(*Player), assign func(*Player, *RatingHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Player)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RatingHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(player.RatingHistoryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.player_rating_history
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "player_rating_history" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "player_rating_history" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *PlayerQuery) loadStandings(ctx context.Context, query *StandingQuery, nodes []*Player, init func(*Player), assign func(*Player, *Standing)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Player)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Standing(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(player.StandingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.player_standings
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "player_standings" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "player_standings" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *PlayerQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
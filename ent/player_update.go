@@ -13,6 +13,9 @@ import (
 	"github.com/kevindamm/wits-go/ent/player"
 	"github.com/kevindamm/wits-go/ent/playerrole"
 	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/rating"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+	"github.com/kevindamm/wits-go/ent/standing"
 )
 
 // PlayerUpdate is the builder for updating Player entities.
@@ -42,6 +45,12 @@ func (pu *PlayerUpdate) SetNillableGcid(s *string) *PlayerUpdate {
 	return pu
 }
 
+// ClearGcid clears the value of the "gcid" field.
+func (pu *PlayerUpdate) ClearGcid() *PlayerUpdate {
+	pu.mutation.ClearGcid()
+	return pu
+}
+
 // SetName sets the "name" field.
 func (pu *PlayerUpdate) SetName(s string) *PlayerUpdate {
 	pu.mutation.SetName(s)
@@ -71,6 +80,51 @@ func (pu *PlayerUpdate) AddRoles(p ...*PlayerRole) *PlayerUpdate {
 	return pu.AddRoleIDs(ids...)
 }
 
+// AddRatingIDs adds the "ratings" edge to the Rating entity by IDs.
+func (pu *PlayerUpdate) AddRatingIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.AddRatingIDs(ids...)
+	return pu
+}
+
+// AddRatings adds the "ratings" edges to the Rating entity.
+func (pu *PlayerUpdate) AddRatings(r ...*Rating) *PlayerUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddRatingIDs(ids...)
+}
+
+// AddRatingHistoryIDs adds the "rating_history" edge to the RatingHistory entity by IDs.
+func (pu *PlayerUpdate) AddRatingHistoryIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.AddRatingHistoryIDs(ids...)
+	return pu
+}
+
+// AddRatingHistory adds the "rating_history" edges to the RatingHistory entity.
+func (pu *PlayerUpdate) AddRatingHistory(r ...*RatingHistory) *PlayerUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddRatingHistoryIDs(ids...)
+}
+
+// AddStandingIDs adds the "standings" edge to the Standing entity by IDs.
+func (pu *PlayerUpdate) AddStandingIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.AddStandingIDs(ids...)
+	return pu
+}
+
+// AddStandings adds the "standings" edges to the Standing entity.
+func (pu *PlayerUpdate) AddStandings(s ...*Standing) *PlayerUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.AddStandingIDs(ids...)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (pu *PlayerUpdate) Mutation() *PlayerMutation {
 	return pu.mutation
@@ -97,6 +151,69 @@ func (pu *PlayerUpdate) RemoveRoles(p ...*PlayerRole) *PlayerUpdate {
 	return pu.RemoveRoleIDs(ids...)
 }
 
+// ClearRatings clears all "ratings" edges to the Rating entity.
+func (pu *PlayerUpdate) ClearRatings() *PlayerUpdate {
+	pu.mutation.ClearRatings()
+	return pu
+}
+
+// RemoveRatingIDs removes the "ratings" edge to Rating entities by IDs.
+func (pu *PlayerUpdate) RemoveRatingIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.RemoveRatingIDs(ids...)
+	return pu
+}
+
+// RemoveRatings removes "ratings" edges to Rating entities.
+func (pu *PlayerUpdate) RemoveRatings(r ...*Rating) *PlayerUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveRatingIDs(ids...)
+}
+
+// ClearRatingHistory clears all "rating_history" edges to the RatingHistory entity.
+func (pu *PlayerUpdate) ClearRatingHistory() *PlayerUpdate {
+	pu.mutation.ClearRatingHistory()
+	return pu
+}
+
+// RemoveRatingHistoryIDs removes the "rating_history" edge to RatingHistory entities by IDs.
+func (pu *PlayerUpdate) RemoveRatingHistoryIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.RemoveRatingHistoryIDs(ids...)
+	return pu
+}
+
+// RemoveRatingHistory removes "rating_history" edges to RatingHistory entities.
+func (pu *PlayerUpdate) RemoveRatingHistory(r ...*RatingHistory) *PlayerUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveRatingHistoryIDs(ids...)
+}
+
+// ClearStandings clears all "standings" edges to the Standing entity.
+func (pu *PlayerUpdate) ClearStandings() *PlayerUpdate {
+	pu.mutation.ClearStandings()
+	return pu
+}
+
+// RemoveStandingIDs removes the "standings" edge to Standing entities by IDs.
+func (pu *PlayerUpdate) RemoveStandingIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.RemoveStandingIDs(ids...)
+	return pu
+}
+
+// RemoveStandings removes "standings" edges to Standing entities.
+func (pu *PlayerUpdate) RemoveStandings(s ...*Standing) *PlayerUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.RemoveStandingIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PlayerUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
@@ -136,6 +253,9 @@ func (pu *PlayerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Gcid(); ok {
 		_spec.SetField(player.FieldGcid, field.TypeString, value)
 	}
+	if pu.mutation.GcidCleared() {
+		_spec.ClearField(player.FieldGcid, field.TypeString)
+	}
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.SetField(player.FieldName, field.TypeString, value)
 	}
@@ -184,6 +304,141 @@ func (pu *PlayerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedRatingsIDs(); len(nodes) > 0 && !pu.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.RatingHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingHistoryTable,
+			Columns: []string{player.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedRatingHistoryIDs(); len(nodes) > 0 && !pu.mutation.RatingHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingHistoryTable,
+			Columns: []string{player.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RatingHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingHistoryTable,
+			Columns: []string{player.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.StandingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.StandingsTable,
+			Columns: []string{player.StandingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(standing.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedStandingsIDs(); len(nodes) > 0 && !pu.mutation.StandingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.StandingsTable,
+			Columns: []string{player.StandingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(standing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.StandingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.StandingsTable,
+			Columns: []string{player.StandingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(standing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{player.Label}
@@ -218,6 +473,12 @@ func (puo *PlayerUpdateOne) SetNillableGcid(s *string) *PlayerUpdateOne {
 	return puo
 }
 
+// ClearGcid clears the value of the "gcid" field.
+func (puo *PlayerUpdateOne) ClearGcid() *PlayerUpdateOne {
+	puo.mutation.ClearGcid()
+	return puo
+}
+
 // SetName sets the "name" field.
 func (puo *PlayerUpdateOne) SetName(s string) *PlayerUpdateOne {
 	puo.mutation.SetName(s)
@@ -247,6 +508,51 @@ func (puo *PlayerUpdateOne) AddRoles(p ...*PlayerRole) *PlayerUpdateOne {
 	return puo.AddRoleIDs(ids...)
 }
 
+// AddRatingIDs adds the "ratings" edge to the Rating entity by IDs.
+func (puo *PlayerUpdateOne) AddRatingIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.AddRatingIDs(ids...)
+	return puo
+}
+
+// AddRatings adds the "ratings" edges to the Rating entity.
+func (puo *PlayerUpdateOne) AddRatings(r ...*Rating) *PlayerUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddRatingIDs(ids...)
+}
+
+// AddRatingHistoryIDs adds the "rating_history" edge to the RatingHistory entity by IDs.
+func (puo *PlayerUpdateOne) AddRatingHistoryIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.AddRatingHistoryIDs(ids...)
+	return puo
+}
+
+// AddRatingHistory adds the "rating_history" edges to the RatingHistory entity.
+func (puo *PlayerUpdateOne) AddRatingHistory(r ...*RatingHistory) *PlayerUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddRatingHistoryIDs(ids...)
+}
+
+// AddStandingIDs adds the "standings" edge to the Standing entity by IDs.
+func (puo *PlayerUpdateOne) AddStandingIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.AddStandingIDs(ids...)
+	return puo
+}
+
+// AddStandings adds the "standings" edges to the Standing entity.
+func (puo *PlayerUpdateOne) AddStandings(s ...*Standing) *PlayerUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.AddStandingIDs(ids...)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (puo *PlayerUpdateOne) Mutation() *PlayerMutation {
 	return puo.mutation
@@ -273,6 +579,69 @@ func (puo *PlayerUpdateOne) RemoveRoles(p ...*PlayerRole) *PlayerUpdateOne {
 	return puo.RemoveRoleIDs(ids...)
 }
 
+// ClearRatings clears all "ratings" edges to the Rating entity.
+func (puo *PlayerUpdateOne) ClearRatings() *PlayerUpdateOne {
+	puo.mutation.ClearRatings()
+	return puo
+}
+
+// RemoveRatingIDs removes the "ratings" edge to Rating entities by IDs.
+func (puo *PlayerUpdateOne) RemoveRatingIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.RemoveRatingIDs(ids...)
+	return puo
+}
+
+// RemoveRatings removes "ratings" edges to Rating entities.
+func (puo *PlayerUpdateOne) RemoveRatings(r ...*Rating) *PlayerUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveRatingIDs(ids...)
+}
+
+// ClearRatingHistory clears all "rating_history" edges to the RatingHistory entity.
+func (puo *PlayerUpdateOne) ClearRatingHistory() *PlayerUpdateOne {
+	puo.mutation.ClearRatingHistory()
+	return puo
+}
+
+// RemoveRatingHistoryIDs removes the "rating_history" edge to RatingHistory entities by IDs.
+func (puo *PlayerUpdateOne) RemoveRatingHistoryIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.RemoveRatingHistoryIDs(ids...)
+	return puo
+}
+
+// RemoveRatingHistory removes "rating_history" edges to RatingHistory entities.
+func (puo *PlayerUpdateOne) RemoveRatingHistory(r ...*RatingHistory) *PlayerUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveRatingHistoryIDs(ids...)
+}
+
+// ClearStandings clears all "standings" edges to the Standing entity.
+func (puo *PlayerUpdateOne) ClearStandings() *PlayerUpdateOne {
+	puo.mutation.ClearStandings()
+	return puo
+}
+
+// RemoveStandingIDs removes the "standings" edge to Standing entities by IDs.
+func (puo *PlayerUpdateOne) RemoveStandingIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.RemoveStandingIDs(ids...)
+	return puo
+}
+
+// RemoveStandings removes "standings" edges to Standing entities.
+func (puo *PlayerUpdateOne) RemoveStandings(s ...*Standing) *PlayerUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveStandingIDs(ids...)
+}
+
 // Where appends a list predicates to the PlayerUpdate builder.
 func (puo *PlayerUpdateOne) Where(ps ...predicate.Player) *PlayerUpdateOne {
 	puo.mutation.Where(ps...)
@@ -342,6 +711,9 @@ func (puo *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err err
 	if value, ok := puo.mutation.Gcid(); ok {
 		_spec.SetField(player.FieldGcid, field.TypeString, value)
 	}
+	if puo.mutation.GcidCleared() {
+		_spec.ClearField(player.FieldGcid, field.TypeString)
+	}
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.SetField(player.FieldName, field.TypeString, value)
 	}
@@ -390,6 +762,141 @@ func (puo *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedRatingsIDs(); len(nodes) > 0 && !puo.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.RatingHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingHistoryTable,
+			Columns: []string{player.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedRatingHistoryIDs(); len(nodes) > 0 && !puo.mutation.RatingHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingHistoryTable,
+			Columns: []string{player.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RatingHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingHistoryTable,
+			Columns: []string{player.RatingHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.StandingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.StandingsTable,
+			Columns: []string{player.StandingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(standing.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedStandingsIDs(); len(nodes) > 0 && !puo.mutation.StandingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.StandingsTable,
+			Columns: []string{player.StandingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(standing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.StandingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.StandingsTable,
+			Columns: []string{player.StandingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(standing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Player{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -8,6 +8,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	wits "github.com/kevindamm/wits-go"
 	"github.com/kevindamm/wits-go/ent/playerrole"
 )
 
@@ -16,14 +17,18 @@ type PlayerRole struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
-	// MatchID holds the value of the "match_id" field.
+	// foreign key to the matches table (Many-to-Many)
 	MatchID int `json:"match_id,omitempty"`
-	// PlayerID holds the value of the "player_id" field.
+	// foreign key to the players table (Many-to-Many)
 	PlayerID int `json:"player_id,omitempty"`
-	// Position holds the value of the "position" field.
+	// enumerated map position where base is located
 	Position int `json:"position,omitempty"`
-	// TurnOrder holds the value of the "turn_order" field.
+	// turn order is independent of base's map position
 	TurnOrder int `json:"turn_order,omitempty"`
+	// the race (and thus the special unit) the player chose
+	Race wits.UnitRaceEnum `json:"race,omitempty"`
+	// the match result (TerminalStatus) relative to this player
+	Result wits.TerminalStatus `json:"result,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PlayerRoleQuery when eager-loading is set.
 	Edges        PlayerRoleEdges `json:"edges"`
@@ -64,7 +69,7 @@ func (*PlayerRole) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case playerrole.FieldID, playerrole.FieldMatchID, playerrole.FieldPlayerID, playerrole.FieldPosition, playerrole.FieldTurnOrder:
+		case playerrole.FieldID, playerrole.FieldMatchID, playerrole.FieldPlayerID, playerrole.FieldPosition, playerrole.FieldTurnOrder, playerrole.FieldRace, playerrole.FieldResult:
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -111,6 +116,18 @@ func (pr *PlayerRole) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.TurnOrder = int(value.Int64)
 			}
+		case playerrole.FieldRace:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field race", values[i])
+			} else if value.Valid {
+				pr.Race = wits.UnitRaceEnum(value.Int64)
+			}
+		case playerrole.FieldResult:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				pr.Result = wits.TerminalStatus(value.Int64)
+			}
 		default:
 			pr.selectValues.Set(columns[i], values[i])
 		}
@@ -168,6 +185,12 @@ func (pr *PlayerRole) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("turn_order=")
 	builder.WriteString(fmt.Sprintf("%v", pr.TurnOrder))
+	builder.WriteString(", ")
+	builder.WriteString("race=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Race))
+	builder.WriteString(", ")
+	builder.WriteString("result=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Result))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -5,6 +5,7 @@ package playerrole
 import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	wits "github.com/kevindamm/wits-go"
 )
 
 const (
@@ -20,6 +21,10 @@ const (
 	FieldPosition = "position"
 	// FieldTurnOrder holds the string denoting the turn_order field in the database.
 	FieldTurnOrder = "turn_order"
+	// FieldRace holds the string denoting the race field in the database.
+	FieldRace = "race"
+	// FieldResult holds the string denoting the result field in the database.
+	FieldResult = "result"
 	// EdgeMatch holds the string denoting the match edge name in mutations.
 	EdgeMatch = "match"
 	// EdgePlayers holds the string denoting the players edge name in mutations.
@@ -45,6 +50,8 @@ var Columns = []string{
 	FieldPlayerID,
 	FieldPosition,
 	FieldTurnOrder,
+	FieldRace,
+	FieldResult,
 }
 
 var (
@@ -71,6 +78,10 @@ var (
 	PositionValidator func(int) error
 	// TurnOrderValidator is a validator for the "turn_order" field. It is called by the builders before save.
 	TurnOrderValidator func(int) error
+	// DefaultRace holds the default value on creation for the "race" field.
+	DefaultRace wits.UnitRaceEnum
+	// DefaultResult holds the default value on creation for the "result" field.
+	DefaultResult wits.TerminalStatus
 )
 
 // OrderOption defines the ordering options for the PlayerRole queries.
@@ -101,6 +112,16 @@ func ByTurnOrder(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTurnOrder, opts...).ToFunc()
 }
 
+// ByRace orders the results by the race field.
+func ByRace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRace, opts...).ToFunc()
+}
+
+// ByResult orders the results by the result field.
+func ByResult(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResult, opts...).ToFunc()
+}
+
 // ByMatchCount orders the results by match count.
 func ByMatchCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -5,6 +5,7 @@ package playerrole
 import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	wits "github.com/kevindamm/wits-go"
 	"github.com/kevindamm/wits-go/ent/predicate"
 )
 
@@ -73,6 +74,18 @@ func TurnOrder(v int) predicate.PlayerRole {
 	return predicate.PlayerRole(sql.FieldEQ(FieldTurnOrder, v))
 }
 
+// Race applies equality check predicate on the "race" field. It's identical to RaceEQ.
+func Race(v wits.UnitRaceEnum) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldEQ(FieldRace, vc))
+}
+
+// Result applies equality check predicate on the "result" field. It's identical to ResultEQ.
+func Result(v wits.TerminalStatus) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldEQ(FieldResult, vc))
+}
+
 // MatchIDEQ applies the EQ predicate on the "match_id" field.
 func MatchIDEQ(v int) predicate.PlayerRole {
 	return predicate.PlayerRole(sql.FieldEQ(FieldMatchID, v))
@@ -233,6 +246,114 @@ func TurnOrderLTE(v int) predicate.PlayerRole {
 	return predicate.PlayerRole(sql.FieldLTE(FieldTurnOrder, v))
 }
 
+// RaceEQ applies the EQ predicate on the "race" field.
+func RaceEQ(v wits.UnitRaceEnum) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldEQ(FieldRace, vc))
+}
+
+// RaceNEQ applies the NEQ predicate on the "race" field.
+func RaceNEQ(v wits.UnitRaceEnum) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldNEQ(FieldRace, vc))
+}
+
+// RaceIn applies the In predicate on the "race" field.
+func RaceIn(vs ...wits.UnitRaceEnum) predicate.PlayerRole {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint8(vs[i])
+	}
+	return predicate.PlayerRole(sql.FieldIn(FieldRace, v...))
+}
+
+// RaceNotIn applies the NotIn predicate on the "race" field.
+func RaceNotIn(vs ...wits.UnitRaceEnum) predicate.PlayerRole {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint8(vs[i])
+	}
+	return predicate.PlayerRole(sql.FieldNotIn(FieldRace, v...))
+}
+
+// RaceGT applies the GT predicate on the "race" field.
+func RaceGT(v wits.UnitRaceEnum) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldGT(FieldRace, vc))
+}
+
+// RaceGTE applies the GTE predicate on the "race" field.
+func RaceGTE(v wits.UnitRaceEnum) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldGTE(FieldRace, vc))
+}
+
+// RaceLT applies the LT predicate on the "race" field.
+func RaceLT(v wits.UnitRaceEnum) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldLT(FieldRace, vc))
+}
+
+// RaceLTE applies the LTE predicate on the "race" field.
+func RaceLTE(v wits.UnitRaceEnum) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldLTE(FieldRace, vc))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v wits.TerminalStatus) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldEQ(FieldResult, vc))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v wits.TerminalStatus) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldNEQ(FieldResult, vc))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...wits.TerminalStatus) predicate.PlayerRole {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint8(vs[i])
+	}
+	return predicate.PlayerRole(sql.FieldIn(FieldResult, v...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...wits.TerminalStatus) predicate.PlayerRole {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint8(vs[i])
+	}
+	return predicate.PlayerRole(sql.FieldNotIn(FieldResult, v...))
+}
+
+// ResultGT applies the GT predicate on the "result" field.
+func ResultGT(v wits.TerminalStatus) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldGT(FieldResult, vc))
+}
+
+// ResultGTE applies the GTE predicate on the "result" field.
+func ResultGTE(v wits.TerminalStatus) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldGTE(FieldResult, vc))
+}
+
+// ResultLT applies the LT predicate on the "result" field.
+func ResultLT(v wits.TerminalStatus) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldLT(FieldResult, vc))
+}
+
+// ResultLTE applies the LTE predicate on the "result" field.
+func ResultLTE(v wits.TerminalStatus) predicate.PlayerRole {
+	vc := uint8(v)
+	return predicate.PlayerRole(sql.FieldLTE(FieldResult, vc))
+}
+
 // HasMatch applies the HasEdge predicate on the "match" edge.
 func HasMatch() predicate.PlayerRole {
 	return predicate.PlayerRole(func(s *sql.Selector) {
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	wits "github.com/kevindamm/wits-go"
 	"github.com/kevindamm/wits-go/ent/match"
 	"github.com/kevindamm/wits-go/ent/player"
 	"github.com/kevindamm/wits-go/ent/playerrole"
@@ -45,6 +46,34 @@ func (prc *PlayerRoleCreate) SetTurnOrder(i int) *PlayerRoleCreate {
 	return prc
 }
 
+// SetRace sets the "race" field.
+func (prc *PlayerRoleCreate) SetRace(wre wits.UnitRaceEnum) *PlayerRoleCreate {
+	prc.mutation.SetRace(wre)
+	return prc
+}
+
+// SetNillableRace sets the "race" field if the given value is not nil.
+func (prc *PlayerRoleCreate) SetNillableRace(wre *wits.UnitRaceEnum) *PlayerRoleCreate {
+	if wre != nil {
+		prc.SetRace(*wre)
+	}
+	return prc
+}
+
+// SetResult sets the "result" field.
+func (prc *PlayerRoleCreate) SetResult(ws wits.TerminalStatus) *PlayerRoleCreate {
+	prc.mutation.SetResult(ws)
+	return prc
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (prc *PlayerRoleCreate) SetNillableResult(ws *wits.TerminalStatus) *PlayerRoleCreate {
+	if ws != nil {
+		prc.SetResult(*ws)
+	}
+	return prc
+}
+
 // AddMatchIDs adds the "match" edge to the Match entity by IDs.
 func (prc *PlayerRoleCreate) AddMatchIDs(ids ...int) *PlayerRoleCreate {
 	prc.mutation.AddMatchIDs(ids...)
@@ -82,6 +111,7 @@ func (prc *PlayerRoleCreate) Mutation() *PlayerRoleMutation {
 
 // Save creates the PlayerRole in the database.
 func (prc *PlayerRoleCreate) Save(ctx context.Context) (*PlayerRole, error) {
+	prc.defaults()
 	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
 }
 
@@ -107,6 +137,18 @@ func (prc *PlayerRoleCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (prc *PlayerRoleCreate) defaults() {
+	if _, ok := prc.mutation.Race(); !ok {
+		v := playerrole.DefaultRace
+		prc.mutation.SetRace(v)
+	}
+	if _, ok := prc.mutation.Result(); !ok {
+		v := playerrole.DefaultResult
+		prc.mutation.SetResult(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (prc *PlayerRoleCreate) check() error {
 	if _, ok := prc.mutation.MatchID(); !ok {
@@ -131,6 +173,12 @@ func (prc *PlayerRoleCreate) check() error {
 			return &ValidationError{Name: "turn_order", err: fmt.Errorf(`ent: validator failed for field "PlayerRole.turn_order": %w`, err)}
 		}
 	}
+	if _, ok := prc.mutation.Race(); !ok {
+		return &ValidationError{Name: "race", err: errors.New(`ent: missing required field "PlayerRole.race"`)}
+	}
+	if _, ok := prc.mutation.Result(); !ok {
+		return &ValidationError{Name: "result", err: errors.New(`ent: missing required field "PlayerRole.result"`)}
+	}
 	if len(prc.mutation.MatchIDs()) == 0 {
 		return &ValidationError{Name: "match", err: errors.New(`ent: missing required edge "PlayerRole.match"`)}
 	}
@@ -179,6 +227,14 @@ func (prc *PlayerRoleCreate) createSpec() (*PlayerRole, *sqlgraph.CreateSpec) {
 		_spec.SetField(playerrole.FieldTurnOrder, field.TypeInt, value)
 		_node.TurnOrder = value
 	}
+	if value, ok := prc.mutation.Race(); ok {
+		_spec.SetField(playerrole.FieldRace, field.TypeUint8, value)
+		_node.Race = value
+	}
+	if value, ok := prc.mutation.Result(); ok {
+		_spec.SetField(playerrole.FieldResult, field.TypeUint8, value)
+		_node.Result = value
+	}
 	if nodes := prc.mutation.MatchIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -232,6 +288,7 @@ func (prcb *PlayerRoleCreateBulk) Save(ctx context.Context) ([]*PlayerRole, erro
 	for i := range prcb.builders {
 		func(i int, root context.Context) {
 			builder := prcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*PlayerRoleMutation)
 				if !ok {
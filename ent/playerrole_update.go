@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	wits "github.com/kevindamm/wits-go"
 	"github.com/kevindamm/wits-go/ent/match"
 	"github.com/kevindamm/wits-go/ent/player"
 	"github.com/kevindamm/wits-go/ent/playerrole"
@@ -113,6 +114,48 @@ func (pru *PlayerRoleUpdate) AddTurnOrder(i int) *PlayerRoleUpdate {
 	return pru
 }
 
+// SetRace sets the "race" field.
+func (pru *PlayerRoleUpdate) SetRace(wre wits.UnitRaceEnum) *PlayerRoleUpdate {
+	pru.mutation.ResetRace()
+	pru.mutation.SetRace(wre)
+	return pru
+}
+
+// SetNillableRace sets the "race" field if the given value is not nil.
+func (pru *PlayerRoleUpdate) SetNillableRace(wre *wits.UnitRaceEnum) *PlayerRoleUpdate {
+	if wre != nil {
+		pru.SetRace(*wre)
+	}
+	return pru
+}
+
+// AddRace adds wre to the "race" field.
+func (pru *PlayerRoleUpdate) AddRace(wre wits.UnitRaceEnum) *PlayerRoleUpdate {
+	pru.mutation.AddRace(wre)
+	return pru
+}
+
+// SetResult sets the "result" field.
+func (pru *PlayerRoleUpdate) SetResult(ws wits.TerminalStatus) *PlayerRoleUpdate {
+	pru.mutation.ResetResult()
+	pru.mutation.SetResult(ws)
+	return pru
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (pru *PlayerRoleUpdate) SetNillableResult(ws *wits.TerminalStatus) *PlayerRoleUpdate {
+	if ws != nil {
+		pru.SetResult(*ws)
+	}
+	return pru
+}
+
+// AddResult adds ws to the "result" field.
+func (pru *PlayerRoleUpdate) AddResult(ws wits.TerminalStatus) *PlayerRoleUpdate {
+	pru.mutation.AddResult(ws)
+	return pru
+}
+
 // AddMatchIDs adds the "match" edge to the Match entity by IDs.
 func (pru *PlayerRoleUpdate) AddMatchIDs(ids ...int) *PlayerRoleUpdate {
 	pru.mutation.AddMatchIDs(ids...)
@@ -268,6 +311,18 @@ func (pru *PlayerRoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pru.mutation.AddedTurnOrder(); ok {
 		_spec.AddField(playerrole.FieldTurnOrder, field.TypeInt, value)
 	}
+	if value, ok := pru.mutation.Race(); ok {
+		_spec.SetField(playerrole.FieldRace, field.TypeUint8, value)
+	}
+	if value, ok := pru.mutation.AddedRace(); ok {
+		_spec.AddField(playerrole.FieldRace, field.TypeUint8, value)
+	}
+	if value, ok := pru.mutation.Result(); ok {
+		_spec.SetField(playerrole.FieldResult, field.TypeUint8, value)
+	}
+	if value, ok := pru.mutation.AddedResult(); ok {
+		_spec.AddField(playerrole.FieldResult, field.TypeUint8, value)
+	}
 	if pru.mutation.MatchCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -462,6 +517,48 @@ func (pruo *PlayerRoleUpdateOne) AddTurnOrder(i int) *PlayerRoleUpdateOne {
 	return pruo
 }
 
+// SetRace sets the "race" field.
+func (pruo *PlayerRoleUpdateOne) SetRace(wre wits.UnitRaceEnum) *PlayerRoleUpdateOne {
+	pruo.mutation.ResetRace()
+	pruo.mutation.SetRace(wre)
+	return pruo
+}
+
+// SetNillableRace sets the "race" field if the given value is not nil.
+func (pruo *PlayerRoleUpdateOne) SetNillableRace(wre *wits.UnitRaceEnum) *PlayerRoleUpdateOne {
+	if wre != nil {
+		pruo.SetRace(*wre)
+	}
+	return pruo
+}
+
+// AddRace adds wre to the "race" field.
+func (pruo *PlayerRoleUpdateOne) AddRace(wre wits.UnitRaceEnum) *PlayerRoleUpdateOne {
+	pruo.mutation.AddRace(wre)
+	return pruo
+}
+
+// SetResult sets the "result" field.
+func (pruo *PlayerRoleUpdateOne) SetResult(ws wits.TerminalStatus) *PlayerRoleUpdateOne {
+	pruo.mutation.ResetResult()
+	pruo.mutation.SetResult(ws)
+	return pruo
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (pruo *PlayerRoleUpdateOne) SetNillableResult(ws *wits.TerminalStatus) *PlayerRoleUpdateOne {
+	if ws != nil {
+		pruo.SetResult(*ws)
+	}
+	return pruo
+}
+
+// AddResult adds ws to the "result" field.
+func (pruo *PlayerRoleUpdateOne) AddResult(ws wits.TerminalStatus) *PlayerRoleUpdateOne {
+	pruo.mutation.AddResult(ws)
+	return pruo
+}
+
 // AddMatchIDs adds the "match" edge to the Match entity by IDs.
 func (pruo *PlayerRoleUpdateOne) AddMatchIDs(ids ...int) *PlayerRoleUpdateOne {
 	pruo.mutation.AddMatchIDs(ids...)
@@ -647,6 +744,18 @@ func (pruo *PlayerRoleUpdateOne) sqlSave(ctx context.Context) (_node *PlayerRole
 	if value, ok := pruo.mutation.AddedTurnOrder(); ok {
 		_spec.AddField(playerrole.FieldTurnOrder, field.TypeInt, value)
 	}
+	if value, ok := pruo.mutation.Race(); ok {
+		_spec.SetField(playerrole.FieldRace, field.TypeUint8, value)
+	}
+	if value, ok := pruo.mutation.AddedRace(); ok {
+		_spec.AddField(playerrole.FieldRace, field.TypeUint8, value)
+	}
+	if value, ok := pruo.mutation.Result(); ok {
+		_spec.SetField(playerrole.FieldResult, field.TypeUint8, value)
+	}
+	if value, ok := pruo.mutation.AddedResult(); ok {
+		_spec.AddField(playerrole.FieldResult, field.TypeUint8, value)
+	}
 	if pruo.mutation.MatchCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
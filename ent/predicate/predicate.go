@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Action is the predicate function for action builders.
+type Action func(*sql.Selector)
+
 // Match is the predicate function for match builders.
 type Match func(*sql.Selector)
 
@@ -17,3 +20,15 @@ type Player func(*sql.Selector)
 
 // PlayerRole is the predicate function for playerrole builders.
 type PlayerRole func(*sql.Selector)
+
+// Rating is the predicate function for rating builders.
+type Rating func(*sql.Selector)
+
+// RatingHistory is the predicate function for ratinghistory builders.
+type RatingHistory func(*sql.Selector)
+
+// Standing is the predicate function for standing builders.
+type Standing func(*sql.Selector)
+
+// Turn is the predicate function for turn builders.
+type Turn func(*sql.Selector)
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kevindamm/wits-go/ent/player"
+	"github.com/kevindamm/wits-go/ent/rating"
+)
+
+// Rating is the model entity for the Rating schema.
+type Rating struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Solo (1v1) and duos (2v2) matches are rated separately.
+	Mode rating.Mode `json:"mode,omitempty"`
+	// Glicko-2 rating, on the familiar (Glicko) scale centered at 1500.
+	Rating float64 `json:"rating,omitempty"`
+	// Rating deviation, the uncertainty in the rating.
+	Deviation float64 `json:"deviation,omitempty"`
+	// The expected fluctuation in the rating.
+	Volatility float64 `json:"volatility,omitempty"`
+	// Games holds the value of the "games" field.
+	Games int `json:"games,omitempty"`
+	// When the last rated match was played.
+	UpdatedTs time.Time `json:"updated_ts,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RatingQuery when eager-loading is set.
+	Edges          RatingEdges `json:"edges"`
+	player_ratings *int
+	selectValues   sql.SelectValues
+}
+
+// RatingEdges holds the relations/edges for other nodes in the graph.
+type RatingEdges struct {
+	// Player holds the value of the player edge.
+	Player *Player `json:"player,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlayerOrErr returns the Player value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RatingEdges) PlayerOrErr() (*Player, error) {
+	if e.Player != nil {
+		return e.Player, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: player.Label}
+	}
+	return nil, &NotLoadedError{edge: "player"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Rating) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rating.FieldRating, rating.FieldDeviation, rating.FieldVolatility:
+			values[i] = new(sql.NullFloat64)
+		case rating.FieldID, rating.FieldGames:
+			values[i] = new(sql.NullInt64)
+		case rating.FieldMode:
+			values[i] = new(sql.NullString)
+		case rating.FieldUpdatedTs:
+			values[i] = new(sql.NullTime)
+		case rating.ForeignKeys[0]: // player_ratings
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Rating fields.
+func (r *Rating) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rating.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case rating.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				r.Mode = rating.Mode(value.String)
+			}
+		case rating.FieldRating:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				r.Rating = value.Float64
+			}
+		case rating.FieldDeviation:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field deviation", values[i])
+			} else if value.Valid {
+				r.Deviation = value.Float64
+			}
+		case rating.FieldVolatility:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field volatility", values[i])
+			} else if value.Valid {
+				r.Volatility = value.Float64
+			}
+		case rating.FieldGames:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field games", values[i])
+			} else if value.Valid {
+				r.Games = int(value.Int64)
+			}
+		case rating.FieldUpdatedTs:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_ts", values[i])
+			} else if value.Valid {
+				r.UpdatedTs = value.Time
+			}
+		case rating.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field player_ratings", value)
+			} else if value.Valid {
+				r.player_ratings = new(int)
+				*r.player_ratings = int(value.Int64)
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Rating.
+// This includes values selected through modifiers, order, etc.
+func (r *Rating) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryPlayer queries the "player" edge of the Rating entity.
+func (r *Rating) QueryPlayer() *PlayerQuery {
+	return NewRatingClient(r.config).QueryPlayer(r)
+}
+
+// Update returns a builder for updating this Rating.
+// Note that you need to call Rating.Unwrap() before calling this method if this Rating
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Rating) Update() *RatingUpdateOne {
+	return NewRatingClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Rating entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Rating) Unwrap() *Rating {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Rating is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Rating) String() string {
+	var builder strings.Builder
+	builder.WriteString("Rating(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", r.Mode))
+	builder.WriteString(", ")
+	builder.WriteString("rating=")
+	builder.WriteString(fmt.Sprintf("%v", r.Rating))
+	builder.WriteString(", ")
+	builder.WriteString("deviation=")
+	builder.WriteString(fmt.Sprintf("%v", r.Deviation))
+	builder.WriteString(", ")
+	builder.WriteString("volatility=")
+	builder.WriteString(fmt.Sprintf("%v", r.Volatility))
+	builder.WriteString(", ")
+	builder.WriteString("games=")
+	builder.WriteString(fmt.Sprintf("%v", r.Games))
+	builder.WriteString(", ")
+	builder.WriteString("updated_ts=")
+	builder.WriteString(r.UpdatedTs.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Ratings is a parsable slice of Rating.
+type Ratings []*Rating
@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rating
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the rating type in the database.
+	Label = "rating"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldRating holds the string denoting the rating field in the database.
+	FieldRating = "rating"
+	// FieldDeviation holds the string denoting the deviation field in the database.
+	FieldDeviation = "deviation"
+	// FieldVolatility holds the string denoting the volatility field in the database.
+	FieldVolatility = "volatility"
+	// FieldGames holds the string denoting the games field in the database.
+	FieldGames = "games"
+	// FieldUpdatedTs holds the string denoting the updated_ts field in the database.
+	FieldUpdatedTs = "updated_ts"
+	// EdgePlayer holds the string denoting the player edge name in mutations.
+	EdgePlayer = "player"
+	// Table holds the table name of the rating in the database.
+	Table = "ratings"
+	// PlayerTable is the table that holds the player relation/edge.
+	PlayerTable = "ratings"
+	// PlayerInverseTable is the table name for the Player entity.
+	// It exists in this package in order to avoid circular dependency with the "player" package.
+	PlayerInverseTable = "players"
+	// PlayerColumn is the table column denoting the player relation/edge.
+	PlayerColumn = "player_ratings"
+)
+
+// Columns holds all SQL columns for rating fields.
+var Columns = []string{
+	FieldID,
+	FieldMode,
+	FieldRating,
+	FieldDeviation,
+	FieldVolatility,
+	FieldGames,
+	FieldUpdatedTs,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "ratings"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"player_ratings",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultGames holds the default value on creation for the "games" field.
+	DefaultGames int
+	// GamesValidator is a validator for the "games" field. It is called by the builders before save.
+	GamesValidator func(int) error
+	// DefaultUpdatedTs holds the default value on creation for the "updated_ts" field.
+	DefaultUpdatedTs func() time.Time
+)
+
+// Mode defines the type for the "mode" enum field.
+type Mode string
+
+// Mode values.
+const (
+	ModeSOLO Mode = "SOLO"
+	ModeDUOS Mode = "DUOS"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
+func ModeValidator(m Mode) error {
+	switch m {
+	case ModeSOLO, ModeDUOS:
+		return nil
+	default:
+		return fmt.Errorf("rating: invalid enum value for mode field: %q", m)
+	}
+}
+
+// OrderOption defines the ordering options for the Rating queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByRating orders the results by the rating field.
+func ByRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRating, opts...).ToFunc()
+}
+
+// ByDeviation orders the results by the deviation field.
+func ByDeviation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviation, opts...).ToFunc()
+}
+
+// ByVolatility orders the results by the volatility field.
+func ByVolatility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVolatility, opts...).ToFunc()
+}
+
+// ByGames orders the results by the games field.
+func ByGames(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGames, opts...).ToFunc()
+}
+
+// ByUpdatedTs orders the results by the updated_ts field.
+func ByUpdatedTs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedTs, opts...).ToFunc()
+}
+
+// ByPlayerField orders the results by player field.
+func ByPlayerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlayerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPlayerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlayerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+	)
+}
@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rating
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kevindamm/wits-go/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Rating {
+	return predicate.Rating(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Rating {
+	return predicate.Rating(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Rating {
+	return predicate.Rating(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Rating {
+	return predicate.Rating(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Rating {
+	return predicate.Rating(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Rating {
+	return predicate.Rating(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Rating {
+	return predicate.Rating(sql.FieldLTE(FieldID, id))
+}
+
+// Rating applies equality check predicate on the "rating" field. It's identical to RatingEQ.
+func Rating(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldRating, v))
+}
+
+// Deviation applies equality check predicate on the "deviation" field. It's identical to DeviationEQ.
+func Deviation(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldDeviation, v))
+}
+
+// Volatility applies equality check predicate on the "volatility" field. It's identical to VolatilityEQ.
+func Volatility(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldVolatility, v))
+}
+
+// Games applies equality check predicate on the "games" field. It's identical to GamesEQ.
+func Games(v int) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldGames, v))
+}
+
+// UpdatedTs applies equality check predicate on the "updated_ts" field. It's identical to UpdatedTsEQ.
+func UpdatedTs(v time.Time) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldUpdatedTs, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v Mode) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v Mode) predicate.Rating {
+	return predicate.Rating(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...Mode) predicate.Rating {
+	return predicate.Rating(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...Mode) predicate.Rating {
+	return predicate.Rating(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// RatingEQ applies the EQ predicate on the "rating" field.
+func RatingEQ(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldRating, v))
+}
+
+// RatingNEQ applies the NEQ predicate on the "rating" field.
+func RatingNEQ(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldNEQ(FieldRating, v))
+}
+
+// RatingIn applies the In predicate on the "rating" field.
+func RatingIn(vs ...float64) predicate.Rating {
+	return predicate.Rating(sql.FieldIn(FieldRating, vs...))
+}
+
+// RatingNotIn applies the NotIn predicate on the "rating" field.
+func RatingNotIn(vs ...float64) predicate.Rating {
+	return predicate.Rating(sql.FieldNotIn(FieldRating, vs...))
+}
+
+// RatingGT applies the GT predicate on the "rating" field.
+func RatingGT(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldGT(FieldRating, v))
+}
+
+// RatingGTE applies the GTE predicate on the "rating" field.
+func RatingGTE(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldGTE(FieldRating, v))
+}
+
+// RatingLT applies the LT predicate on the "rating" field.
+func RatingLT(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldLT(FieldRating, v))
+}
+
+// RatingLTE applies the LTE predicate on the "rating" field.
+func RatingLTE(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldLTE(FieldRating, v))
+}
+
+// DeviationEQ applies the EQ predicate on the "deviation" field.
+func DeviationEQ(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldDeviation, v))
+}
+
+// DeviationNEQ applies the NEQ predicate on the "deviation" field.
+func DeviationNEQ(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldNEQ(FieldDeviation, v))
+}
+
+// DeviationIn applies the In predicate on the "deviation" field.
+func DeviationIn(vs ...float64) predicate.Rating {
+	return predicate.Rating(sql.FieldIn(FieldDeviation, vs...))
+}
+
+// DeviationNotIn applies the NotIn predicate on the "deviation" field.
+func DeviationNotIn(vs ...float64) predicate.Rating {
+	return predicate.Rating(sql.FieldNotIn(FieldDeviation, vs...))
+}
+
+// DeviationGT applies the GT predicate on the "deviation" field.
+func DeviationGT(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldGT(FieldDeviation, v))
+}
+
+// DeviationGTE applies the GTE predicate on the "deviation" field.
+func DeviationGTE(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldGTE(FieldDeviation, v))
+}
+
+// DeviationLT applies the LT predicate on the "deviation" field.
+func DeviationLT(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldLT(FieldDeviation, v))
+}
+
+// DeviationLTE applies the LTE predicate on the "deviation" field.
+func DeviationLTE(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldLTE(FieldDeviation, v))
+}
+
+// VolatilityEQ applies the EQ predicate on the "volatility" field.
+func VolatilityEQ(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldVolatility, v))
+}
+
+// VolatilityNEQ applies the NEQ predicate on the "volatility" field.
+func VolatilityNEQ(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldNEQ(FieldVolatility, v))
+}
+
+// VolatilityIn applies the In predicate on the "volatility" field.
+func VolatilityIn(vs ...float64) predicate.Rating {
+	return predicate.Rating(sql.FieldIn(FieldVolatility, vs...))
+}
+
+// VolatilityNotIn applies the NotIn predicate on the "volatility" field.
+func VolatilityNotIn(vs ...float64) predicate.Rating {
+	return predicate.Rating(sql.FieldNotIn(FieldVolatility, vs...))
+}
+
+// VolatilityGT applies the GT predicate on the "volatility" field.
+func VolatilityGT(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldGT(FieldVolatility, v))
+}
+
+// VolatilityGTE applies the GTE predicate on the "volatility" field.
+func VolatilityGTE(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldGTE(FieldVolatility, v))
+}
+
+// VolatilityLT applies the LT predicate on the "volatility" field.
+func VolatilityLT(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldLT(FieldVolatility, v))
+}
+
+// VolatilityLTE applies the LTE predicate on the "volatility" field.
+func VolatilityLTE(v float64) predicate.Rating {
+	return predicate.Rating(sql.FieldLTE(FieldVolatility, v))
+}
+
+// GamesEQ applies the EQ predicate on the "games" field.
+func GamesEQ(v int) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldGames, v))
+}
+
+// GamesNEQ applies the NEQ predicate on the "games" field.
+func GamesNEQ(v int) predicate.Rating {
+	return predicate.Rating(sql.FieldNEQ(FieldGames, v))
+}
+
+// GamesIn applies the In predicate on the "games" field.
+func GamesIn(vs ...int) predicate.Rating {
+	return predicate.Rating(sql.FieldIn(FieldGames, vs...))
+}
+
+// GamesNotIn applies the NotIn predicate on the "games" field.
+func GamesNotIn(vs ...int) predicate.Rating {
+	return predicate.Rating(sql.FieldNotIn(FieldGames, vs...))
+}
+
+// GamesGT applies the GT predicate on the "games" field.
+func GamesGT(v int) predicate.Rating {
+	return predicate.Rating(sql.FieldGT(FieldGames, v))
+}
+
+// GamesGTE applies the GTE predicate on the "games" field.
+func GamesGTE(v int) predicate.Rating {
+	return predicate.Rating(sql.FieldGTE(FieldGames, v))
+}
+
+// GamesLT applies the LT predicate on the "games" field.
+func GamesLT(v int) predicate.Rating {
+	return predicate.Rating(sql.FieldLT(FieldGames, v))
+}
+
+// GamesLTE applies the LTE predicate on the "games" field.
+func GamesLTE(v int) predicate.Rating {
+	return predicate.Rating(sql.FieldLTE(FieldGames, v))
+}
+
+// UpdatedTsEQ applies the EQ predicate on the "updated_ts" field.
+func UpdatedTsEQ(v time.Time) predicate.Rating {
+	return predicate.Rating(sql.FieldEQ(FieldUpdatedTs, v))
+}
+
+// UpdatedTsNEQ applies the NEQ predicate on the "updated_ts" field.
+func UpdatedTsNEQ(v time.Time) predicate.Rating {
+	return predicate.Rating(sql.FieldNEQ(FieldUpdatedTs, v))
+}
+
+// UpdatedTsIn applies the In predicate on the "updated_ts" field.
+func UpdatedTsIn(vs ...time.Time) predicate.Rating {
+	return predicate.Rating(sql.FieldIn(FieldUpdatedTs, vs...))
+}
+
+// UpdatedTsNotIn applies the NotIn predicate on the "updated_ts" field.
+func UpdatedTsNotIn(vs ...time.Time) predicate.Rating {
+	return predicate.Rating(sql.FieldNotIn(FieldUpdatedTs, vs...))
+}
+
+// UpdatedTsGT applies the GT predicate on the "updated_ts" field.
+func UpdatedTsGT(v time.Time) predicate.Rating {
+	return predicate.Rating(sql.FieldGT(FieldUpdatedTs, v))
+}
+
+// UpdatedTsGTE applies the GTE predicate on the "updated_ts" field.
+func UpdatedTsGTE(v time.Time) predicate.Rating {
+	return predicate.Rating(sql.FieldGTE(FieldUpdatedTs, v))
+}
+
+// UpdatedTsLT applies the LT predicate on the "updated_ts" field.
+func UpdatedTsLT(v time.Time) predicate.Rating {
+	return predicate.Rating(sql.FieldLT(FieldUpdatedTs, v))
+}
+
+// UpdatedTsLTE applies the LTE predicate on the "updated_ts" field.
+func UpdatedTsLTE(v time.Time) predicate.Rating {
+	return predicate.Rating(sql.FieldLTE(FieldUpdatedTs, v))
+}
+
+// HasPlayer applies the HasEdge predicate on the "player" edge.
+func HasPlayer() predicate.Rating {
+	return predicate.Rating(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlayerWith applies the HasEdge predicate on the "player" edge with a given conditions (other predicates).
+func HasPlayerWith(preds ...predicate.Player) predicate.Rating {
+	return predicate.Rating(func(s *sql.Selector) {
+		step := newPlayerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Rating) predicate.Rating {
+	return predicate.Rating(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Rating) predicate.Rating {
+	return predicate.Rating(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Rating) predicate.Rating {
+	return predicate.Rating(sql.NotPredicates(p))
+}
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/ent/player"
+	"github.com/kevindamm/wits-go/ent/rating"
+)
+
+// RatingCreate is the builder for creating a Rating entity.
+type RatingCreate struct {
+	config
+	mutation *RatingMutation
+	hooks    []Hook
+}
+
+// SetMode sets the "mode" field.
+func (rc *RatingCreate) SetMode(r rating.Mode) *RatingCreate {
+	rc.mutation.SetMode(r)
+	return rc
+}
+
+// SetRating sets the "rating" field.
+func (rc *RatingCreate) SetRating(f float64) *RatingCreate {
+	rc.mutation.SetRating(f)
+	return rc
+}
+
+// SetDeviation sets the "deviation" field.
+func (rc *RatingCreate) SetDeviation(f float64) *RatingCreate {
+	rc.mutation.SetDeviation(f)
+	return rc
+}
+
+// SetVolatility sets the "volatility" field.
+func (rc *RatingCreate) SetVolatility(f float64) *RatingCreate {
+	rc.mutation.SetVolatility(f)
+	return rc
+}
+
+// SetGames sets the "games" field.
+func (rc *RatingCreate) SetGames(i int) *RatingCreate {
+	rc.mutation.SetGames(i)
+	return rc
+}
+
+// SetNillableGames sets the "games" field if the given value is not nil.
+func (rc *RatingCreate) SetNillableGames(i *int) *RatingCreate {
+	if i != nil {
+		rc.SetGames(*i)
+	}
+	return rc
+}
+
+// SetUpdatedTs sets the "updated_ts" field.
+func (rc *RatingCreate) SetUpdatedTs(t time.Time) *RatingCreate {
+	rc.mutation.SetUpdatedTs(t)
+	return rc
+}
+
+// SetNillableUpdatedTs sets the "updated_ts" field if the given value is not nil.
+func (rc *RatingCreate) SetNillableUpdatedTs(t *time.Time) *RatingCreate {
+	if t != nil {
+		rc.SetUpdatedTs(*t)
+	}
+	return rc
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (rc *RatingCreate) SetPlayerID(id int) *RatingCreate {
+	rc.mutation.SetPlayerID(id)
+	return rc
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (rc *RatingCreate) SetPlayer(p *Player) *RatingCreate {
+	return rc.SetPlayerID(p.ID)
+}
+
+// Mutation returns the RatingMutation object of the builder.
+func (rc *RatingCreate) Mutation() *RatingMutation {
+	return rc.mutation
+}
+
+// Save creates the Rating in the database.
+func (rc *RatingCreate) Save(ctx context.Context) (*Rating, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RatingCreate) SaveX(ctx context.Context) *Rating {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RatingCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RatingCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RatingCreate) defaults() {
+	if _, ok := rc.mutation.Games(); !ok {
+		v := rating.DefaultGames
+		rc.mutation.SetGames(v)
+	}
+	if _, ok := rc.mutation.UpdatedTs(); !ok {
+		v := rating.DefaultUpdatedTs()
+		rc.mutation.SetUpdatedTs(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RatingCreate) check() error {
+	if _, ok := rc.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "Rating.mode"`)}
+	}
+	if v, ok := rc.mutation.Mode(); ok {
+		if err := rating.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Rating.mode": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Rating(); !ok {
+		return &ValidationError{Name: "rating", err: errors.New(`ent: missing required field "Rating.rating"`)}
+	}
+	if _, ok := rc.mutation.Deviation(); !ok {
+		return &ValidationError{Name: "deviation", err: errors.New(`ent: missing required field "Rating.deviation"`)}
+	}
+	if _, ok := rc.mutation.Volatility(); !ok {
+		return &ValidationError{Name: "volatility", err: errors.New(`ent: missing required field "Rating.volatility"`)}
+	}
+	if _, ok := rc.mutation.Games(); !ok {
+		return &ValidationError{Name: "games", err: errors.New(`ent: missing required field "Rating.games"`)}
+	}
+	if v, ok := rc.mutation.Games(); ok {
+		if err := rating.GamesValidator(v); err != nil {
+			return &ValidationError{Name: "games", err: fmt.Errorf(`ent: validator failed for field "Rating.games": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.UpdatedTs(); !ok {
+		return &ValidationError{Name: "updated_ts", err: errors.New(`ent: missing required field "Rating.updated_ts"`)}
+	}
+	if len(rc.mutation.PlayerIDs()) == 0 {
+		return &ValidationError{Name: "player", err: errors.New(`ent: missing required edge "Rating.player"`)}
+	}
+	return nil
+}
+
+func (rc *RatingCreate) sqlSave(ctx context.Context) (*Rating, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *RatingCreate) createSpec() (*Rating, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Rating{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(rating.Table, sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt))
+	)
+	if value, ok := rc.mutation.Mode(); ok {
+		_spec.SetField(rating.FieldMode, field.TypeEnum, value)
+		_node.Mode = value
+	}
+	if value, ok := rc.mutation.Rating(); ok {
+		_spec.SetField(rating.FieldRating, field.TypeFloat64, value)
+		_node.Rating = value
+	}
+	if value, ok := rc.mutation.Deviation(); ok {
+		_spec.SetField(rating.FieldDeviation, field.TypeFloat64, value)
+		_node.Deviation = value
+	}
+	if value, ok := rc.mutation.Volatility(); ok {
+		_spec.SetField(rating.FieldVolatility, field.TypeFloat64, value)
+		_node.Volatility = value
+	}
+	if value, ok := rc.mutation.Games(); ok {
+		_spec.SetField(rating.FieldGames, field.TypeInt, value)
+		_node.Games = value
+	}
+	if value, ok := rc.mutation.UpdatedTs(); ok {
+		_spec.SetField(rating.FieldUpdatedTs, field.TypeTime, value)
+		_node.UpdatedTs = value
+	}
+	if nodes := rc.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.PlayerTable,
+			Columns: []string{rating.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.player_ratings = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RatingCreateBulk is the builder for creating many Rating entities in bulk.
+type RatingCreateBulk struct {
+	config
+	err      error
+	builders []*RatingCreate
+}
+
+// Save creates the Rating entities in the database.
+func (rcb *RatingCreateBulk) Save(ctx context.Context) ([]*Rating, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Rating, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RatingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RatingCreateBulk) SaveX(ctx context.Context) []*Rating {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RatingCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RatingCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
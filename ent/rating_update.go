@@ -0,0 +1,595 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/ent/player"
+	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/rating"
+)
+
+// RatingUpdate is the builder for updating Rating entities.
+type RatingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RatingMutation
+}
+
+// Where appends a list predicates to the RatingUpdate builder.
+func (ru *RatingUpdate) Where(ps ...predicate.Rating) *RatingUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetMode sets the "mode" field.
+func (ru *RatingUpdate) SetMode(r rating.Mode) *RatingUpdate {
+	ru.mutation.SetMode(r)
+	return ru
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (ru *RatingUpdate) SetNillableMode(r *rating.Mode) *RatingUpdate {
+	if r != nil {
+		ru.SetMode(*r)
+	}
+	return ru
+}
+
+// SetRating sets the "rating" field.
+func (ru *RatingUpdate) SetRating(f float64) *RatingUpdate {
+	ru.mutation.ResetRating()
+	ru.mutation.SetRating(f)
+	return ru
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (ru *RatingUpdate) SetNillableRating(f *float64) *RatingUpdate {
+	if f != nil {
+		ru.SetRating(*f)
+	}
+	return ru
+}
+
+// AddRating adds f to the "rating" field.
+func (ru *RatingUpdate) AddRating(f float64) *RatingUpdate {
+	ru.mutation.AddRating(f)
+	return ru
+}
+
+// SetDeviation sets the "deviation" field.
+func (ru *RatingUpdate) SetDeviation(f float64) *RatingUpdate {
+	ru.mutation.ResetDeviation()
+	ru.mutation.SetDeviation(f)
+	return ru
+}
+
+// SetNillableDeviation sets the "deviation" field if the given value is not nil.
+func (ru *RatingUpdate) SetNillableDeviation(f *float64) *RatingUpdate {
+	if f != nil {
+		ru.SetDeviation(*f)
+	}
+	return ru
+}
+
+// AddDeviation adds f to the "deviation" field.
+func (ru *RatingUpdate) AddDeviation(f float64) *RatingUpdate {
+	ru.mutation.AddDeviation(f)
+	return ru
+}
+
+// SetVolatility sets the "volatility" field.
+func (ru *RatingUpdate) SetVolatility(f float64) *RatingUpdate {
+	ru.mutation.ResetVolatility()
+	ru.mutation.SetVolatility(f)
+	return ru
+}
+
+// SetNillableVolatility sets the "volatility" field if the given value is not nil.
+func (ru *RatingUpdate) SetNillableVolatility(f *float64) *RatingUpdate {
+	if f != nil {
+		ru.SetVolatility(*f)
+	}
+	return ru
+}
+
+// AddVolatility adds f to the "volatility" field.
+func (ru *RatingUpdate) AddVolatility(f float64) *RatingUpdate {
+	ru.mutation.AddVolatility(f)
+	return ru
+}
+
+// SetGames sets the "games" field.
+func (ru *RatingUpdate) SetGames(i int) *RatingUpdate {
+	ru.mutation.ResetGames()
+	ru.mutation.SetGames(i)
+	return ru
+}
+
+// SetNillableGames sets the "games" field if the given value is not nil.
+func (ru *RatingUpdate) SetNillableGames(i *int) *RatingUpdate {
+	if i != nil {
+		ru.SetGames(*i)
+	}
+	return ru
+}
+
+// AddGames adds i to the "games" field.
+func (ru *RatingUpdate) AddGames(i int) *RatingUpdate {
+	ru.mutation.AddGames(i)
+	return ru
+}
+
+// SetUpdatedTs sets the "updated_ts" field.
+func (ru *RatingUpdate) SetUpdatedTs(t time.Time) *RatingUpdate {
+	ru.mutation.SetUpdatedTs(t)
+	return ru
+}
+
+// SetNillableUpdatedTs sets the "updated_ts" field if the given value is not nil.
+func (ru *RatingUpdate) SetNillableUpdatedTs(t *time.Time) *RatingUpdate {
+	if t != nil {
+		ru.SetUpdatedTs(*t)
+	}
+	return ru
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (ru *RatingUpdate) SetPlayerID(id int) *RatingUpdate {
+	ru.mutation.SetPlayerID(id)
+	return ru
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (ru *RatingUpdate) SetPlayer(p *Player) *RatingUpdate {
+	return ru.SetPlayerID(p.ID)
+}
+
+// Mutation returns the RatingMutation object of the builder.
+func (ru *RatingUpdate) Mutation() *RatingMutation {
+	return ru.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (ru *RatingUpdate) ClearPlayer() *RatingUpdate {
+	ru.mutation.ClearPlayer()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RatingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RatingUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RatingUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RatingUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *RatingUpdate) check() error {
+	if v, ok := ru.mutation.Mode(); ok {
+		if err := rating.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Rating.mode": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Games(); ok {
+		if err := rating.GamesValidator(v); err != nil {
+			return &ValidationError{Name: "games", err: fmt.Errorf(`ent: validator failed for field "Rating.games": %w`, err)}
+		}
+	}
+	if ru.mutation.PlayerCleared() && len(ru.mutation.PlayerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rating.player"`)
+	}
+	return nil
+}
+
+func (ru *RatingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rating.Table, rating.Columns, sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Mode(); ok {
+		_spec.SetField(rating.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.Rating(); ok {
+		_spec.SetField(rating.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.AddedRating(); ok {
+		_spec.AddField(rating.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.Deviation(); ok {
+		_spec.SetField(rating.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.AddedDeviation(); ok {
+		_spec.AddField(rating.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.Volatility(); ok {
+		_spec.SetField(rating.FieldVolatility, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.AddedVolatility(); ok {
+		_spec.AddField(rating.FieldVolatility, field.TypeFloat64, value)
+	}
+	if value, ok := ru.mutation.Games(); ok {
+		_spec.SetField(rating.FieldGames, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedGames(); ok {
+		_spec.AddField(rating.FieldGames, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.UpdatedTs(); ok {
+		_spec.SetField(rating.FieldUpdatedTs, field.TypeTime, value)
+	}
+	if ru.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.PlayerTable,
+			Columns: []string{rating.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.PlayerTable,
+			Columns: []string{rating.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rating.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// RatingUpdateOne is the builder for updating a single Rating entity.
+type RatingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RatingMutation
+}
+
+// SetMode sets the "mode" field.
+func (ruo *RatingUpdateOne) SetMode(r rating.Mode) *RatingUpdateOne {
+	ruo.mutation.SetMode(r)
+	return ruo
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (ruo *RatingUpdateOne) SetNillableMode(r *rating.Mode) *RatingUpdateOne {
+	if r != nil {
+		ruo.SetMode(*r)
+	}
+	return ruo
+}
+
+// SetRating sets the "rating" field.
+func (ruo *RatingUpdateOne) SetRating(f float64) *RatingUpdateOne {
+	ruo.mutation.ResetRating()
+	ruo.mutation.SetRating(f)
+	return ruo
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (ruo *RatingUpdateOne) SetNillableRating(f *float64) *RatingUpdateOne {
+	if f != nil {
+		ruo.SetRating(*f)
+	}
+	return ruo
+}
+
+// AddRating adds f to the "rating" field.
+func (ruo *RatingUpdateOne) AddRating(f float64) *RatingUpdateOne {
+	ruo.mutation.AddRating(f)
+	return ruo
+}
+
+// SetDeviation sets the "deviation" field.
+func (ruo *RatingUpdateOne) SetDeviation(f float64) *RatingUpdateOne {
+	ruo.mutation.ResetDeviation()
+	ruo.mutation.SetDeviation(f)
+	return ruo
+}
+
+// SetNillableDeviation sets the "deviation" field if the given value is not nil.
+func (ruo *RatingUpdateOne) SetNillableDeviation(f *float64) *RatingUpdateOne {
+	if f != nil {
+		ruo.SetDeviation(*f)
+	}
+	return ruo
+}
+
+// AddDeviation adds f to the "deviation" field.
+func (ruo *RatingUpdateOne) AddDeviation(f float64) *RatingUpdateOne {
+	ruo.mutation.AddDeviation(f)
+	return ruo
+}
+
+// SetVolatility sets the "volatility" field.
+func (ruo *RatingUpdateOne) SetVolatility(f float64) *RatingUpdateOne {
+	ruo.mutation.ResetVolatility()
+	ruo.mutation.SetVolatility(f)
+	return ruo
+}
+
+// SetNillableVolatility sets the "volatility" field if the given value is not nil.
+func (ruo *RatingUpdateOne) SetNillableVolatility(f *float64) *RatingUpdateOne {
+	if f != nil {
+		ruo.SetVolatility(*f)
+	}
+	return ruo
+}
+
+// AddVolatility adds f to the "volatility" field.
+func (ruo *RatingUpdateOne) AddVolatility(f float64) *RatingUpdateOne {
+	ruo.mutation.AddVolatility(f)
+	return ruo
+}
+
+// SetGames sets the "games" field.
+func (ruo *RatingUpdateOne) SetGames(i int) *RatingUpdateOne {
+	ruo.mutation.ResetGames()
+	ruo.mutation.SetGames(i)
+	return ruo
+}
+
+// SetNillableGames sets the "games" field if the given value is not nil.
+func (ruo *RatingUpdateOne) SetNillableGames(i *int) *RatingUpdateOne {
+	if i != nil {
+		ruo.SetGames(*i)
+	}
+	return ruo
+}
+
+// AddGames adds i to the "games" field.
+func (ruo *RatingUpdateOne) AddGames(i int) *RatingUpdateOne {
+	ruo.mutation.AddGames(i)
+	return ruo
+}
+
+// SetUpdatedTs sets the "updated_ts" field.
+func (ruo *RatingUpdateOne) SetUpdatedTs(t time.Time) *RatingUpdateOne {
+	ruo.mutation.SetUpdatedTs(t)
+	return ruo
+}
+
+// SetNillableUpdatedTs sets the "updated_ts" field if the given value is not nil.
+func (ruo *RatingUpdateOne) SetNillableUpdatedTs(t *time.Time) *RatingUpdateOne {
+	if t != nil {
+		ruo.SetUpdatedTs(*t)
+	}
+	return ruo
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (ruo *RatingUpdateOne) SetPlayerID(id int) *RatingUpdateOne {
+	ruo.mutation.SetPlayerID(id)
+	return ruo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (ruo *RatingUpdateOne) SetPlayer(p *Player) *RatingUpdateOne {
+	return ruo.SetPlayerID(p.ID)
+}
+
+// Mutation returns the RatingMutation object of the builder.
+func (ruo *RatingUpdateOne) Mutation() *RatingMutation {
+	return ruo.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (ruo *RatingUpdateOne) ClearPlayer() *RatingUpdateOne {
+	ruo.mutation.ClearPlayer()
+	return ruo
+}
+
+// Where appends a list predicates to the RatingUpdate builder.
+func (ruo *RatingUpdateOne) Where(ps ...predicate.Rating) *RatingUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RatingUpdateOne) Select(field string, fields ...string) *RatingUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Rating entity.
+func (ruo *RatingUpdateOne) Save(ctx context.Context) (*Rating, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RatingUpdateOne) SaveX(ctx context.Context) *Rating {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RatingUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RatingUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *RatingUpdateOne) check() error {
+	if v, ok := ruo.mutation.Mode(); ok {
+		if err := rating.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Rating.mode": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Games(); ok {
+		if err := rating.GamesValidator(v); err != nil {
+			return &ValidationError{Name: "games", err: fmt.Errorf(`ent: validator failed for field "Rating.games": %w`, err)}
+		}
+	}
+	if ruo.mutation.PlayerCleared() && len(ruo.mutation.PlayerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rating.player"`)
+	}
+	return nil
+}
+
+func (ruo *RatingUpdateOne) sqlSave(ctx context.Context) (_node *Rating, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rating.Table, rating.Columns, sqlgraph.NewFieldSpec(rating.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Rating.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rating.FieldID)
+		for _, f := range fields {
+			if !rating.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rating.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Mode(); ok {
+		_spec.SetField(rating.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.Rating(); ok {
+		_spec.SetField(rating.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.AddedRating(); ok {
+		_spec.AddField(rating.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.Deviation(); ok {
+		_spec.SetField(rating.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.AddedDeviation(); ok {
+		_spec.AddField(rating.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.Volatility(); ok {
+		_spec.SetField(rating.FieldVolatility, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.AddedVolatility(); ok {
+		_spec.AddField(rating.FieldVolatility, field.TypeFloat64, value)
+	}
+	if value, ok := ruo.mutation.Games(); ok {
+		_spec.SetField(rating.FieldGames, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedGames(); ok {
+		_spec.AddField(rating.FieldGames, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.UpdatedTs(); ok {
+		_spec.SetField(rating.FieldUpdatedTs, field.TypeTime, value)
+	}
+	if ruo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.PlayerTable,
+			Columns: []string{rating.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rating.PlayerTable,
+			Columns: []string{rating.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Rating{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rating.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/player"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+)
+
+// RatingHistory is the model entity for the RatingHistory schema.
+type RatingHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode ratinghistory.Mode `json:"mode,omitempty"`
+	// Rating holds the value of the "rating" field.
+	Rating float64 `json:"rating,omitempty"`
+	// Deviation holds the value of the "deviation" field.
+	Deviation float64 `json:"deviation,omitempty"`
+	// Volatility holds the value of the "volatility" field.
+	Volatility float64 `json:"volatility,omitempty"`
+	// The change in rating due to this match.
+	Delta float64 `json:"delta,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RatingHistoryQuery when eager-loading is set.
+	Edges                 RatingHistoryEdges `json:"edges"`
+	match_rating_history  *int
+	player_rating_history *int
+	selectValues          sql.SelectValues
+}
+
+// RatingHistoryEdges holds the relations/edges for other nodes in the graph.
+type RatingHistoryEdges struct {
+	// Player holds the value of the player edge.
+	Player *Player `json:"player,omitempty"`
+	// Match holds the value of the match edge.
+	Match *Match `json:"match,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PlayerOrErr returns the Player value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RatingHistoryEdges) PlayerOrErr() (*Player, error) {
+	if e.Player != nil {
+		return e.Player, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: player.Label}
+	}
+	return nil, &NotLoadedError{edge: "player"}
+}
+
+// MatchOrErr returns the Match value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RatingHistoryEdges) MatchOrErr() (*Match, error) {
+	if e.Match != nil {
+		return e.Match, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: match.Label}
+	}
+	return nil, &NotLoadedError{edge: "match"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RatingHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ratinghistory.FieldRating, ratinghistory.FieldDeviation, ratinghistory.FieldVolatility, ratinghistory.FieldDelta:
+			values[i] = new(sql.NullFloat64)
+		case ratinghistory.FieldID:
+			values[i] = new(sql.NullInt64)
+		case ratinghistory.FieldMode:
+			values[i] = new(sql.NullString)
+		case ratinghistory.ForeignKeys[0]: // match_rating_history
+			values[i] = new(sql.NullInt64)
+		case ratinghistory.ForeignKeys[1]: // player_rating_history
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RatingHistory fields.
+func (rh *RatingHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ratinghistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rh.ID = int(value.Int64)
+		case ratinghistory.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				rh.Mode = ratinghistory.Mode(value.String)
+			}
+		case ratinghistory.FieldRating:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				rh.Rating = value.Float64
+			}
+		case ratinghistory.FieldDeviation:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field deviation", values[i])
+			} else if value.Valid {
+				rh.Deviation = value.Float64
+			}
+		case ratinghistory.FieldVolatility:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field volatility", values[i])
+			} else if value.Valid {
+				rh.Volatility = value.Float64
+			}
+		case ratinghistory.FieldDelta:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field delta", values[i])
+			} else if value.Valid {
+				rh.Delta = value.Float64
+			}
+		case ratinghistory.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field match_rating_history", value)
+			} else if value.Valid {
+				rh.match_rating_history = new(int)
+				*rh.match_rating_history = int(value.Int64)
+			}
+		case ratinghistory.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field player_rating_history", value)
+			} else if value.Valid {
+				rh.player_rating_history = new(int)
+				*rh.player_rating_history = int(value.Int64)
+			}
+		default:
+			rh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RatingHistory.
+// This includes values selected through modifiers, order, etc.
+func (rh *RatingHistory) Value(name string) (ent.Value, error) {
+	return rh.selectValues.Get(name)
+}
+
+// QueryPlayer queries the "player" edge of the RatingHistory entity.
+func (rh *RatingHistory) QueryPlayer() *PlayerQuery {
+	return NewRatingHistoryClient(rh.config).QueryPlayer(rh)
+}
+
+// QueryMatch queries the "match" edge of the RatingHistory entity.
+func (rh *RatingHistory) QueryMatch() *MatchQuery {
+	return NewRatingHistoryClient(rh.config).QueryMatch(rh)
+}
+
+// Update returns a builder for updating this RatingHistory.
+// Note that you need to call RatingHistory.Unwrap() before calling this method if this RatingHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rh *RatingHistory) Update() *RatingHistoryUpdateOne {
+	return NewRatingHistoryClient(rh.config).UpdateOne(rh)
+}
+
+// Unwrap unwraps the RatingHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rh *RatingHistory) Unwrap() *RatingHistory {
+	_tx, ok := rh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RatingHistory is not a transactional entity")
+	}
+	rh.config.driver = _tx.drv
+	return rh
+}
+
+// String implements the fmt.Stringer.
+func (rh *RatingHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("RatingHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rh.ID))
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", rh.Mode))
+	builder.WriteString(", ")
+	builder.WriteString("rating=")
+	builder.WriteString(fmt.Sprintf("%v", rh.Rating))
+	builder.WriteString(", ")
+	builder.WriteString("deviation=")
+	builder.WriteString(fmt.Sprintf("%v", rh.Deviation))
+	builder.WriteString(", ")
+	builder.WriteString("volatility=")
+	builder.WriteString(fmt.Sprintf("%v", rh.Volatility))
+	builder.WriteString(", ")
+	builder.WriteString("delta=")
+	builder.WriteString(fmt.Sprintf("%v", rh.Delta))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RatingHistories is a parsable slice of RatingHistory.
+type RatingHistories []*RatingHistory
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ratinghistory
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ratinghistory type in the database.
+	Label = "rating_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldRating holds the string denoting the rating field in the database.
+	FieldRating = "rating"
+	// FieldDeviation holds the string denoting the deviation field in the database.
+	FieldDeviation = "deviation"
+	// FieldVolatility holds the string denoting the volatility field in the database.
+	FieldVolatility = "volatility"
+	// FieldDelta holds the string denoting the delta field in the database.
+	FieldDelta = "delta"
+	// EdgePlayer holds the string denoting the player edge name in mutations.
+	EdgePlayer = "player"
+	// EdgeMatch holds the string denoting the match edge name in mutations.
+	EdgeMatch = "match"
+	// Table holds the table name of the ratinghistory in the database.
+	Table = "rating_histories"
+	// PlayerTable is the table that holds the player relation/edge.
+	PlayerTable = "rating_histories"
+	// PlayerInverseTable is the table name for the Player entity.
+	// It exists in this package in order to avoid circular dependency with the "player" package.
+	PlayerInverseTable = "players"
+	// PlayerColumn is the table column denoting the player relation/edge.
+	PlayerColumn = "player_rating_history"
+	// MatchTable is the table that holds the match relation/edge.
+	MatchTable = "rating_histories"
+	// MatchInverseTable is the table name for the Match entity.
+	// It exists in this package in order to avoid circular dependency with the "match" package.
+	MatchInverseTable = "matches"
+	// MatchColumn is the table column denoting the match relation/edge.
+	MatchColumn = "match_rating_history"
+)
+
+// Columns holds all SQL columns for ratinghistory fields.
+var Columns = []string{
+	FieldID,
+	FieldMode,
+	FieldRating,
+	FieldDeviation,
+	FieldVolatility,
+	FieldDelta,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "rating_histories"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"match_rating_history",
+	"player_rating_history",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Mode defines the type for the "mode" enum field.
+type Mode string
+
+// Mode values.
+const (
+	ModeSOLO Mode = "SOLO"
+	ModeDUOS Mode = "DUOS"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
+func ModeValidator(m Mode) error {
+	switch m {
+	case ModeSOLO, ModeDUOS:
+		return nil
+	default:
+		return fmt.Errorf("ratinghistory: invalid enum value for mode field: %q", m)
+	}
+}
+
+// OrderOption defines the ordering options for the RatingHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByRating orders the results by the rating field.
+func ByRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRating, opts...).ToFunc()
+}
+
+// ByDeviation orders the results by the deviation field.
+func ByDeviation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviation, opts...).ToFunc()
+}
+
+// ByVolatility orders the results by the volatility field.
+func ByVolatility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVolatility, opts...).ToFunc()
+}
+
+// ByDelta orders the results by the delta field.
+func ByDelta(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDelta, opts...).ToFunc()
+}
+
+// ByPlayerField orders the results by player field.
+func ByPlayerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlayerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMatchField orders the results by match field.
+func ByMatchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMatchStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPlayerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlayerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+	)
+}
+func newMatchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MatchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MatchTable, MatchColumn),
+	)
+}
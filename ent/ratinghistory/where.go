@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ratinghistory
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kevindamm/wits-go/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldLTE(FieldID, id))
+}
+
+// Rating applies equality check predicate on the "rating" field. It's identical to RatingEQ.
+func Rating(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldEQ(FieldRating, v))
+}
+
+// Deviation applies equality check predicate on the "deviation" field. It's identical to DeviationEQ.
+func Deviation(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldEQ(FieldDeviation, v))
+}
+
+// Volatility applies equality check predicate on the "volatility" field. It's identical to VolatilityEQ.
+func Volatility(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldEQ(FieldVolatility, v))
+}
+
+// Delta applies equality check predicate on the "delta" field. It's identical to DeltaEQ.
+func Delta(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldEQ(FieldDelta, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v Mode) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v Mode) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...Mode) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...Mode) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// RatingEQ applies the EQ predicate on the "rating" field.
+func RatingEQ(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldEQ(FieldRating, v))
+}
+
+// RatingNEQ applies the NEQ predicate on the "rating" field.
+func RatingNEQ(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNEQ(FieldRating, v))
+}
+
+// RatingIn applies the In predicate on the "rating" field.
+func RatingIn(vs ...float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldIn(FieldRating, vs...))
+}
+
+// RatingNotIn applies the NotIn predicate on the "rating" field.
+func RatingNotIn(vs ...float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNotIn(FieldRating, vs...))
+}
+
+// RatingGT applies the GT predicate on the "rating" field.
+func RatingGT(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldGT(FieldRating, v))
+}
+
+// RatingGTE applies the GTE predicate on the "rating" field.
+func RatingGTE(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldGTE(FieldRating, v))
+}
+
+// RatingLT applies the LT predicate on the "rating" field.
+func RatingLT(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldLT(FieldRating, v))
+}
+
+// RatingLTE applies the LTE predicate on the "rating" field.
+func RatingLTE(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldLTE(FieldRating, v))
+}
+
+// DeviationEQ applies the EQ predicate on the "deviation" field.
+func DeviationEQ(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldEQ(FieldDeviation, v))
+}
+
+// DeviationNEQ applies the NEQ predicate on the "deviation" field.
+func DeviationNEQ(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNEQ(FieldDeviation, v))
+}
+
+// DeviationIn applies the In predicate on the "deviation" field.
+func DeviationIn(vs ...float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldIn(FieldDeviation, vs...))
+}
+
+// DeviationNotIn applies the NotIn predicate on the "deviation" field.
+func DeviationNotIn(vs ...float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNotIn(FieldDeviation, vs...))
+}
+
+// DeviationGT applies the GT predicate on the "deviation" field.
+func DeviationGT(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldGT(FieldDeviation, v))
+}
+
+// DeviationGTE applies the GTE predicate on the "deviation" field.
+func DeviationGTE(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldGTE(FieldDeviation, v))
+}
+
+// DeviationLT applies the LT predicate on the "deviation" field.
+func DeviationLT(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldLT(FieldDeviation, v))
+}
+
+// DeviationLTE applies the LTE predicate on the "deviation" field.
+func DeviationLTE(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldLTE(FieldDeviation, v))
+}
+
+// VolatilityEQ applies the EQ predicate on the "volatility" field.
+func VolatilityEQ(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldEQ(FieldVolatility, v))
+}
+
+// VolatilityNEQ applies the NEQ predicate on the "volatility" field.
+func VolatilityNEQ(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNEQ(FieldVolatility, v))
+}
+
+// VolatilityIn applies the In predicate on the "volatility" field.
+func VolatilityIn(vs ...float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldIn(FieldVolatility, vs...))
+}
+
+// VolatilityNotIn applies the NotIn predicate on the "volatility" field.
+func VolatilityNotIn(vs ...float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNotIn(FieldVolatility, vs...))
+}
+
+// VolatilityGT applies the GT predicate on the "volatility" field.
+func VolatilityGT(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldGT(FieldVolatility, v))
+}
+
+// VolatilityGTE applies the GTE predicate on the "volatility" field.
+func VolatilityGTE(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldGTE(FieldVolatility, v))
+}
+
+// VolatilityLT applies the LT predicate on the "volatility" field.
+func VolatilityLT(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldLT(FieldVolatility, v))
+}
+
+// VolatilityLTE applies the LTE predicate on the "volatility" field.
+func VolatilityLTE(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldLTE(FieldVolatility, v))
+}
+
+// DeltaEQ applies the EQ predicate on the "delta" field.
+func DeltaEQ(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldEQ(FieldDelta, v))
+}
+
+// DeltaNEQ applies the NEQ predicate on the "delta" field.
+func DeltaNEQ(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNEQ(FieldDelta, v))
+}
+
+// DeltaIn applies the In predicate on the "delta" field.
+func DeltaIn(vs ...float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldIn(FieldDelta, vs...))
+}
+
+// DeltaNotIn applies the NotIn predicate on the "delta" field.
+func DeltaNotIn(vs ...float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldNotIn(FieldDelta, vs...))
+}
+
+// DeltaGT applies the GT predicate on the "delta" field.
+func DeltaGT(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldGT(FieldDelta, v))
+}
+
+// DeltaGTE applies the GTE predicate on the "delta" field.
+func DeltaGTE(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldGTE(FieldDelta, v))
+}
+
+// DeltaLT applies the LT predicate on the "delta" field.
+func DeltaLT(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldLT(FieldDelta, v))
+}
+
+// DeltaLTE applies the LTE predicate on the "delta" field.
+func DeltaLTE(v float64) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.FieldLTE(FieldDelta, v))
+}
+
+// HasPlayer applies the HasEdge predicate on the "player" edge.
+func HasPlayer() predicate.RatingHistory {
+	return predicate.RatingHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlayerWith applies the HasEdge predicate on the "player" edge with a given conditions (other predicates).
+func HasPlayerWith(preds ...predicate.Player) predicate.RatingHistory {
+	return predicate.RatingHistory(func(s *sql.Selector) {
+		step := newPlayerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMatch applies the HasEdge predicate on the "match" edge.
+func HasMatch() predicate.RatingHistory {
+	return predicate.RatingHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MatchTable, MatchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMatchWith applies the HasEdge predicate on the "match" edge with a given conditions (other predicates).
+func HasMatchWith(preds ...predicate.Match) predicate.RatingHistory {
+	return predicate.RatingHistory(func(s *sql.Selector) {
+		step := newMatchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RatingHistory) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RatingHistory) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RatingHistory) predicate.RatingHistory {
+	return predicate.RatingHistory(sql.NotPredicates(p))
+}
@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/player"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+)
+
+// RatingHistoryCreate is the builder for creating a RatingHistory entity.
+type RatingHistoryCreate struct {
+	config
+	mutation *RatingHistoryMutation
+	hooks    []Hook
+}
+
+// SetMode sets the "mode" field.
+func (rhc *RatingHistoryCreate) SetMode(r ratinghistory.Mode) *RatingHistoryCreate {
+	rhc.mutation.SetMode(r)
+	return rhc
+}
+
+// SetRating sets the "rating" field.
+func (rhc *RatingHistoryCreate) SetRating(f float64) *RatingHistoryCreate {
+	rhc.mutation.SetRating(f)
+	return rhc
+}
+
+// SetDeviation sets the "deviation" field.
+func (rhc *RatingHistoryCreate) SetDeviation(f float64) *RatingHistoryCreate {
+	rhc.mutation.SetDeviation(f)
+	return rhc
+}
+
+// SetVolatility sets the "volatility" field.
+func (rhc *RatingHistoryCreate) SetVolatility(f float64) *RatingHistoryCreate {
+	rhc.mutation.SetVolatility(f)
+	return rhc
+}
+
+// SetDelta sets the "delta" field.
+func (rhc *RatingHistoryCreate) SetDelta(f float64) *RatingHistoryCreate {
+	rhc.mutation.SetDelta(f)
+	return rhc
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (rhc *RatingHistoryCreate) SetPlayerID(id int) *RatingHistoryCreate {
+	rhc.mutation.SetPlayerID(id)
+	return rhc
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (rhc *RatingHistoryCreate) SetPlayer(p *Player) *RatingHistoryCreate {
+	return rhc.SetPlayerID(p.ID)
+}
+
+// SetMatchID sets the "match" edge to the Match entity by ID.
+func (rhc *RatingHistoryCreate) SetMatchID(id int) *RatingHistoryCreate {
+	rhc.mutation.SetMatchID(id)
+	return rhc
+}
+
+// SetMatch sets the "match" edge to the Match entity.
+func (rhc *RatingHistoryCreate) SetMatch(m *Match) *RatingHistoryCreate {
+	return rhc.SetMatchID(m.ID)
+}
+
+// Mutation returns the RatingHistoryMutation object of the builder.
+func (rhc *RatingHistoryCreate) Mutation() *RatingHistoryMutation {
+	return rhc.mutation
+}
+
+// Save creates the RatingHistory in the database.
+func (rhc *RatingHistoryCreate) Save(ctx context.Context) (*RatingHistory, error) {
+	return withHooks(ctx, rhc.sqlSave, rhc.mutation, rhc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rhc *RatingHistoryCreate) SaveX(ctx context.Context) *RatingHistory {
+	v, err := rhc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rhc *RatingHistoryCreate) Exec(ctx context.Context) error {
+	_, err := rhc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhc *RatingHistoryCreate) ExecX(ctx context.Context) {
+	if err := rhc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rhc *RatingHistoryCreate) check() error {
+	if _, ok := rhc.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "RatingHistory.mode"`)}
+	}
+	if v, ok := rhc.mutation.Mode(); ok {
+		if err := ratinghistory.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "RatingHistory.mode": %w`, err)}
+		}
+	}
+	if _, ok := rhc.mutation.Rating(); !ok {
+		return &ValidationError{Name: "rating", err: errors.New(`ent: missing required field "RatingHistory.rating"`)}
+	}
+	if _, ok := rhc.mutation.Deviation(); !ok {
+		return &ValidationError{Name: "deviation", err: errors.New(`ent: missing required field "RatingHistory.deviation"`)}
+	}
+	if _, ok := rhc.mutation.Volatility(); !ok {
+		return &ValidationError{Name: "volatility", err: errors.New(`ent: missing required field "RatingHistory.volatility"`)}
+	}
+	if _, ok := rhc.mutation.Delta(); !ok {
+		return &ValidationError{Name: "delta", err: errors.New(`ent: missing required field "RatingHistory.delta"`)}
+	}
+	if len(rhc.mutation.PlayerIDs()) == 0 {
+		return &ValidationError{Name: "player", err: errors.New(`ent: missing required edge "RatingHistory.player"`)}
+	}
+	if len(rhc.mutation.MatchIDs()) == 0 {
+		return &ValidationError{Name: "match", err: errors.New(`ent: missing required edge "RatingHistory.match"`)}
+	}
+	return nil
+}
+
+func (rhc *RatingHistoryCreate) sqlSave(ctx context.Context) (*RatingHistory, error) {
+	if err := rhc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rhc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rhc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rhc.mutation.id = &_node.ID
+	rhc.mutation.done = true
+	return _node, nil
+}
+
+func (rhc *RatingHistoryCreate) createSpec() (*RatingHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RatingHistory{config: rhc.config}
+		_spec = sqlgraph.NewCreateSpec(ratinghistory.Table, sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt))
+	)
+	if value, ok := rhc.mutation.Mode(); ok {
+		_spec.SetField(ratinghistory.FieldMode, field.TypeEnum, value)
+		_node.Mode = value
+	}
+	if value, ok := rhc.mutation.Rating(); ok {
+		_spec.SetField(ratinghistory.FieldRating, field.TypeFloat64, value)
+		_node.Rating = value
+	}
+	if value, ok := rhc.mutation.Deviation(); ok {
+		_spec.SetField(ratinghistory.FieldDeviation, field.TypeFloat64, value)
+		_node.Deviation = value
+	}
+	if value, ok := rhc.mutation.Volatility(); ok {
+		_spec.SetField(ratinghistory.FieldVolatility, field.TypeFloat64, value)
+		_node.Volatility = value
+	}
+	if value, ok := rhc.mutation.Delta(); ok {
+		_spec.SetField(ratinghistory.FieldDelta, field.TypeFloat64, value)
+		_node.Delta = value
+	}
+	if nodes := rhc.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratinghistory.PlayerTable,
+			Columns: []string{ratinghistory.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.player_rating_history = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rhc.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratinghistory.MatchTable,
+			Columns: []string{ratinghistory.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.match_rating_history = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RatingHistoryCreateBulk is the builder for creating many RatingHistory entities in bulk.
+type RatingHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*RatingHistoryCreate
+}
+
+// Save creates the RatingHistory entities in the database.
+func (rhcb *RatingHistoryCreateBulk) Save(ctx context.Context) ([]*RatingHistory, error) {
+	if rhcb.err != nil {
+		return nil, rhcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rhcb.builders))
+	nodes := make([]*RatingHistory, len(rhcb.builders))
+	mutators := make([]Mutator, len(rhcb.builders))
+	for i := range rhcb.builders {
+		func(i int, root context.Context) {
+			builder := rhcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RatingHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rhcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rhcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rhcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rhcb *RatingHistoryCreateBulk) SaveX(ctx context.Context) []*RatingHistory {
+	v, err := rhcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rhcb *RatingHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := rhcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhcb *RatingHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := rhcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
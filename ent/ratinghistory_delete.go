@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+)
+
+// RatingHistoryDelete is the builder for deleting a RatingHistory entity.
+type RatingHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *RatingHistoryMutation
+}
+
+// Where appends a list predicates to the RatingHistoryDelete builder.
+func (rhd *RatingHistoryDelete) Where(ps ...predicate.RatingHistory) *RatingHistoryDelete {
+	rhd.mutation.Where(ps...)
+	return rhd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rhd *RatingHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rhd.sqlExec, rhd.mutation, rhd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhd *RatingHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := rhd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rhd *RatingHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ratinghistory.Table, sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt))
+	if ps := rhd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rhd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rhd.mutation.done = true
+	return affected, err
+}
+
+// RatingHistoryDeleteOne is the builder for deleting a single RatingHistory entity.
+type RatingHistoryDeleteOne struct {
+	rhd *RatingHistoryDelete
+}
+
+// Where appends a list predicates to the RatingHistoryDelete builder.
+func (rhdo *RatingHistoryDeleteOne) Where(ps ...predicate.RatingHistory) *RatingHistoryDeleteOne {
+	rhdo.rhd.mutation.Where(ps...)
+	return rhdo
+}
+
+// Exec executes the deletion query.
+func (rhdo *RatingHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := rhdo.rhd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ratinghistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhdo *RatingHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := rhdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/player"
+	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+)
+
+// RatingHistoryQuery is the builder for querying RatingHistory entities.
+type RatingHistoryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []ratinghistory.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RatingHistory
+	withPlayer *PlayerQuery
+	withMatch  *MatchQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RatingHistoryQuery builder.
+func (rhq *RatingHistoryQuery) Where(ps ...predicate.RatingHistory) *RatingHistoryQuery {
+	rhq.predicates = append(rhq.predicates, ps...)
+	return rhq
+}
+
+// Limit the number of records to be returned by this query.
+func (rhq *RatingHistoryQuery) Limit(limit int) *RatingHistoryQuery {
+	rhq.ctx.Limit = &limit
+	return rhq
+}
+
+// Offset to start from.
+func (rhq *RatingHistoryQuery) Offset(offset int) *RatingHistoryQuery {
+	rhq.ctx.Offset = &offset
+	return rhq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rhq *RatingHistoryQuery) Unique(unique bool) *RatingHistoryQuery {
+	rhq.ctx.Unique = &unique
+	return rhq
+}
+
+// Order specifies how the records should be ordered.
+func (rhq *RatingHistoryQuery) Order(o ...ratinghistory.OrderOption) *RatingHistoryQuery {
+	rhq.order = append(rhq.order, o...)
+	return rhq
+}
+
+// QueryPlayer chains the current query on the "player" edge.
+func (rhq *RatingHistoryQuery) QueryPlayer() *PlayerQuery {
+	query := (&PlayerClient{config: rhq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rhq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rhq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ratinghistory.Table, ratinghistory.FieldID, selector),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ratinghistory.PlayerTable, ratinghistory.PlayerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rhq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMatch chains the current query on the "match" edge.
+func (rhq *RatingHistoryQuery) QueryMatch() *MatchQuery {
+	query := (&MatchClient{config: rhq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rhq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rhq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ratinghistory.Table, ratinghistory.FieldID, selector),
+			sqlgraph.To(match.Table, match.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ratinghistory.MatchTable, ratinghistory.MatchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rhq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RatingHistory entity from the query.
+// Returns a *NotFoundError when no RatingHistory was found.
+func (rhq *RatingHistoryQuery) First(ctx context.Context) (*RatingHistory, error) {
+	nodes, err := rhq.Limit(1).All(setContextOp(ctx, rhq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ratinghistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rhq *RatingHistoryQuery) FirstX(ctx context.Context) *RatingHistory {
+	node, err := rhq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RatingHistory ID from the query.
+// Returns a *NotFoundError when no RatingHistory ID was found.
+func (rhq *RatingHistoryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rhq.Limit(1).IDs(setContextOp(ctx, rhq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ratinghistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rhq *RatingHistoryQuery) FirstIDX(ctx context.Context) int {
+	id, err := rhq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RatingHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RatingHistory entity is found.
+// Returns a *NotFoundError when no RatingHistory entities are found.
+func (rhq *RatingHistoryQuery) Only(ctx context.Context) (*RatingHistory, error) {
+	nodes, err := rhq.Limit(2).All(setContextOp(ctx, rhq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ratinghistory.Label}
+	default:
+		return nil, &NotSingularError{ratinghistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rhq *RatingHistoryQuery) OnlyX(ctx context.Context) *RatingHistory {
+	node, err := rhq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RatingHistory ID in the query.
+// Returns a *NotSingularError when more than one RatingHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rhq *RatingHistoryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rhq.Limit(2).IDs(setContextOp(ctx, rhq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ratinghistory.Label}
+	default:
+		err = &NotSingularError{ratinghistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rhq *RatingHistoryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rhq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RatingHistories.
+func (rhq *RatingHistoryQuery) All(ctx context.Context) ([]*RatingHistory, error) {
+	ctx = setContextOp(ctx, rhq.ctx, ent.OpQueryAll)
+	if err := rhq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RatingHistory, *RatingHistoryQuery]()
+	return withInterceptors[[]*RatingHistory](ctx, rhq, qr, rhq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rhq *RatingHistoryQuery) AllX(ctx context.Context) []*RatingHistory {
+	nodes, err := rhq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RatingHistory IDs.
+func (rhq *RatingHistoryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rhq.ctx.Unique == nil && rhq.path != nil {
+		rhq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rhq.ctx, ent.OpQueryIDs)
+	if err = rhq.Select(ratinghistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rhq *RatingHistoryQuery) IDsX(ctx context.Context) []int {
+	ids, err := rhq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rhq *RatingHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rhq.ctx, ent.OpQueryCount)
+	if err := rhq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rhq, querierCount[*RatingHistoryQuery](), rhq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rhq *RatingHistoryQuery) CountX(ctx context.Context) int {
+	count, err := rhq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rhq *RatingHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rhq.ctx, ent.OpQueryExist)
+	switch _, err := rhq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rhq *RatingHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := rhq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RatingHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rhq *RatingHistoryQuery) Clone() *RatingHistoryQuery {
+	if rhq == nil {
+		return nil
+	}
+	return &RatingHistoryQuery{
+		config:     rhq.config,
+		ctx:        rhq.ctx.Clone(),
+		order:      append([]ratinghistory.OrderOption{}, rhq.order...),
+		inters:     append([]Interceptor{}, rhq.inters...),
+		predicates: append([]predicate.RatingHistory{}, rhq.predicates...),
+		withPlayer: rhq.withPlayer.Clone(),
+		withMatch:  rhq.withMatch.Clone(),
+		// clone intermediate query.
+		sql:  rhq.sql.Clone(),
+		path: rhq.path,
+	}
+}
+
+// WithPlayer tells the query-builder to eager-load the nodes that are connected to
+// the "player" edge. The optional arguments are used to configure the query builder of the edge.
+func (rhq *RatingHistoryQuery) WithPlayer(opts ...func(*PlayerQuery)) *RatingHistoryQuery {
+	query := (&PlayerClient{config: rhq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rhq.withPlayer = query
+	return rhq
+}
+
+// WithMatch tells the query-builder to eager-load the nodes that are connected to
+// the "match" edge. The optional arguments are used to configure the query builder of the edge.
+func (rhq *RatingHistoryQuery) WithMatch(opts ...func(*MatchQuery)) *RatingHistoryQuery {
+	query := (&MatchClient{config: rhq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rhq.withMatch = query
+	return rhq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Mode ratinghistory.Mode `json:"mode,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RatingHistory.Query().
+//		GroupBy(ratinghistory.FieldMode).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rhq *RatingHistoryQuery) GroupBy(field string, fields ...string) *RatingHistoryGroupBy {
+	rhq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RatingHistoryGroupBy{build: rhq}
+	grbuild.flds = &rhq.ctx.Fields
+	grbuild.label = ratinghistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Mode ratinghistory.Mode `json:"mode,omitempty"`
+//	}
+//
+//	client.RatingHistory.Query().
+//		Select(ratinghistory.FieldMode).
+//		Scan(ctx, &v)
+func (rhq *RatingHistoryQuery) Select(fields ...string) *RatingHistorySelect {
+	rhq.ctx.Fields = append(rhq.ctx.Fields, fields...)
+	sbuild := &RatingHistorySelect{RatingHistoryQuery: rhq}
+	sbuild.label = ratinghistory.Label
+	sbuild.flds, sbuild.scan = &rhq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RatingHistorySelect configured with the given aggregations.
+func (rhq *RatingHistoryQuery) Aggregate(fns ...AggregateFunc) *RatingHistorySelect {
+	return rhq.Select().Aggregate(fns...)
+}
+
+func (rhq *RatingHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rhq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rhq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rhq.ctx.Fields {
+		if !ratinghistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rhq.path != nil {
+		prev, err := rhq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rhq.sql = prev
+	}
+	return nil
+}
+
+func (rhq *RatingHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RatingHistory, error) {
+	var (
+		nodes       = []*RatingHistory{}
+		withFKs     = rhq.withFKs
+		_spec       = rhq.querySpec()
+		loadedTypes = [2]bool{
+			rhq.withPlayer != nil,
+			rhq.withMatch != nil,
+		}
+	)
+	if rhq.withPlayer != nil || rhq.withMatch != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, ratinghistory.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RatingHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RatingHistory{config: rhq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rhq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rhq.withPlayer; query != nil {
+		if err := rhq.loadPlayer(ctx, query, nodes, nil,
+			func(n *RatingHistory, e *Player) { n.Edges.Player = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rhq.withMatch; query != nil {
+		if err := rhq.loadMatch(ctx, query, nodes, nil,
+			func(n *RatingHistory, e *Match) { n.Edges.Match = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rhq *RatingHistoryQuery) loadPlayer(ctx context.Context, query *PlayerQuery, nodes []*RatingHistory, init func(*RatingHistory), assign func(*RatingHistory, *Player)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RatingHistory)
+	for i := range nodes {
+		if nodes[i].player_rating_history == nil {
+			continue
+		}
+		fk := *nodes[i].player_rating_history
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(player.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "player_rating_history" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rhq *RatingHistoryQuery) loadMatch(ctx context.Context, query *MatchQuery, nodes []*RatingHistory, init func(*RatingHistory), assign func(*RatingHistory, *Match)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RatingHistory)
+	for i := range nodes {
+		if nodes[i].match_rating_history == nil {
+			continue
+		}
+		fk := *nodes[i].match_rating_history
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(match.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "match_rating_history" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rhq *RatingHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rhq.querySpec()
+	_spec.Node.Columns = rhq.ctx.Fields
+	if len(rhq.ctx.Fields) > 0 {
+		_spec.Unique = rhq.ctx.Unique != nil && *rhq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rhq.driver, _spec)
+}
+
+func (rhq *RatingHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ratinghistory.Table, ratinghistory.Columns, sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt))
+	_spec.From = rhq.sql
+	if unique := rhq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rhq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rhq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratinghistory.FieldID)
+		for i := range fields {
+			if fields[i] != ratinghistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rhq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rhq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rhq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rhq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rhq *RatingHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rhq.driver.Dialect())
+	t1 := builder.Table(ratinghistory.Table)
+	columns := rhq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ratinghistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rhq.sql != nil {
+		selector = rhq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rhq.ctx.Unique != nil && *rhq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rhq.predicates {
+		p(selector)
+	}
+	for _, p := range rhq.order {
+		p(selector)
+	}
+	if offset := rhq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rhq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RatingHistoryGroupBy is the group-by builder for RatingHistory entities.
+type RatingHistoryGroupBy struct {
+	selector
+	build *RatingHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rhgb *RatingHistoryGroupBy) Aggregate(fns ...AggregateFunc) *RatingHistoryGroupBy {
+	rhgb.fns = append(rhgb.fns, fns...)
+	return rhgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rhgb *RatingHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rhgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rhgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RatingHistoryQuery, *RatingHistoryGroupBy](ctx, rhgb.build, rhgb, rhgb.build.inters, v)
+}
+
+func (rhgb *RatingHistoryGroupBy) sqlScan(ctx context.Context, root *RatingHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rhgb.fns))
+	for _, fn := range rhgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rhgb.flds)+len(rhgb.fns))
+		for _, f := range *rhgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rhgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rhgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RatingHistorySelect is the builder for selecting fields of RatingHistory entities.
+type RatingHistorySelect struct {
+	*RatingHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rhs *RatingHistorySelect) Aggregate(fns ...AggregateFunc) *RatingHistorySelect {
+	rhs.fns = append(rhs.fns, fns...)
+	return rhs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rhs *RatingHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rhs.ctx, ent.OpQuerySelect)
+	if err := rhs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RatingHistoryQuery, *RatingHistorySelect](ctx, rhs.RatingHistoryQuery, rhs, rhs.inters, v)
+}
+
+func (rhs *RatingHistorySelect) sqlScan(ctx context.Context, root *RatingHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rhs.fns))
+	for _, fn := range rhs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rhs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rhs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
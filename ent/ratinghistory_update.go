@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/player"
+	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+)
+
+// RatingHistoryUpdate is the builder for updating RatingHistory entities.
+type RatingHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RatingHistoryMutation
+}
+
+// Where appends a list predicates to the RatingHistoryUpdate builder.
+func (rhu *RatingHistoryUpdate) Where(ps ...predicate.RatingHistory) *RatingHistoryUpdate {
+	rhu.mutation.Where(ps...)
+	return rhu
+}
+
+// SetMode sets the "mode" field.
+func (rhu *RatingHistoryUpdate) SetMode(r ratinghistory.Mode) *RatingHistoryUpdate {
+	rhu.mutation.SetMode(r)
+	return rhu
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (rhu *RatingHistoryUpdate) SetNillableMode(r *ratinghistory.Mode) *RatingHistoryUpdate {
+	if r != nil {
+		rhu.SetMode(*r)
+	}
+	return rhu
+}
+
+// SetRating sets the "rating" field.
+func (rhu *RatingHistoryUpdate) SetRating(f float64) *RatingHistoryUpdate {
+	rhu.mutation.ResetRating()
+	rhu.mutation.SetRating(f)
+	return rhu
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (rhu *RatingHistoryUpdate) SetNillableRating(f *float64) *RatingHistoryUpdate {
+	if f != nil {
+		rhu.SetRating(*f)
+	}
+	return rhu
+}
+
+// AddRating adds f to the "rating" field.
+func (rhu *RatingHistoryUpdate) AddRating(f float64) *RatingHistoryUpdate {
+	rhu.mutation.AddRating(f)
+	return rhu
+}
+
+// SetDeviation sets the "deviation" field.
+func (rhu *RatingHistoryUpdate) SetDeviation(f float64) *RatingHistoryUpdate {
+	rhu.mutation.ResetDeviation()
+	rhu.mutation.SetDeviation(f)
+	return rhu
+}
+
+// SetNillableDeviation sets the "deviation" field if the given value is not nil.
+func (rhu *RatingHistoryUpdate) SetNillableDeviation(f *float64) *RatingHistoryUpdate {
+	if f != nil {
+		rhu.SetDeviation(*f)
+	}
+	return rhu
+}
+
+// AddDeviation adds f to the "deviation" field.
+func (rhu *RatingHistoryUpdate) AddDeviation(f float64) *RatingHistoryUpdate {
+	rhu.mutation.AddDeviation(f)
+	return rhu
+}
+
+// SetVolatility sets the "volatility" field.
+func (rhu *RatingHistoryUpdate) SetVolatility(f float64) *RatingHistoryUpdate {
+	rhu.mutation.ResetVolatility()
+	rhu.mutation.SetVolatility(f)
+	return rhu
+}
+
+// SetNillableVolatility sets the "volatility" field if the given value is not nil.
+func (rhu *RatingHistoryUpdate) SetNillableVolatility(f *float64) *RatingHistoryUpdate {
+	if f != nil {
+		rhu.SetVolatility(*f)
+	}
+	return rhu
+}
+
+// AddVolatility adds f to the "volatility" field.
+func (rhu *RatingHistoryUpdate) AddVolatility(f float64) *RatingHistoryUpdate {
+	rhu.mutation.AddVolatility(f)
+	return rhu
+}
+
+// SetDelta sets the "delta" field.
+func (rhu *RatingHistoryUpdate) SetDelta(f float64) *RatingHistoryUpdate {
+	rhu.mutation.ResetDelta()
+	rhu.mutation.SetDelta(f)
+	return rhu
+}
+
+// SetNillableDelta sets the "delta" field if the given value is not nil.
+func (rhu *RatingHistoryUpdate) SetNillableDelta(f *float64) *RatingHistoryUpdate {
+	if f != nil {
+		rhu.SetDelta(*f)
+	}
+	return rhu
+}
+
+// AddDelta adds f to the "delta" field.
+func (rhu *RatingHistoryUpdate) AddDelta(f float64) *RatingHistoryUpdate {
+	rhu.mutation.AddDelta(f)
+	return rhu
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (rhu *RatingHistoryUpdate) SetPlayerID(id int) *RatingHistoryUpdate {
+	rhu.mutation.SetPlayerID(id)
+	return rhu
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (rhu *RatingHistoryUpdate) SetPlayer(p *Player) *RatingHistoryUpdate {
+	return rhu.SetPlayerID(p.ID)
+}
+
+// SetMatchID sets the "match" edge to the Match entity by ID.
+func (rhu *RatingHistoryUpdate) SetMatchID(id int) *RatingHistoryUpdate {
+	rhu.mutation.SetMatchID(id)
+	return rhu
+}
+
+// SetMatch sets the "match" edge to the Match entity.
+func (rhu *RatingHistoryUpdate) SetMatch(m *Match) *RatingHistoryUpdate {
+	return rhu.SetMatchID(m.ID)
+}
+
+// Mutation returns the RatingHistoryMutation object of the builder.
+func (rhu *RatingHistoryUpdate) Mutation() *RatingHistoryMutation {
+	return rhu.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (rhu *RatingHistoryUpdate) ClearPlayer() *RatingHistoryUpdate {
+	rhu.mutation.ClearPlayer()
+	return rhu
+}
+
+// ClearMatch clears the "match" edge to the Match entity.
+func (rhu *RatingHistoryUpdate) ClearMatch() *RatingHistoryUpdate {
+	rhu.mutation.ClearMatch()
+	return rhu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rhu *RatingHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rhu.sqlSave, rhu.mutation, rhu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rhu *RatingHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := rhu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rhu *RatingHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := rhu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhu *RatingHistoryUpdate) ExecX(ctx context.Context) {
+	if err := rhu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rhu *RatingHistoryUpdate) check() error {
+	if v, ok := rhu.mutation.Mode(); ok {
+		if err := ratinghistory.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "RatingHistory.mode": %w`, err)}
+		}
+	}
+	if rhu.mutation.PlayerCleared() && len(rhu.mutation.PlayerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RatingHistory.player"`)
+	}
+	if rhu.mutation.MatchCleared() && len(rhu.mutation.MatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RatingHistory.match"`)
+	}
+	return nil
+}
+
+func (rhu *RatingHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rhu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ratinghistory.Table, ratinghistory.Columns, sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt))
+	if ps := rhu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rhu.mutation.Mode(); ok {
+		_spec.SetField(ratinghistory.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := rhu.mutation.Rating(); ok {
+		_spec.SetField(ratinghistory.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := rhu.mutation.AddedRating(); ok {
+		_spec.AddField(ratinghistory.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := rhu.mutation.Deviation(); ok {
+		_spec.SetField(ratinghistory.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := rhu.mutation.AddedDeviation(); ok {
+		_spec.AddField(ratinghistory.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := rhu.mutation.Volatility(); ok {
+		_spec.SetField(ratinghistory.FieldVolatility, field.TypeFloat64, value)
+	}
+	if value, ok := rhu.mutation.AddedVolatility(); ok {
+		_spec.AddField(ratinghistory.FieldVolatility, field.TypeFloat64, value)
+	}
+	if value, ok := rhu.mutation.Delta(); ok {
+		_spec.SetField(ratinghistory.FieldDelta, field.TypeFloat64, value)
+	}
+	if value, ok := rhu.mutation.AddedDelta(); ok {
+		_spec.AddField(ratinghistory.FieldDelta, field.TypeFloat64, value)
+	}
+	if rhu.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratinghistory.PlayerTable,
+			Columns: []string{ratinghistory.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rhu.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratinghistory.PlayerTable,
+			Columns: []string{ratinghistory.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rhu.mutation.MatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratinghistory.MatchTable,
+			Columns: []string{ratinghistory.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rhu.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratinghistory.MatchTable,
+			Columns: []string{ratinghistory.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rhu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratinghistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rhu.mutation.done = true
+	return n, nil
+}
+
+// RatingHistoryUpdateOne is the builder for updating a single RatingHistory entity.
+type RatingHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RatingHistoryMutation
+}
+
+// SetMode sets the "mode" field.
+func (rhuo *RatingHistoryUpdateOne) SetMode(r ratinghistory.Mode) *RatingHistoryUpdateOne {
+	rhuo.mutation.SetMode(r)
+	return rhuo
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (rhuo *RatingHistoryUpdateOne) SetNillableMode(r *ratinghistory.Mode) *RatingHistoryUpdateOne {
+	if r != nil {
+		rhuo.SetMode(*r)
+	}
+	return rhuo
+}
+
+// SetRating sets the "rating" field.
+func (rhuo *RatingHistoryUpdateOne) SetRating(f float64) *RatingHistoryUpdateOne {
+	rhuo.mutation.ResetRating()
+	rhuo.mutation.SetRating(f)
+	return rhuo
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (rhuo *RatingHistoryUpdateOne) SetNillableRating(f *float64) *RatingHistoryUpdateOne {
+	if f != nil {
+		rhuo.SetRating(*f)
+	}
+	return rhuo
+}
+
+// AddRating adds f to the "rating" field.
+func (rhuo *RatingHistoryUpdateOne) AddRating(f float64) *RatingHistoryUpdateOne {
+	rhuo.mutation.AddRating(f)
+	return rhuo
+}
+
+// SetDeviation sets the "deviation" field.
+func (rhuo *RatingHistoryUpdateOne) SetDeviation(f float64) *RatingHistoryUpdateOne {
+	rhuo.mutation.ResetDeviation()
+	rhuo.mutation.SetDeviation(f)
+	return rhuo
+}
+
+// SetNillableDeviation sets the "deviation" field if the given value is not nil.
+func (rhuo *RatingHistoryUpdateOne) SetNillableDeviation(f *float64) *RatingHistoryUpdateOne {
+	if f != nil {
+		rhuo.SetDeviation(*f)
+	}
+	return rhuo
+}
+
+// AddDeviation adds f to the "deviation" field.
+func (rhuo *RatingHistoryUpdateOne) AddDeviation(f float64) *RatingHistoryUpdateOne {
+	rhuo.mutation.AddDeviation(f)
+	return rhuo
+}
+
+// SetVolatility sets the "volatility" field.
+func (rhuo *RatingHistoryUpdateOne) SetVolatility(f float64) *RatingHistoryUpdateOne {
+	rhuo.mutation.ResetVolatility()
+	rhuo.mutation.SetVolatility(f)
+	return rhuo
+}
+
+// SetNillableVolatility sets the "volatility" field if the given value is not nil.
+func (rhuo *RatingHistoryUpdateOne) SetNillableVolatility(f *float64) *RatingHistoryUpdateOne {
+	if f != nil {
+		rhuo.SetVolatility(*f)
+	}
+	return rhuo
+}
+
+// AddVolatility adds f to the "volatility" field.
+func (rhuo *RatingHistoryUpdateOne) AddVolatility(f float64) *RatingHistoryUpdateOne {
+	rhuo.mutation.AddVolatility(f)
+	return rhuo
+}
+
+// SetDelta sets the "delta" field.
+func (rhuo *RatingHistoryUpdateOne) SetDelta(f float64) *RatingHistoryUpdateOne {
+	rhuo.mutation.ResetDelta()
+	rhuo.mutation.SetDelta(f)
+	return rhuo
+}
+
+// SetNillableDelta sets the "delta" field if the given value is not nil.
+func (rhuo *RatingHistoryUpdateOne) SetNillableDelta(f *float64) *RatingHistoryUpdateOne {
+	if f != nil {
+		rhuo.SetDelta(*f)
+	}
+	return rhuo
+}
+
+// AddDelta adds f to the "delta" field.
+func (rhuo *RatingHistoryUpdateOne) AddDelta(f float64) *RatingHistoryUpdateOne {
+	rhuo.mutation.AddDelta(f)
+	return rhuo
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (rhuo *RatingHistoryUpdateOne) SetPlayerID(id int) *RatingHistoryUpdateOne {
+	rhuo.mutation.SetPlayerID(id)
+	return rhuo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (rhuo *RatingHistoryUpdateOne) SetPlayer(p *Player) *RatingHistoryUpdateOne {
+	return rhuo.SetPlayerID(p.ID)
+}
+
+// SetMatchID sets the "match" edge to the Match entity by ID.
+func (rhuo *RatingHistoryUpdateOne) SetMatchID(id int) *RatingHistoryUpdateOne {
+	rhuo.mutation.SetMatchID(id)
+	return rhuo
+}
+
+// SetMatch sets the "match" edge to the Match entity.
+func (rhuo *RatingHistoryUpdateOne) SetMatch(m *Match) *RatingHistoryUpdateOne {
+	return rhuo.SetMatchID(m.ID)
+}
+
+// Mutation returns the RatingHistoryMutation object of the builder.
+func (rhuo *RatingHistoryUpdateOne) Mutation() *RatingHistoryMutation {
+	return rhuo.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (rhuo *RatingHistoryUpdateOne) ClearPlayer() *RatingHistoryUpdateOne {
+	rhuo.mutation.ClearPlayer()
+	return rhuo
+}
+
+// ClearMatch clears the "match" edge to the Match entity.
+func (rhuo *RatingHistoryUpdateOne) ClearMatch() *RatingHistoryUpdateOne {
+	rhuo.mutation.ClearMatch()
+	return rhuo
+}
+
+// Where appends a list predicates to the RatingHistoryUpdate builder.
+func (rhuo *RatingHistoryUpdateOne) Where(ps ...predicate.RatingHistory) *RatingHistoryUpdateOne {
+	rhuo.mutation.Where(ps...)
+	return rhuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rhuo *RatingHistoryUpdateOne) Select(field string, fields ...string) *RatingHistoryUpdateOne {
+	rhuo.fields = append([]string{field}, fields...)
+	return rhuo
+}
+
+// Save executes the query and returns the updated RatingHistory entity.
+func (rhuo *RatingHistoryUpdateOne) Save(ctx context.Context) (*RatingHistory, error) {
+	return withHooks(ctx, rhuo.sqlSave, rhuo.mutation, rhuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rhuo *RatingHistoryUpdateOne) SaveX(ctx context.Context) *RatingHistory {
+	node, err := rhuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rhuo *RatingHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := rhuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhuo *RatingHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := rhuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rhuo *RatingHistoryUpdateOne) check() error {
+	if v, ok := rhuo.mutation.Mode(); ok {
+		if err := ratinghistory.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "RatingHistory.mode": %w`, err)}
+		}
+	}
+	if rhuo.mutation.PlayerCleared() && len(rhuo.mutation.PlayerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RatingHistory.player"`)
+	}
+	if rhuo.mutation.MatchCleared() && len(rhuo.mutation.MatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RatingHistory.match"`)
+	}
+	return nil
+}
+
+func (rhuo *RatingHistoryUpdateOne) sqlSave(ctx context.Context) (_node *RatingHistory, err error) {
+	if err := rhuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ratinghistory.Table, ratinghistory.Columns, sqlgraph.NewFieldSpec(ratinghistory.FieldID, field.TypeInt))
+	id, ok := rhuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RatingHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rhuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratinghistory.FieldID)
+		for _, f := range fields {
+			if !ratinghistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ratinghistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rhuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rhuo.mutation.Mode(); ok {
+		_spec.SetField(ratinghistory.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := rhuo.mutation.Rating(); ok {
+		_spec.SetField(ratinghistory.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := rhuo.mutation.AddedRating(); ok {
+		_spec.AddField(ratinghistory.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := rhuo.mutation.Deviation(); ok {
+		_spec.SetField(ratinghistory.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := rhuo.mutation.AddedDeviation(); ok {
+		_spec.AddField(ratinghistory.FieldDeviation, field.TypeFloat64, value)
+	}
+	if value, ok := rhuo.mutation.Volatility(); ok {
+		_spec.SetField(ratinghistory.FieldVolatility, field.TypeFloat64, value)
+	}
+	if value, ok := rhuo.mutation.AddedVolatility(); ok {
+		_spec.AddField(ratinghistory.FieldVolatility, field.TypeFloat64, value)
+	}
+	if value, ok := rhuo.mutation.Delta(); ok {
+		_spec.SetField(ratinghistory.FieldDelta, field.TypeFloat64, value)
+	}
+	if value, ok := rhuo.mutation.AddedDelta(); ok {
+		_spec.AddField(ratinghistory.FieldDelta, field.TypeFloat64, value)
+	}
+	if rhuo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratinghistory.PlayerTable,
+			Columns: []string{ratinghistory.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rhuo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratinghistory.PlayerTable,
+			Columns: []string{ratinghistory.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rhuo.mutation.MatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratinghistory.MatchTable,
+			Columns: []string{ratinghistory.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rhuo.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratinghistory.MatchTable,
+			Columns: []string{ratinghistory.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RatingHistory{config: rhuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rhuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratinghistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rhuo.mutation.done = true
+	return _node, nil
+}
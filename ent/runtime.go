@@ -5,24 +5,39 @@ package ent
 import (
 	"time"
 
+	wits "github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent/action"
 	"github.com/kevindamm/wits-go/ent/match"
 	"github.com/kevindamm/wits-go/ent/osnmap"
 	"github.com/kevindamm/wits-go/ent/playerrole"
+	"github.com/kevindamm/wits-go/ent/rating"
 	"github.com/kevindamm/wits-go/ent/schema"
+	"github.com/kevindamm/wits-go/ent/standing"
+	"github.com/kevindamm/wits-go/ent/turn"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	actionFields := schema.Action{}.Fields()
+	_ = actionFields
+	// actionDescSequence is the schema descriptor for sequence field.
+	actionDescSequence := actionFields[0].Descriptor()
+	// action.SequenceValidator is a validator for the "sequence" field. It is called by the builders before save.
+	action.SequenceValidator = actionDescSequence.Validators[0].(func(int) error)
+	// actionDescTurnNumber is the schema descriptor for turn_number field.
+	actionDescTurnNumber := actionFields[4].Descriptor()
+	// action.TurnNumberValidator is a validator for the "turn_number" field. It is called by the builders before save.
+	action.TurnNumberValidator = actionDescTurnNumber.Validators[0].(func(int) error)
 	matchFields := schema.Match{}.Fields()
 	_ = matchFields
 	// matchDescSeason is the schema descriptor for season field.
-	matchDescSeason := matchFields[2].Descriptor()
+	matchDescSeason := matchFields[4].Descriptor()
 	// match.DefaultSeason holds the default value on creation for the season field.
 	match.DefaultSeason = matchDescSeason.Default.(int8)
 	// matchDescCreatedTs is the schema descriptor for created_ts field.
-	matchDescCreatedTs := matchFields[3].Descriptor()
+	matchDescCreatedTs := matchFields[5].Descriptor()
 	// match.DefaultCreatedTs holds the default value on creation for the created_ts field.
 	match.DefaultCreatedTs = matchDescCreatedTs.Default.(func() time.Time)
 	osnmapFields := schema.OsnMap{}.Fields()
@@ -31,6 +46,34 @@ func init() {
 	osnmapDescRoleCount := osnmapFields[2].Descriptor()
 	// osnmap.RoleCountValidator is a validator for the "role_count" field. It is called by the builders before save.
 	osnmap.RoleCountValidator = osnmapDescRoleCount.Validators[0].(func(int) error)
+	// osnmapDescFloorCount is the schema descriptor for floor_count field.
+	osnmapDescFloorCount := osnmapFields[5].Descriptor()
+	// osnmap.DefaultFloorCount holds the default value on creation for the floor_count field.
+	osnmap.DefaultFloorCount = osnmapDescFloorCount.Default.(int)
+	// osnmap.FloorCountValidator is a validator for the "floor_count" field. It is called by the builders before save.
+	osnmap.FloorCountValidator = osnmapDescFloorCount.Validators[0].(func(int) error)
+	// osnmapDescWallCount is the schema descriptor for wall_count field.
+	osnmapDescWallCount := osnmapFields[6].Descriptor()
+	// osnmap.DefaultWallCount holds the default value on creation for the wall_count field.
+	osnmap.DefaultWallCount = osnmapDescWallCount.Default.(int)
+	// osnmap.WallCountValidator is a validator for the "wall_count" field. It is called by the builders before save.
+	osnmap.WallCountValidator = osnmapDescWallCount.Validators[0].(func(int) error)
+	// osnmapDescBonusCount is the schema descriptor for bonus_count field.
+	osnmapDescBonusCount := osnmapFields[7].Descriptor()
+	// osnmap.DefaultBonusCount holds the default value on creation for the bonus_count field.
+	osnmap.DefaultBonusCount = osnmapDescBonusCount.Default.(int)
+	// osnmap.BonusCountValidator is a validator for the "bonus_count" field. It is called by the builders before save.
+	osnmap.BonusCountValidator = osnmapDescBonusCount.Validators[0].(func(int) error)
+	// osnmapDescSpawnCount is the schema descriptor for spawn_count field.
+	osnmapDescSpawnCount := osnmapFields[8].Descriptor()
+	// osnmap.DefaultSpawnCount holds the default value on creation for the spawn_count field.
+	osnmap.DefaultSpawnCount = osnmapDescSpawnCount.Default.(int)
+	// osnmap.SpawnCountValidator is a validator for the "spawn_count" field. It is called by the builders before save.
+	osnmap.SpawnCountValidator = osnmapDescSpawnCount.Validators[0].(func(int) error)
+	// osnmapDescLegacy is the schema descriptor for legacy field.
+	osnmapDescLegacy := osnmapFields[10].Descriptor()
+	// osnmap.DefaultLegacy holds the default value on creation for the legacy field.
+	osnmap.DefaultLegacy = osnmapDescLegacy.Default.(bool)
 	playerroleFields := schema.PlayerRole{}.Fields()
 	_ = playerroleFields
 	// playerroleDescPosition is the schema descriptor for position field.
@@ -41,4 +84,62 @@ func init() {
 	playerroleDescTurnOrder := playerroleFields[3].Descriptor()
 	// playerrole.TurnOrderValidator is a validator for the "turn_order" field. It is called by the builders before save.
 	playerrole.TurnOrderValidator = playerroleDescTurnOrder.Validators[0].(func(int) error)
+	// playerroleDescRace is the schema descriptor for race field.
+	playerroleDescRace := playerroleFields[4].Descriptor()
+	// playerrole.DefaultRace holds the default value on creation for the race field.
+	playerrole.DefaultRace = wits.UnitRaceEnum(playerroleDescRace.Default.(uint8))
+	// playerroleDescResult is the schema descriptor for result field.
+	playerroleDescResult := playerroleFields[5].Descriptor()
+	// playerrole.DefaultResult holds the default value on creation for the result field.
+	playerrole.DefaultResult = wits.TerminalStatus(playerroleDescResult.Default.(uint8))
+	ratingFields := schema.Rating{}.Fields()
+	_ = ratingFields
+	// ratingDescGames is the schema descriptor for games field.
+	ratingDescGames := ratingFields[4].Descriptor()
+	// rating.DefaultGames holds the default value on creation for the games field.
+	rating.DefaultGames = ratingDescGames.Default.(int)
+	// rating.GamesValidator is a validator for the "games" field. It is called by the builders before save.
+	rating.GamesValidator = ratingDescGames.Validators[0].(func(int) error)
+	// ratingDescUpdatedTs is the schema descriptor for updated_ts field.
+	ratingDescUpdatedTs := ratingFields[5].Descriptor()
+	// rating.DefaultUpdatedTs holds the default value on creation for the updated_ts field.
+	rating.DefaultUpdatedTs = ratingDescUpdatedTs.Default.(func() time.Time)
+	standingFields := schema.Standing{}.Fields()
+	_ = standingFields
+	// standingDescSeason is the schema descriptor for season field.
+	standingDescSeason := standingFields[0].Descriptor()
+	// standing.SeasonValidator is a validator for the "season" field. It is called by the builders before save.
+	standing.SeasonValidator = standingDescSeason.Validators[0].(func(int8) error)
+	// standingDescLeague is the schema descriptor for league field.
+	standingDescLeague := standingFields[2].Descriptor()
+	// standing.LeagueValidator is a validator for the "league" field. It is called by the builders before save.
+	standing.LeagueValidator = standingDescLeague.Validators[0].(func(int) error)
+	// standingDescRank is the schema descriptor for rank field.
+	standingDescRank := standingFields[3].Descriptor()
+	// standing.RankValidator is a validator for the "rank" field. It is called by the builders before save.
+	standing.RankValidator = standingDescRank.Validators[0].(func(int) error)
+	// standingDescPoints is the schema descriptor for points field.
+	standingDescPoints := standingFields[4].Descriptor()
+	// standing.DefaultPoints holds the default value on creation for the points field.
+	standing.DefaultPoints = standingDescPoints.Default.(int)
+	// standing.PointsValidator is a validator for the "points" field. It is called by the builders before save.
+	standing.PointsValidator = standingDescPoints.Validators[0].(func(int) error)
+	// standingDescWins is the schema descriptor for wins field.
+	standingDescWins := standingFields[5].Descriptor()
+	// standing.DefaultWins holds the default value on creation for the wins field.
+	standing.DefaultWins = standingDescWins.Default.(int)
+	// standing.WinsValidator is a validator for the "wins" field. It is called by the builders before save.
+	standing.WinsValidator = standingDescWins.Validators[0].(func(int) error)
+	// standingDescLosses is the schema descriptor for losses field.
+	standingDescLosses := standingFields[6].Descriptor()
+	// standing.DefaultLosses holds the default value on creation for the losses field.
+	standing.DefaultLosses = standingDescLosses.Default.(int)
+	// standing.LossesValidator is a validator for the "losses" field. It is called by the builders before save.
+	standing.LossesValidator = standingDescLosses.Validators[0].(func(int) error)
+	turnFields := schema.Turn{}.Fields()
+	_ = turnFields
+	// turnDescNumber is the schema descriptor for number field.
+	turnDescNumber := turnFields[0].Descriptor()
+	// turn.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	turn.NumberValidator = turnDescNumber.Validators[0].(func(int) error)
 }
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/kevindamm/wits-go"
+)
+
+// Action holds the schema definition for the Action entity.
+type Action struct {
+	ent.Schema
+}
+
+// Fields of the Action.
+func (Action) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("sequence").
+			NonNegative().
+			Comment("The order of this action within its turn, starting at 0."),
+
+		field.Enum("name").
+			Values(
+				"MoveUnit",
+				"HealUnit",
+				"SpawnUnit",
+				"Attack",
+				"CharmUnit",
+				"ToggleAlt",
+				"Teleport").
+			Comment("The kind of action, passing (ending the turn) is not recorded."),
+
+		field.Uint8("class").
+			GoType(wits.UnitClassEnum(0)).
+			Comment("the class of the acting unit, or of the unit spawned"),
+		field.Uint8("race").
+			GoType(wits.UnitRaceEnum(0)).
+			Comment("the race of the acting (or spawned) unit, identifies the special unit"),
+
+		field.Int("turn_number").
+			Positive().
+			Comment("Denormalized from the turn, for indexing."),
+
+		field.Int("agent_i").Comment("position of the acting unit (or spawn tile)"),
+		field.Int("agent_j"),
+		field.Int("target_i").Optional().Nillable().
+			Comment("position of the target or destination, if the action has one"),
+		field.Int("target_j").Optional().Nillable(),
+		field.Int("dest_i").Optional().Nillable().
+			Comment("where the target is teleported to, for Teleport actions"),
+		field.Int("dest_j").Optional().Nillable(),
+	}
+}
+
+// Edges of the Action.
+func (Action) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("turn", Turn.Type).
+			Ref("actions").
+			Unique().
+			Required(),
+
+		// Denormalized from the turn's match, for indexing.
+		edge.From("map", OsnMap.Type).
+			Ref("actions").
+			Unique(),
+	}
+}
+
+func (Action) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sequence").Edges("turn").Unique(),
+		index.Fields("class", "race").Edges("map"),
+		index.Fields("name", "class", "turn_number"),
+	}
+}
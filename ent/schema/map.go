@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/witsjson"
 )
 
 // Arena holds the schema definition for the Arena entity.
@@ -17,6 +18,42 @@ func (OsnMap) Fields() []ent.Field {
 		field.String("name"),
 		field.String("shortname"),
 		field.Int("role_count").Positive(),
+
+		field.String("map_id").
+			Optional().
+			Unique().
+			Comment("The full map ID (as in oml/solo/peekaboo), the shortname is its last segment."),
+		field.String("content_hash").
+			Optional().
+			Comment("Hex-encoded SHA-256 of the formatted definition, it changes whenever the map does."),
+
+		field.Int("floor_count").
+			NonNegative().
+			Default(0),
+		field.Int("wall_count").
+			NonNegative().
+			Default(0),
+		field.Int("bonus_count").
+			NonNegative().
+			Default(0),
+		field.Int("spawn_count").
+			NonNegative().
+			Default(0),
+		field.Enum("symmetry").
+			Values("NONE", "ROTATE", "VERTICAL", "HORIZONTAL").
+			Default("NONE").
+			Comment("The symmetry declared by the definition, a half-turn rotation or a reflection."),
+		field.Bool("legacy").
+			Default(false).
+			Comment("Whether the definition is in the legacy (column and row) coordinates."),
+
+		field.Text("thumbnail_svg").
+			Optional().
+			StructTag(`json:"-"`).
+			Comment("A small drawing of the map, served separately from the map's JSON."),
+		field.JSON("definition", &witsjson.MapDefinition{}).
+			Optional().
+			Comment("The full map definition, as in the map's JSON file."),
 	}
 }
 
@@ -24,5 +61,6 @@ func (OsnMap) Fields() []ent.Field {
 func (OsnMap) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("matches", Match.Type),
+		edge.To("actions", Action.Type),
 	}
 }
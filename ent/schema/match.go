@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/state"
 )
 
 // Match holds the schema definition for the Match entity.
@@ -24,6 +25,14 @@ func (Match) Fields() []ent.Field {
 		field.Int("version").
 			Immutable().
 			Comment("Which runtime version this match was played on.  Latest version is 1603."),
+		field.JSON("rules", &state.Ruleset{}).
+			Optional().
+			Immutable().
+			Comment("The custom variant of the rules the match was played under, if not those of its version."),
+
+		field.String("map_hash").
+			Optional().
+			Comment("The content hash of the map definition the match was played on, see OsnMap.content_hash."),
 
 		field.Int8("season").
 			Default(0).
@@ -33,6 +42,10 @@ func (Match) Fields() []ent.Field {
 			Default(time.Now).
 			Nillable().
 			Comment("The timestamp when this match was recorded.  Nillable so it is not required in JSON responses."),
+		field.Time("played_ts").
+			Optional().
+			Nillable().
+			Comment("When the match was played, if known.  Matches are rated and ranked in the order they were played, see archive.ByPlayed."),
 
 		field.Int("turn_count"),
 		field.Enum("fetch_status").
@@ -50,6 +63,9 @@ func (Match) Fields() []ent.Field {
 			Default("LISTED").
 			Nillable().
 			Comment("Where in the fetch/minify/convert process the replay is.  Nillable so that it is not required in JSON responses."),
+		field.String("invalid_reason").
+			Optional().
+			Comment("Why the replay could not advance when its fetch_status is INVALID."),
 	}
 }
 
@@ -62,5 +78,8 @@ func (Match) Edges() []ent.Edge {
 
 		edge.From("roles", PlayerRole.Type).
 			Ref("match"),
+
+		edge.To("turns", Turn.Type),
+		edge.To("rating_history", RatingHistory.Type),
 	}
 }
@@ -15,6 +15,7 @@ type Player struct {
 func (Player) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("gcid").
+			Optional().
 			Nillable().
 			Sensitive().
 			Unique(),
@@ -28,5 +29,9 @@ func (Player) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("roles", PlayerRole.Type).
 			Ref("players"),
+
+		edge.To("ratings", Rating.Type),
+		edge.To("rating_history", RatingHistory.Type),
+		edge.To("standings", Standing.Type),
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/kevindamm/wits-go"
 )
 
 // PlayerRole holds the schema definition for the PlayerRole entity.
@@ -29,6 +30,15 @@ func (PlayerRole) Fields() []ent.Field {
 		field.Int("turn_order").
 			Range(1, 4).
 			Comment("turn order is independent of base's map position"),
+
+		field.Uint8("race").
+			GoType(wits.UnitRaceEnum(0)).
+			Default(uint8(wits.RACE_UNKNOWN)).
+			Comment("the race (and thus the special unit) the player chose"),
+		field.Uint8("result").
+			GoType(wits.TerminalStatus(0)).
+			Default(uint8(wits.STATUS_UNKNOWN)).
+			Comment("the match result (TerminalStatus) relative to this player"),
 	}
 }
 
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Rating holds the schema definition for the Rating entity, the current
+// Glicko-2 rating of a player in one of the game modes.
+type Rating struct {
+	ent.Schema
+}
+
+// Fields of the Rating.
+func (Rating) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("mode").
+			Values("SOLO", "DUOS").
+			Comment("Solo (1v1) and duos (2v2) matches are rated separately."),
+
+		field.Float("rating").
+			Comment("Glicko-2 rating, on the familiar (Glicko) scale centered at 1500."),
+		field.Float("deviation").
+			Comment("Rating deviation, the uncertainty in the rating."),
+		field.Float("volatility").
+			Comment("The expected fluctuation in the rating."),
+
+		field.Int("games").
+			NonNegative().
+			Default(0),
+		field.Time("updated_ts").
+			Default(time.Now).
+			Comment("When the last rated match was played."),
+	}
+}
+
+// Edges of the Rating.
+func (Rating) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("player", Player.Type).
+			Ref("ratings").
+			Unique().
+			Required(),
+	}
+}
+
+func (Rating) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("mode").Edges("player").Unique(),
+		index.Fields("mode", "rating"),
+	}
+}
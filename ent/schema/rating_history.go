@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RatingHistory holds the schema definition for the RatingHistory entity, a
+// player's rating after each of their rated matches.
+type RatingHistory struct {
+	ent.Schema
+}
+
+// Fields of the RatingHistory.
+func (RatingHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("mode").
+			Values("SOLO", "DUOS"),
+
+		field.Float("rating"),
+		field.Float("deviation"),
+		field.Float("volatility"),
+		field.Float("delta").
+			Comment("The change in rating due to this match."),
+	}
+}
+
+// Edges of the RatingHistory.
+func (RatingHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("player", Player.Type).
+			Ref("rating_history").
+			Unique().
+			Required(),
+
+		edge.From("match", Match.Type).
+			Ref("rating_history").
+			Unique().
+			Required(),
+	}
+}
+
+func (RatingHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("mode").Edges("player"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Standing holds the schema definition for the Standing entity, a player's
+// league and rank points in a ranked season (final, once the season has ended).
+type Standing struct {
+	ent.Schema
+}
+
+// Fields of the Standing.
+func (Standing) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int8("season").
+			Positive().
+			Comment("The season number, as in Match.season."),
+
+		field.Enum("tier").
+			Values("Novice", "Intermediate", "Advanced", "Expert").
+			Comment("The skill level of the player's league (see wits.LeagueTier)."),
+		field.Int("league").
+			NonNegative().
+			Comment("Which of the tier's leagues the player is in, starting at 0."),
+		field.Int("rank").
+			Positive().
+			Comment("The player's rank within their league."),
+
+		field.Int("points").
+			NonNegative().
+			Default(0),
+		field.Int("wins").
+			NonNegative().
+			Default(0),
+		field.Int("losses").
+			NonNegative().
+			Default(0),
+	}
+}
+
+// Edges of the Standing.
+func (Standing) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("player", Player.Type).
+			Ref("standings").
+			Unique().
+			Required(),
+	}
+}
+
+func (Standing) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("season").Edges("player").Unique(),
+		index.Fields("season", "tier", "league", "rank"),
+	}
+}
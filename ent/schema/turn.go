@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/kevindamm/wits-go"
+)
+
+// Turn holds the schema definition for the Turn entity.
+type Turn struct {
+	ent.Schema
+}
+
+// Fields of the Turn.
+func (Turn) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("number").
+			Positive().
+			Comment("The turn count within the match, starting at 1."),
+
+		field.Uint8("team").
+			GoType(wits.FriendlyEnum(0)).
+			Comment("the team (turn order) of the player whose turn this was"),
+	}
+}
+
+// Edges of the Turn.
+func (Turn) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("match", Match.Type).
+			Ref("turns").
+			Unique().
+			Required(),
+
+		edge.To("actions", Action.Type),
+	}
+}
+
+func (Turn) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("number").Edges("match").Unique(),
+	}
+}
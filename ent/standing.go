@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kevindamm/wits-go/ent/player"
+	"github.com/kevindamm/wits-go/ent/standing"
+)
+
+// Standing is the model entity for the Standing schema.
+type Standing struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// The season number, as in Match.season.
+	Season int8 `json:"season,omitempty"`
+	// The skill level of the player's league (see wits.LeagueTier).
+	Tier standing.Tier `json:"tier,omitempty"`
+	// Which of the tier's leagues the player is in, starting at 0.
+	League int `json:"league,omitempty"`
+	// The player's rank within their league.
+	Rank int `json:"rank,omitempty"`
+	// Points holds the value of the "points" field.
+	Points int `json:"points,omitempty"`
+	// Wins holds the value of the "wins" field.
+	Wins int `json:"wins,omitempty"`
+	// Losses holds the value of the "losses" field.
+	Losses int `json:"losses,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StandingQuery when eager-loading is set.
+	Edges            StandingEdges `json:"edges"`
+	player_standings *int
+	selectValues     sql.SelectValues
+}
+
+// StandingEdges holds the relations/edges for other nodes in the graph.
+type StandingEdges struct {
+	// Player holds the value of the player edge.
+	Player *Player `json:"player,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlayerOrErr returns the Player value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StandingEdges) PlayerOrErr() (*Player, error) {
+	if e.Player != nil {
+		return e.Player, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: player.Label}
+	}
+	return nil, &NotLoadedError{edge: "player"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Standing) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case standing.FieldID, standing.FieldSeason, standing.FieldLeague, standing.FieldRank, standing.FieldPoints, standing.FieldWins, standing.FieldLosses:
+			values[i] = new(sql.NullInt64)
+		case standing.FieldTier:
+			values[i] = new(sql.NullString)
+		case standing.ForeignKeys[0]: // player_standings
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Standing fields.
+func (s *Standing) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case standing.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case standing.FieldSeason:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field season", values[i])
+			} else if value.Valid {
+				s.Season = int8(value.Int64)
+			}
+		case standing.FieldTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tier", values[i])
+			} else if value.Valid {
+				s.Tier = standing.Tier(value.String)
+			}
+		case standing.FieldLeague:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field league", values[i])
+			} else if value.Valid {
+				s.League = int(value.Int64)
+			}
+		case standing.FieldRank:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				s.Rank = int(value.Int64)
+			}
+		case standing.FieldPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field points", values[i])
+			} else if value.Valid {
+				s.Points = int(value.Int64)
+			}
+		case standing.FieldWins:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wins", values[i])
+			} else if value.Valid {
+				s.Wins = int(value.Int64)
+			}
+		case standing.FieldLosses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field losses", values[i])
+			} else if value.Valid {
+				s.Losses = int(value.Int64)
+			}
+		case standing.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field player_standings", value)
+			} else if value.Valid {
+				s.player_standings = new(int)
+				*s.player_standings = int(value.Int64)
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Standing.
+// This includes values selected through modifiers, order, etc.
+func (s *Standing) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryPlayer queries the "player" edge of the Standing entity.
+func (s *Standing) QueryPlayer() *PlayerQuery {
+	return NewStandingClient(s.config).QueryPlayer(s)
+}
+
+// Update returns a builder for updating this Standing.
+// Note that you need to call Standing.Unwrap() before calling this method if this Standing
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Standing) Update() *StandingUpdateOne {
+	return NewStandingClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Standing entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Standing) Unwrap() *Standing {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Standing is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Standing) String() string {
+	var builder strings.Builder
+	builder.WriteString("Standing(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("season=")
+	builder.WriteString(fmt.Sprintf("%v", s.Season))
+	builder.WriteString(", ")
+	builder.WriteString("tier=")
+	builder.WriteString(fmt.Sprintf("%v", s.Tier))
+	builder.WriteString(", ")
+	builder.WriteString("league=")
+	builder.WriteString(fmt.Sprintf("%v", s.League))
+	builder.WriteString(", ")
+	builder.WriteString("rank=")
+	builder.WriteString(fmt.Sprintf("%v", s.Rank))
+	builder.WriteString(", ")
+	builder.WriteString("points=")
+	builder.WriteString(fmt.Sprintf("%v", s.Points))
+	builder.WriteString(", ")
+	builder.WriteString("wins=")
+	builder.WriteString(fmt.Sprintf("%v", s.Wins))
+	builder.WriteString(", ")
+	builder.WriteString("losses=")
+	builder.WriteString(fmt.Sprintf("%v", s.Losses))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Standings is a parsable slice of Standing.
+type Standings []*Standing
@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package standing
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the standing type in the database.
+	Label = "standing"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSeason holds the string denoting the season field in the database.
+	FieldSeason = "season"
+	// FieldTier holds the string denoting the tier field in the database.
+	FieldTier = "tier"
+	// FieldLeague holds the string denoting the league field in the database.
+	FieldLeague = "league"
+	// FieldRank holds the string denoting the rank field in the database.
+	FieldRank = "rank"
+	// FieldPoints holds the string denoting the points field in the database.
+	FieldPoints = "points"
+	// FieldWins holds the string denoting the wins field in the database.
+	FieldWins = "wins"
+	// FieldLosses holds the string denoting the losses field in the database.
+	FieldLosses = "losses"
+	// EdgePlayer holds the string denoting the player edge name in mutations.
+	EdgePlayer = "player"
+	// Table holds the table name of the standing in the database.
+	Table = "standings"
+	// PlayerTable is the table that holds the player relation/edge.
+	PlayerTable = "standings"
+	// PlayerInverseTable is the table name for the Player entity.
+	// It exists in this package in order to avoid circular dependency with the "player" package.
+	PlayerInverseTable = "players"
+	// PlayerColumn is the table column denoting the player relation/edge.
+	PlayerColumn = "player_standings"
+)
+
+// Columns holds all SQL columns for standing fields.
+var Columns = []string{
+	FieldID,
+	FieldSeason,
+	FieldTier,
+	FieldLeague,
+	FieldRank,
+	FieldPoints,
+	FieldWins,
+	FieldLosses,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "standings"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"player_standings",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SeasonValidator is a validator for the "season" field. It is called by the builders before save.
+	SeasonValidator func(int8) error
+	// LeagueValidator is a validator for the "league" field. It is called by the builders before save.
+	LeagueValidator func(int) error
+	// RankValidator is a validator for the "rank" field. It is called by the builders before save.
+	RankValidator func(int) error
+	// DefaultPoints holds the default value on creation for the "points" field.
+	DefaultPoints int
+	// PointsValidator is a validator for the "points" field. It is called by the builders before save.
+	PointsValidator func(int) error
+	// DefaultWins holds the default value on creation for the "wins" field.
+	DefaultWins int
+	// WinsValidator is a validator for the "wins" field. It is called by the builders before save.
+	WinsValidator func(int) error
+	// DefaultLosses holds the default value on creation for the "losses" field.
+	DefaultLosses int
+	// LossesValidator is a validator for the "losses" field. It is called by the builders before save.
+	LossesValidator func(int) error
+)
+
+// Tier defines the type for the "tier" enum field.
+type Tier string
+
+// Tier values.
+const (
+	TierNovice       Tier = "Novice"
+	TierIntermediate Tier = "Intermediate"
+	TierAdvanced     Tier = "Advanced"
+	TierExpert       Tier = "Expert"
+)
+
+func (t Tier) String() string {
+	return string(t)
+}
+
+// TierValidator is a validator for the "tier" field enum values. It is called by the builders before save.
+func TierValidator(t Tier) error {
+	switch t {
+	case TierNovice, TierIntermediate, TierAdvanced, TierExpert:
+		return nil
+	default:
+		return fmt.Errorf("standing: invalid enum value for tier field: %q", t)
+	}
+}
+
+// OrderOption defines the ordering options for the Standing queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySeason orders the results by the season field.
+func BySeason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeason, opts...).ToFunc()
+}
+
+// ByTier orders the results by the tier field.
+func ByTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTier, opts...).ToFunc()
+}
+
+// ByLeague orders the results by the league field.
+func ByLeague(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeague, opts...).ToFunc()
+}
+
+// ByRank orders the results by the rank field.
+func ByRank(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRank, opts...).ToFunc()
+}
+
+// ByPoints orders the results by the points field.
+func ByPoints(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPoints, opts...).ToFunc()
+}
+
+// ByWins orders the results by the wins field.
+func ByWins(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWins, opts...).ToFunc()
+}
+
+// ByLosses orders the results by the losses field.
+func ByLosses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLosses, opts...).ToFunc()
+}
+
+// ByPlayerField orders the results by player field.
+func ByPlayerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlayerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPlayerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlayerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+	)
+}
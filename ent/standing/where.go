@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package standing
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kevindamm/wits-go/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Standing {
+	return predicate.Standing(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Standing {
+	return predicate.Standing(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Standing {
+	return predicate.Standing(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Standing {
+	return predicate.Standing(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Standing {
+	return predicate.Standing(sql.FieldLTE(FieldID, id))
+}
+
+// Season applies equality check predicate on the "season" field. It's identical to SeasonEQ.
+func Season(v int8) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldSeason, v))
+}
+
+// League applies equality check predicate on the "league" field. It's identical to LeagueEQ.
+func League(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldLeague, v))
+}
+
+// Rank applies equality check predicate on the "rank" field. It's identical to RankEQ.
+func Rank(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldRank, v))
+}
+
+// Points applies equality check predicate on the "points" field. It's identical to PointsEQ.
+func Points(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldPoints, v))
+}
+
+// Wins applies equality check predicate on the "wins" field. It's identical to WinsEQ.
+func Wins(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldWins, v))
+}
+
+// Losses applies equality check predicate on the "losses" field. It's identical to LossesEQ.
+func Losses(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldLosses, v))
+}
+
+// SeasonEQ applies the EQ predicate on the "season" field.
+func SeasonEQ(v int8) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldSeason, v))
+}
+
+// SeasonNEQ applies the NEQ predicate on the "season" field.
+func SeasonNEQ(v int8) predicate.Standing {
+	return predicate.Standing(sql.FieldNEQ(FieldSeason, v))
+}
+
+// SeasonIn applies the In predicate on the "season" field.
+func SeasonIn(vs ...int8) predicate.Standing {
+	return predicate.Standing(sql.FieldIn(FieldSeason, vs...))
+}
+
+// SeasonNotIn applies the NotIn predicate on the "season" field.
+func SeasonNotIn(vs ...int8) predicate.Standing {
+	return predicate.Standing(sql.FieldNotIn(FieldSeason, vs...))
+}
+
+// SeasonGT applies the GT predicate on the "season" field.
+func SeasonGT(v int8) predicate.Standing {
+	return predicate.Standing(sql.FieldGT(FieldSeason, v))
+}
+
+// SeasonGTE applies the GTE predicate on the "season" field.
+func SeasonGTE(v int8) predicate.Standing {
+	return predicate.Standing(sql.FieldGTE(FieldSeason, v))
+}
+
+// SeasonLT applies the LT predicate on the "season" field.
+func SeasonLT(v int8) predicate.Standing {
+	return predicate.Standing(sql.FieldLT(FieldSeason, v))
+}
+
+// SeasonLTE applies the LTE predicate on the "season" field.
+func SeasonLTE(v int8) predicate.Standing {
+	return predicate.Standing(sql.FieldLTE(FieldSeason, v))
+}
+
+// TierEQ applies the EQ predicate on the "tier" field.
+func TierEQ(v Tier) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldTier, v))
+}
+
+// TierNEQ applies the NEQ predicate on the "tier" field.
+func TierNEQ(v Tier) predicate.Standing {
+	return predicate.Standing(sql.FieldNEQ(FieldTier, v))
+}
+
+// TierIn applies the In predicate on the "tier" field.
+func TierIn(vs ...Tier) predicate.Standing {
+	return predicate.Standing(sql.FieldIn(FieldTier, vs...))
+}
+
+// TierNotIn applies the NotIn predicate on the "tier" field.
+func TierNotIn(vs ...Tier) predicate.Standing {
+	return predicate.Standing(sql.FieldNotIn(FieldTier, vs...))
+}
+
+// LeagueEQ applies the EQ predicate on the "league" field.
+func LeagueEQ(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldLeague, v))
+}
+
+// LeagueNEQ applies the NEQ predicate on the "league" field.
+func LeagueNEQ(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldNEQ(FieldLeague, v))
+}
+
+// LeagueIn applies the In predicate on the "league" field.
+func LeagueIn(vs ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldIn(FieldLeague, vs...))
+}
+
+// LeagueNotIn applies the NotIn predicate on the "league" field.
+func LeagueNotIn(vs ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldNotIn(FieldLeague, vs...))
+}
+
+// LeagueGT applies the GT predicate on the "league" field.
+func LeagueGT(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldGT(FieldLeague, v))
+}
+
+// LeagueGTE applies the GTE predicate on the "league" field.
+func LeagueGTE(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldGTE(FieldLeague, v))
+}
+
+// LeagueLT applies the LT predicate on the "league" field.
+func LeagueLT(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldLT(FieldLeague, v))
+}
+
+// LeagueLTE applies the LTE predicate on the "league" field.
+func LeagueLTE(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldLTE(FieldLeague, v))
+}
+
+// RankEQ applies the EQ predicate on the "rank" field.
+func RankEQ(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldRank, v))
+}
+
+// RankNEQ applies the NEQ predicate on the "rank" field.
+func RankNEQ(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldNEQ(FieldRank, v))
+}
+
+// RankIn applies the In predicate on the "rank" field.
+func RankIn(vs ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldIn(FieldRank, vs...))
+}
+
+// RankNotIn applies the NotIn predicate on the "rank" field.
+func RankNotIn(vs ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldNotIn(FieldRank, vs...))
+}
+
+// RankGT applies the GT predicate on the "rank" field.
+func RankGT(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldGT(FieldRank, v))
+}
+
+// RankGTE applies the GTE predicate on the "rank" field.
+func RankGTE(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldGTE(FieldRank, v))
+}
+
+// RankLT applies the LT predicate on the "rank" field.
+func RankLT(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldLT(FieldRank, v))
+}
+
+// RankLTE applies the LTE predicate on the "rank" field.
+func RankLTE(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldLTE(FieldRank, v))
+}
+
+// PointsEQ applies the EQ predicate on the "points" field.
+func PointsEQ(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldPoints, v))
+}
+
+// PointsNEQ applies the NEQ predicate on the "points" field.
+func PointsNEQ(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldNEQ(FieldPoints, v))
+}
+
+// PointsIn applies the In predicate on the "points" field.
+func PointsIn(vs ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldIn(FieldPoints, vs...))
+}
+
+// PointsNotIn applies the NotIn predicate on the "points" field.
+func PointsNotIn(vs ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldNotIn(FieldPoints, vs...))
+}
+
+// PointsGT applies the GT predicate on the "points" field.
+func PointsGT(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldGT(FieldPoints, v))
+}
+
+// PointsGTE applies the GTE predicate on the "points" field.
+func PointsGTE(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldGTE(FieldPoints, v))
+}
+
+// PointsLT applies the LT predicate on the "points" field.
+func PointsLT(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldLT(FieldPoints, v))
+}
+
+// PointsLTE applies the LTE predicate on the "points" field.
+func PointsLTE(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldLTE(FieldPoints, v))
+}
+
+// WinsEQ applies the EQ predicate on the "wins" field.
+func WinsEQ(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldWins, v))
+}
+
+// WinsNEQ applies the NEQ predicate on the "wins" field.
+func WinsNEQ(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldNEQ(FieldWins, v))
+}
+
+// WinsIn applies the In predicate on the "wins" field.
+func WinsIn(vs ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldIn(FieldWins, vs...))
+}
+
+// WinsNotIn applies the NotIn predicate on the "wins" field.
+func WinsNotIn(vs ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldNotIn(FieldWins, vs...))
+}
+
+// WinsGT applies the GT predicate on the "wins" field.
+func WinsGT(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldGT(FieldWins, v))
+}
+
+// WinsGTE applies the GTE predicate on the "wins" field.
+func WinsGTE(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldGTE(FieldWins, v))
+}
+
+// WinsLT applies the LT predicate on the "wins" field.
+func WinsLT(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldLT(FieldWins, v))
+}
+
+// WinsLTE applies the LTE predicate on the "wins" field.
+func WinsLTE(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldLTE(FieldWins, v))
+}
+
+// LossesEQ applies the EQ predicate on the "losses" field.
+func LossesEQ(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldEQ(FieldLosses, v))
+}
+
+// LossesNEQ applies the NEQ predicate on the "losses" field.
+func LossesNEQ(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldNEQ(FieldLosses, v))
+}
+
+// LossesIn applies the In predicate on the "losses" field.
+func LossesIn(vs ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldIn(FieldLosses, vs...))
+}
+
+// LossesNotIn applies the NotIn predicate on the "losses" field.
+func LossesNotIn(vs ...int) predicate.Standing {
+	return predicate.Standing(sql.FieldNotIn(FieldLosses, vs...))
+}
+
+// LossesGT applies the GT predicate on the "losses" field.
+func LossesGT(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldGT(FieldLosses, v))
+}
+
+// LossesGTE applies the GTE predicate on the "losses" field.
+func LossesGTE(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldGTE(FieldLosses, v))
+}
+
+// LossesLT applies the LT predicate on the "losses" field.
+func LossesLT(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldLT(FieldLosses, v))
+}
+
+// LossesLTE applies the LTE predicate on the "losses" field.
+func LossesLTE(v int) predicate.Standing {
+	return predicate.Standing(sql.FieldLTE(FieldLosses, v))
+}
+
+// HasPlayer applies the HasEdge predicate on the "player" edge.
+func HasPlayer() predicate.Standing {
+	return predicate.Standing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlayerWith applies the HasEdge predicate on the "player" edge with a given conditions (other predicates).
+func HasPlayerWith(preds ...predicate.Player) predicate.Standing {
+	return predicate.Standing(func(s *sql.Selector) {
+		step := newPlayerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Standing) predicate.Standing {
+	return predicate.Standing(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Standing) predicate.Standing {
+	return predicate.Standing(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Standing) predicate.Standing {
+	return predicate.Standing(sql.NotPredicates(p))
+}
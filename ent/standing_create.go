@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/ent/player"
+	"github.com/kevindamm/wits-go/ent/standing"
+)
+
+// StandingCreate is the builder for creating a Standing entity.
+type StandingCreate struct {
+	config
+	mutation *StandingMutation
+	hooks    []Hook
+}
+
+// SetSeason sets the "season" field.
+func (sc *StandingCreate) SetSeason(i int8) *StandingCreate {
+	sc.mutation.SetSeason(i)
+	return sc
+}
+
+// SetTier sets the "tier" field.
+func (sc *StandingCreate) SetTier(s standing.Tier) *StandingCreate {
+	sc.mutation.SetTier(s)
+	return sc
+}
+
+// SetLeague sets the "league" field.
+func (sc *StandingCreate) SetLeague(i int) *StandingCreate {
+	sc.mutation.SetLeague(i)
+	return sc
+}
+
+// SetRank sets the "rank" field.
+func (sc *StandingCreate) SetRank(i int) *StandingCreate {
+	sc.mutation.SetRank(i)
+	return sc
+}
+
+// SetPoints sets the "points" field.
+func (sc *StandingCreate) SetPoints(i int) *StandingCreate {
+	sc.mutation.SetPoints(i)
+	return sc
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (sc *StandingCreate) SetNillablePoints(i *int) *StandingCreate {
+	if i != nil {
+		sc.SetPoints(*i)
+	}
+	return sc
+}
+
+// SetWins sets the "wins" field.
+func (sc *StandingCreate) SetWins(i int) *StandingCreate {
+	sc.mutation.SetWins(i)
+	return sc
+}
+
+// SetNillableWins sets the "wins" field if the given value is not nil.
+func (sc *StandingCreate) SetNillableWins(i *int) *StandingCreate {
+	if i != nil {
+		sc.SetWins(*i)
+	}
+	return sc
+}
+
+// SetLosses sets the "losses" field.
+func (sc *StandingCreate) SetLosses(i int) *StandingCreate {
+	sc.mutation.SetLosses(i)
+	return sc
+}
+
+// SetNillableLosses sets the "losses" field if the given value is not nil.
+func (sc *StandingCreate) SetNillableLosses(i *int) *StandingCreate {
+	if i != nil {
+		sc.SetLosses(*i)
+	}
+	return sc
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (sc *StandingCreate) SetPlayerID(id int) *StandingCreate {
+	sc.mutation.SetPlayerID(id)
+	return sc
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (sc *StandingCreate) SetPlayer(p *Player) *StandingCreate {
+	return sc.SetPlayerID(p.ID)
+}
+
+// Mutation returns the StandingMutation object of the builder.
+func (sc *StandingCreate) Mutation() *StandingMutation {
+	return sc.mutation
+}
+
+// Save creates the Standing in the database.
+func (sc *StandingCreate) Save(ctx context.Context) (*Standing, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *StandingCreate) SaveX(ctx context.Context) *Standing {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *StandingCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *StandingCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *StandingCreate) defaults() {
+	if _, ok := sc.mutation.Points(); !ok {
+		v := standing.DefaultPoints
+		sc.mutation.SetPoints(v)
+	}
+	if _, ok := sc.mutation.Wins(); !ok {
+		v := standing.DefaultWins
+		sc.mutation.SetWins(v)
+	}
+	if _, ok := sc.mutation.Losses(); !ok {
+		v := standing.DefaultLosses
+		sc.mutation.SetLosses(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *StandingCreate) check() error {
+	if _, ok := sc.mutation.Season(); !ok {
+		return &ValidationError{Name: "season", err: errors.New(`ent: missing required field "Standing.season"`)}
+	}
+	if v, ok := sc.mutation.Season(); ok {
+		if err := standing.SeasonValidator(v); err != nil {
+			return &ValidationError{Name: "season", err: fmt.Errorf(`ent: validator failed for field "Standing.season": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Tier(); !ok {
+		return &ValidationError{Name: "tier", err: errors.New(`ent: missing required field "Standing.tier"`)}
+	}
+	if v, ok := sc.mutation.Tier(); ok {
+		if err := standing.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "Standing.tier": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.League(); !ok {
+		return &ValidationError{Name: "league", err: errors.New(`ent: missing required field "Standing.league"`)}
+	}
+	if v, ok := sc.mutation.League(); ok {
+		if err := standing.LeagueValidator(v); err != nil {
+			return &ValidationError{Name: "league", err: fmt.Errorf(`ent: validator failed for field "Standing.league": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Rank(); !ok {
+		return &ValidationError{Name: "rank", err: errors.New(`ent: missing required field "Standing.rank"`)}
+	}
+	if v, ok := sc.mutation.Rank(); ok {
+		if err := standing.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "Standing.rank": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Points(); !ok {
+		return &ValidationError{Name: "points", err: errors.New(`ent: missing required field "Standing.points"`)}
+	}
+	if v, ok := sc.mutation.Points(); ok {
+		if err := standing.PointsValidator(v); err != nil {
+			return &ValidationError{Name: "points", err: fmt.Errorf(`ent: validator failed for field "Standing.points": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Wins(); !ok {
+		return &ValidationError{Name: "wins", err: errors.New(`ent: missing required field "Standing.wins"`)}
+	}
+	if v, ok := sc.mutation.Wins(); ok {
+		if err := standing.WinsValidator(v); err != nil {
+			return &ValidationError{Name: "wins", err: fmt.Errorf(`ent: validator failed for field "Standing.wins": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Losses(); !ok {
+		return &ValidationError{Name: "losses", err: errors.New(`ent: missing required field "Standing.losses"`)}
+	}
+	if v, ok := sc.mutation.Losses(); ok {
+		if err := standing.LossesValidator(v); err != nil {
+			return &ValidationError{Name: "losses", err: fmt.Errorf(`ent: validator failed for field "Standing.losses": %w`, err)}
+		}
+	}
+	if len(sc.mutation.PlayerIDs()) == 0 {
+		return &ValidationError{Name: "player", err: errors.New(`ent: missing required edge "Standing.player"`)}
+	}
+	return nil
+}
+
+func (sc *StandingCreate) sqlSave(ctx context.Context) (*Standing, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *StandingCreate) createSpec() (*Standing, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Standing{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(standing.Table, sqlgraph.NewFieldSpec(standing.FieldID, field.TypeInt))
+	)
+	if value, ok := sc.mutation.Season(); ok {
+		_spec.SetField(standing.FieldSeason, field.TypeInt8, value)
+		_node.Season = value
+	}
+	if value, ok := sc.mutation.Tier(); ok {
+		_spec.SetField(standing.FieldTier, field.TypeEnum, value)
+		_node.Tier = value
+	}
+	if value, ok := sc.mutation.League(); ok {
+		_spec.SetField(standing.FieldLeague, field.TypeInt, value)
+		_node.League = value
+	}
+	if value, ok := sc.mutation.Rank(); ok {
+		_spec.SetField(standing.FieldRank, field.TypeInt, value)
+		_node.Rank = value
+	}
+	if value, ok := sc.mutation.Points(); ok {
+		_spec.SetField(standing.FieldPoints, field.TypeInt, value)
+		_node.Points = value
+	}
+	if value, ok := sc.mutation.Wins(); ok {
+		_spec.SetField(standing.FieldWins, field.TypeInt, value)
+		_node.Wins = value
+	}
+	if value, ok := sc.mutation.Losses(); ok {
+		_spec.SetField(standing.FieldLosses, field.TypeInt, value)
+		_node.Losses = value
+	}
+	if nodes := sc.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   standing.PlayerTable,
+			Columns: []string{standing.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.player_standings = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StandingCreateBulk is the builder for creating many Standing entities in bulk.
+type StandingCreateBulk struct {
+	config
+	err      error
+	builders []*StandingCreate
+}
+
+// Save creates the Standing entities in the database.
+func (scb *StandingCreateBulk) Save(ctx context.Context) ([]*Standing, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Standing, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StandingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *StandingCreateBulk) SaveX(ctx context.Context) []*Standing {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *StandingCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *StandingCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
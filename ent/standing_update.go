@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kevindamm/wits-go/ent/player"
+	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/standing"
+)
+
+// StandingUpdate is the builder for updating Standing entities.
+type StandingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StandingMutation
+}
+
+// Where appends a list predicates to the StandingUpdate builder.
+func (su *StandingUpdate) Where(ps ...predicate.Standing) *StandingUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetSeason sets the "season" field.
+func (su *StandingUpdate) SetSeason(i int8) *StandingUpdate {
+	su.mutation.ResetSeason()
+	su.mutation.SetSeason(i)
+	return su
+}
+
+// SetNillableSeason sets the "season" field if the given value is not nil.
+func (su *StandingUpdate) SetNillableSeason(i *int8) *StandingUpdate {
+	if i != nil {
+		su.SetSeason(*i)
+	}
+	return su
+}
+
+// AddSeason adds i to the "season" field.
+func (su *StandingUpdate) AddSeason(i int8) *StandingUpdate {
+	su.mutation.AddSeason(i)
+	return su
+}
+
+// SetTier sets the "tier" field.
+func (su *StandingUpdate) SetTier(s standing.Tier) *StandingUpdate {
+	su.mutation.SetTier(s)
+	return su
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (su *StandingUpdate) SetNillableTier(s *standing.Tier) *StandingUpdate {
+	if s != nil {
+		su.SetTier(*s)
+	}
+	return su
+}
+
+// SetLeague sets the "league" field.
+func (su *StandingUpdate) SetLeague(i int) *StandingUpdate {
+	su.mutation.ResetLeague()
+	su.mutation.SetLeague(i)
+	return su
+}
+
+// SetNillableLeague sets the "league" field if the given value is not nil.
+func (su *StandingUpdate) SetNillableLeague(i *int) *StandingUpdate {
+	if i != nil {
+		su.SetLeague(*i)
+	}
+	return su
+}
+
+// AddLeague adds i to the "league" field.
+func (su *StandingUpdate) AddLeague(i int) *StandingUpdate {
+	su.mutation.AddLeague(i)
+	return su
+}
+
+// SetRank sets the "rank" field.
+func (su *StandingUpdate) SetRank(i int) *StandingUpdate {
+	su.mutation.ResetRank()
+	su.mutation.SetRank(i)
+	return su
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (su *StandingUpdate) SetNillableRank(i *int) *StandingUpdate {
+	if i != nil {
+		su.SetRank(*i)
+	}
+	return su
+}
+
+// AddRank adds i to the "rank" field.
+func (su *StandingUpdate) AddRank(i int) *StandingUpdate {
+	su.mutation.AddRank(i)
+	return su
+}
+
+// SetPoints sets the "points" field.
+func (su *StandingUpdate) SetPoints(i int) *StandingUpdate {
+	su.mutation.ResetPoints()
+	su.mutation.SetPoints(i)
+	return su
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (su *StandingUpdate) SetNillablePoints(i *int) *StandingUpdate {
+	if i != nil {
+		su.SetPoints(*i)
+	}
+	return su
+}
+
+// AddPoints adds i to the "points" field.
+func (su *StandingUpdate) AddPoints(i int) *StandingUpdate {
+	su.mutation.AddPoints(i)
+	return su
+}
+
+// SetWins sets the "wins" field.
+func (su *StandingUpdate) SetWins(i int) *StandingUpdate {
+	su.mutation.ResetWins()
+	su.mutation.SetWins(i)
+	return su
+}
+
+// SetNillableWins sets the "wins" field if the given value is not nil.
+func (su *StandingUpdate) SetNillableWins(i *int) *StandingUpdate {
+	if i != nil {
+		su.SetWins(*i)
+	}
+	return su
+}
+
+// AddWins adds i to the "wins" field.
+func (su *StandingUpdate) AddWins(i int) *StandingUpdate {
+	su.mutation.AddWins(i)
+	return su
+}
+
+// SetLosses sets the "losses" field.
+func (su *StandingUpdate) SetLosses(i int) *StandingUpdate {
+	su.mutation.ResetLosses()
+	su.mutation.SetLosses(i)
+	return su
+}
+
+// SetNillableLosses sets the "losses" field if the given value is not nil.
+func (su *StandingUpdate) SetNillableLosses(i *int) *StandingUpdate {
+	if i != nil {
+		su.SetLosses(*i)
+	}
+	return su
+}
+
+// AddLosses adds i to the "losses" field.
+func (su *StandingUpdate) AddLosses(i int) *StandingUpdate {
+	su.mutation.AddLosses(i)
+	return su
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (su *StandingUpdate) SetPlayerID(id int) *StandingUpdate {
+	su.mutation.SetPlayerID(id)
+	return su
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (su *StandingUpdate) SetPlayer(p *Player) *StandingUpdate {
+	return su.SetPlayerID(p.ID)
+}
+
+// Mutation returns the StandingMutation object of the builder.
+func (su *StandingUpdate) Mutation() *StandingMutation {
+	return su.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (su *StandingUpdate) ClearPlayer() *StandingUpdate {
+	su.mutation.ClearPlayer()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *StandingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *StandingUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *StandingUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *StandingUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *StandingUpdate) check() error {
+	if v, ok := su.mutation.Season(); ok {
+		if err := standing.SeasonValidator(v); err != nil {
+			return &ValidationError{Name: "season", err: fmt.Errorf(`ent: validator failed for field "Standing.season": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Tier(); ok {
+		if err := standing.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "Standing.tier": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.League(); ok {
+		if err := standing.LeagueValidator(v); err != nil {
+			return &ValidationError{Name: "league", err: fmt.Errorf(`ent: validator failed for field "Standing.league": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Rank(); ok {
+		if err := standing.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "Standing.rank": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Points(); ok {
+		if err := standing.PointsValidator(v); err != nil {
+			return &ValidationError{Name: "points", err: fmt.Errorf(`ent: validator failed for field "Standing.points": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Wins(); ok {
+		if err := standing.WinsValidator(v); err != nil {
+			return &ValidationError{Name: "wins", err: fmt.Errorf(`ent: validator failed for field "Standing.wins": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Losses(); ok {
+		if err := standing.LossesValidator(v); err != nil {
+			return &ValidationError{Name: "losses", err: fmt.Errorf(`ent: validator failed for field "Standing.losses": %w`, err)}
+		}
+	}
+	if su.mutation.PlayerCleared() && len(su.mutation.PlayerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Standing.player"`)
+	}
+	return nil
+}
+
+func (su *StandingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(standing.Table, standing.Columns, sqlgraph.NewFieldSpec(standing.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Season(); ok {
+		_spec.SetField(standing.FieldSeason, field.TypeInt8, value)
+	}
+	if value, ok := su.mutation.AddedSeason(); ok {
+		_spec.AddField(standing.FieldSeason, field.TypeInt8, value)
+	}
+	if value, ok := su.mutation.Tier(); ok {
+		_spec.SetField(standing.FieldTier, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.League(); ok {
+		_spec.SetField(standing.FieldLeague, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedLeague(); ok {
+		_spec.AddField(standing.FieldLeague, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Rank(); ok {
+		_spec.SetField(standing.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedRank(); ok {
+		_spec.AddField(standing.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Points(); ok {
+		_spec.SetField(standing.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedPoints(); ok {
+		_spec.AddField(standing.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Wins(); ok {
+		_spec.SetField(standing.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedWins(); ok {
+		_spec.AddField(standing.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Losses(); ok {
+		_spec.SetField(standing.FieldLosses, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedLosses(); ok {
+		_spec.AddField(standing.FieldLosses, field.TypeInt, value)
+	}
+	if su.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   standing.PlayerTable,
+			Columns: []string{standing.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   standing.PlayerTable,
+			Columns: []string{standing.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{standing.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// StandingUpdateOne is the builder for updating a single Standing entity.
+type StandingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StandingMutation
+}
+
+// SetSeason sets the "season" field.
+func (suo *StandingUpdateOne) SetSeason(i int8) *StandingUpdateOne {
+	suo.mutation.ResetSeason()
+	suo.mutation.SetSeason(i)
+	return suo
+}
+
+// SetNillableSeason sets the "season" field if the given value is not nil.
+func (suo *StandingUpdateOne) SetNillableSeason(i *int8) *StandingUpdateOne {
+	if i != nil {
+		suo.SetSeason(*i)
+	}
+	return suo
+}
+
+// AddSeason adds i to the "season" field.
+func (suo *StandingUpdateOne) AddSeason(i int8) *StandingUpdateOne {
+	suo.mutation.AddSeason(i)
+	return suo
+}
+
+// SetTier sets the "tier" field.
+func (suo *StandingUpdateOne) SetTier(s standing.Tier) *StandingUpdateOne {
+	suo.mutation.SetTier(s)
+	return suo
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (suo *StandingUpdateOne) SetNillableTier(s *standing.Tier) *StandingUpdateOne {
+	if s != nil {
+		suo.SetTier(*s)
+	}
+	return suo
+}
+
+// SetLeague sets the "league" field.
+func (suo *StandingUpdateOne) SetLeague(i int) *StandingUpdateOne {
+	suo.mutation.ResetLeague()
+	suo.mutation.SetLeague(i)
+	return suo
+}
+
+// SetNillableLeague sets the "league" field if the given value is not nil.
+func (suo *StandingUpdateOne) SetNillableLeague(i *int) *StandingUpdateOne {
+	if i != nil {
+		suo.SetLeague(*i)
+	}
+	return suo
+}
+
+// AddLeague adds i to the "league" field.
+func (suo *StandingUpdateOne) AddLeague(i int) *StandingUpdateOne {
+	suo.mutation.AddLeague(i)
+	return suo
+}
+
+// SetRank sets the "rank" field.
+func (suo *StandingUpdateOne) SetRank(i int) *StandingUpdateOne {
+	suo.mutation.ResetRank()
+	suo.mutation.SetRank(i)
+	return suo
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (suo *StandingUpdateOne) SetNillableRank(i *int) *StandingUpdateOne {
+	if i != nil {
+		suo.SetRank(*i)
+	}
+	return suo
+}
+
+// AddRank adds i to the "rank" field.
+func (suo *StandingUpdateOne) AddRank(i int) *StandingUpdateOne {
+	suo.mutation.AddRank(i)
+	return suo
+}
+
+// SetPoints sets the "points" field.
+func (suo *StandingUpdateOne) SetPoints(i int) *StandingUpdateOne {
+	suo.mutation.ResetPoints()
+	suo.mutation.SetPoints(i)
+	return suo
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (suo *StandingUpdateOne) SetNillablePoints(i *int) *StandingUpdateOne {
+	if i != nil {
+		suo.SetPoints(*i)
+	}
+	return suo
+}
+
+// AddPoints adds i to the "points" field.
+func (suo *StandingUpdateOne) AddPoints(i int) *StandingUpdateOne {
+	suo.mutation.AddPoints(i)
+	return suo
+}
+
+// SetWins sets the "wins" field.
+func (suo *StandingUpdateOne) SetWins(i int) *StandingUpdateOne {
+	suo.mutation.ResetWins()
+	suo.mutation.SetWins(i)
+	return suo
+}
+
+// SetNillableWins sets the "wins" field if the given value is not nil.
+func (suo *StandingUpdateOne) SetNillableWins(i *int) *StandingUpdateOne {
+	if i != nil {
+		suo.SetWins(*i)
+	}
+	return suo
+}
+
+// AddWins adds i to the "wins" field.
+func (suo *StandingUpdateOne) AddWins(i int) *StandingUpdateOne {
+	suo.mutation.AddWins(i)
+	return suo
+}
+
+// SetLosses sets the "losses" field.
+func (suo *StandingUpdateOne) SetLosses(i int) *StandingUpdateOne {
+	suo.mutation.ResetLosses()
+	suo.mutation.SetLosses(i)
+	return suo
+}
+
+// SetNillableLosses sets the "losses" field if the given value is not nil.
+func (suo *StandingUpdateOne) SetNillableLosses(i *int) *StandingUpdateOne {
+	if i != nil {
+		suo.SetLosses(*i)
+	}
+	return suo
+}
+
+// AddLosses adds i to the "losses" field.
+func (suo *StandingUpdateOne) AddLosses(i int) *StandingUpdateOne {
+	suo.mutation.AddLosses(i)
+	return suo
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (suo *StandingUpdateOne) SetPlayerID(id int) *StandingUpdateOne {
+	suo.mutation.SetPlayerID(id)
+	return suo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (suo *StandingUpdateOne) SetPlayer(p *Player) *StandingUpdateOne {
+	return suo.SetPlayerID(p.ID)
+}
+
+// Mutation returns the StandingMutation object of the builder.
+func (suo *StandingUpdateOne) Mutation() *StandingMutation {
+	return suo.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (suo *StandingUpdateOne) ClearPlayer() *StandingUpdateOne {
+	suo.mutation.ClearPlayer()
+	return suo
+}
+
+// Where appends a list predicates to the StandingUpdate builder.
+func (suo *StandingUpdateOne) Where(ps ...predicate.Standing) *StandingUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *StandingUpdateOne) Select(field string, fields ...string) *StandingUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Standing entity.
+func (suo *StandingUpdateOne) Save(ctx context.Context) (*Standing, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *StandingUpdateOne) SaveX(ctx context.Context) *Standing {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *StandingUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *StandingUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *StandingUpdateOne) check() error {
+	if v, ok := suo.mutation.Season(); ok {
+		if err := standing.SeasonValidator(v); err != nil {
+			return &ValidationError{Name: "season", err: fmt.Errorf(`ent: validator failed for field "Standing.season": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Tier(); ok {
+		if err := standing.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "Standing.tier": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.League(); ok {
+		if err := standing.LeagueValidator(v); err != nil {
+			return &ValidationError{Name: "league", err: fmt.Errorf(`ent: validator failed for field "Standing.league": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Rank(); ok {
+		if err := standing.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "Standing.rank": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Points(); ok {
+		if err := standing.PointsValidator(v); err != nil {
+			return &ValidationError{Name: "points", err: fmt.Errorf(`ent: validator failed for field "Standing.points": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Wins(); ok {
+		if err := standing.WinsValidator(v); err != nil {
+			return &ValidationError{Name: "wins", err: fmt.Errorf(`ent: validator failed for field "Standing.wins": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Losses(); ok {
+		if err := standing.LossesValidator(v); err != nil {
+			return &ValidationError{Name: "losses", err: fmt.Errorf(`ent: validator failed for field "Standing.losses": %w`, err)}
+		}
+	}
+	if suo.mutation.PlayerCleared() && len(suo.mutation.PlayerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Standing.player"`)
+	}
+	return nil
+}
+
+func (suo *StandingUpdateOne) sqlSave(ctx context.Context) (_node *Standing, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(standing.Table, standing.Columns, sqlgraph.NewFieldSpec(standing.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Standing.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, standing.FieldID)
+		for _, f := range fields {
+			if !standing.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != standing.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Season(); ok {
+		_spec.SetField(standing.FieldSeason, field.TypeInt8, value)
+	}
+	if value, ok := suo.mutation.AddedSeason(); ok {
+		_spec.AddField(standing.FieldSeason, field.TypeInt8, value)
+	}
+	if value, ok := suo.mutation.Tier(); ok {
+		_spec.SetField(standing.FieldTier, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.League(); ok {
+		_spec.SetField(standing.FieldLeague, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedLeague(); ok {
+		_spec.AddField(standing.FieldLeague, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Rank(); ok {
+		_spec.SetField(standing.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedRank(); ok {
+		_spec.AddField(standing.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Points(); ok {
+		_spec.SetField(standing.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedPoints(); ok {
+		_spec.AddField(standing.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Wins(); ok {
+		_spec.SetField(standing.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedWins(); ok {
+		_spec.AddField(standing.FieldWins, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Losses(); ok {
+		_spec.SetField(standing.FieldLosses, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedLosses(); ok {
+		_spec.AddField(standing.FieldLosses, field.TypeInt, value)
+	}
+	if suo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   standing.PlayerTable,
+			Columns: []string{standing.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   standing.PlayerTable,
+			Columns: []string{standing.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Standing{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{standing.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
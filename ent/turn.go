@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	wits "github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/turn"
+)
+
+// Turn is the model entity for the Turn schema.
+type Turn struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// The turn count within the match, starting at 1.
+	Number int `json:"number,omitempty"`
+	// the team (turn order) of the player whose turn this was
+	Team wits.FriendlyEnum `json:"team,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TurnQuery when eager-loading is set.
+	Edges        TurnEdges `json:"edges"`
+	match_turns  *int
+	selectValues sql.SelectValues
+}
+
+// TurnEdges holds the relations/edges for other nodes in the graph.
+type TurnEdges struct {
+	// Match holds the value of the match edge.
+	Match *Match `json:"match,omitempty"`
+	// Actions holds the value of the actions edge.
+	Actions []*Action `json:"actions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MatchOrErr returns the Match value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TurnEdges) MatchOrErr() (*Match, error) {
+	if e.Match != nil {
+		return e.Match, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: match.Label}
+	}
+	return nil, &NotLoadedError{edge: "match"}
+}
+
+// ActionsOrErr returns the Actions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TurnEdges) ActionsOrErr() ([]*Action, error) {
+	if e.loadedTypes[1] {
+		return e.Actions, nil
+	}
+	return nil, &NotLoadedError{edge: "actions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Turn) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case turn.FieldID, turn.FieldNumber, turn.FieldTeam:
+			values[i] = new(sql.NullInt64)
+		case turn.ForeignKeys[0]: // match_turns
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Turn fields.
+func (t *Turn) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case turn.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			t.ID = int(value.Int64)
+		case turn.FieldNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				t.Number = int(value.Int64)
+			}
+		case turn.FieldTeam:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team", values[i])
+			} else if value.Valid {
+				t.Team = wits.FriendlyEnum(value.Int64)
+			}
+		case turn.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field match_turns", value)
+			} else if value.Valid {
+				t.match_turns = new(int)
+				*t.match_turns = int(value.Int64)
+			}
+		default:
+			t.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Turn.
+// This includes values selected through modifiers, order, etc.
+func (t *Turn) Value(name string) (ent.Value, error) {
+	return t.selectValues.Get(name)
+}
+
+// QueryMatch queries the "match" edge of the Turn entity.
+func (t *Turn) QueryMatch() *MatchQuery {
+	return NewTurnClient(t.config).QueryMatch(t)
+}
+
+// QueryActions queries the "actions" edge of the Turn entity.
+func (t *Turn) QueryActions() *ActionQuery {
+	return NewTurnClient(t.config).QueryActions(t)
+}
+
+// Update returns a builder for updating this Turn.
+// Note that you need to call Turn.Unwrap() before calling this method if this Turn
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Turn) Update() *TurnUpdateOne {
+	return NewTurnClient(t.config).UpdateOne(t)
+}
+
+// Unwrap unwraps the Turn entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Turn) Unwrap() *Turn {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Turn is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Turn) String() string {
+	var builder strings.Builder
+	builder.WriteString("Turn(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("number=")
+	builder.WriteString(fmt.Sprintf("%v", t.Number))
+	builder.WriteString(", ")
+	builder.WriteString("team=")
+	builder.WriteString(fmt.Sprintf("%v", t.Team))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Turns is a parsable slice of Turn.
+type Turns []*Turn
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package turn
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	wits "github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Turn {
+	return predicate.Turn(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Turn {
+	return predicate.Turn(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Turn {
+	return predicate.Turn(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Turn {
+	return predicate.Turn(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Turn {
+	return predicate.Turn(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Turn {
+	return predicate.Turn(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Turn {
+	return predicate.Turn(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Turn {
+	return predicate.Turn(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Turn {
+	return predicate.Turn(sql.FieldLTE(FieldID, id))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v int) predicate.Turn {
+	return predicate.Turn(sql.FieldEQ(FieldNumber, v))
+}
+
+// Team applies equality check predicate on the "team" field. It's identical to TeamEQ.
+func Team(v wits.FriendlyEnum) predicate.Turn {
+	vc := uint8(v)
+	return predicate.Turn(sql.FieldEQ(FieldTeam, vc))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v int) predicate.Turn {
+	return predicate.Turn(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v int) predicate.Turn {
+	return predicate.Turn(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...int) predicate.Turn {
+	return predicate.Turn(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...int) predicate.Turn {
+	return predicate.Turn(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v int) predicate.Turn {
+	return predicate.Turn(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v int) predicate.Turn {
+	return predicate.Turn(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v int) predicate.Turn {
+	return predicate.Turn(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v int) predicate.Turn {
+	return predicate.Turn(sql.FieldLTE(FieldNumber, v))
+}
+
+// TeamEQ applies the EQ predicate on the "team" field.
+func TeamEQ(v wits.FriendlyEnum) predicate.Turn {
+	vc := uint8(v)
+	return predicate.Turn(sql.FieldEQ(FieldTeam, vc))
+}
+
+// TeamNEQ applies the NEQ predicate on the "team" field.
+func TeamNEQ(v wits.FriendlyEnum) predicate.Turn {
+	vc := uint8(v)
+	return predicate.Turn(sql.FieldNEQ(FieldTeam, vc))
+}
+
+// TeamIn applies the In predicate on the "team" field.
+func TeamIn(vs ...wits.FriendlyEnum) predicate.Turn {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint8(vs[i])
+	}
+	return predicate.Turn(sql.FieldIn(FieldTeam, v...))
+}
+
+// TeamNotIn applies the NotIn predicate on the "team" field.
+func TeamNotIn(vs ...wits.FriendlyEnum) predicate.Turn {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint8(vs[i])
+	}
+	return predicate.Turn(sql.FieldNotIn(FieldTeam, v...))
+}
+
+// TeamGT applies the GT predicate on the "team" field.
+func TeamGT(v wits.FriendlyEnum) predicate.Turn {
+	vc := uint8(v)
+	return predicate.Turn(sql.FieldGT(FieldTeam, vc))
+}
+
+// TeamGTE applies the GTE predicate on the "team" field.
+func TeamGTE(v wits.FriendlyEnum) predicate.Turn {
+	vc := uint8(v)
+	return predicate.Turn(sql.FieldGTE(FieldTeam, vc))
+}
+
+// TeamLT applies the LT predicate on the "team" field.
+func TeamLT(v wits.FriendlyEnum) predicate.Turn {
+	vc := uint8(v)
+	return predicate.Turn(sql.FieldLT(FieldTeam, vc))
+}
+
+// TeamLTE applies the LTE predicate on the "team" field.
+func TeamLTE(v wits.FriendlyEnum) predicate.Turn {
+	vc := uint8(v)
+	return predicate.Turn(sql.FieldLTE(FieldTeam, vc))
+}
+
+// HasMatch applies the HasEdge predicate on the "match" edge.
+func HasMatch() predicate.Turn {
+	return predicate.Turn(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MatchTable, MatchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMatchWith applies the HasEdge predicate on the "match" edge with a given conditions (other predicates).
+func HasMatchWith(preds ...predicate.Match) predicate.Turn {
+	return predicate.Turn(func(s *sql.Selector) {
+		step := newMatchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasActions applies the HasEdge predicate on the "actions" edge.
+func HasActions() predicate.Turn {
+	return predicate.Turn(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActionsTable, ActionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActionsWith applies the HasEdge predicate on the "actions" edge with a given conditions (other predicates).
+func HasActionsWith(preds ...predicate.Action) predicate.Turn {
+	return predicate.Turn(func(s *sql.Selector) {
+		step := newActionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Turn) predicate.Turn {
+	return predicate.Turn(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Turn) predicate.Turn {
+	return predicate.Turn(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Turn) predicate.Turn {
+	return predicate.Turn(sql.NotPredicates(p))
+}
@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	wits "github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/turn"
+)
+
+// TurnCreate is the builder for creating a Turn entity.
+type TurnCreate struct {
+	config
+	mutation *TurnMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (tc *TurnCreate) SetNumber(i int) *TurnCreate {
+	tc.mutation.SetNumber(i)
+	return tc
+}
+
+// SetTeam sets the "team" field.
+func (tc *TurnCreate) SetTeam(we wits.FriendlyEnum) *TurnCreate {
+	tc.mutation.SetTeam(we)
+	return tc
+}
+
+// SetMatchID sets the "match" edge to the Match entity by ID.
+func (tc *TurnCreate) SetMatchID(id int) *TurnCreate {
+	tc.mutation.SetMatchID(id)
+	return tc
+}
+
+// SetMatch sets the "match" edge to the Match entity.
+func (tc *TurnCreate) SetMatch(m *Match) *TurnCreate {
+	return tc.SetMatchID(m.ID)
+}
+
+// AddActionIDs adds the "actions" edge to the Action entity by IDs.
+func (tc *TurnCreate) AddActionIDs(ids ...int) *TurnCreate {
+	tc.mutation.AddActionIDs(ids...)
+	return tc
+}
+
+// AddActions adds the "actions" edges to the Action entity.
+func (tc *TurnCreate) AddActions(a ...*Action) *TurnCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tc.AddActionIDs(ids...)
+}
+
+// Mutation returns the TurnMutation object of the builder.
+func (tc *TurnCreate) Mutation() *TurnMutation {
+	return tc.mutation
+}
+
+// Save creates the Turn in the database.
+func (tc *TurnCreate) Save(ctx context.Context) (*Turn, error) {
+	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TurnCreate) SaveX(ctx context.Context) *Turn {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TurnCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TurnCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TurnCreate) check() error {
+	if _, ok := tc.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Turn.number"`)}
+	}
+	if v, ok := tc.mutation.Number(); ok {
+		if err := turn.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Turn.number": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Team(); !ok {
+		return &ValidationError{Name: "team", err: errors.New(`ent: missing required field "Turn.team"`)}
+	}
+	if len(tc.mutation.MatchIDs()) == 0 {
+		return &ValidationError{Name: "match", err: errors.New(`ent: missing required edge "Turn.match"`)}
+	}
+	return nil
+}
+
+func (tc *TurnCreate) sqlSave(ctx context.Context) (*Turn, error) {
+	if err := tc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tc.mutation.id = &_node.ID
+	tc.mutation.done = true
+	return _node, nil
+}
+
+func (tc *TurnCreate) createSpec() (*Turn, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Turn{config: tc.config}
+		_spec = sqlgraph.NewCreateSpec(turn.Table, sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt))
+	)
+	if value, ok := tc.mutation.Number(); ok {
+		_spec.SetField(turn.FieldNumber, field.TypeInt, value)
+		_node.Number = value
+	}
+	if value, ok := tc.mutation.Team(); ok {
+		_spec.SetField(turn.FieldTeam, field.TypeUint8, value)
+		_node.Team = value
+	}
+	if nodes := tc.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   turn.MatchTable,
+			Columns: []string{turn.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.match_turns = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   turn.ActionsTable,
+			Columns: []string{turn.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TurnCreateBulk is the builder for creating many Turn entities in bulk.
+type TurnCreateBulk struct {
+	config
+	err      error
+	builders []*TurnCreate
+}
+
+// Save creates the Turn entities in the database.
+func (tcb *TurnCreateBulk) Save(ctx context.Context) ([]*Turn, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Turn, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TurnMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TurnCreateBulk) SaveX(ctx context.Context) []*Turn {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TurnCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TurnCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
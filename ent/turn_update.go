@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	wits "github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/predicate"
+	"github.com/kevindamm/wits-go/ent/turn"
+)
+
+// TurnUpdate is the builder for updating Turn entities.
+type TurnUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TurnMutation
+}
+
+// Where appends a list predicates to the TurnUpdate builder.
+func (tu *TurnUpdate) Where(ps ...predicate.Turn) *TurnUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetNumber sets the "number" field.
+func (tu *TurnUpdate) SetNumber(i int) *TurnUpdate {
+	tu.mutation.ResetNumber()
+	tu.mutation.SetNumber(i)
+	return tu
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (tu *TurnUpdate) SetNillableNumber(i *int) *TurnUpdate {
+	if i != nil {
+		tu.SetNumber(*i)
+	}
+	return tu
+}
+
+// AddNumber adds i to the "number" field.
+func (tu *TurnUpdate) AddNumber(i int) *TurnUpdate {
+	tu.mutation.AddNumber(i)
+	return tu
+}
+
+// SetTeam sets the "team" field.
+func (tu *TurnUpdate) SetTeam(we wits.FriendlyEnum) *TurnUpdate {
+	tu.mutation.ResetTeam()
+	tu.mutation.SetTeam(we)
+	return tu
+}
+
+// SetNillableTeam sets the "team" field if the given value is not nil.
+func (tu *TurnUpdate) SetNillableTeam(we *wits.FriendlyEnum) *TurnUpdate {
+	if we != nil {
+		tu.SetTeam(*we)
+	}
+	return tu
+}
+
+// AddTeam adds we to the "team" field.
+func (tu *TurnUpdate) AddTeam(we wits.FriendlyEnum) *TurnUpdate {
+	tu.mutation.AddTeam(we)
+	return tu
+}
+
+// SetMatchID sets the "match" edge to the Match entity by ID.
+func (tu *TurnUpdate) SetMatchID(id int) *TurnUpdate {
+	tu.mutation.SetMatchID(id)
+	return tu
+}
+
+// SetMatch sets the "match" edge to the Match entity.
+func (tu *TurnUpdate) SetMatch(m *Match) *TurnUpdate {
+	return tu.SetMatchID(m.ID)
+}
+
+// AddActionIDs adds the "actions" edge to the Action entity by IDs.
+func (tu *TurnUpdate) AddActionIDs(ids ...int) *TurnUpdate {
+	tu.mutation.AddActionIDs(ids...)
+	return tu
+}
+
+// AddActions adds the "actions" edges to the Action entity.
+func (tu *TurnUpdate) AddActions(a ...*Action) *TurnUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.AddActionIDs(ids...)
+}
+
+// Mutation returns the TurnMutation object of the builder.
+func (tu *TurnUpdate) Mutation() *TurnMutation {
+	return tu.mutation
+}
+
+// ClearMatch clears the "match" edge to the Match entity.
+func (tu *TurnUpdate) ClearMatch() *TurnUpdate {
+	tu.mutation.ClearMatch()
+	return tu
+}
+
+// ClearActions clears all "actions" edges to the Action entity.
+func (tu *TurnUpdate) ClearActions() *TurnUpdate {
+	tu.mutation.ClearActions()
+	return tu
+}
+
+// RemoveActionIDs removes the "actions" edge to Action entities by IDs.
+func (tu *TurnUpdate) RemoveActionIDs(ids ...int) *TurnUpdate {
+	tu.mutation.RemoveActionIDs(ids...)
+	return tu
+}
+
+// RemoveActions removes "actions" edges to Action entities.
+func (tu *TurnUpdate) RemoveActions(a ...*Action) *TurnUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.RemoveActionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TurnUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TurnUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TurnUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TurnUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TurnUpdate) check() error {
+	if v, ok := tu.mutation.Number(); ok {
+		if err := turn.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Turn.number": %w`, err)}
+		}
+	}
+	if tu.mutation.MatchCleared() && len(tu.mutation.MatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Turn.match"`)
+	}
+	return nil
+}
+
+func (tu *TurnUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(turn.Table, turn.Columns, sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.Number(); ok {
+		_spec.SetField(turn.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedNumber(); ok {
+		_spec.AddField(turn.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.Team(); ok {
+		_spec.SetField(turn.FieldTeam, field.TypeUint8, value)
+	}
+	if value, ok := tu.mutation.AddedTeam(); ok {
+		_spec.AddField(turn.FieldTeam, field.TypeUint8, value)
+	}
+	if tu.mutation.MatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   turn.MatchTable,
+			Columns: []string{turn.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   turn.MatchTable,
+			Columns: []string{turn.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   turn.ActionsTable,
+			Columns: []string{turn.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedActionsIDs(); len(nodes) > 0 && !tu.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   turn.ActionsTable,
+			Columns: []string{turn.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   turn.ActionsTable,
+			Columns: []string{turn.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{turn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TurnUpdateOne is the builder for updating a single Turn entity.
+type TurnUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TurnMutation
+}
+
+// SetNumber sets the "number" field.
+func (tuo *TurnUpdateOne) SetNumber(i int) *TurnUpdateOne {
+	tuo.mutation.ResetNumber()
+	tuo.mutation.SetNumber(i)
+	return tuo
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (tuo *TurnUpdateOne) SetNillableNumber(i *int) *TurnUpdateOne {
+	if i != nil {
+		tuo.SetNumber(*i)
+	}
+	return tuo
+}
+
+// AddNumber adds i to the "number" field.
+func (tuo *TurnUpdateOne) AddNumber(i int) *TurnUpdateOne {
+	tuo.mutation.AddNumber(i)
+	return tuo
+}
+
+// SetTeam sets the "team" field.
+func (tuo *TurnUpdateOne) SetTeam(we wits.FriendlyEnum) *TurnUpdateOne {
+	tuo.mutation.ResetTeam()
+	tuo.mutation.SetTeam(we)
+	return tuo
+}
+
+// SetNillableTeam sets the "team" field if the given value is not nil.
+func (tuo *TurnUpdateOne) SetNillableTeam(we *wits.FriendlyEnum) *TurnUpdateOne {
+	if we != nil {
+		tuo.SetTeam(*we)
+	}
+	return tuo
+}
+
+// AddTeam adds we to the "team" field.
+func (tuo *TurnUpdateOne) AddTeam(we wits.FriendlyEnum) *TurnUpdateOne {
+	tuo.mutation.AddTeam(we)
+	return tuo
+}
+
+// SetMatchID sets the "match" edge to the Match entity by ID.
+func (tuo *TurnUpdateOne) SetMatchID(id int) *TurnUpdateOne {
+	tuo.mutation.SetMatchID(id)
+	return tuo
+}
+
+// SetMatch sets the "match" edge to the Match entity.
+func (tuo *TurnUpdateOne) SetMatch(m *Match) *TurnUpdateOne {
+	return tuo.SetMatchID(m.ID)
+}
+
+// AddActionIDs adds the "actions" edge to the Action entity by IDs.
+func (tuo *TurnUpdateOne) AddActionIDs(ids ...int) *TurnUpdateOne {
+	tuo.mutation.AddActionIDs(ids...)
+	return tuo
+}
+
+// AddActions adds the "actions" edges to the Action entity.
+func (tuo *TurnUpdateOne) AddActions(a ...*Action) *TurnUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tuo.AddActionIDs(ids...)
+}
+
+// Mutation returns the TurnMutation object of the builder.
+func (tuo *TurnUpdateOne) Mutation() *TurnMutation {
+	return tuo.mutation
+}
+
+// ClearMatch clears the "match" edge to the Match entity.
+func (tuo *TurnUpdateOne) ClearMatch() *TurnUpdateOne {
+	tuo.mutation.ClearMatch()
+	return tuo
+}
+
+// ClearActions clears all "actions" edges to the Action entity.
+func (tuo *TurnUpdateOne) ClearActions() *TurnUpdateOne {
+	tuo.mutation.ClearActions()
+	return tuo
+}
+
+// RemoveActionIDs removes the "actions" edge to Action entities by IDs.
+func (tuo *TurnUpdateOne) RemoveActionIDs(ids ...int) *TurnUpdateOne {
+	tuo.mutation.RemoveActionIDs(ids...)
+	return tuo
+}
+
+// RemoveActions removes "actions" edges to Action entities.
+func (tuo *TurnUpdateOne) RemoveActions(a ...*Action) *TurnUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tuo.RemoveActionIDs(ids...)
+}
+
+// Where appends a list predicates to the TurnUpdate builder.
+func (tuo *TurnUpdateOne) Where(ps ...predicate.Turn) *TurnUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TurnUpdateOne) Select(field string, fields ...string) *TurnUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Turn entity.
+func (tuo *TurnUpdateOne) Save(ctx context.Context) (*Turn, error) {
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TurnUpdateOne) SaveX(ctx context.Context) *Turn {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TurnUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TurnUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TurnUpdateOne) check() error {
+	if v, ok := tuo.mutation.Number(); ok {
+		if err := turn.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Turn.number": %w`, err)}
+		}
+	}
+	if tuo.mutation.MatchCleared() && len(tuo.mutation.MatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Turn.match"`)
+	}
+	return nil
+}
+
+func (tuo *TurnUpdateOne) sqlSave(ctx context.Context) (_node *Turn, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(turn.Table, turn.Columns, sqlgraph.NewFieldSpec(turn.FieldID, field.TypeInt))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Turn.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, turn.FieldID)
+		for _, f := range fields {
+			if !turn.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != turn.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.Number(); ok {
+		_spec.SetField(turn.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedNumber(); ok {
+		_spec.AddField(turn.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.Team(); ok {
+		_spec.SetField(turn.FieldTeam, field.TypeUint8, value)
+	}
+	if value, ok := tuo.mutation.AddedTeam(); ok {
+		_spec.AddField(turn.FieldTeam, field.TypeUint8, value)
+	}
+	if tuo.mutation.MatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   turn.MatchTable,
+			Columns: []string{turn.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   turn.MatchTable,
+			Columns: []string{turn.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   turn.ActionsTable,
+			Columns: []string{turn.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedActionsIDs(); len(nodes) > 0 && !tuo.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   turn.ActionsTable,
+			Columns: []string{turn.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   turn.ActionsTable,
+			Columns: []string{turn.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(action.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Turn{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{turn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
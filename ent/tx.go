@@ -12,6 +12,8 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Action is the client for interacting with the Action builders.
+	Action *ActionClient
 	// Match is the client for interacting with the Match builders.
 	Match *MatchClient
 	// OsnMap is the client for interacting with the OsnMap builders.
@@ -20,6 +22,14 @@ type Tx struct {
 	Player *PlayerClient
 	// PlayerRole is the client for interacting with the PlayerRole builders.
 	PlayerRole *PlayerRoleClient
+	// Rating is the client for interacting with the Rating builders.
+	Rating *RatingClient
+	// RatingHistory is the client for interacting with the RatingHistory builders.
+	RatingHistory *RatingHistoryClient
+	// Standing is the client for interacting with the Standing builders.
+	Standing *StandingClient
+	// Turn is the client for interacting with the Turn builders.
+	Turn *TurnClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +161,15 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Action = NewActionClient(tx.config)
 	tx.Match = NewMatchClient(tx.config)
 	tx.OsnMap = NewOsnMapClient(tx.config)
 	tx.Player = NewPlayerClient(tx.config)
 	tx.PlayerRole = NewPlayerRoleClient(tx.config)
+	tx.Rating = NewRatingClient(tx.config)
+	tx.RatingHistory = NewRatingHistoryClient(tx.config)
+	tx.Standing = NewStandingClient(tx.config)
+	tx.Turn = NewTurnClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +179,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Match.QueryXXX(), the query will be executed
+// applies a query, for example: Action.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
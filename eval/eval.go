@@ -0,0 +1,182 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/eval/eval.go
+
+package eval
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+)
+
+// The terms of a position's evaluation, each the difference between a side and
+// its opponents.  Weights have the same terms.
+type Terms struct {
+	// Units are valued at their cost, in proportion to their remaining health.
+	Material float64 `json:"material"`
+	BaseHP   float64 `json:"base_hp"`
+	Wits     float64 `json:"wits"`
+
+	// The number of bonus tiles that units of the side are standing on.
+	Bonus float64 `json:"bonus"`
+
+	// The number of tiles that units of the side can move to.
+	Mobility float64 `json:"mobility"`
+
+	// The strength of the units that can reach and attack an opposing base
+	// within a turn.
+	BaseThreat float64 `json:"base_threat"`
+}
+
+func (terms Terms) vector() []float64 {
+	return []float64{
+		terms.Material, terms.BaseHP, terms.Wits,
+		terms.Bonus, terms.Mobility, terms.BaseThreat}
+}
+
+func fromVector(vector []float64) Terms {
+	return Terms{vector[0], vector[1], vector[2], vector[3], vector[4], vector[5]}
+}
+
+// The weight of each term, the weighted sum of the terms is the log-odds that
+// the side will win (see Evaluator.WinProbability).
+type Weights Terms
+
+func DefaultWeights() Weights {
+	return Weights{
+		Material:   0.08,
+		BaseHP:     0.5,
+		Wits:       0.04,
+		Bonus:      0.2,
+		Mobility:   0.01,
+		BaseThreat: 0.1,
+	}
+}
+
+// Reads weights from a JSON file, terms that are not in the file are zero.
+func LoadWeights(filename string) (Weights, error) {
+	var weights Weights
+	encoded, err := os.ReadFile(filename)
+	if err != nil {
+		return weights, err
+	}
+	err = json.Unmarshal(encoded, &weights)
+	return weights, err
+}
+
+func (weights Weights) WriteJSON(filename string) error {
+	encoded, err := json.MarshalIndent(weights, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, append(encoded, '\n'), 0644)
+}
+
+// The log-odds for a finished match, in place of a weighted sum.
+const WIN_SCORE = 100.0
+
+type Evaluator struct {
+	Weights Weights
+}
+
+func Default() Evaluator {
+	return Evaluator{DefaultWeights()}
+}
+
+// Scores the position for the team's side, as the log-odds of their winning.
+func (evaluator Evaluator) Evaluate(game *state.GameState, team wits.FriendlyEnum) float64 {
+	if game.IsOver() {
+		switch game.ResultFor(team) {
+		case wits.VICTORY_DESTRUCTION, wits.VICTORY_EXTINCTION, wits.VICTORY_RESIGNATION:
+			return WIN_SCORE
+		case wits.DELAY_OF_GAME:
+			return 0
+		}
+		return -WIN_SCORE
+	}
+	score := 0.0
+	weights := Terms(evaluator.Weights).vector()
+	for i, term := range TermsFor(game, team).vector() {
+		score += weights[i] * term
+	}
+	return score
+}
+
+// The estimated probability that the team's side will win, for displaying.
+func (evaluator Evaluator) WinProbability(game *state.GameState, team wits.FriendlyEnum) float64 {
+	return sigmoid(evaluator.Evaluate(game, team))
+}
+
+func sigmoid(x float64) float64 {
+	return 1 / (1 + math.Exp(-x))
+}
+
+// Computes the (unweighted) terms of the position for the team's side.
+func TermsFor(game *state.GameState, team wits.FriendlyEnum) Terms {
+	gamemap, rules := game.Map(), game.Rules()
+	side := state.Side(team)
+	sign := func(owner wits.FriendlyEnum) float64 {
+		if state.Side(owner) == side {
+			return 1
+		}
+		return -1
+	}
+
+	var terms Terms
+	bases := make([]wits.FriendlyEnum, 0, game.PlayerCount())
+	for i := range game.PlayerCount() {
+		owner := wits.FriendlyEnum(i + 1)
+		terms.BaseHP += sign(owner) * float64(game.BaseHP(owner))
+		terms.Wits += sign(owner) * float64(game.Player(owner).Wits)
+		if game.BaseHP(owner) > 0 {
+			bases = append(bases, owner)
+		}
+	}
+
+	for index := range gamemap.TileCount() {
+		at := wits.HexCoordIndex(index)
+		unit := game.UnitAt(at)
+		if unit.IsEmpty() {
+			continue
+		}
+		owner := unit.Team()
+		health := float64(unit.Health()) / float64(rules.Health(unit.Class()))
+		terms.Material += sign(owner) * float64(rules.Cost(unit.Class())) * health
+		if gamemap.IsBonus(at) {
+			terms.Bonus += sign(owner)
+		}
+		terms.Mobility += sign(owner) * float64(len(game.Reachable(at)))
+
+		if rules.Range(unit) == 0 {
+			continue
+		}
+		for _, base := range bases {
+			if state.Side(base) == state.Side(owner) {
+				continue
+			}
+			// Bases are one tile closer, as they are larger than a tile.
+			reach := rules.Distance(unit) + rules.Range(unit) + 1
+			if gamemap.Distance(at, gamemap.Base(base)) <= reach {
+				terms.BaseThreat += sign(owner) * float64(rules.Strength(unit))
+				break
+			}
+		}
+	}
+	return terms
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/eval/eval_test.go
+
+package eval_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/eval"
+	"github.com/kevindamm/wits-go/internal/testutil"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func TestEvaluator_Symmetric(t *testing.T) {
+	game := testutil.NewPeekaboo(t)
+	evaluator := eval.Default()
+	rng := rand.New(rand.NewPCG(1, 1))
+	for range 6 {
+		legal := game.LegalActions()
+		if len(legal) > 0 {
+			game.Apply(legal[rng.IntN(len(legal))])
+		}
+		game.EndTurn()
+
+		self := evaluator.Evaluate(game, wits.FR_SELF)
+		enemy := evaluator.Evaluate(game, wits.FR_ENEMY)
+		if math.Abs(self+enemy) > 1e-9 {
+			t.Fatalf("evaluations %f and %f are not opposites", self, enemy)
+		}
+	}
+}
+
+func TestEvaluator_Terminal(t *testing.T) {
+	game := testutil.NewPeekaboo(t)
+	game.Resign(wits.FR_SELF)
+	evaluator := eval.Default()
+	if score := evaluator.Evaluate(game, wits.FR_SELF); score != -eval.WIN_SCORE {
+		t.Errorf("resigning side scored %f", score)
+	}
+	if score := evaluator.Evaluate(game, wits.FR_ENEMY); score != eval.WIN_SCORE {
+		t.Errorf("opponent of resigning side scored %f", score)
+	}
+}
+
+func TestTermsFor_Material(t *testing.T) {
+	game := testutil.NewPeekaboo(t)
+	before := eval.TermsFor(game, wits.FR_SELF)
+	for _, action := range game.LegalActions() {
+		if action.Name == witsjson.SPAWN_UNIT {
+			if err := game.Apply(action); err != nil {
+				t.Fatal(err)
+			}
+			break
+		}
+	}
+	after := eval.TermsFor(game, wits.FR_SELF)
+	if after.Material <= before.Material {
+		t.Errorf("material %f did not increase after spawning (was %f)",
+			after.Material, before.Material)
+	}
+	if after.Wits >= before.Wits {
+		t.Errorf("wits %f did not decrease after spawning (was %f)",
+			after.Wits, before.Wits)
+	}
+}
+
+func TestWeights_RoundTrip(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "weights.json")
+	weights := eval.DefaultWeights()
+	weights.Mobility = 0.125
+	if err := weights.WriteJSON(filename); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := eval.LoadWeights(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded != weights {
+		t.Errorf("loaded %v, expected %v", loaded, weights)
+	}
+}
+
+func TestTune_Synthetic(t *testing.T) {
+	// Outcomes are drawn from a known model where only material and base HP
+	// matter, in a ratio of 1:4.
+	rng := rand.New(rand.NewPCG(3, 3))
+	samples := make([]eval.Sample, 2000)
+	for i := range samples {
+		terms := eval.Terms{
+			Material: rng.NormFloat64() * 10,
+			BaseHP:   rng.NormFloat64() * 2,
+			Wits:     rng.NormFloat64() * 5,
+		}
+		logit := 0.1*terms.Material + 0.4*terms.BaseHP
+		samples[i] = eval.Sample{
+			Terms: terms,
+			Won:   rng.Float64() < 1/(1+math.Exp(-logit)),
+		}
+	}
+	weights, loss := eval.Tune(samples, eval.DefaultTuneOptions())
+	if weights.Material <= 0 || weights.BaseHP <= 0 {
+		t.Fatalf("expected positive weights, got %v", weights)
+	}
+	if ratio := weights.BaseHP / weights.Material; ratio < 3 || ratio > 5 {
+		t.Errorf("base HP to material ratio %f, expected about 4", ratio)
+	}
+	if math.Abs(weights.Wits) > 0.05 {
+		t.Errorf("wits weight %f should be near zero", weights.Wits)
+	}
+	if loss <= 0 || loss >= math.Ln2 {
+		t.Errorf("loss %f should be better than chance", loss)
+	}
+}
+
+func TestSamples(t *testing.T) {
+	game := testutil.NewPeekaboo(t)
+	initial := game.Clone()
+	turns := bot.PlayMatch(game,
+		[]bot.Bot{bot.NewGreedyBot(1), bot.NewRandomBot(1)}, time.Second, 100)
+	if game.Result() == wits.DELAY_OF_GAME {
+		t.Skip("match was not decided")
+	}
+
+	played := make([]wits.PlayerTurn, len(turns))
+	for i, turn := range turns {
+		played[i] = turn
+	}
+	samples, err := eval.Samples(initial, played, game.ResultFor(wits.FR_SELF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(samples) == 0 || len(samples)%2 != 0 {
+		t.Fatalf("expected samples in pairs, got %d", len(samples))
+	}
+	for i := 0; i < len(samples); i += 2 {
+		if samples[i].Won == samples[i+1].Won {
+			t.Fatalf("samples %d and %d have the same outcome", i, i+1)
+		}
+		if samples[i].Terms.BaseHP != -samples[i+1].Terms.BaseHP {
+			t.Fatalf("samples %d and %d are not opposing views", i, i+1)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/eval/tune.go
+
+package eval
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+)
+
+// The terms of a position, from one side's perspective, and whether that side
+// went on to win the match.
+type Sample struct {
+	Terms Terms
+	Won   bool
+}
+
+// Simulates the turns of a finished match, sampling the position at the end
+// of each turn from both sides.  Matches that were not decided (a delay of
+// game, or an unknown result) have no samples.
+func Samples(initial *state.GameState, turns []wits.PlayerTurn, result wits.TerminalStatus) ([]Sample, error) {
+	samples := make([]Sample, 0, 2*len(turns))
+	won := false
+	switch result {
+	case wits.VICTORY_DESTRUCTION, wits.VICTORY_EXTINCTION, wits.VICTORY_RESIGNATION:
+		won = true
+	case wits.LOSS_DESTRUCTION, wits.LOSS_EXTINCTION, wits.LOSS_RESIGNATION:
+	default:
+		return samples, nil
+	}
+
+	game := initial.Clone()
+	gamemap := game.Map()
+	for _, turn := range turns {
+		for i, action := range turn.Actions() {
+			resolved, err := gamemap.Resolve(action)
+			if err != nil {
+				return nil, fmt.Errorf("turn %d action %d: %w", turn.TurnCount(), i, err)
+			}
+			if resolved.IsPass() {
+				break
+			}
+			if err := game.Apply(resolved); err != nil {
+				return nil, fmt.Errorf("turn %d action %d: %w", turn.TurnCount(), i, err)
+			}
+		}
+		game.EndTurn()
+		if game.IsOver() {
+			break
+		}
+		samples = append(samples,
+			Sample{TermsFor(game, wits.FR_SELF), won},
+			Sample{TermsFor(game, wits.FR_ENEMY), !won})
+	}
+	return samples, nil
+}
+
+type TuneOptions struct {
+	Iterations   int
+	LearningRate float64
+
+	// L2 regularization, keeps the weights of rarely varying terms small.
+	Regularization float64
+}
+
+func DefaultTuneOptions() TuneOptions {
+	return TuneOptions{
+		Iterations:     2000,
+		LearningRate:   0.5,
+		Regularization: 0.001,
+	}
+}
+
+// Fits the weights by logistic regression of the samples' outcomes on their
+// terms, with batch gradient descent.  The terms are standardized while
+// fitting, so that the learning rate suits all of them.  There is no intercept
+// because the samples are taken from both sides.  Returns the weights and
+// their mean log-loss over the samples.
+func Tune(samples []Sample, options TuneOptions) (Weights, float64) {
+	if len(samples) == 0 {
+		return Weights{}, 0
+	}
+	count := float64(len(samples))
+	features := make([][]float64, len(samples))
+	for i, sample := range samples {
+		features[i] = sample.Terms.vector()
+	}
+	dimensions := len(features[0])
+	scale := make([]float64, dimensions)
+	for _, vector := range features {
+		for j, value := range vector {
+			scale[j] += value * value
+		}
+	}
+	for j := range scale {
+		scale[j] = math.Sqrt(scale[j] / count)
+		if scale[j] == 0 {
+			scale[j] = 1
+		}
+	}
+	for _, vector := range features {
+		for j := range vector {
+			vector[j] /= scale[j]
+		}
+	}
+
+	weights := make([]float64, dimensions)
+	gradient := make([]float64, dimensions)
+	for range options.Iterations {
+		clear(gradient)
+		for i, vector := range features {
+			errorTerm := predict(weights, vector) - outcome(samples[i])
+			for j, value := range vector {
+				gradient[j] += errorTerm * value
+			}
+		}
+		for j := range weights {
+			gradient[j] = gradient[j]/count + options.Regularization*weights[j]
+			weights[j] -= options.LearningRate * gradient[j]
+		}
+	}
+
+	loss := 0.0
+	for i, vector := range features {
+		p := min(max(predict(weights, vector), 1e-12), 1-1e-12)
+		if samples[i].Won {
+			loss -= math.Log(p)
+		} else {
+			loss -= math.Log(1 - p)
+		}
+	}
+	for j := range weights {
+		weights[j] /= scale[j]
+	}
+	return Weights(fromVector(weights)), loss / count
+}
+
+func predict(weights, vector []float64) float64 {
+	sum := 0.0
+	for j, value := range vector {
+		sum += weights[j] * value
+	}
+	return sigmoid(sum)
+}
+
+func outcome(sample Sample) float64 {
+	if sample.Won {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/ingest/pipeline.go
+
+package ingest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Advances matches through the stages of match.FetchStatus, from the replay
+// files found locally to their turns being indexed in the DB:
+//
+//	LISTED     the match is known but its replay has not been fetched yet
+//	FETCHED    the replay file <hash>.json is in the replay directory
+//	UNWRAPPED  the replay has been decoded
+//	CONVERTED  its map is known, its players and their roles are stored
+//	CANONICAL  its turns are in canonical form (and written, if configured)
+//	VALIDATED  every action is legal when simulated, and the result agrees
+//	INDEXED    its turns and actions are stored
+//
+// A match that cannot advance is marked INVALID along with the reason, or
+// LEGACY if it was played on a runtime version whose rules are not known (see
+// state.RulesForVersion), which is retried once the rules of its version are
+// added.  Matches recorded with a custom variant of the rules are simulated
+// under that variant.
+// Matches resume from the stage they reached, and each stage can be safely
+// repeated, so the pipeline may be run again after being interrupted.
+type Pipeline struct {
+	client    *ent.Client
+	maps      witsjson.MapLibrary
+	replayDir string
+
+	// Where canonical replays are written, named by match hash.  Optional.
+	CanonicalDir string
+
+	// How many matches are processed concurrently.
+	Workers int
+
+	// The runtime version recorded for matches discovered in the replay
+	// directory, as the replay files do not include it.
+	Version int
+
+	// The ranked season recorded for matches discovered in the replay
+	// directory, zero if they were not ranked (see season.Recompute).
+	Season int8
+
+	// Retries matches that were previously found INVALID.
+	RetryInvalid bool
+
+	// Replays are decoded and simulated concurrently, but DB writes are made
+	// one match at a time so that shared players are not created twice.
+	dbLock sync.Mutex
+}
+
+func New(client *ent.Client, maps witsjson.MapLibrary, replayDir string) *Pipeline {
+	return &Pipeline{
+		client:    client,
+		maps:      maps,
+		replayDir: replayDir,
+		Workers:   4,
+		Version:   state.LATEST_VERSION,
+	}
+}
+
+// The order of the stages, matches only move forward through these.
+var stages = []match.FetchStatus{
+	match.FetchStatusLISTED,
+	match.FetchStatusFETCHED,
+	match.FetchStatusUNWRAPPED,
+	match.FetchStatusCONVERTED,
+	match.FetchStatusCANONICAL,
+	match.FetchStatusVALIDATED,
+	match.FetchStatusINDEXED,
+}
+
+// True if a match at this status has already completed the stage.
+func reached(status match.FetchStatus, stage match.FetchStatus) bool {
+	return slices.Index(stages, status) >= slices.Index(stages, stage)
+}
+
+// The number of matches at each status after the pipeline has run.
+type Summary map[match.FetchStatus]int
+
+// Discovers replays and advances every match that has not finished, returning
+// the number of matches at each status (including those already finished).
+func (pipeline *Pipeline) Run(ctx context.Context) (Summary, error) {
+	if err := pipeline.Discover(ctx); err != nil {
+		return nil, err
+	}
+	pending := []match.FetchStatus{
+		match.FetchStatusFETCHED,
+		match.FetchStatusUNWRAPPED,
+		match.FetchStatusCONVERTED,
+		match.FetchStatusCANONICAL,
+		match.FetchStatusVALIDATED,
+		match.FetchStatusLEGACY,
+	}
+	if pipeline.RetryInvalid {
+		pending = append(pending, match.FetchStatusINVALID)
+	}
+	hashes, err := pipeline.client.Match.Query().
+		Where(match.FetchStatusIn(pending...)).
+		Order(match.ByID()).
+		Select(match.FieldMatchHash).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	work := make(chan string)
+	errs := make(chan error, len(hashes))
+	var wg sync.WaitGroup
+	for range max(pipeline.Workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for hash := range work {
+				if _, err := pipeline.Advance(ctx, hash); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	for _, hash := range hashes {
+		work <- hash
+	}
+	close(work)
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+
+	summary := make(Summary)
+	var counts []struct {
+		FetchStatus match.FetchStatus `json:"fetch_status"`
+		Count       int               `json:"count"`
+	}
+	err = pipeline.client.Match.Query().
+		GroupBy(match.FieldFetchStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	for _, count := range counts {
+		summary[count.FetchStatus] = count.Count
+	}
+	return summary, nil
+}
+
+// Finds the replay files in the replay directory, adding matches that are not
+// yet known and marking LISTED matches as FETCHED when their file is present.
+func (pipeline *Pipeline) Discover(ctx context.Context) error {
+	entries, err := os.ReadDir(pipeline.replayDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		hash, isJSON := strings.CutSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !isJSON {
+			continue
+		}
+		existing, err := pipeline.client.Match.Query().
+			Where(match.MatchHashEQ(hash)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			err = pipeline.client.Match.Create().
+				SetMatchHash(hash).
+				SetVersion(pipeline.Version).
+				SetSeason(pipeline.Season).
+				SetTurnCount(0).
+				SetFetchStatus(match.FetchStatusFETCHED).
+				Exec(ctx)
+		} else if err == nil && *existing.FetchStatus == match.FetchStatusLISTED {
+			err = existing.Update().
+				SetFetchStatus(match.FetchStatusFETCHED).
+				Exec(ctx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pipeline *Pipeline) replayPath(hash string) string {
+	return filepath.Join(pipeline.replayDir, hash+".json")
+}
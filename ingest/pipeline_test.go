@@ -0,0 +1,223 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/ingest/pipeline_test.go
+
+package ingest_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go/ent/enttest"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ingest"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const replayTemplate = `{
+	"game_id": "HASH",
+	"map_name": "MAP",
+	"players": [
+		{"gcID": "G:1", "name": "alice", "race": "FEEDBACK", "team": 1, "result": 3},
+		{"gcID": "G:2", "name": "bob", "race": "SCALLYWAGS", "team": 2, "result": 7}
+	],
+	"replay": [
+		{"turn": 1, "actions": [
+			{"name": "SpawnUnit", "action": {"spawn": [5, 8], "class": "RUNNER"}},
+			{"name": "Pass"},
+			{"name": "MoveUnit", "action": {"from": [3, 6], "to": [4, 5]}}]},
+		{"turn": 2, "actions": [SECOND]}
+	],
+	"result": 3,
+	"played_ts": "2016-03-01T12:00:00Z"
+}`
+
+func writeReplay(t *testing.T, dir, hash, mapName, second string) {
+	encoded := strings.NewReplacer(
+		"HASH", hash, "MAP", mapName, "SECOND", second).Replace(replayTemplate)
+	if err := os.WriteFile(filepath.Join(dir, hash+".json"), []byte(encoded), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPipeline_Run(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	maps, _, err := witsjson.LoadMapLibrary("../maps/solo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replayDir, canonicalDir := t.TempDir(), t.TempDir()
+	writeReplay(t, replayDir, "good", "Peek-a-Boo", "")
+	writeReplay(t, replayDir, "illegal", "Peek-a-Boo",
+		`{"name": "SpawnUnit", "action": {"spawn": [5, 8], "class": "RUNNER"}}`)
+	writeReplay(t, replayDir, "nomap", "Nowhere", "")
+	writeReplay(t, replayDir, "legacy", "Peek-a-Boo", "")
+	client.Match.Create().
+		SetMatchHash("legacy").
+		SetVersion(1500).
+		SetTurnCount(0).
+		ExecX(ctx)
+	if err := os.WriteFile(filepath.Join(replayDir, "garbage.json"), []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	client.Match.Create().
+		SetMatchHash("unfetched").
+		SetVersion(1603).
+		SetTurnCount(0).
+		ExecX(ctx)
+
+	pipeline := ingest.New(client, maps, replayDir)
+	pipeline.CanonicalDir = canonicalDir
+	pipeline.Season = 2
+	summary, err := pipeline.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := ingest.Summary{
+		match.FetchStatusLISTED:  1,
+		match.FetchStatusINDEXED: 1,
+		match.FetchStatusINVALID: 3,
+		match.FetchStatusLEGACY:  1,
+	}
+	for status, count := range expected {
+		if summary[status] != count {
+			t.Errorf("expected %d %s matches, summary %v", count, status, summary)
+		}
+	}
+
+	good := client.Match.Query().Where(match.MatchHashEQ("good")).OnlyX(ctx)
+	if good.TurnCount != 2 || client.Turn.Query().CountX(ctx) != 2 {
+		t.Errorf("expected 2 turns for the indexed match, got %d", good.TurnCount)
+	}
+	if good.PlayedTs == nil || !good.PlayedTs.Equal(time.Date(2016, 3, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the replay's play time, got %v", good.PlayedTs)
+	}
+	if good.Season != 2 {
+		t.Errorf("expected the discovered match in the pipeline's season, got %d", good.Season)
+	}
+	if good.MapHash != maps["peekaboo"].Definition().ContentHash() {
+		t.Errorf("expected the hash of the map the match was played on, got %q", good.MapHash)
+	}
+	// The move after passing is dropped when canonicalized.
+	if count := client.Action.Query().CountX(ctx); count != 1 {
+		t.Errorf("expected only the spawn to be indexed, found %d actions", count)
+	}
+	if roles := good.QueryRoles().CountX(ctx); roles != 2 {
+		t.Errorf("expected 2 player roles, found %d", roles)
+	}
+	if _, err := os.Stat(filepath.Join(canonicalDir, "good.json")); err != nil {
+		t.Errorf("canonical replay not written: %v", err)
+	}
+	illegal := client.Match.Query().Where(match.MatchHashEQ("illegal")).OnlyX(ctx)
+	if !strings.Contains(illegal.InvalidReason, "turn 2 action 0") {
+		t.Errorf("unexpected reason for the invalid match: %q", illegal.InvalidReason)
+	}
+
+	// Running again changes nothing, even when retrying the invalid matches.
+	pipeline.RetryInvalid = true
+	again, err := pipeline.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for status, count := range expected {
+		if again[status] != count {
+			t.Errorf("expected %d %s matches after rerunning, summary %v", count, status, again)
+		}
+	}
+	if count := client.Turn.Query().CountX(ctx); count != 2 {
+		t.Errorf("rerunning should not duplicate turns, found %d", count)
+	}
+	if count := client.Player.Query().CountX(ctx); count != 2 {
+		t.Errorf("players should be shared between matches, found %d", count)
+	}
+
+	// A corrected replay is indexed when retried.
+	writeReplay(t, replayDir, "illegal", "Peek-a-Boo", "")
+	if status, err := pipeline.Advance(ctx, "illegal"); err != nil || status != match.FetchStatusINDEXED {
+		t.Errorf("expected the corrected replay to be indexed, got %s %v", status, err)
+	}
+
+	// A legacy match is indexed once the rules of its version are added.
+	rules := state.LatestRules()
+	rules.Version = 1500
+	if err := state.AddVersion(rules); err != nil {
+		t.Fatal(err)
+	}
+	retried, err := pipeline.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retried[match.FetchStatusLEGACY] != 0 || retried[match.FetchStatusINDEXED] != 3 {
+		t.Errorf("expected the legacy replay to be indexed, summary %v", retried)
+	}
+}
+
+func TestPipeline_Variant(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	maps, _, err := witsjson.LoadMapLibrary("../maps/solo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The replay's first turn spawns a runner, legal under the standard rules.
+	replayDir := t.TempDir()
+	writeReplay(t, replayDir, "standard", "Peek-a-Boo", "")
+	writeReplay(t, replayDir, "pricey", "Peek-a-Boo", "")
+	variant, err := state.ParseVariant([]byte(
+		`{"name": "pricey-runners", "units": {"RUNNER": {"cost": 4}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Match.Create().
+		SetMatchHash("standard").
+		SetVersion(state.LATEST_VERSION).
+		SetFetchStatus(match.FetchStatusFETCHED).
+		SetTurnCount(0).
+		ExecX(ctx)
+	client.Match.Create().
+		SetMatchHash("pricey").
+		SetVersion(variant.Version).
+		SetRules(variant).
+		SetFetchStatus(match.FetchStatusFETCHED).
+		SetTurnCount(0).
+		ExecX(ctx)
+
+	pipeline := ingest.New(client, maps, replayDir)
+	if status, err := pipeline.Advance(ctx, "standard"); err != nil || status != match.FetchStatusINDEXED {
+		t.Errorf("expected the standard match to be indexed, got %s %v", status, err)
+	}
+	status, err := pipeline.Advance(ctx, "pricey")
+	if err != nil || status != match.FetchStatusINVALID {
+		t.Fatalf("expected the variant match to be invalid, got %s %v", status, err)
+	}
+	pricey := client.Match.Query().Where(match.MatchHashEQ("pricey")).OnlyX(ctx)
+	if !strings.Contains(pricey.InvalidReason, "requires 4 wits") {
+		t.Errorf("unexpected reason for the invalid match: %q", pricey.InvalidReason)
+	}
+	if pricey.Rules == nil || pricey.Rules.Name != "pricey-runners" {
+		t.Errorf("the variant was not stored with the match: %v", pricey.Rules)
+	}
+}
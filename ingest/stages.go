@@ -0,0 +1,425 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/ingest/stages.go
+
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/archive"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/playerrole"
+	"github.com/kevindamm/wits-go/ent/turn"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// A problem with the replay itself, rather than with the pipeline or the DB.
+// The match is marked INVALID with this as the reason.
+type InvalidReplayError struct {
+	Stage  match.FetchStatus
+	Reason string
+}
+
+func (err InvalidReplayError) Error() string {
+	return fmt.Sprintf("%s: %s", err.Stage, err.Reason)
+}
+
+func invalid(stage match.FetchStatus, format string, args ...any) error {
+	return InvalidReplayError{stage, fmt.Sprintf(format, args...)}
+}
+
+// Advances the match as far through the stages as it can go, returning the
+// status it reached.  Problems with the replay are recorded on the match (as
+// INVALID) rather than being returned as an error.
+func (pipeline *Pipeline) Advance(ctx context.Context, hash string) (match.FetchStatus, error) {
+	pipeline.dbLock.Lock()
+	entity, err := pipeline.client.Match.Query().
+		Where(match.MatchHashEQ(hash)).
+		Only(ctx)
+	pipeline.dbLock.Unlock()
+	if err != nil {
+		return match.FetchStatusUNKNOWN, err
+	}
+	status := *entity.FetchStatus
+	switch status {
+	case match.FetchStatusINVALID:
+		if !pipeline.RetryInvalid {
+			return status, nil
+		}
+		status = match.FetchStatusFETCHED
+	case match.FetchStatusLEGACY:
+		if _, err := state.RulesForVersion(entity.Version); err != nil {
+			return status, nil
+		}
+		status = match.FetchStatusFETCHED
+	case match.FetchStatusUNKNOWN, match.FetchStatusLISTED, match.FetchStatusINDEXED:
+		return status, nil
+	}
+
+	status, err = pipeline.advance(ctx, entity, status)
+	var invalidErr InvalidReplayError
+	if errors.As(err, &invalidErr) {
+		return match.FetchStatusINVALID, pipeline.update(ctx, entity, func(update *ent.MatchUpdateOne) {
+			update.SetFetchStatus(match.FetchStatusINVALID).
+				SetInvalidReason(invalidErr.Error())
+		})
+	}
+	if err != nil {
+		return status, fmt.Errorf("match %s: %w", hash, err)
+	}
+	return status, nil
+}
+
+func (pipeline *Pipeline) advance(ctx context.Context, entity *ent.Match, status match.FetchStatus) (match.FetchStatus, error) {
+	rules := entity.Rules
+	if rules == nil {
+		var err error
+		if rules, err = state.RulesForVersion(entity.Version); err != nil {
+			return match.FetchStatusLEGACY,
+				pipeline.setStatus(ctx, entity, match.FetchStatusLEGACY)
+		}
+	}
+	replay, err := pipeline.unwrap(entity.MatchHash)
+	if err != nil {
+		return status, err
+	}
+	if !reached(status, match.FetchStatusUNWRAPPED) {
+		status = match.FetchStatusUNWRAPPED
+		if err := pipeline.setStatus(ctx, entity, status); err != nil {
+			return status, err
+		}
+	}
+
+	definition, found := pipeline.maps.Find(replay.MapID(), replay.MapName())
+	if !found {
+		return status, invalid(match.FetchStatusCONVERTED,
+			"map not found (id %q, name %q)", replay.MapID(), replay.MapName())
+	}
+	gamemap := state.NewGameMap(replayMap{definition, replay.Init_.Units()})
+	if len(replay.Players_) != gamemap.RoleCount() {
+		return status, invalid(match.FetchStatusCONVERTED,
+			"map %s requires %d players, replay has %d",
+			definition.MapID(), gamemap.RoleCount(), len(replay.Players_))
+	}
+	if !reached(status, match.FetchStatusCONVERTED) {
+		status = match.FetchStatusCONVERTED
+		if err := pipeline.convert(ctx, entity, definition, replay); err != nil {
+			return status, err
+		}
+	}
+
+	canonical := Canonicalize(replay, definition)
+	if !reached(status, match.FetchStatusCANONICAL) {
+		status = match.FetchStatusCANONICAL
+		if len(pipeline.CanonicalDir) > 0 {
+			filename := filepath.Join(pipeline.CanonicalDir, entity.MatchHash+".json")
+			if err := canonical.WriteJSON(filename); err != nil {
+				return status, err
+			}
+		}
+		if err := pipeline.setStatus(ctx, entity, status); err != nil {
+			return status, err
+		}
+	}
+
+	initial, err := Validate(&gamemap, canonical, rules)
+	if err != nil {
+		return status, err
+	}
+	if !reached(status, match.FetchStatusVALIDATED) {
+		status = match.FetchStatusVALIDATED
+		err := pipeline.update(ctx, entity, func(update *ent.MatchUpdateOne) {
+			update.SetFetchStatus(status).
+				SetTurnCount(len(canonical.Turns_))
+		})
+		if err != nil {
+			return status, err
+		}
+	}
+
+	status = match.FetchStatusINDEXED
+	return status, pipeline.index(ctx, entity, initial, canonical)
+}
+
+// Decodes the replay file, checking that it is the replay of this match.
+func (pipeline *Pipeline) unwrap(hash string) (witsjson.GameReplayJSON, error) {
+	var replay witsjson.GameReplayJSON
+	encoded, err := os.ReadFile(pipeline.replayPath(hash))
+	if errors.Is(err, os.ErrNotExist) {
+		return replay, invalid(match.FetchStatusFETCHED, "replay file not found")
+	} else if err != nil {
+		return replay, err
+	}
+	if err := json.Unmarshal(encoded, &replay); err != nil {
+		return replay, invalid(match.FetchStatusUNWRAPPED, "%s", err)
+	}
+	if len(replay.GameID_) > 0 && replay.GameID_.ShortID() != hash {
+		return replay, invalid(match.FetchStatusUNWRAPPED,
+			"replay is for game %s", replay.GameID_.ShortID())
+	}
+	for _, player := range replay.Players_ {
+		if len(player.Name_) == 0 {
+			return replay, invalid(match.FetchStatusUNWRAPPED, "player without a name")
+		}
+		if player.Race() == wits.RACE_UNKNOWN || player.Team() == wits.FR_UNKNOWN {
+			return replay, invalid(match.FetchStatusUNWRAPPED,
+				"player %s has no race or team", player.Name_)
+		}
+	}
+	return replay, nil
+}
+
+// Stores the map (if it is not yet known), the players and their roles.
+func (pipeline *Pipeline) convert(ctx context.Context, entity *ent.Match,
+	definition witsjson.GameMapJSON, replay witsjson.GameReplayJSON) error {
+	return pipeline.transact(ctx, func(client *ent.Client) error {
+		gamemap, err := archive.FindOrCreateMap(ctx, client,
+			witsjson.ShortName(definition.MapID()), definition.MapName(), len(replay.Players_))
+		if err != nil {
+			return err
+		}
+		for _, role := range replay.Players_ {
+			profile, err := archive.FindOrCreatePlayer(ctx, client, role.Name(), role.GCID())
+			if err != nil {
+				return err
+			}
+			exists, err := client.PlayerRole.Query().
+				Where(playerrole.MatchIDEQ(entity.ID), playerrole.PlayerIDEQ(profile.ID)).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+			err = client.PlayerRole.Create().
+				SetMatchID(entity.ID).
+				SetPlayerID(profile.ID).
+				SetPosition(int(role.Team())).
+				SetTurnOrder(int(role.Team())).
+				SetRace(role.Race()).
+				SetResult(role.Result()).
+				AddMatch(entity).
+				AddPlayers(profile).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		return client.Match.UpdateOne(entity).
+			SetMap(gamemap).
+			SetMapHash(definition.Definition().ContentHash()).
+			SetNillablePlayedTs(replay.Played_).
+			SetFetchStatus(match.FetchStatusCONVERTED).
+			Exec(ctx)
+	})
+}
+
+// Replaces any turns that were stored for the match by an earlier attempt.
+func (pipeline *Pipeline) index(ctx context.Context, entity *ent.Match,
+	initial *state.GameState, replay witsjson.GameReplayJSON) error {
+	return pipeline.transact(ctx, func(client *ent.Client) error {
+		_, err := client.Action.Delete().
+			Where(action.HasTurnWith(turn.HasMatchWith(match.IDEQ(entity.ID)))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = client.Turn.Delete().
+			Where(turn.HasMatchWith(match.IDEQ(entity.ID))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		gamemap, err := client.Match.QueryMap(entity).Only(ctx)
+		if err != nil {
+			return err
+		}
+		if err := archive.IndexTurns(ctx, client, entity, gamemap, initial, replay.MatchReplay()); err != nil {
+			return err
+		}
+		return client.Match.UpdateOne(entity).
+			SetFetchStatus(match.FetchStatusINDEXED).
+			ClearInvalidReason().
+			Exec(ctx)
+	})
+}
+
+func (pipeline *Pipeline) setStatus(ctx context.Context, entity *ent.Match, status match.FetchStatus) error {
+	return pipeline.update(ctx, entity, func(update *ent.MatchUpdateOne) {
+		update.SetFetchStatus(status)
+	})
+}
+
+func (pipeline *Pipeline) update(ctx context.Context, entity *ent.Match, modify func(*ent.MatchUpdateOne)) error {
+	pipeline.dbLock.Lock()
+	defer pipeline.dbLock.Unlock()
+	update := pipeline.client.Match.UpdateOne(entity)
+	modify(update)
+	return update.Exec(ctx)
+}
+
+func (pipeline *Pipeline) transact(ctx context.Context, body func(*ent.Client) error) error {
+	pipeline.dbLock.Lock()
+	defer pipeline.dbLock.Unlock()
+	tx, err := pipeline.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := body(tx.Client()); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// The map as described by the replay, which may override its initial units.
+type replayMap struct {
+	witsjson.GameMapJSON
+	units []wits.UnitInit
+}
+
+func (gamemap replayMap) Units() []wits.UnitInit {
+	if len(gamemap.units) > 0 {
+		return gamemap.units
+	}
+	return gamemap.GameMapJSON.Units()
+}
+
+// Finds the replay's map and simulates its canonical form (see Validate),
+// returning the initial state of the match along with the canonical replay.
+func Prepare(maps witsjson.MapLibrary, replay witsjson.GameReplayJSON,
+	rules *state.Ruleset) (*state.GameState, witsjson.GameReplayJSON, error) {
+	definition, found := maps.Find(replay.MapID(), replay.MapName())
+	if !found {
+		return nil, replay, invalid(match.FetchStatusCONVERTED,
+			"map not found (id %q, name %q)", replay.MapID(), replay.MapName())
+	}
+	gamemap := state.NewGameMap(replayMap{definition, replay.Init_.Units()})
+	canonical := Canonicalize(replay, definition)
+	initial, err := Validate(&gamemap, canonical, rules)
+	return initial, canonical, err
+}
+
+// Finds the replay's map and creates the initial state of the match, along
+// with the canonical replay, without simulating its turns (as Prepare does).
+// For stepping through a replay up to where it may diverge.
+func Setup(maps witsjson.MapLibrary, replay witsjson.GameReplayJSON,
+	rules *state.Ruleset) (*state.GameState, witsjson.GameReplayJSON, error) {
+	definition, found := maps.Find(replay.MapID(), replay.MapName())
+	if !found {
+		return nil, replay, fmt.Errorf("map not found (id %q, name %q)", replay.MapID(), replay.MapName())
+	}
+	gamemap := state.NewGameMap(replayMap{definition, replay.Init_.Units()})
+	canonical := Canonicalize(replay, definition)
+	initial, err := state.NewGameWithRules(&gamemap, races(canonical), rules)
+	return initial, canonical, err
+}
+
+func races(replay witsjson.GameReplayJSON) []wits.UnitRaceEnum {
+	races := make([]wits.UnitRaceEnum, len(replay.Players_))
+	for i, player := range replay.Players_ {
+		races[i] = player.Race()
+	}
+	return races
+}
+
+// Puts the replay in canonical form: players are ordered by team, turns are
+// numbered consecutively, and each turn ends with a single PassAction (any
+// actions after a pass are dropped).  The map ID is filled in if missing.
+func Canonicalize(replay witsjson.GameReplayJSON, definition witsjson.GameMapJSON) witsjson.GameReplayJSON {
+	canonical := replay
+	canonical.MapID_ = definition.MapID()
+	canonical.GameMap_ = definition.MapName()
+	canonical.Players_ = slices.Clone(replay.Players_)
+	slices.SortStableFunc(canonical.Players_, func(a, b witsjson.PlayerRoleJSON) int {
+		return int(a.Team_) - int(b.Team_)
+	})
+
+	canonical.Turns_ = make([]witsjson.PlayerTurnJSON, len(replay.Turns_))
+	for i, played := range replay.Turns_ {
+		actions := make([]wits.PlayerAction, 0, len(played.Actions_)+1)
+		for _, action := range played.Actions_ {
+			if _, isPass := action.(wits.PassAction); isPass {
+				break
+			}
+			actions = append(actions, action)
+		}
+		canonical.Turns_[i] = witsjson.PlayerTurnJSON{
+			Turn_:    uint(i + 1),
+			Actions_: append(actions, wits.PassAction{}),
+		}
+	}
+	return canonical
+}
+
+// Simulates the (canonical) replay under the rules it was played with, every
+// action must be legal and the result of the simulation must agree with the
+// replay's result.  The initial state of the match is returned.
+func Validate(gamemap *state.GameMap, replay witsjson.GameReplayJSON,
+	rules *state.Ruleset) (*state.GameState, error) {
+	stage := match.FetchStatusVALIDATED
+	initial, err := state.NewGameWithRules(gamemap, races(replay), rules)
+	if err != nil {
+		return nil, invalid(stage, "%s", err)
+	}
+
+	game := initial.Clone()
+	for _, played := range replay.Turns_ {
+		if game.IsOver() {
+			return nil, invalid(stage, "turn %d played after the match ended", played.Turn_)
+		}
+		for i, action := range played.Actions_ {
+			resolved, err := gamemap.Resolve(action)
+			if err != nil {
+				return nil, invalid(stage, "turn %d action %d: %s", played.Turn_, i, err)
+			}
+			if resolved.IsPass() {
+				break
+			}
+			if err := game.Apply(resolved); err != nil {
+				return nil, invalid(stage, "turn %d action %d: %s", played.Turn_, i, err)
+			}
+		}
+		game.EndTurn()
+	}
+
+	result := replay.MatchResult()
+	switch {
+	case game.IsOver() && result != wits.STATUS_UNKNOWN && result != game.Result():
+		return nil, invalid(stage, "replay result %d but simulated %d", result, game.Result())
+	case !game.IsOver() && !concedes(result):
+		return nil, invalid(stage, "replay ended with result %d before the match was decided", result)
+	}
+	return initial, nil
+}
+
+// Results that end a match before either side is destroyed or extinct.
+func concedes(result wits.TerminalStatus) bool {
+	return result == wits.VICTORY_RESIGNATION ||
+		result == wits.LOSS_RESIGNATION ||
+		result == wits.DELAY_OF_GAME
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/internal/testutil/testutil.go
+
+// Package testutil sets up games on this repository's maps for the tests of
+// other packages.
+package testutil
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The path of the repository's maps/solo directory, from any package's tests.
+func SoloMapsDir() string {
+	_, filename, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(filename), "..", "..", "maps", "solo")
+}
+
+// Loads every solo map.
+func LoadMaps(t testing.TB) witsjson.MapLibrary {
+	t.Helper()
+	maps, _, err := witsjson.LoadMapLibrary(SoloMapsDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return maps
+}
+
+// Starts a game on the named map with the standard rules.
+func NewGame(t testing.TB, maps witsjson.MapLibrary, name string, races ...wits.UnitRaceEnum) *state.GameState {
+	t.Helper()
+	description, found := maps[name]
+	if !found {
+		t.Fatalf("no map named %s", name)
+	}
+	gamemap := state.NewGameMap(description)
+	game, err := state.NewGame(&gamemap, races)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return game
+}
+
+// Starts a game on peekaboo, FEEDBACK against SCALLYWAGS.
+func NewPeekaboo(t testing.TB) *state.GameState {
+	t.Helper()
+	return NewGame(t, LoadMaps(t), "peekaboo", wits.RACE_FEEDBACK, wits.RACE_SCALLYWAGS)
+}
+
+// Enough for the greedy bot to finish its turns on the small maps.
+const GREEDY_EVALUATIONS = 2000
+
+// A greedy bot that is limited by its work rather than by time (see
+// GreedyBot.Evaluations), so that tests play the same games however fast they
+// run.  It should be given a generous time budget.
+func NewGreedyBot(seed uint64) *bot.GreedyBot {
+	greedy := bot.NewGreedyBot(seed)
+	greedy.Evaluations = GREEDY_EVALUATIONS
+	return greedy
+}
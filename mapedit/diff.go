@@ -0,0 +1,169 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/mapedit/diff.go
+
+package mapedit
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The differences between two versions of a map, in order of coordinates.
+type Diff struct {
+	Added   []Tile       `json:"added,omitempty"`
+	Removed []Tile       `json:"removed,omitempty"`
+	Changed []TileChange `json:"changed,omitempty"`
+
+	UnitsAdded   []Unit     `json:"units_added,omitempty"`
+	UnitsRemoved []Unit     `json:"units_removed,omitempty"`
+	UnitsMoved   []UnitMove `json:"units_moved,omitempty"`
+}
+
+// A tile of a different kind (or team) at the same coordinate.
+type TileChange struct {
+	From Tile `json:"from"`
+	To   Tile `json:"to"`
+}
+
+// A unit of the same class and team at a different coordinate.
+type UnitMove struct {
+	From  witsjson.HexCoordJSON     `json:"from"`
+	To    witsjson.HexCoordJSON     `json:"to"`
+	Team  witsjson.FriendlyEnumJSON `json:"team"`
+	Class witsjson.UnitClassJSON    `json:"class"`
+}
+
+// The differences from the map before to the map after.
+func Compare(before, after *witsjson.MapDefinition) Diff {
+	return newModel(before).compare(newModel(after))
+}
+
+func (diff Diff) IsEmpty() bool {
+	return len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Changed) == 0 &&
+		len(diff.UnitsAdded) == 0 && len(diff.UnitsRemoved) == 0 && len(diff.UnitsMoved) == 0
+}
+
+// The differences that revert these differences.
+func (diff Diff) Invert() Diff {
+	inverse := Diff{
+		Added:        diff.Removed,
+		Removed:      diff.Added,
+		UnitsAdded:   diff.UnitsRemoved,
+		UnitsRemoved: diff.UnitsAdded,
+	}
+	for _, change := range diff.Changed {
+		inverse.Changed = append(inverse.Changed, TileChange{change.To, change.From})
+	}
+	for _, move := range diff.UnitsMoved {
+		inverse.UnitsMoved = append(inverse.UnitsMoved, UnitMove{move.To, move.From, move.Team, move.Class})
+	}
+	return inverse
+}
+
+// The differences from this model to the other.  A unit removed from one
+// coordinate and added at another, with the same class and team, has moved.
+func (m *model) compare(other *model) Diff {
+	var diff Diff
+	for _, coord := range sortedCoords(m.tiles) {
+		tile := m.tiles[coord]
+		if next, found := other.tiles[coord]; !found {
+			diff.Removed = append(diff.Removed, tile)
+		} else if next != tile {
+			diff.Changed = append(diff.Changed, TileChange{tile, next})
+		}
+	}
+	for _, coord := range sortedCoords(other.tiles) {
+		if _, found := m.tiles[coord]; !found {
+			diff.Added = append(diff.Added, other.tiles[coord])
+		}
+	}
+
+	removed, added := make([]Unit, 0), make([]Unit, 0)
+	for _, coord := range sortedCoords(m.units) {
+		if other.units[coord] != m.units[coord] {
+			removed = append(removed, m.units[coord])
+		}
+	}
+	for _, coord := range sortedCoords(other.units) {
+		if m.units[coord] != other.units[coord] {
+			added = append(added, other.units[coord])
+		}
+	}
+	for _, unit := range removed {
+		moved := slices.IndexFunc(added, func(to Unit) bool {
+			return to.Team == unit.Team && to.Class == unit.Class
+		})
+		if moved < 0 {
+			diff.UnitsRemoved = append(diff.UnitsRemoved, unit)
+			continue
+		}
+		diff.UnitsMoved = append(diff.UnitsMoved, UnitMove{unit.Coord, added[moved].Coord, unit.Team, unit.Class})
+		added = slices.Delete(added, moved, moved+1)
+	}
+	if len(added) > 0 {
+		diff.UnitsAdded = added
+	}
+	return diff
+}
+
+// Makes the changes of the diff, which must have been made from a map with
+// the same tiles and units where the diff changes them.  The model may be
+// partly changed when the diff does not apply.
+func (m *model) apply(diff Diff) error {
+	for _, tile := range diff.Removed {
+		if m.tiles[tile.Coord] != tile {
+			return fmt.Errorf("expected %s at %v to remove", tile.Kind, tile.Coord)
+		}
+		delete(m.tiles, tile.Coord)
+	}
+	for _, change := range diff.Changed {
+		if change.From.Coord != change.To.Coord || m.tiles[change.From.Coord] != change.From {
+			return fmt.Errorf("expected %s at %v to change", change.From.Kind, change.From.Coord)
+		}
+		m.tiles[change.To.Coord] = change.To
+	}
+	for _, tile := range diff.Added {
+		if existing, found := m.tiles[tile.Coord]; found {
+			return fmt.Errorf("%s is already at %v", existing.Kind, tile.Coord)
+		}
+		m.tiles[tile.Coord] = tile
+	}
+
+	for _, unit := range diff.UnitsRemoved {
+		if m.units[unit.Coord] != unit {
+			return fmt.Errorf("expected a unit at %v to remove", unit.Coord)
+		}
+		delete(m.units, unit.Coord)
+	}
+	moving := make([]Unit, 0, len(diff.UnitsMoved))
+	for _, move := range diff.UnitsMoved {
+		unit := Unit{move.From, move.Team, move.Class}
+		if m.units[move.From] != unit {
+			return fmt.Errorf("expected a unit at %v to move", move.From)
+		}
+		delete(m.units, move.From)
+		moving = append(moving, Unit{move.To, move.Team, move.Class})
+	}
+	for _, unit := range append(moving, diff.UnitsAdded...) {
+		if _, found := m.units[unit.Coord]; found {
+			return fmt.Errorf("a unit is already at %v", unit.Coord)
+		}
+		m.units[unit.Coord] = unit
+	}
+	return nil
+}
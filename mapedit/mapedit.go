@@ -0,0 +1,405 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/mapedit/mapedit.go
+
+// Package mapedit edits map definitions one change at a time, with undo and
+// redo, and compares two versions of a map.  Edits and the differences they
+// make are encoded as JSON for the map editor's frontend.
+package mapedit
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The kinds of tiles, named as in the map files.
+type TileKind string
+
+const (
+	TILE_FLOOR TileKind = "FLOOR"
+	TILE_WALL  TileKind = "WALL"
+	TILE_BONUS TileKind = "BONUS"
+	TILE_SPAWN TileKind = "SPAWN"
+	TILE_BASE  TileKind = "BASE"
+)
+
+// A tile of the map.  Only spawns and bases belong to a team.
+type Tile struct {
+	Coord witsjson.HexCoordJSON     `json:"coord"`
+	Kind  TileKind                  `json:"kind"`
+	Team  witsjson.FriendlyEnumJSON `json:"team,omitempty"`
+}
+
+// A unit that a team starts the game with.
+type Unit struct {
+	Coord witsjson.HexCoordJSON     `json:"coord"`
+	Team  witsjson.FriendlyEnumJSON `json:"team"`
+	Class witsjson.UnitClassJSON    `json:"class"`
+}
+
+// The tiles and units of a map, by their coordinate.
+type model struct {
+	tiles map[witsjson.HexCoordJSON]Tile
+	units map[witsjson.HexCoordJSON]Unit
+}
+
+func newModel(definition *witsjson.MapDefinition) *model {
+	m := &model{
+		tiles: make(map[witsjson.HexCoordJSON]Tile),
+		units: make(map[witsjson.HexCoordJSON]Unit),
+	}
+	terrain := definition.Terrain
+	for _, defs := range [][]wits.TileDefinition{
+		terrain.Floor(), terrain.Wall(), terrain.Bonus(), terrain.Spawn(), terrain.Base()} {
+		for _, def := range defs {
+			coord := toCoord(def.Position())
+			m.tiles[coord] = Tile{coord, TileKind(def.Typename()), witsjson.FriendlyEnumJSON(def.Team())}
+		}
+	}
+	for _, unit := range definition.Init.Units {
+		m.units[unit.Coord] = Unit{unit.Coord, unit.Team_, unit.Class_}
+	}
+	return m
+}
+
+func (m *model) clone() *model {
+	clone := &model{
+		tiles: make(map[witsjson.HexCoordJSON]Tile, len(m.tiles)),
+		units: make(map[witsjson.HexCoordJSON]Unit, len(m.units)),
+	}
+	for coord, tile := range m.tiles {
+		clone.tiles[coord] = tile
+	}
+	for coord, unit := range m.units {
+		clone.units[coord] = unit
+	}
+	return clone
+}
+
+// Writes the tiles and units into the definition, in order of their
+// coordinates.
+func (m *model) define(definition *witsjson.MapDefinition) {
+	terrain := witsjson.TerrainDefinition{
+		Floor_: make(witsjson.FloorList, 0),
+		Wall_:  make(witsjson.WallList, 0),
+		Bonus_: make(witsjson.BonusList, 0),
+		Spawn_: make(witsjson.SpawnList, 0),
+		Base_:  make(witsjson.BaseList, 0),
+	}
+	for _, coord := range sortedCoords(m.tiles) {
+		tile := m.tiles[coord]
+		i, j := coord.I(), coord.J()
+		switch tile.Kind {
+		case TILE_FLOOR:
+			terrain.Floor_ = append(terrain.Floor_, witsjson.NewTile(string(tile.Kind), i, j))
+		case TILE_WALL:
+			terrain.Wall_ = append(terrain.Wall_, witsjson.NewTile(string(tile.Kind), i, j))
+		case TILE_BONUS:
+			terrain.Bonus_ = append(terrain.Bonus_, witsjson.NewTile(string(tile.Kind), i, j))
+		case TILE_SPAWN:
+			terrain.Spawn_ = append(terrain.Spawn_, witsjson.NewSpawn(i, j, wits.FriendlyEnum(tile.Team)))
+		case TILE_BASE:
+			terrain.Base_ = append(terrain.Base_, witsjson.NewBase(i, j, wits.FriendlyEnum(tile.Team)))
+		}
+	}
+	definition.Terrain = terrain
+
+	definition.Init.Units = make([]witsjson.UnitInitJSON, 0, len(m.units))
+	for _, coord := range sortedCoords(m.units) {
+		unit := m.units[coord]
+		definition.Init.Units = append(definition.Init.Units,
+			witsjson.UnitInitJSON{Coord: coord, Team_: unit.Team, Class_: unit.Class})
+	}
+}
+
+// The team's base, and whether the team has one.
+func (m *model) base(team witsjson.FriendlyEnumJSON) (Tile, bool) {
+	for _, tile := range m.tiles {
+		if tile.Kind == TILE_BASE && tile.Team == team {
+			return tile, true
+		}
+	}
+	return Tile{}, false
+}
+
+func toCoord(position wits.HexCoord) witsjson.HexCoordJSON {
+	return witsjson.NewHexCoord(position.I(), position.J())
+}
+
+func compareCoords(a, b witsjson.HexCoordJSON) int {
+	return cmp.Or(cmp.Compare(a.I(), b.I()), cmp.Compare(a.J(), b.J()))
+}
+
+func sortedCoords[T any](byCoord map[witsjson.HexCoordJSON]T) []witsjson.HexCoordJSON {
+	coords := make([]witsjson.HexCoordJSON, 0, len(byCoord))
+	for coord := range byCoord {
+		coords = append(coords, coord)
+	}
+	slices.SortFunc(coords, compareCoords)
+	return coords
+}
+
+// The changes that can be made to a map.
+type EditOp string
+
+const (
+	// Adds a tile of the kind (and team, for spawns) at an empty coordinate.
+	EDIT_ADD_TILE EditOp = "add_tile"
+	// Removes the tile at the coordinate, which must not have a unit on it.
+	EDIT_REMOVE_TILE EditOp = "remove_tile"
+	// Changes the kind (and team) of the tile at the coordinate.  Bases are
+	// only placed by EDIT_MOVE_BASE.
+	EDIT_RETYPE_TILE EditOp = "retype_tile"
+	// Moves the team's base to an empty coordinate, or places it if the team
+	// has no base yet.
+	EDIT_MOVE_BASE EditOp = "move_base"
+	// Adds a unit of the class and team on the walkable tile at the coordinate.
+	EDIT_ADD_UNIT EditOp = "add_unit"
+	// Removes the unit at the coordinate.
+	EDIT_REMOVE_UNIT EditOp = "remove_unit"
+)
+
+// A single change to the map, as sent by the map editor.
+type Edit struct {
+	Op    EditOp                    `json:"op"`
+	Coord witsjson.HexCoordJSON     `json:"coord"`
+	Kind  TileKind                  `json:"kind,omitempty"`
+	Team  witsjson.FriendlyEnumJSON `json:"team,omitempty"`
+	Class witsjson.UnitClassJSON    `json:"class,omitempty"`
+}
+
+// Makes the edit, if it can be made, on the model.
+func (edit Edit) apply(m *model) error {
+	coord := edit.Coord
+	tile, found := m.tiles[coord]
+	switch edit.Op {
+	case EDIT_ADD_TILE:
+		if found {
+			return fmt.Errorf("%s is already at %v", tile.Kind, coord)
+		}
+		if err := edit.checkKind(); err != nil {
+			return err
+		}
+		m.tiles[coord] = Tile{coord, edit.Kind, edit.Team}
+
+	case EDIT_REMOVE_TILE:
+		if !found {
+			return fmt.Errorf("no tile at %v", coord)
+		}
+		if _, occupied := m.units[coord]; occupied {
+			return fmt.Errorf("a unit is on the tile at %v", coord)
+		}
+		delete(m.tiles, coord)
+
+	case EDIT_RETYPE_TILE:
+		if !found {
+			return fmt.Errorf("no tile at %v", coord)
+		}
+		if tile.Kind == TILE_BASE {
+			return fmt.Errorf("the base at %v can only be moved", coord)
+		}
+		if err := edit.checkKind(); err != nil {
+			return err
+		}
+		if _, occupied := m.units[coord]; occupied && edit.Kind == TILE_WALL {
+			return fmt.Errorf("a unit is on the tile at %v", coord)
+		}
+		m.tiles[coord] = Tile{coord, edit.Kind, edit.Team}
+
+	case EDIT_MOVE_BASE:
+		if err := checkTeam(edit.Team); err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("%s is already at %v", tile.Kind, coord)
+		}
+		if base, placed := m.base(edit.Team); placed {
+			delete(m.tiles, base.Coord)
+		}
+		m.tiles[coord] = Tile{coord, TILE_BASE, edit.Team}
+
+	case EDIT_ADD_UNIT:
+		if !found || tile.Kind == TILE_WALL || tile.Kind == TILE_BASE {
+			return fmt.Errorf("no walkable tile at %v", coord)
+		}
+		if _, occupied := m.units[coord]; occupied {
+			return fmt.Errorf("a unit is already at %v", coord)
+		}
+		if err := checkTeam(edit.Team); err != nil {
+			return err
+		}
+		if edit.Class == witsjson.UnitClassJSON(wits.CLASS_UNKNOWN) {
+			return errors.New("a unit class is required")
+		}
+		m.units[coord] = Unit{coord, edit.Team, edit.Class}
+
+	case EDIT_REMOVE_UNIT:
+		if _, occupied := m.units[coord]; !occupied {
+			return fmt.Errorf("no unit at %v", coord)
+		}
+		delete(m.units, coord)
+
+	default:
+		return fmt.Errorf("unknown edit %q", edit.Op)
+	}
+	return nil
+}
+
+func (edit Edit) checkKind() error {
+	switch edit.Kind {
+	case TILE_FLOOR, TILE_WALL, TILE_BONUS:
+		if edit.Team != 0 {
+			return fmt.Errorf("a %s tile has no team", edit.Kind)
+		}
+		return nil
+	case TILE_SPAWN:
+		return checkTeam(edit.Team)
+	case TILE_BASE:
+		return errors.New("bases are placed with move_base")
+	}
+	return fmt.Errorf("unknown tile kind %q", edit.Kind)
+}
+
+func checkTeam(team witsjson.FriendlyEnumJSON) error {
+	if team < witsjson.FriendlyEnumJSON(wits.FR_SELF) || team > witsjson.FriendlyEnumJSON(wits.FR_ENEMY2) {
+		return fmt.Errorf("invalid team %d", team)
+	}
+	return nil
+}
+
+var (
+	ErrNothingToUndo = errors.New("there is no edit to undo")
+	ErrNothingToRedo = errors.New("there is no edit to redo")
+)
+
+// Edits a map, keeping the differences made by each edit so that they can be
+// undone (and redone).  Editors are not safe for concurrent use.
+type Editor struct {
+	// The map's ID, name, symmetry and coordinate system are not edited.
+	header   witsjson.MapDefinition
+	original *model
+	current  *model
+
+	undo, redo []Diff
+}
+
+func NewEditor(definition *witsjson.MapDefinition) *Editor {
+	header := *definition
+	header.Terrain, header.Init = witsjson.TerrainDefinition{}, witsjson.MapInit{}
+	original := newModel(definition)
+	return &Editor{header: header, original: original, current: original.clone()}
+}
+
+// Makes the edit, returning the difference it made.  If the edit cannot be
+// made the map is unchanged.  Any undone edits can no longer be redone.
+func (editor *Editor) Apply(edit Edit) (Diff, error) {
+	next := editor.current.clone()
+	if err := edit.apply(next); err != nil {
+		return Diff{}, err
+	}
+	diff := editor.current.compare(next)
+	editor.record(next, diff)
+	return diff, nil
+}
+
+// Makes every change in the diff (as a single edit), which must have been
+// made from the map as it is now.
+func (editor *Editor) ApplyDiff(diff Diff) error {
+	next := editor.current.clone()
+	if err := next.apply(diff); err != nil {
+		return err
+	}
+	editor.record(next, diff)
+	return nil
+}
+
+func (editor *Editor) record(next *model, diff Diff) {
+	editor.current = next
+	editor.undo = append(editor.undo, diff)
+	editor.redo = editor.redo[:0]
+}
+
+// Reverts the latest edit, returning the difference made by reverting it.
+func (editor *Editor) Undo() (Diff, error) {
+	if len(editor.undo) == 0 {
+		return Diff{}, ErrNothingToUndo
+	}
+	last := editor.undo[len(editor.undo)-1]
+	reverted := last.Invert()
+	next := editor.current.clone()
+	if err := next.apply(reverted); err != nil {
+		return Diff{}, err
+	}
+	editor.current = next
+	editor.undo = editor.undo[:len(editor.undo)-1]
+	editor.redo = append(editor.redo, last)
+	return reverted, nil
+}
+
+// Makes the latest undone edit again, returning the difference it made.
+func (editor *Editor) Redo() (Diff, error) {
+	if len(editor.redo) == 0 {
+		return Diff{}, ErrNothingToRedo
+	}
+	last := editor.redo[len(editor.redo)-1]
+	next := editor.current.clone()
+	if err := next.apply(last); err != nil {
+		return Diff{}, err
+	}
+	editor.current = next
+	editor.redo = editor.redo[:len(editor.redo)-1]
+	editor.undo = append(editor.undo, last)
+	return last, nil
+}
+
+// The number of edits that can be undone and redone.
+func (editor *Editor) History() (undo, redo int) {
+	return len(editor.undo), len(editor.redo)
+}
+
+// The map as it is after the edits.
+func (editor *Editor) Definition() *witsjson.MapDefinition {
+	definition := editor.header
+	editor.current.define(&definition)
+	return &definition
+}
+
+// The differences between the map as it was when editing began and now.
+func (editor *Editor) Changes() Diff {
+	return editor.original.compare(editor.current)
+}
+
+// The problems that keep the map from being played, as in state.ValidateMap.
+func (editor *Editor) Problems() []string {
+	err := state.ValidateMap(witsjson.NewGameMap(editor.Definition()))
+	if err == nil {
+		return nil
+	}
+	problems := make([]string, 0)
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, problem := range joined.Unwrap() {
+			problems = append(problems, problem.Error())
+		}
+	} else {
+		problems = append(problems, err.Error())
+	}
+	return problems
+}
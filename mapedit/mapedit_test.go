@@ -0,0 +1,224 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/mapedit/mapedit_test.go
+
+package mapedit_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/mapedit"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func peekaboo(t *testing.T) *witsjson.MapDefinition {
+	gamemap, err := witsjson.ReadMapFile("../maps/solo/peekaboo.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gamemap.Definition()
+}
+
+// A floor tile without a unit on it.
+func emptyFloor(t *testing.T, definition *witsjson.MapDefinition) witsjson.HexCoordJSON {
+	occupied := make(map[witsjson.HexCoordJSON]bool)
+	for _, unit := range definition.Init.Units {
+		occupied[unit.Coord] = true
+	}
+	for _, tile := range definition.Terrain.Floor() {
+		coord := witsjson.NewHexCoord(tile.Position().I(), tile.Position().J())
+		if !occupied[coord] {
+			return coord
+		}
+	}
+	t.Fatal("no empty floor tile")
+	return witsjson.HexCoordJSON{}
+}
+
+func TestEditor(t *testing.T) {
+	original := peekaboo(t)
+	editor := mapedit.NewEditor(original)
+	if diff := mapedit.Compare(original, editor.Definition()); !diff.IsEmpty() {
+		t.Fatalf("expected an unedited map to be unchanged, found %+v", diff)
+	}
+	if problems := editor.Problems(); len(problems) > 0 {
+		t.Fatalf("expected no problems with peekaboo, found %v", problems)
+	}
+
+	floor := emptyFloor(t, original)
+	outside := witsjson.NewHexCoord(-3, -3)
+	red := witsjson.FriendlyEnumJSON(wits.FR_SELF)
+	heavy := witsjson.UnitClassJSON(wits.CLASS_HEAVY)
+	edits := []struct {
+		edit     mapedit.Edit
+		expected mapedit.Diff
+	}{
+		{mapedit.Edit{Op: mapedit.EDIT_RETYPE_TILE, Coord: floor, Kind: mapedit.TILE_BONUS},
+			mapedit.Diff{Changed: []mapedit.TileChange{{
+				From: mapedit.Tile{Coord: floor, Kind: mapedit.TILE_FLOOR},
+				To:   mapedit.Tile{Coord: floor, Kind: mapedit.TILE_BONUS}}}}},
+		{mapedit.Edit{Op: mapedit.EDIT_ADD_TILE, Coord: outside, Kind: mapedit.TILE_SPAWN, Team: red},
+			mapedit.Diff{Added: []mapedit.Tile{{Coord: outside, Kind: mapedit.TILE_SPAWN, Team: red}}}},
+		{mapedit.Edit{Op: mapedit.EDIT_ADD_UNIT, Coord: floor, Team: red, Class: heavy},
+			mapedit.Diff{UnitsAdded: []mapedit.Unit{{Coord: floor, Team: red, Class: heavy}}}},
+		{mapedit.Edit{Op: mapedit.EDIT_REMOVE_UNIT, Coord: floor},
+			mapedit.Diff{UnitsRemoved: []mapedit.Unit{{Coord: floor, Team: red, Class: heavy}}}},
+	}
+	for _, test := range edits {
+		diff, err := editor.Apply(test.edit)
+		if err != nil {
+			t.Fatalf("%s: %v", test.edit.Op, err)
+		}
+		if !reflect.DeepEqual(diff, test.expected) {
+			t.Errorf("%s: expected %+v, found %+v", test.edit.Op, test.expected, diff)
+		}
+	}
+
+	base := original.Terrain.Base()[0]
+	baseCoord := witsjson.NewHexCoord(base.Position().I(), base.Position().J())
+	moved := witsjson.NewHexCoord(-5, -5)
+	diff, err := editor.Apply(mapedit.Edit{Op: mapedit.EDIT_MOVE_BASE, Coord: moved, Team: red})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Coord != baseCoord || len(diff.Added) != 1 || diff.Added[0].Coord != moved {
+		t.Errorf("expected the base to move from %v to %v, found %+v", baseCoord, moved, diff)
+	}
+	if len(editor.Problems()) == 0 {
+		t.Error("expected problems with the base moved off the map")
+	}
+
+	changes := editor.Changes()
+	for undone := 0; undone < len(edits)+1; undone++ {
+		if _, err := editor.Undo(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := editor.Undo(); err != mapedit.ErrNothingToUndo {
+		t.Errorf("expected ErrNothingToUndo, found %v", err)
+	}
+	if diff := mapedit.Compare(original, editor.Definition()); !diff.IsEmpty() {
+		t.Errorf("expected every edit to be undone, found %+v", diff)
+	}
+	for {
+		if _, err := editor.Redo(); err == mapedit.ErrNothingToRedo {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !reflect.DeepEqual(editor.Changes(), changes) {
+		t.Errorf("expected the redone edits to make %+v, found %+v", changes, editor.Changes())
+	}
+	if undo, redo := editor.History(); undo != len(edits)+1 || redo != 0 {
+		t.Errorf("expected %d edits to undo and none to redo, found %d and %d", len(edits)+1, undo, redo)
+	}
+}
+
+func TestEditor_Invalid(t *testing.T) {
+	original := peekaboo(t)
+	unit := original.Init.Units[0]
+	base := original.Terrain.Base()[0]
+	baseCoord := witsjson.NewHexCoord(base.Position().I(), base.Position().J())
+	wall := original.Terrain.Wall()[0]
+	wallCoord := witsjson.NewHexCoord(wall.Position().I(), wall.Position().J())
+	heavy := witsjson.UnitClassJSON(wits.CLASS_HEAVY)
+
+	editor := mapedit.NewEditor(original)
+	for _, edit := range []mapedit.Edit{
+		{Op: mapedit.EDIT_ADD_TILE, Coord: unit.Coord, Kind: mapedit.TILE_FLOOR},
+		{Op: mapedit.EDIT_ADD_TILE, Coord: witsjson.NewHexCoord(-1, -1), Kind: mapedit.TILE_BASE, Team: 1},
+		{Op: mapedit.EDIT_ADD_TILE, Coord: witsjson.NewHexCoord(-1, -1), Kind: mapedit.TILE_SPAWN},
+		{Op: mapedit.EDIT_REMOVE_TILE, Coord: unit.Coord},
+		{Op: mapedit.EDIT_REMOVE_TILE, Coord: witsjson.NewHexCoord(-1, -1)},
+		{Op: mapedit.EDIT_RETYPE_TILE, Coord: baseCoord, Kind: mapedit.TILE_FLOOR},
+		{Op: mapedit.EDIT_RETYPE_TILE, Coord: unit.Coord, Kind: mapedit.TILE_WALL},
+		{Op: mapedit.EDIT_MOVE_BASE, Coord: unit.Coord, Team: 1},
+		{Op: mapedit.EDIT_MOVE_BASE, Coord: witsjson.NewHexCoord(-1, -1), Team: 7},
+		{Op: mapedit.EDIT_ADD_UNIT, Coord: wallCoord, Team: 1, Class: heavy},
+		{Op: mapedit.EDIT_ADD_UNIT, Coord: unit.Coord, Team: 1, Class: heavy},
+		{Op: mapedit.EDIT_REMOVE_UNIT, Coord: wallCoord},
+		{Op: "paint", Coord: unit.Coord},
+	} {
+		if _, err := editor.Apply(edit); err == nil {
+			t.Errorf("expected an error for %+v", edit)
+		}
+	}
+	if changes := editor.Changes(); !changes.IsEmpty() {
+		t.Errorf("expected invalid edits to leave the map unchanged, found %+v", changes)
+	}
+	if _, err := editor.Redo(); err != mapedit.ErrNothingToRedo {
+		t.Errorf("expected ErrNothingToRedo, found %v", err)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	original := peekaboo(t)
+	editor := mapedit.NewEditor(original)
+	floor := emptyFloor(t, original)
+	unit := original.Init.Units[0]
+	for _, edit := range []mapedit.Edit{
+		{Op: mapedit.EDIT_REMOVE_UNIT, Coord: unit.Coord},
+		{Op: mapedit.EDIT_ADD_UNIT, Coord: floor, Team: unit.Team_, Class: unit.Class_},
+		{Op: mapedit.EDIT_ADD_TILE, Coord: witsjson.NewHexCoord(-2, 0), Kind: mapedit.TILE_WALL},
+	} {
+		if _, err := editor.Apply(edit); err != nil {
+			t.Fatal(err)
+		}
+	}
+	edited := editor.Definition()
+	diff := mapedit.Compare(original, edited)
+	expected := mapedit.Diff{
+		Added:      []mapedit.Tile{{Coord: witsjson.NewHexCoord(-2, 0), Kind: mapedit.TILE_WALL}},
+		UnitsMoved: []mapedit.UnitMove{{From: unit.Coord, To: floor, Team: unit.Team_, Class: unit.Class_}},
+	}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Errorf("expected %+v, found %+v", expected, diff)
+	}
+
+	// Diffs are sent to and from the map editor as JSON.
+	encoded, err := json.Marshal(diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded mapedit.Diff
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, diff) {
+		t.Errorf("expected %s to decode as %+v, found %+v", encoded, diff, decoded)
+	}
+
+	// The diff applies to the original map and its inverse reverts it.
+	other := mapedit.NewEditor(original)
+	if err := other.ApplyDiff(decoded); err != nil {
+		t.Fatal(err)
+	}
+	if remaining := mapedit.Compare(edited, other.Definition()); !remaining.IsEmpty() {
+		t.Errorf("expected the diff to make the same map, differs by %+v", remaining)
+	}
+	if err := other.ApplyDiff(decoded); err == nil {
+		t.Error("expected an error applying the diff twice")
+	}
+	if err := other.ApplyDiff(decoded.Invert()); err != nil {
+		t.Fatal(err)
+	}
+	if remaining := mapedit.Compare(original, other.Definition()); !remaining.IsEmpty() {
+		t.Errorf("expected the inverted diff to revert the map, differs by %+v", remaining)
+	}
+}
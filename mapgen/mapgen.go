@@ -0,0 +1,610 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/mapgen/mapgen.go
+
+// Package mapgen generates solo maps at random, symmetric so that neither team
+// is favored by the terrain.  The same options (including the seed) always
+// generate the same map.
+package mapgen
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// How the second team's half of the map is made from the first team's half.
+type Symmetry string
+
+const (
+	// A half-turn about the center of the map.
+	ROTATE Symmetry = "ROTATE"
+
+	// Mirrored left to right, and top to bottom.
+	VERTICAL   Symmetry = Symmetry(witsjson.FLIP_VERTICAL)
+	HORIZONTAL Symmetry = Symmetry(witsjson.FLIP_HORIZONTAL)
+)
+
+type Options struct {
+	Seed uint64
+
+	// The map's ID and name, derived from the symmetry and seed if empty.
+	MapID string
+	Name  string
+
+	Symmetry Symmetry
+
+	// The map is drawn within this many columns and rows of tiles (odd columns
+	// are shifted down by half a tile, as in the legacy maps).
+	Width, Height int
+
+	// The number of walkable tiles, and of walls among them.  The map may have
+	// a few more walkable tiles than this, to connect the bases.
+	Tiles int
+	Walls int
+
+	// Spawns for each team and bonus tiles (for either team) on the map.
+	Spawns int
+	Bonus  int
+
+	// The least distance between the centers of the bases, and from each
+	// spawn to the other team's base and spawns.
+	MinBaseDistance  int
+	MinSpawnDistance int
+
+	// The classes of the units that each team starts with, near their base.
+	Units []wits.UnitClassEnum
+
+	// Layouts that do not meet the constraints are generated again, up to
+	// this many times.
+	Attempts int
+}
+
+func DefaultOptions() Options {
+	return Options{
+		Symmetry:         ROTATE,
+		Width:            13,
+		Height:           11,
+		Tiles:            64,
+		Walls:            10,
+		Spawns:           1,
+		Bonus:            2,
+		MinBaseDistance:  9,
+		MinSpawnDistance: 6,
+		Units:            []wits.UnitClassEnum{wits.CLASS_HEAVY, wits.CLASS_SOLDIER, wits.CLASS_MEDIC},
+		Attempts:         200,
+	}
+}
+
+// The default options for a map of this symmetry.  A map mirrored top to
+// bottom is drawn taller than it is wide, to leave room for its bases to be
+// MinBaseDistance apart.
+func DefaultOptionsFor(symmetry Symmetry) Options {
+	options := DefaultOptions()
+	options.Symmetry = symmetry
+	if symmetry == HORIZONTAL {
+		options.Width, options.Height = 11, 15
+	}
+	return options
+}
+
+// The least distance between initial units of opposing teams.
+const UNIT_DISTANCE = 4
+
+var ErrNoLayout = errors.New("no layout meets the constraints")
+
+// Generates a map definition (in axial coordinates) that passes
+// state.ValidateMap, with its symmetry declared.
+func Generate(options Options) (*witsjson.MapDefinition, error) {
+	if options.Width < 5 || options.Height < 5 {
+		return nil, fmt.Errorf("a map of %dx%d is too small", options.Width, options.Height)
+	}
+	definition := &witsjson.MapDefinition{MapID: options.MapID, Name: options.Name}
+	if len(definition.MapID) == 0 {
+		definition.MapID = fmt.Sprintf("gen/solo/%s-%d", strings.ToLower(string(options.Symmetry)), options.Seed)
+	}
+	if len(definition.Name) == 0 {
+		definition.Name = fmt.Sprintf("Generated %s %d", strings.ToLower(string(options.Symmetry)), options.Seed)
+	}
+
+	// The axis or center of the symmetry is in the middle of the drawing.
+	switch options.Symmetry {
+	case ROTATE:
+		column := (options.Width - 1) / 2
+		row := (options.Height - 1) / 2
+		definition.Rotate = &witsjson.Rotation{
+			Position: toCoord(offsetToAxial(column, row)),
+			Center:   options.Height%2 == 1,
+		}
+	case VERTICAL:
+		definition.Mirror = &witsjson.Reflection{
+			Axis: (options.Width - 1) / 2, Flip: witsjson.FLIP_VERTICAL, Center: true}
+	case HORIZONTAL:
+		definition.Mirror = &witsjson.Reflection{
+			Axis: (options.Height - 1) / 2, Flip: witsjson.FLIP_HORIZONTAL, Center: options.Height%2 == 1}
+	default:
+		return nil, fmt.Errorf("unknown symmetry %q", options.Symmetry)
+	}
+	symmetry, _ := state.MapSymmetry(definition)
+
+	generator := &generator{
+		Options:  options,
+		rng:      rand.New(rand.NewPCG(options.Seed, options.Seed)),
+		symmetry: symmetry,
+	}
+	generator.cells()
+	if len(generator.baseOptions()) == 0 {
+		return nil, fmt.Errorf("no room for bases %d apart within %dx%d tiles",
+			options.MinBaseDistance, options.Width, options.Height)
+	}
+	for range max(options.Attempts, 1) {
+		if layout, ok := generator.layout(); ok {
+			layout.define(definition)
+			if err := state.ValidateMap(witsjson.NewGameMap(definition)); err == nil {
+				return definition, nil
+			}
+		}
+	}
+	return nil, ErrNoLayout
+}
+
+// Axial coordinates of the tile at the column and row of the offset layout.
+func offsetToAxial(column, row int) [2]int {
+	return state.ToAxial(witsjson.NewHexCoord(column, row), true)
+}
+
+func toCoord(axial [2]int) witsjson.HexCoordJSON {
+	return witsjson.NewHexCoord(axial[0], axial[1])
+}
+
+type generator struct {
+	Options
+	rng      *rand.Rand
+	symmetry state.Symmetry
+
+	// The coordinates within the drawing whose image is also within it, in a
+	// stable order (maps are iterated in random order).
+	candidates [][2]int
+	within     map[[2]int]bool
+}
+
+func (generator *generator) cells() {
+	all := make(map[[2]int]bool)
+	for column := range generator.Width {
+		for row := range generator.Height {
+			all[offsetToAxial(column, row)] = true
+		}
+	}
+	generator.within = make(map[[2]int]bool)
+	for column := range generator.Width {
+		for row := range generator.Height {
+			cell := offsetToAxial(column, row)
+			if all[generator.symmetry(cell)] {
+				generator.candidates = append(generator.candidates, cell)
+				generator.within[cell] = true
+			}
+		}
+	}
+}
+
+// A layout in progress, the first team's features are mirrored for the other.
+type layout struct {
+	*generator
+	bases    [2][2]int
+	reserved map[[2]int]bool // the bases and the rings around them
+	floor    map[[2]int]bool // walkable tiles
+	walls    map[[2]int]bool
+	bonus    map[[2]int]bool
+	spawns   [][2]int // the first team's
+	units    map[[2]int]wits.UnitClassEnum
+	teams    map[[2]int]wits.FriendlyEnum
+}
+
+// Lays out the map in stages, false if any stage cannot meet its constraints.
+func (generator *generator) layout() (*layout, bool) {
+	layout := &layout{
+		generator: generator,
+		reserved:  make(map[[2]int]bool),
+		floor:     make(map[[2]int]bool),
+		walls:     make(map[[2]int]bool),
+		bonus:     make(map[[2]int]bool),
+		units:     make(map[[2]int]wits.UnitClassEnum),
+		teams:     make(map[[2]int]wits.FriendlyEnum),
+	}
+	return layout, layout.placeBases() &&
+		layout.grow() &&
+		layout.connectBases() &&
+		layout.placeWalls() &&
+		layout.placeSpawns() &&
+		layout.placeUnits() &&
+		layout.placeBonus()
+}
+
+func (generator *generator) pick(cells [][2]int) [2]int {
+	return cells[generator.rng.IntN(len(cells))]
+}
+
+func neighbors(cell [2]int) [][2]int {
+	adjacent := make([][2]int, len(state.AXIAL_NEIGHBORS))
+	for i, offset := range state.AXIAL_NEIGHBORS {
+		adjacent[i] = [2]int{cell[0] + offset[0], cell[1] + offset[1]}
+	}
+	return adjacent
+}
+
+// The cells within the drawing that are at this distance from the cell.
+func (generator *generator) ring(cell [2]int, distance int) [][2]int {
+	cells := make([][2]int, 0)
+	for _, candidate := range generator.candidates {
+		if state.AxialDistance(cell, candidate) == distance {
+			cells = append(cells, candidate)
+		}
+	}
+	return cells
+}
+
+// Adds the cell and its image to the set.
+func (layout *layout) add(set map[[2]int]bool, cell [2]int) {
+	set[cell] = true
+	set[layout.symmetry(cell)] = true
+}
+
+// Where the first team's base may be: somewhere its image is far enough away,
+// with both bases (and the rings around them) within the drawing.
+func (generator *generator) baseOptions() [][2]int {
+	options := make([][2]int, 0)
+	for _, cell := range generator.candidates {
+		image := generator.symmetry(cell)
+		if state.AxialDistance(cell, image) < max(generator.MinBaseDistance, 5) ||
+			len(generator.ring(cell, 1)) < 6 || len(generator.ring(cell, 2)) < 6 {
+			continue
+		}
+		options = append(options, cell)
+	}
+	return options
+}
+
+func (layout *layout) placeBases() bool {
+	base := layout.pick(layout.baseOptions())
+	layout.bases = [2][2]int{base, layout.symmetry(base)}
+	for _, base := range layout.bases {
+		layout.reserved[base] = true
+		for _, cell := range neighbors(base) {
+			layout.reserved[cell] = true
+		}
+	}
+	return true
+}
+
+// Grows the walkable region from the middle of the map, adding a cell next to
+// the region (and that cell's image) at a time, so the region stays connected
+// and symmetric.  Cells with more neighbors in the region are more likely to
+// be added, which keeps the region compact.  Space is left for the walls,
+// which are placed within it.
+func (layout *layout) grow() bool {
+	// A cell that is its own image, or next to it.
+	seeds := make([][2]int, 0)
+	for _, cell := range layout.candidates {
+		image := layout.symmetry(cell)
+		if !layout.reserved[cell] && (image == cell || state.AxialDistance(cell, image) == 1) {
+			seeds = append(seeds, cell)
+		}
+	}
+	if len(seeds) == 0 {
+		return false
+	}
+	layout.add(layout.floor, layout.pick(seeds))
+
+	for len(layout.floor) < layout.Tiles+layout.Walls {
+		frontier := layout.frontier()
+		if len(frontier) == 0 {
+			return false
+		}
+		weights := make([]int, len(frontier))
+		total := 0
+		for i, cell := range frontier {
+			adjacent := 0
+			for _, next := range neighbors(cell) {
+				if layout.floor[next] {
+					adjacent++
+				}
+			}
+			weights[i] = adjacent * adjacent
+			total += weights[i]
+		}
+		choice := layout.rng.IntN(total)
+		for i, weight := range weights {
+			if choice -= weight; choice < 0 {
+				layout.add(layout.floor, frontier[i])
+				break
+			}
+		}
+	}
+	return true
+}
+
+// The unreserved cells next to the walkable region and not already in it.
+func (layout *layout) frontier() [][2]int {
+	frontier := make([][2]int, 0)
+	for _, cell := range layout.candidates {
+		if layout.floor[cell] || layout.reserved[cell] {
+			continue
+		}
+		for _, adjacent := range neighbors(cell) {
+			if layout.floor[adjacent] {
+				frontier = append(frontier, cell)
+				break
+			}
+		}
+	}
+	return frontier
+}
+
+// Extends the region along the shortest path to the first team's base, if
+// it does not reach the base already (the image of the path reaches the
+// other base).
+func (layout *layout) connectBases() bool {
+	approach := layout.ring(layout.bases[0], 2)
+	for _, cell := range approach {
+		if layout.floor[cell] {
+			return true
+		}
+	}
+	// Search outward from the region for any cell next to the base's ring.
+	goal := make(map[[2]int]bool)
+	for _, cell := range approach {
+		goal[cell] = true
+	}
+	previous := make(map[[2]int][2]int)
+	queue := make([][2]int, 0)
+	for _, cell := range layout.candidates {
+		if layout.floor[cell] {
+			previous[cell] = cell
+			queue = append(queue, cell)
+		}
+	}
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+		if goal[cell] {
+			for !layout.floor[cell] {
+				layout.add(layout.floor, cell)
+				cell = previous[cell]
+			}
+			return true
+		}
+		for _, next := range neighbors(cell) {
+			if _, seen := previous[next]; !seen && layout.within[next] && !layout.reserved[next] {
+				previous[next] = cell
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
+// Turns walkable tiles into walls (with their images) where that does not
+// disconnect the region or leave a base with fewer than two tiles next to it.
+func (layout *layout) placeWalls() bool {
+	order := slices.Clone(layout.candidates)
+	layout.rng.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+	for _, cell := range order {
+		if len(layout.walls) >= layout.Walls {
+			break
+		}
+		image := layout.symmetry(cell)
+		if !layout.floor[cell] || !layout.floor[image] {
+			continue
+		}
+		delete(layout.floor, cell)
+		delete(layout.floor, image)
+		if layout.connected() && layout.approaches(layout.bases[0]) >= 2 {
+			layout.add(layout.walls, cell)
+		} else {
+			layout.add(layout.floor, cell)
+		}
+	}
+	return len(layout.walls) >= layout.Walls
+}
+
+// Whether every walkable tile is reached from any one of them.
+func (layout *layout) connected() bool {
+	return len(layout.walking(nil)) == len(layout.floor)
+}
+
+// The number of walkable tiles next to the base's ring.
+func (layout *layout) approaches(base [2]int) int {
+	count := 0
+	for _, cell := range layout.ring(base, 2) {
+		if layout.floor[cell] {
+			count++
+		}
+	}
+	return count
+}
+
+// Walking distances over the region, from the tiles next to the base's ring
+// (at a distance of 2 from its center), or from any tile if base is nil.
+func (layout *layout) walking(base *[2]int) map[[2]int]int {
+	distance := make(map[[2]int]int)
+	queue := make([][2]int, 0)
+	for _, cell := range layout.candidates {
+		if !layout.floor[cell] {
+			continue
+		}
+		if base == nil {
+			distance[cell] = 0
+			queue = append(queue, cell)
+			break
+		}
+		if state.AxialDistance(cell, *base) == 2 {
+			distance[cell] = 2
+			queue = append(queue, cell)
+		}
+	}
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+		for _, next := range neighbors(cell) {
+			if _, seen := distance[next]; !seen && layout.floor[next] {
+				distance[next] = distance[cell] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return distance
+}
+
+// Spawns are near their team's base and far from the other team's spawns
+// and base, never on a tile that is its own image.
+func (layout *layout) placeSpawns() bool {
+	own, other := layout.bases[0], layout.bases[1]
+	for range layout.Spawns {
+		options := make([][2]int, 0)
+		for _, cell := range layout.candidates {
+			image := layout.symmetry(cell)
+			if !layout.floor[cell] || layout.teams[cell] != wits.FR_UNKNOWN ||
+				state.AxialDistance(cell, own) < 3 || state.AxialDistance(cell, own) > 5 ||
+				state.AxialDistance(cell, other) < layout.MinSpawnDistance ||
+				state.AxialDistance(cell, image) < layout.MinSpawnDistance {
+				continue
+			}
+			apart := true
+			for _, spawn := range layout.spawns {
+				apart = apart && state.AxialDistance(cell, spawn) >= 2 &&
+					state.AxialDistance(cell, layout.symmetry(spawn)) >= layout.MinSpawnDistance
+			}
+			if apart {
+				options = append(options, cell)
+			}
+		}
+		if len(options) == 0 {
+			return false
+		}
+		spawn := layout.pick(options)
+		layout.spawns = append(layout.spawns, spawn)
+		layout.teams[spawn] = wits.FR_SELF
+		layout.teams[layout.symmetry(spawn)] = wits.FR_ENEMY
+	}
+	return true
+}
+
+// The initial units are on tiles near their team's base (other than spawns)
+// and a few tiles away from the other team's units.
+func (layout *layout) placeUnits() bool {
+	own, other := layout.bases[0], layout.bases[1]
+	for _, class := range layout.Units {
+		options := make([][2]int, 0)
+		for _, cell := range layout.candidates {
+			image := layout.symmetry(cell)
+			distance := state.AxialDistance(cell, own)
+			if layout.floor[cell] && distance >= 2 && distance <= 4 &&
+				state.AxialDistance(cell, image) >= UNIT_DISTANCE &&
+				state.AxialDistance(cell, other) >= layout.MinSpawnDistance &&
+				layout.teams[cell] == wits.FR_UNKNOWN && layout.teams[image] == wits.FR_UNKNOWN &&
+				layout.apart(cell) {
+				options = append(options, cell)
+			}
+		}
+		if len(options) == 0 {
+			return false
+		}
+		cell := layout.pick(options)
+		layout.units[cell] = class
+		layout.units[layout.symmetry(cell)] = class
+		layout.teams[cell] = wits.FR_SELF
+		layout.teams[layout.symmetry(cell)] = wits.FR_ENEMY
+	}
+	return true
+}
+
+// Whether the cell is far enough from the other team's units.
+func (layout *layout) apart(cell [2]int) bool {
+	for unit := range layout.units {
+		if layout.teams[unit] == wits.FR_ENEMY && state.AxialDistance(cell, unit) < UNIT_DISTANCE {
+			return false
+		}
+	}
+	return true
+}
+
+// Bonus tiles are where the walking distance from each base is the same,
+// other than where spawns and units are.
+func (layout *layout) placeBonus() bool {
+	first, second := layout.walking(&layout.bases[0]), layout.walking(&layout.bases[1])
+	for len(layout.bonus) < layout.Bonus {
+		remaining := layout.Bonus - len(layout.bonus)
+		options := make([][2]int, 0)
+		for _, cell := range layout.candidates {
+			image := layout.symmetry(cell)
+			if !layout.floor[cell] || layout.bonus[cell] || first[cell] != second[cell] ||
+				layout.teams[cell] != wits.FR_UNKNOWN || layout.teams[image] != wits.FR_UNKNOWN {
+				continue
+			}
+			// A tile that is its own image is a single bonus, others are pairs.
+			if (image == cell) == (remaining%2 == 1) {
+				options = append(options, cell)
+			}
+		}
+		if len(options) == 0 {
+			return false
+		}
+		layout.add(layout.bonus, layout.pick(options))
+	}
+	return true
+}
+
+// Fills in the definition's terrain and initial units from the layout.
+func (layout *layout) define(definition *witsjson.MapDefinition) {
+	terrain := witsjson.TerrainDefinition{}
+	for _, cell := range layout.candidates {
+		coord := toCoord(cell)
+		switch {
+		case layout.walls[cell]:
+			terrain.Wall_ = append(terrain.Wall_, witsjson.NewTile("WALL", coord.I(), coord.J()))
+		case !layout.floor[cell]:
+		case layout.bonus[cell]:
+			terrain.Bonus_ = append(terrain.Bonus_, witsjson.NewTile("BONUS", coord.I(), coord.J()))
+		case slices.Contains(layout.spawns, cell):
+			terrain.Spawn_ = append(terrain.Spawn_, witsjson.NewSpawn(coord.I(), coord.J(), wits.FR_SELF))
+		case slices.Contains(layout.spawns, layout.symmetry(cell)):
+			terrain.Spawn_ = append(terrain.Spawn_, witsjson.NewSpawn(coord.I(), coord.J(), wits.FR_ENEMY))
+		default:
+			terrain.Floor_ = append(terrain.Floor_, witsjson.NewTile("FLOOR", coord.I(), coord.J()))
+		}
+	}
+	for i, base := range layout.bases {
+		coord := toCoord(base)
+		terrain.Base_ = append(terrain.Base_, witsjson.NewBase(coord.I(), coord.J(), wits.FriendlyEnum(i+1)))
+	}
+	definition.Terrain = terrain
+
+	definition.Init.Units = make([]witsjson.UnitInitJSON, 0, len(layout.units))
+	for _, cell := range layout.candidates {
+		if class, found := layout.units[cell]; found {
+			definition.Init.Units = append(definition.Init.Units, witsjson.UnitInitJSON{
+				Coord:  toCoord(cell),
+				Team_:  witsjson.FriendlyEnumJSON(layout.teams[cell]),
+				Class_: witsjson.UnitClassJSON(class),
+			})
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/mapgen/mapgen_test.go
+
+package mapgen_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/mapgen"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func options(symmetry mapgen.Symmetry, seed uint64) mapgen.Options {
+	options := mapgen.DefaultOptionsFor(symmetry)
+	options.Seed = seed
+	return options
+}
+
+// The defaults of cmd/mapgen (seed 1 and the symmetry's default options)
+// generate a map of every symmetry.
+func TestGenerate_Defaults(t *testing.T) {
+	for _, symmetry := range []mapgen.Symmetry{mapgen.ROTATE, mapgen.VERTICAL, mapgen.HORIZONTAL} {
+		options := mapgen.DefaultOptionsFor(symmetry)
+		options.Seed = 1
+		if _, err := mapgen.Generate(options); err != nil {
+			t.Errorf("%s: %v", symmetry, err)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	for _, symmetry := range []mapgen.Symmetry{mapgen.ROTATE, mapgen.VERTICAL, mapgen.HORIZONTAL} {
+		for seed := range uint64(8) {
+			options := options(symmetry, seed)
+			definition, err := mapgen.Generate(options)
+			if err != nil {
+				t.Fatalf("%s %d: %v", symmetry, seed, err)
+			}
+			// The formatted map is read back as a map file would be.
+			var gamemap witsjson.GameMapJSON
+			if err := json.Unmarshal(definition.Format(), &gamemap); err != nil {
+				t.Fatalf("%s %d: %v", symmetry, seed, err)
+			}
+			if err := state.ValidateMap(gamemap); err != nil {
+				t.Errorf("%s %d: %v", symmetry, seed, err)
+			}
+			checkSymmetric(t, gamemap)
+			checkPlacement(t, gamemap, options)
+		}
+	}
+}
+
+// Every tile and unit has an image of the same kind, for the other team.
+func checkSymmetric(t *testing.T, gamemap witsjson.GameMapJSON) {
+	symmetry, ok := state.MapSymmetry(gamemap.Definition())
+	if !ok {
+		t.Fatalf("%s declares no symmetry", gamemap.MapID())
+	}
+	terrain := gamemap.Terrain()
+	kinds := make(map[[2]int]string)
+	for _, defs := range [][]wits.TileDefinition{
+		terrain.Floor(), terrain.Wall(), terrain.Bonus(), terrain.Spawn(), terrain.Base()} {
+		for _, def := range defs {
+			kinds[state.ToAxial(def.Position(), false)] = def.Typename() + string(rune('0'+def.Team()))
+		}
+	}
+	for _, unit := range gamemap.Units() {
+		kinds[state.ToAxial(unit.Position(), false)] += unit.Class().String() + string(rune('0'+unit.Team()))
+	}
+	swap := map[byte]byte{'0': '0', '1': '2', '2': '1'}
+	for at, kind := range kinds {
+		expected := []byte(kind)
+		for i, char := range expected {
+			if replaced, found := swap[char]; found {
+				expected[i] = replaced
+			}
+		}
+		if image := kinds[symmetry(at)]; image != string(expected) {
+			t.Errorf("%s: %s at %v but %s at its image %v", gamemap.MapID(), kind, at, image, symmetry(at))
+		}
+	}
+}
+
+// The bases and spawns are far enough apart and the bonus tiles are the same
+// walking distance from each base.
+func checkPlacement(t *testing.T, description witsjson.GameMapJSON, options mapgen.Options) {
+	gamemap := state.NewGameMap(description)
+	bases := []wits.HexCoordIndex{gamemap.Base(wits.FR_SELF), gamemap.Base(wits.FR_ENEMY)}
+	if distance := gamemap.Distance(bases[0], bases[1]); int(distance) < options.MinBaseDistance {
+		t.Errorf("%s: bases %d apart", description.MapID(), distance)
+	}
+	for team := range 2 {
+		spawns := gamemap.Spawns(wits.FriendlyEnum(team + 1))
+		if len(spawns) != options.Spawns {
+			t.Errorf("%s: %d spawns for team %d", description.MapID(), len(spawns), team+1)
+		}
+		for _, spawn := range spawns {
+			if distance := gamemap.Distance(spawn, bases[1-team]); int(distance) < options.MinSpawnDistance {
+				t.Errorf("%s: spawn %d from the other base", description.MapID(), distance)
+			}
+		}
+	}
+
+	first, second := walking(&gamemap, bases[0]), walking(&gamemap, bases[1])
+	bonus := gamemap.BonusTiles()
+	if len(bonus) != options.Bonus {
+		t.Errorf("%s: %d bonus tiles", description.MapID(), len(bonus))
+	}
+	for _, at := range bonus {
+		if first[at] != second[at] {
+			t.Errorf("%s: bonus at %s is %d and %d from the bases",
+				description.MapID(), gamemap.Coord(at), first[at], second[at])
+		}
+	}
+}
+
+// The walking distance of each tile from the base.
+func walking(gamemap *state.GameMap, base wits.HexCoordIndex) map[wits.HexCoordIndex]int {
+	distance := make(map[wits.HexCoordIndex]int)
+	queue := make([]wits.HexCoordIndex, 0)
+	for index := range gamemap.TileCount() {
+		at := wits.HexCoordIndex(index)
+		if gamemap.IsWalkable(at) && gamemap.Distance(base, at) == 2 {
+			distance[at] = 2
+			queue = append(queue, at)
+		}
+	}
+	for len(queue) > 0 {
+		at := queue[0]
+		queue = queue[1:]
+		for _, next := range gamemap.Neighbors(at) {
+			if _, seen := distance[next]; !seen && gamemap.IsWalkable(next) {
+				distance[next] = distance[at] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return distance
+}
+
+func TestGenerate_Seeded(t *testing.T) {
+	first, err := mapgen.Generate(options(mapgen.ROTATE, 42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, _ := mapgen.Generate(options(mapgen.ROTATE, 42))
+	other, _ := mapgen.Generate(options(mapgen.ROTATE, 43))
+	if !bytes.Equal(first.Format(), again.Format()) {
+		t.Error("the same seed generated different maps")
+	}
+	if bytes.Equal(first.Format(), other.Format()) {
+		t.Error("different seeds generated the same map")
+	}
+	if first.MapID != "gen/solo/rotate-42" {
+		t.Errorf("unexpected map ID %s", first.MapID)
+	}
+
+	gamemap := state.NewGameMap(witsjson.NewGameMap(first))
+	game, err := state.NewGame(&gamemap, []wits.UnitRaceEnum{wits.RACE_FEEDBACK, wits.RACE_ADORABLES})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(game.LegalActions()) == 0 {
+		t.Error("no legal actions at the start of a generated map")
+	}
+}
+
+func TestGenerate_Constraints(t *testing.T) {
+	small := options(mapgen.ROTATE, 1)
+	small.Width, small.Height = 4, 4
+	if _, err := mapgen.Generate(small); err == nil {
+		t.Error("expected an error for a map too small")
+	}
+	distant := options(mapgen.HORIZONTAL, 1)
+	distant.Width, distant.Height = 13, 11
+	if _, err := mapgen.Generate(distant); err == nil {
+		t.Error("expected an error for bases that cannot be far enough apart")
+	}
+	crowded := options(mapgen.VERTICAL, 1)
+	crowded.Tiles, crowded.Attempts = 400, 3
+	if _, err := mapgen.Generate(crowded); err != mapgen.ErrNoLayout {
+		t.Errorf("expected ErrNoLayout for more tiles than fit, got %v", err)
+	}
+	unknown := options("SPIRAL", 1)
+	if _, err := mapgen.Generate(unknown); err == nil {
+		t.Error("expected an error for an unknown symmetry")
+	}
+}
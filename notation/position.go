@@ -0,0 +1,398 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/notation/position.go
+
+// Package notation writes and parses positions as a single line of text, in
+// the spirit of FEN for chess.  The fields are separated by spaces:
+//
+//	<map ID> <board> <races> <base HP> <wits> <side> <turn> <used> <bonus>
+//
+// The board lists the tiles in the order of the map's tile indices (see
+// state.GameMap), a number is that many empty tiles and a unit is written as
+// its class letter, team digit and health digit:
+//
+//	R runner  S soldier  M medic  N sniper  H heavy  T thorn  X special
+//
+// followed by any of the modifiers @r for a race (if it is not the race of the
+// unit's team, as for a charmed unit), ~ for the alternate state, > if it has
+// moved, ! if it has acted, ^ if it has toggled and (i:j) for the bramble or
+// thorn that a thorn grew from.
+//
+// The races are a letter for each team (f, a, s or v) in order of their team,
+// the base HP and wits are slash-separated numbers in the same order, the
+// side to move is the team's number and the turn counts from 1.  The used
+// spawns and bonus tiles are comma-separated i:j coordinates, or - if there
+// are none.  The bonus tiles are those of the map, they are checked when
+// parsing so that a position is not read on a different version of the map.
+// The rules that the position is played under are not part of it, they are
+// given when it is parsed (Parse plays it under the latest rules).
+//
+// For example, the first turn on Peek-a-Boo (where H13 is a heavy of the first
+// team with 3 health, followed by 7 empty tiles):
+//
+//	oml/solo/peekaboo 4H137S121M1132M211S227H2310 fs 5/5 3/0 1 1 - 1:4,11:5
+package notation
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+const FIELD_COUNT = 9
+
+const EMPTY_LIST = "-"
+
+// Class letters, indexed by wits.UnitClassEnum.
+const CLASS_LETTERS = ".RSMNHTX"
+
+// Race letters, indexed by wits.UnitRaceEnum.
+const RACE_LETTERS = ".fasv"
+
+// Unit modifiers.
+const (
+	MOD_RACE      = '@'
+	MOD_ALTERNATE = '~'
+	MOD_MOVED     = '>'
+	MOD_ACTED     = '!'
+	MOD_ALTED     = '^'
+	MOD_PARENT    = '('
+)
+
+// Writes the position of the game, which is not necessarily in progress (its
+// result is not part of the position).
+func Write(game *state.GameState) string {
+	gamemap := game.Map()
+	var board strings.Builder
+	empty := 0
+	for index := range gamemap.TileCount() {
+		at := wits.HexCoordIndex(index)
+		unit := game.UnitAt(at)
+		if unit.IsEmpty() {
+			empty++
+			continue
+		}
+		if empty > 0 {
+			board.WriteString(strconv.Itoa(empty))
+			empty = 0
+		}
+		board.WriteString(Token(unit, game.Player(unit.Team()).Race))
+		if parent := game.Parent(at); parent != state.NO_TILE {
+			fmt.Fprintf(&board, "(%s)", formatCoord(gamemap.Coord(parent)))
+		}
+	}
+	if empty > 0 {
+		board.WriteString(strconv.Itoa(empty))
+	}
+
+	count := game.PlayerCount()
+	races := make([]byte, count)
+	baseHP := make([]string, count)
+	playerWits := make([]string, count)
+	for i := range count {
+		player := game.Player(wits.FriendlyEnum(i + 1))
+		races[i] = RACE_LETTERS[player.Race]
+		baseHP[i] = strconv.Itoa(int(player.BaseHP))
+		playerWits[i] = strconv.Itoa(int(player.Wits))
+	}
+
+	used := make([]string, 0)
+	for index := range gamemap.TileCount() {
+		if at := wits.HexCoordIndex(index); game.IsSpawnUsed(at) {
+			used = append(used, formatCoord(gamemap.Coord(at)))
+		}
+	}
+	bonus := make([]string, 0)
+	for _, at := range gamemap.BonusTiles() {
+		bonus = append(bonus, formatCoord(gamemap.Coord(at)))
+	}
+
+	return strings.Join([]string{
+		string(gamemap.MapID()),
+		board.String(),
+		string(races),
+		strings.Join(baseHP, "/"),
+		strings.Join(playerWits, "/"),
+		strconv.Itoa(int(game.Current())),
+		strconv.Itoa(int(game.Turn())),
+		formatList(used),
+		formatList(bonus),
+	}, " ")
+}
+
+// The token of a unit (without its parent), teamRace is the race of the
+// player of the unit's team.
+func Token(unit state.UnitBits, teamRace wits.UnitRaceEnum) string {
+	token := []byte{
+		CLASS_LETTERS[unit.Class()],
+		byte('0' + unit.Team()),
+		byte('0' + unit.Health())}
+	if unit.Race() != teamRace {
+		token = append(token, MOD_RACE, RACE_LETTERS[unit.Race()])
+	}
+	for _, modifier := range []struct {
+		set  bool
+		char byte
+	}{
+		{unit.IsAlternate(), MOD_ALTERNATE},
+		{unit.HasMoved(), MOD_MOVED},
+		{unit.HasActed(), MOD_ACTED},
+		{unit.HasAlted(), MOD_ALTED},
+	} {
+		if modifier.set {
+			token = append(token, modifier.char)
+		}
+	}
+	return string(token)
+}
+
+func formatCoord(coord witsjson.HexCoordJSON) string {
+	return fmt.Sprintf("%d:%d", coord.I(), coord.J())
+}
+
+func formatList(items []string) string {
+	if len(items) == 0 {
+		return EMPTY_LIST
+	}
+	return strings.Join(items, ",")
+}
+
+// The map ID of the position, without parsing the rest of it.
+func MapID(encoded string) wits.GameMapID {
+	mapID, _, _ := strings.Cut(strings.TrimSpace(encoded), " ")
+	return wits.GameMapID(mapID)
+}
+
+// Parses the position, finding its map in the library.  It is played under the
+// latest rules.
+func Parse(encoded string, maps witsjson.MapLibrary) (*state.GameState, error) {
+	return ParseWithRules(encoded, maps, state.LatestRules())
+}
+
+// Parses the position (as Parse does) played under these rules.
+func ParseWithRules(encoded string, maps witsjson.MapLibrary, rules *state.Ruleset) (*state.GameState, error) {
+	definition, found := maps.Find(MapID(encoded), "")
+	if !found {
+		return nil, fmt.Errorf("map %q not found", MapID(encoded))
+	}
+	gamemap := state.NewGameMap(definition)
+	return ParseOnWithRules(&gamemap, encoded, rules)
+}
+
+// Parses the position on this map, which must be the position's map.  It is
+// played under the latest rules.
+func ParseOn(gamemap *state.GameMap, encoded string) (*state.GameState, error) {
+	return ParseOnWithRules(gamemap, encoded, state.LatestRules())
+}
+
+// Parses the position on this map (as ParseOn does) played under these rules.
+func ParseOnWithRules(gamemap *state.GameMap, encoded string, rules *state.Ruleset) (*state.GameState, error) {
+	fields := strings.Fields(encoded)
+	if len(fields) != FIELD_COUNT {
+		return nil, fmt.Errorf("position has %d fields, expected %d", len(fields), FIELD_COUNT)
+	}
+	if wits.GameMapID(fields[0]) != gamemap.MapID() {
+		return nil, fmt.Errorf("position is for map %s, not %s", fields[0], gamemap.MapID())
+	}
+
+	view := state.GameView{
+		MapID:      gamemap.MapID(),
+		Units:      make([]state.UnitView, 0),
+		UsedSpawns: make([]witsjson.HexCoordJSON, 0),
+	}
+	races := fields[2]
+	baseHP := strings.Split(fields[3], "/")
+	playerWits := strings.Split(fields[4], "/")
+	if len(baseHP) != len(races) || len(playerWits) != len(races) {
+		return nil, fmt.Errorf("players have %d races, %d base HP and %d wits",
+			len(races), len(baseHP), len(playerWits))
+	}
+	teamRaces := make([]wits.UnitRaceEnum, len(races))
+	for i := range races {
+		race, err := parseRace(races[i])
+		if err != nil {
+			return nil, err
+		}
+		hp, errHP := strconv.ParseUint(baseHP[i], 10, 8)
+		available, errWits := strconv.ParseUint(playerWits[i], 10, 8)
+		if errHP != nil || errWits != nil {
+			return nil, fmt.Errorf("invalid base HP %q or wits %q", baseHP[i], playerWits[i])
+		}
+		teamRaces[i] = race
+		view.Players = append(view.Players, state.PlayerView{
+			Team:   witsjson.FriendlyEnumJSON(i + 1),
+			Race:   witsjson.UnitRaceJSON(race),
+			Wits:   wits.ActionPoints(available),
+			BaseHP: wits.BaseHealth(hp),
+		})
+	}
+
+	side, err := strconv.ParseUint(fields[5], 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid side to move %q", fields[5])
+	}
+	turn, err := strconv.ParseUint(fields[6], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid turn %q", fields[6])
+	}
+	view.Current = witsjson.FriendlyEnumJSON(side)
+	view.Turn = uint(turn)
+
+	if err := parseBoard(gamemap, fields[1], teamRaces, &view); err != nil {
+		return nil, err
+	}
+	used, err := parseCoords(fields[7])
+	if err != nil {
+		return nil, err
+	}
+	view.UsedSpawns = append(view.UsedSpawns, used...)
+
+	bonus, err := parseCoords(fields[8])
+	if err != nil {
+		return nil, err
+	}
+	expected := make([]wits.HexCoordIndex, 0)
+	for _, coord := range bonus {
+		expected = append(expected, gamemap.Index(coord))
+	}
+	if !slices.Equal(expected, gamemap.BonusTiles()) {
+		return nil, fmt.Errorf("bonus tiles %s differ from the map's", fields[8])
+	}
+	return state.FromViewWithRules(gamemap, view, rules)
+}
+
+func parseBoard(gamemap *state.GameMap, board string, teamRaces []wits.UnitRaceEnum, view *state.GameView) error {
+	index := 0
+	for i := 0; i < len(board); {
+		if isDigit(board[i]) {
+			start := i
+			for i < len(board) && isDigit(board[i]) {
+				i++
+			}
+			empty, _ := strconv.Atoi(board[start:i])
+			index += empty
+			continue
+		}
+
+		letter := strings.IndexByte(CLASS_LETTERS, board[i])
+		class := wits.UnitClassEnum(letter)
+		if letter <= 0 || i+2 >= len(board) ||
+			!isDigit(board[i+1]) || !isDigit(board[i+2]) {
+			return fmt.Errorf("invalid unit at %q", board[i:])
+		}
+		team := wits.FriendlyEnum(board[i+1] - '0')
+		if team == wits.FR_UNKNOWN || int(team) > len(teamRaces) {
+			return fmt.Errorf("invalid team at %q", board[i:])
+		}
+		if index >= gamemap.TileCount() {
+			return fmt.Errorf("board has more than %d tiles", gamemap.TileCount())
+		}
+		unit := state.UnitView{
+			Coord:  gamemap.Coord(wits.HexCoordIndex(index)),
+			Team:   witsjson.FriendlyEnumJSON(team),
+			Class:  witsjson.UnitClassJSON(class),
+			Race:   witsjson.UnitRaceJSON(teamRaces[team-1]),
+			Health: wits.UnitHealth(board[i+2] - '0'),
+		}
+		i += 3
+
+	modifiers:
+		for i < len(board) {
+			switch board[i] {
+			case MOD_RACE:
+				if i+1 >= len(board) {
+					return fmt.Errorf("missing race at end of board")
+				}
+				race, err := parseRace(board[i+1])
+				if err != nil {
+					return err
+				}
+				unit.Race = witsjson.UnitRaceJSON(race)
+				i += 2
+			case MOD_ALTERNATE:
+				unit.Alternate = true
+				i++
+			case MOD_MOVED:
+				unit.Moved = true
+				i++
+			case MOD_ACTED:
+				unit.Acted = true
+				i++
+			case MOD_ALTED:
+				unit.Alted = true
+				i++
+			case MOD_PARENT:
+				end := strings.IndexByte(board[i:], ')')
+				if end < 0 {
+					return fmt.Errorf("unclosed parent at %q", board[i:])
+				}
+				parent, err := parseCoord(board[i+1 : i+end])
+				if err != nil {
+					return err
+				}
+				unit.Parent = &parent
+				i += end + 1
+			default:
+				break modifiers
+			}
+		}
+		view.Units = append(view.Units, unit)
+		index++
+	}
+	if index != gamemap.TileCount() {
+		return fmt.Errorf("board has %d tiles, the map has %d", index, gamemap.TileCount())
+	}
+	return nil
+}
+
+func isDigit(char byte) bool { return '0' <= char && char <= '9' }
+
+func parseRace(letter byte) (wits.UnitRaceEnum, error) {
+	race := strings.IndexByte(RACE_LETTERS, letter)
+	if race <= 0 {
+		return wits.RACE_UNKNOWN, fmt.Errorf("invalid race %q", letter)
+	}
+	return wits.UnitRaceEnum(race), nil
+}
+
+func parseCoords(field string) ([]witsjson.HexCoordJSON, error) {
+	coords := make([]witsjson.HexCoordJSON, 0)
+	if field == EMPTY_LIST {
+		return coords, nil
+	}
+	for _, item := range strings.Split(field, ",") {
+		coord, err := parseCoord(item)
+		if err != nil {
+			return nil, err
+		}
+		coords = append(coords, coord)
+	}
+	return coords, nil
+}
+
+func parseCoord(encoded string) (witsjson.HexCoordJSON, error) {
+	i, j, found := strings.Cut(encoded, ":")
+	ci, errI := strconv.Atoi(i)
+	cj, errJ := strconv.Atoi(j)
+	if !found || errI != nil || errJ != nil {
+		return witsjson.HexCoordJSON{}, fmt.Errorf("invalid coordinate %q", encoded)
+	}
+	return witsjson.NewHexCoord(ci, cj), nil
+}
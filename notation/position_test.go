@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/notation/position_test.go
+
+package notation_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/internal/testutil"
+	"github.com/kevindamm/wits-go/notation"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+const PEEKABOO_START = "oml/solo/peekaboo 4H137S121M1132M211S227H2310 fs 5/5 3/0 1 1 - 1:4,11:5"
+
+func TestWrite(t *testing.T) {
+	game := testutil.NewGame(t, testutil.LoadMaps(t), "peekaboo", wits.RACE_FEEDBACK, wits.RACE_SCALLYWAGS)
+	if encoded := notation.Write(game); encoded != PEEKABOO_START {
+		t.Errorf("Write() = %q\nexpected %q", encoded, PEEKABOO_START)
+	}
+}
+
+// Positions from played games are the same after writing and parsing them.
+func TestParse_RoundTrip(t *testing.T) {
+	maps := testutil.LoadMaps(t)
+	for _, races := range [][]wits.UnitRaceEnum{
+		{wits.RACE_FEEDBACK, wits.RACE_ADORABLES},
+		{wits.RACE_SCALLYWAGS, wits.RACE_VEGGIENAUTS},
+		{wits.RACE_VEGGIENAUTS, wits.RACE_FEEDBACK},
+	} {
+		game := testutil.NewGame(t, maps, "thorn-gulley", races...)
+		bots := []bot.Bot{bot.NewRandomBot(uint64(races[0])), bot.NewRandomBot(uint64(races[1]))}
+		for !game.IsOver() && game.Turn() < 30 {
+			// Check the position partway through the turn, as well as between turns.
+			view := game.Fogged(game.Current())
+			for _, action := range bots[game.Current()-1].Turn(view, time.Second) {
+				if action.IsPass() || game.Apply(action) != nil {
+					break
+				}
+				roundTrip(t, maps, game)
+			}
+			game.EndTurn()
+			roundTrip(t, maps, game)
+		}
+	}
+}
+
+func roundTrip(t *testing.T, maps witsjson.MapLibrary, game *state.GameState) {
+	t.Helper()
+	encoded := notation.Write(game)
+	parsed, err := notation.Parse(encoded, maps)
+	if err != nil {
+		t.Fatalf("%v\n%s", err, encoded)
+	}
+	if again := notation.Write(parsed); again != encoded {
+		t.Fatalf("position changed\n%s\n%s", encoded, again)
+	}
+	for index := range game.Map().TileCount() {
+		at := wits.HexCoordIndex(index)
+		if parsed.UnitAt(at) != game.UnitAt(at) || parsed.Parent(at) != game.Parent(at) {
+			t.Fatalf("tile %d differs in %s", index, encoded)
+		}
+	}
+}
+
+func TestParseWithRules(t *testing.T) {
+	maps := testutil.LoadMaps(t)
+	rules, err := state.ParseVariant([]byte(`{"name": "costly", "action_cost": 3}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	game, err := notation.ParseWithRules(PEEKABOO_START, maps, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if game.Rules() != rules {
+		t.Errorf("expected the position to be played under the variant, got %s", game.Rules())
+	}
+	for _, action := range game.LegalActions() {
+		if action.Name == witsjson.MOVE_UNIT {
+			if err := game.Apply(action); err != nil {
+				t.Fatal(err)
+			}
+			break
+		}
+	}
+	if wits := game.Player(wits.FR_SELF).Wits; wits != 0 {
+		t.Errorf("expected a move to cost all 3 wits, %d remain", wits)
+	}
+	if latest, _ := notation.Parse(PEEKABOO_START, maps); latest.Rules().IsVariant() {
+		t.Errorf("expected Parse to play under the latest rules")
+	}
+}
+
+func TestToken_Modifiers(t *testing.T) {
+	game := testutil.NewGame(t, testutil.LoadMaps(t), "peekaboo", wits.RACE_FEEDBACK, wits.RACE_SCALLYWAGS)
+	charmed := state.NewUnit(wits.CLASS_SNIPER, wits.RACE_ADORABLES, wits.FR_SELF)
+	if token := notation.Token(charmed, game.Player(wits.FR_SELF).Race); token != "N11@a" {
+		t.Errorf("Token() = %q", token)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	maps := testutil.LoadMaps(t)
+	fields := strings.Fields(PEEKABOO_START)
+	replace := func(i int, value string) string {
+		changed := append([]string{}, fields...)
+		changed[i] = value
+		return strings.Join(changed, " ")
+	}
+	for name, encoded := range map[string]string{
+		"fields":     strings.Join(fields[:8], " "),
+		"map":        replace(0, "oml/solo/nowhere"),
+		"short":      replace(1, "4H137S121M1132M211S227H239"),
+		"long":       replace(1, "4H137S121M1132M211S227H2311"),
+		"class":      replace(1, "4Q137S121M1132M211S227H2310"),
+		"team":       replace(1, "4H337S121M1132M211S227H2310"),
+		"health":     replace(1, "4H107S121M1132M211S227H2310"),
+		"modifier":   replace(1, "4H13@z7S121M1132M211S227H2310"),
+		"parent":     replace(1, "4H13(1:27S121M1132M211S227H2310"),
+		"race":       replace(2, "fz"),
+		"players":    replace(3, "5/5/5"),
+		"wits":       replace(4, "3/x"),
+		"side":       replace(5, "3"),
+		"turn":       replace(6, "-1"),
+		"used":       replace(7, "1"),
+		"bonus":      replace(8, "1:4"),
+		"used spawn": replace(7, "99:99"),
+	} {
+		if _, err := notation.Parse(encoded, maps); err == nil {
+			t.Errorf("%s: Parse(%q) expected an error", name, encoded)
+		}
+	}
+}
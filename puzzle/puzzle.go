@@ -0,0 +1,526 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/puzzle/puzzle.go
+
+package puzzle
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/notation"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// What the player must achieve, within the current turn.
+type Goal string
+
+const (
+	// Destroy the opposing base (or every opposing unit and base).
+	GOAL_WIN Goal = "win"
+
+	// Remove an opposing special unit from the board.
+	GOAL_SPECIAL Goal = "special"
+)
+
+var GOALS = []Goal{GOAL_WIN, GOAL_SPECIAL}
+
+// A position with a unique solution, in notation (see package notation) with
+// the solution's actions in RelVar notation.
+type Puzzle struct {
+	GameID   string   `json:"game_id,omitempty"`
+	Turn     uint     `json:"turn"`
+	Goal     Goal     `json:"goal"`
+	Position string   `json:"position"`
+	Solution []string `json:"solution"`
+}
+
+type Options struct {
+	// Solutions longer than this are not searched for.
+	MaxActions int
+
+	// Positions solved in fewer actions than this are too easy to be puzzles.
+	MinActions int
+
+	// Searches are abandoned after expanding this many positions, without a
+	// solution, to bound the time spent on crowded boards.
+	MaxPositions int
+}
+
+func DefaultOptions() Options {
+	return Options{MaxActions: 4, MinActions: 2, MaxPositions: 5_000}
+}
+
+// Finds the puzzles in the position, for the current player, one for each
+// goal that can be achieved in a unique way.  A special is only a puzzle if
+// the match cannot also be won.
+func Find(game *state.GameState, options Options) []Puzzle {
+	puzzles := make([]Puzzle, 0)
+	for _, goal := range GOALS {
+		solutions := Solve(game, goal, options)
+		if len(solutions) == 0 {
+			continue
+		}
+		if len(solutions) == 1 && len(solutions[0]) >= options.MinActions {
+			puzzles = append(puzzles, NewPuzzle(game, goal, solutions[0]))
+		}
+		if goal == GOAL_WIN {
+			break
+		}
+	}
+	return puzzles
+}
+
+func NewPuzzle(game *state.GameState, goal Goal, solution []state.Action) Puzzle {
+	gamemap := game.Map()
+	puzzle := Puzzle{
+		Turn:     game.Turn(),
+		Goal:     goal,
+		Position: notation.Write(game),
+		Solution: make([]string, len(solution)),
+	}
+	for i, action := range solution {
+		puzzle.Solution[i] = gamemap.PlayerAction(action).RelVarEncoding()
+	}
+	return puzzle
+}
+
+// Searches for the shortest ways to achieve the goal within the current turn,
+// with at most options.MaxActions actions.  Solutions that are the same actions in a
+// different order are the same solution.  Only one or two solutions are
+// returned: enough to tell whether the solution is unique.  Spawns are not
+// searched, spawned units cannot act until the next turn.
+//
+// The solutions are first searched among the actions that attack a target or
+// bring a unit into reach of one (the targets are the opposing bases, or
+// specials, and every opposing unit when there are few enough of them to win
+// by extinction).  When that finds a single solution, every legal action is
+// searched up to its length so that one needing a unit to move out of the
+// way is not missed; the solution is only returned as unique if none is.
+// Nothing is returned if either search exceeds options.MaxPositions.
+func Solve(game *state.GameState, goal Goal, options Options) [][]state.Action {
+	solver := &solver{
+		goal:     goal,
+		team:     game.Current(),
+		rules:    game.Rules(),
+		specials: opposingSpecials(game, game.Current()),
+		limit:    options.MaxPositions,
+		memo:     make(map[memoKey][][]state.Action),
+	}
+	if goal == GOAL_SPECIAL && solver.specials == 0 {
+		return nil
+	}
+	gamemap := game.Map()
+	side := state.Side(solver.team)
+	units := make([]wits.HexCoordIndex, 0)
+	for index := range gamemap.TileCount() {
+		at := wits.HexCoordIndex(index)
+		unit := game.UnitAt(at)
+		if unit.IsEmpty() || state.Side(unit.Team()) == side {
+			continue
+		}
+		units = append(units, at)
+		if goal == GOAL_SPECIAL && unit.IsSpecial() {
+			solver.targets = append(solver.targets, at)
+		}
+	}
+	if goal == GOAL_WIN {
+		for i := range game.PlayerCount() {
+			team := wits.FriendlyEnum(i + 1)
+			if state.Side(team) != side && game.BaseHP(team) > 0 {
+				solver.targets = append(solver.targets, gamemap.Base(team))
+			}
+		}
+		if len(units) <= options.MaxActions {
+			solver.targets = append(solver.targets, units...)
+		}
+	}
+	solutions := solver.search(game, options.MaxActions)
+	if len(solutions) != 1 {
+		return solutions
+	}
+	exhaustive := *solver
+	exhaustive.exhaustive, exhaustive.expanded = true, 0
+	exhaustive.memo = make(map[memoKey][][]state.Action)
+	return exhaustive.search(game, len(solutions[0]))
+}
+
+// The shortest solutions of at most this many actions, by iterative deepening.
+func (solver *solver) search(game *state.GameState, maxActions int) [][]state.Action {
+	for depth := 1; depth <= maxActions; depth++ {
+		solutions := solver.solve(game, depth)
+		if solver.exceeded {
+			return nil
+		}
+		if len(solutions) > 0 {
+			return solutions
+		}
+	}
+	return nil
+}
+
+type solver struct {
+	goal     Goal
+	team     wits.FriendlyEnum
+	rules    *state.Ruleset
+	specials int
+	targets  []wits.HexCoordIndex
+
+	// Whether every legal action is searched, not only the relevant ones.
+	exhaustive bool
+
+	limit    int
+	expanded int
+	exceeded bool
+	memo     map[memoKey][][]state.Action
+}
+
+type memoKey struct {
+	position  string
+	remaining int
+}
+
+func (solver *solver) achieved(game *state.GameState) bool {
+	switch solver.goal {
+	case GOAL_WIN:
+		result := game.ResultFor(solver.team)
+		return result == wits.VICTORY_DESTRUCTION || result == wits.VICTORY_EXTINCTION
+	case GOAL_SPECIAL:
+		return opposingSpecials(game, solver.team) < solver.specials
+	}
+	return false
+}
+
+// Returns (up to two) distinct sequences of at most remaining actions that
+// achieve the goal from this position.
+func (solver *solver) solve(game *state.GameState, remaining int) [][]state.Action {
+	if solver.achieved(game) {
+		return [][]state.Action{{}}
+	}
+	if remaining == 0 || game.IsOver() || !solver.possible(game, remaining) {
+		return nil
+	}
+	key := memoKey{positionKey(game), remaining}
+	if solutions, found := solver.memo[key]; found {
+		return solutions
+	}
+	if solver.limit > 0 && solver.expanded >= solver.limit {
+		solver.exceeded = true
+		return nil
+	}
+	solver.expanded++
+
+	solutions := make([][]state.Action, 0, 2)
+	seen := make(map[string]bool)
+	for _, action := range game.LegalActions() {
+		if !solver.searched(game, action) {
+			continue
+		}
+		next := game.Clone()
+		if next.Apply(action) != nil {
+			continue
+		}
+		for _, suffix := range solver.solve(next, remaining-1) {
+			solution := append([]state.Action{action}, suffix...)
+			if id := setKey(solution); !seen[id] {
+				seen[id] = true
+				solutions = append(solutions, solution)
+			}
+		}
+		if len(solutions) >= 2 {
+			break
+		}
+	}
+	solver.memo[key] = solutions
+	return solutions
+}
+
+// Whether the action is searched, every action but spawns and passes (which
+// cannot achieve anything within the turn) when the search is exhaustive.
+func (solver *solver) searched(game *state.GameState, action state.Action) bool {
+	if solver.exhaustive {
+		return action.Name != witsjson.SPAWN_UNIT && !action.IsPass()
+	}
+	return solver.relevant(game, action)
+}
+
+// Whether the action attacks a target, or brings a unit into reach of one.
+func (solver *solver) relevant(game *state.GameState, action state.Action) bool {
+	switch action.Name {
+	case witsjson.ATTACK, witsjson.CHARM_UNIT:
+		return slices.Contains(solver.targets, action.Target)
+	case witsjson.MOVE_UNIT:
+		unit := game.UnitAt(action.Agent)
+		if unit.Special() == state.SPECIAL_MOBI && !unit.HasActed() {
+			return true
+		}
+		return solver.inReach(game.Map(), unit, action.Target)
+	case witsjson.TELEPORT_UNIT:
+		return solver.inReach(game.Map(), game.UnitAt(action.Target), action.Dest)
+	case witsjson.TOGGLE_ALT:
+		unit := game.UnitAt(action.Agent)
+		return unit.Special() == state.SPECIAL_BOMBSHELL && !unit.IsAlternate()
+	}
+	return false
+}
+
+// Whether the unit could attack (or charm) a target from this tile.
+func (solver *solver) inReach(gamemap *state.GameMap, unit state.UnitBits, from wits.HexCoordIndex) bool {
+	reach := solver.rules.Range(unit)
+	switch unit.Special() {
+	case state.SPECIAL_BOMBSHELL:
+		reach = solver.rules.Units[wits.CLASS_SPECIAL].Range
+	case state.SPECIAL_SCRAMBLER:
+		if solver.goal == GOAL_SPECIAL {
+			reach = 1
+		}
+	}
+	for _, target := range solver.targets {
+		distance := gamemap.Distance(from, target)
+		if gamemap.BaseTeam(target) != wits.FR_UNKNOWN {
+			distance -= 1
+		}
+		if distance <= reach {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether the goal might still be achieved in this many actions, an
+// optimistic bound for pruning the search.  Each target needs its health in
+// damage from units that can reach it in time, with the actions that are
+// left.  A mobi may teleport one unit into reach and a scrambler may charm a
+// special instead.
+func (solver *solver) possible(game *state.GameState, remaining int) bool {
+	actions := min(remaining, int(game.Player(solver.team).Wits/solver.rules.ActionCost))
+	attackers := make([]state.TileUnit, 0)
+	var mobi, scrambler *state.TileUnit
+	for index := range game.Map().TileCount() {
+		at := wits.HexCoordIndex(index)
+		unit := game.UnitAt(at)
+		if unit.IsEmpty() || unit.Team() != solver.team || unit.HasActed() {
+			continue
+		}
+		tileUnit := state.TileUnit{UnitBits: unit, At: at}
+		switch unit.Special() {
+		case state.SPECIAL_MOBI:
+			mobi = &tileUnit
+		case state.SPECIAL_SCRAMBLER:
+			scrambler = &tileUnit
+		}
+		attackers = append(attackers, tileUnit)
+	}
+
+	gamemap := game.Map()
+	side := state.Side(solver.team)
+	targets := make([]wits.HexCoordIndex, 0)
+	health := make([]int, 0)
+	opposingHealth, opposingUnits := 0, 0
+	for index := range gamemap.TileCount() {
+		at := wits.HexCoordIndex(index)
+		unit := game.UnitAt(at)
+		if unit.IsEmpty() || state.Side(unit.Team()) == side {
+			continue
+		}
+		opposingHealth += int(unit.Health())
+		opposingUnits++
+		if solver.goal == GOAL_SPECIAL && unit.IsSpecial() {
+			targets = append(targets, at)
+			health = append(health, int(unit.Health()))
+		}
+	}
+	if solver.goal == GOAL_WIN {
+		for i := range game.PlayerCount() {
+			team := wits.FriendlyEnum(i + 1)
+			if state.Side(team) != side && game.BaseHP(team) > 0 {
+				targets = append(targets, gamemap.Base(team))
+				health = append(health, int(game.BaseHP(team)))
+			}
+		}
+		// Extinction, when every attacker is in reach of everything.
+		if opposingUnits <= actions && damage(solver.rules, attackers, actions, func(state.TileUnit) int {
+			return 1
+		}) >= float64(opposingHealth) {
+			return true
+		}
+	}
+
+	for i, target := range targets {
+		if scrambler != nil && solver.goal == GOAL_SPECIAL {
+			charm := solver.rules.Distance(scrambler.UnitBits) + 1
+			if scrambler.HasMoved() {
+				charm = 1
+			}
+			if gamemap.Distance(scrambler.At, target) <= charm {
+				return true
+			}
+		}
+		cost := func(attacker state.TileUnit) int {
+			return actionsToAttack(solver.rules, gamemap, attacker, target)
+		}
+		// The mobi can teleport one unit (that is not otherwise in reach)
+		// anywhere, optimistically.
+		teleported := -1
+		if mobi != nil {
+			best := wits.UnitHealth(0)
+			for j, attacker := range attackers {
+				if cost(attacker) == 0 && solver.rules.Distance(attacker.UnitBits) > 0 &&
+					solver.rules.Strength(attacker.UnitBits) > best {
+					teleported, best = j, solver.rules.Strength(attacker.UnitBits)
+				}
+			}
+		}
+		withTeleport := func(attacker state.TileUnit) int {
+			if teleported >= 0 && attacker == attackers[teleported] {
+				return 2
+			}
+			return cost(attacker)
+		}
+		if damage(solver.rules, attackers, actions, withTeleport) >= float64(health[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// The fewest actions for the unit to attack the target (moving and deploying
+// as needed), or zero if it cannot reach the target this turn.
+func actionsToAttack(rules *state.Ruleset, gamemap *state.GameMap,
+	attacker state.TileUnit, target wits.HexCoordIndex) int {
+	unit := attacker.UnitBits
+	reach, actions := rules.Range(unit), 1
+	if unit.Special() == state.SPECIAL_BOMBSHELL && !unit.IsAlternate() {
+		if unit.HasAlted() {
+			return 0
+		}
+		reach, actions = rules.Units[wits.CLASS_SPECIAL].Range, 2
+	}
+	if reach == 0 {
+		return 0
+	}
+	distance := gamemap.Distance(attacker.At, target)
+	if gamemap.BaseTeam(target) != wits.FR_UNKNOWN {
+		distance -= 1
+	}
+	if distance <= reach {
+		return actions
+	}
+	if !unit.HasMoved() && distance <= reach+rules.Units[unit.Class()].Travel {
+		return actions + 1
+	}
+	return 0
+}
+
+// An upper bound on the damage that the attackers can do with this many
+// actions, by choosing the attackers with the most damage per action (and a
+// part of the last one).
+func damage(rules *state.Ruleset, attackers []state.TileUnit, actions int,
+	cost func(state.TileUnit) int) float64 {
+	type option struct{ strength, cost int }
+	options := make([]option, 0, len(attackers))
+	for _, attacker := range attackers {
+		strength := int(rules.Strength(attacker.UnitBits))
+		if attacker.Special() == state.SPECIAL_BOMBSHELL {
+			strength = int(rules.Units[wits.CLASS_SPECIAL].Strength)
+		}
+		if c := cost(attacker); c > 0 && strength > 0 {
+			options = append(options, option{strength, c})
+		}
+	}
+	slices.SortFunc(options, func(a, b option) int {
+		return b.strength*a.cost - a.strength*b.cost
+	})
+	total, left := 0.0, float64(actions)
+	for _, option := range options {
+		if left <= 0 {
+			break
+		}
+		used := min(left, float64(option.cost))
+		total += float64(option.strength) * used / float64(option.cost)
+		left -= used
+	}
+	return total
+}
+
+// A compact key for the position within a turn, for the memo.
+func positionKey(game *state.GameState) string {
+	var key strings.Builder
+	for index := range game.Map().TileCount() {
+		at := wits.HexCoordIndex(index)
+		unit := game.UnitAt(at)
+		key.WriteByte(byte(unit))
+		key.WriteByte(byte(unit >> 8))
+		key.WriteByte(byte(game.Parent(at)))
+	}
+	for i := range game.PlayerCount() {
+		player := game.Player(wits.FriendlyEnum(i + 1))
+		key.WriteByte(byte(player.Wits))
+		key.WriteByte(byte(player.BaseHP))
+	}
+	return key.String()
+}
+
+// Identifies the solution by its set of actions, regardless of their order.
+func setKey(actions []state.Action) string {
+	keys := make([]string, len(actions))
+	for i, action := range actions {
+		keys[i] = fmt.Sprint(action)
+	}
+	slices.Sort(keys)
+	return strings.Join(keys, ";")
+}
+
+func opposingSpecials(game *state.GameState, team wits.FriendlyEnum) int {
+	count := 0
+	for index := range game.Map().TileCount() {
+		unit := game.UnitAt(wits.HexCoordIndex(index))
+		if unit.IsSpecial() && state.Side(unit.Team()) != state.Side(team) {
+			count++
+		}
+	}
+	return count
+}
+
+// Replays the turns of a match from its initial state, finding the puzzles at
+// the start of each turn.
+func FromReplay(initial *state.GameState, turns []wits.PlayerTurn, options Options) ([]Puzzle, error) {
+	puzzles := make([]Puzzle, 0)
+	game := initial.Clone()
+	gamemap := game.Map()
+	for _, turn := range turns {
+		if game.IsOver() {
+			break
+		}
+		puzzles = append(puzzles, Find(game, options)...)
+		for i, action := range turn.Actions() {
+			resolved, err := gamemap.Resolve(action)
+			if err != nil {
+				return puzzles, fmt.Errorf("turn %d action %d: %w", turn.TurnCount(), i, err)
+			}
+			if resolved.IsPass() {
+				break
+			}
+			if err := game.Apply(resolved); err != nil {
+				return puzzles, fmt.Errorf("turn %d action %d: %w", turn.TurnCount(), i, err)
+			}
+		}
+		game.EndTurn()
+	}
+	return puzzles, nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/puzzle/puzzle_test.go
+
+package puzzle_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/notation"
+	"github.com/kevindamm/wits-go/puzzle"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+const (
+	PEEKABOO_START = "oml/solo/peekaboo 4H137S121M1132M211S227H2310 fs 5/5 3/0 1 1 - 1:4,11:5"
+
+	// Two runners reach the base, with a bombshell deployed in between.
+	WIN_IN_FOUR = "oml/solo/peekaboo 10M21>7R115S12R11M113R115R11R115R112S21>15H13X14R11R21>!^2 sa 5/5 5/0 1 19 - 1:4,11:5"
+
+	// Only the runner at 8,3 can step into range of the opposing special.
+	SPECIAL_IN_TWO = "oml/solo/peekaboo 18R115S12R12M114R122M211R11R11X13>3N2119H13S11@aR11>!^R212 fa 5/5 5/3 2 32 - 1:4,11:5"
+
+	// The runner at 9,2 is in reach of the base but too weak, it makes way for
+	// the heavy behind it and may move to any of several tiles.
+	MAKE_WAY = "oml/solo/peekaboo 35S12!5H135H23R1111S22S12!6 fs 5/2 3/0 1 10 - 1:4,11:5"
+)
+
+func parse(t *testing.T, encoded string) *state.GameState {
+	maps, _, err := witsjson.LoadMapLibrary("../maps/solo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	game, err := notation.Parse(encoded, maps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return game
+}
+
+func TestFind_Win(t *testing.T) {
+	game := parse(t, WIN_IN_FOUR)
+	puzzles := puzzle.Find(game, puzzle.DefaultOptions())
+	if len(puzzles) != 1 {
+		t.Fatalf("found %d puzzles, expected 1", len(puzzles))
+	}
+	found := puzzles[0]
+	if found.Goal != puzzle.GOAL_WIN || found.Turn != 19 || found.Position != WIN_IN_FOUR {
+		t.Errorf("unexpected puzzle %v", found)
+	}
+	expected := []string{
+		`["move", ["ij", 5, 4], ["ij", 8, 3]]`,
+		`["pow", ["ij", 8, 3], ["ij", 11, 1]]`,
+		`["toggle", ["ij", 11, 5]]`,
+		`["pow", ["ij", 11, 5], ["ij", 11, 1]]`,
+	}
+	if !sameActions(found.Solution, expected) {
+		t.Errorf("solution %v, expected %v", found.Solution, expected)
+	}
+
+	// The solution actually wins.
+	gamemap := game.Map()
+	for _, encoded := range found.Solution {
+		action, err := witsjson.ParseRelVar(encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resolved, err := gamemap.Resolve(action)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := game.Apply(resolved); err != nil {
+			t.Fatalf("applying %s: %v", encoded, err)
+		}
+	}
+	if game.ResultFor(wits.FR_SELF) != wits.VICTORY_DESTRUCTION {
+		t.Errorf("solution does not win, result %d", game.ResultFor(wits.FR_SELF))
+	}
+}
+
+func TestFind_Special(t *testing.T) {
+	game := parse(t, SPECIAL_IN_TWO)
+	puzzles := puzzle.Find(game, puzzle.DefaultOptions())
+	if len(puzzles) != 1 || puzzles[0].Goal != puzzle.GOAL_SPECIAL {
+		t.Fatalf("expected a single special puzzle, found %v", puzzles)
+	}
+	expected := []string{
+		`["move", ["ij", 8, 3], ["ij", 7, 3]]`,
+		`["pow", ["ij", 7, 3], ["ij", 7, 6]]`,
+	}
+	if !slices.Equal(puzzles[0].Solution, expected) {
+		t.Errorf("solution %v, expected %v", puzzles[0].Solution, expected)
+	}
+
+	options := puzzle.DefaultOptions()
+	options.MinActions = 3
+	if puzzles := puzzle.Find(game, options); len(puzzles) != 0 {
+		t.Errorf("found puzzles shorter than the minimum: %v", puzzles)
+	}
+}
+
+func TestFind_None(t *testing.T) {
+	if puzzles := puzzle.Find(parse(t, PEEKABOO_START), puzzle.DefaultOptions()); len(puzzles) != 0 {
+		t.Errorf("found puzzles in the starting position: %v", puzzles)
+	}
+}
+
+func TestSolve_NotUnique(t *testing.T) {
+	game := parse(t, MAKE_WAY)
+	solutions := puzzle.Solve(game, puzzle.GOAL_WIN, puzzle.DefaultOptions())
+	if len(solutions) != 2 || len(solutions[0]) != 3 {
+		t.Fatalf("expected more than one solution of 3 actions, got %v", solutions)
+	}
+	if puzzles := puzzle.Find(game, puzzle.DefaultOptions()); len(puzzles) != 0 {
+		t.Errorf("found puzzles without a unique solution: %v", puzzles)
+	}
+}
+
+func TestSolve_Limits(t *testing.T) {
+	game := parse(t, WIN_IN_FOUR)
+	options := puzzle.DefaultOptions()
+	options.MaxActions = 3
+	if solutions := puzzle.Solve(game, puzzle.GOAL_WIN, options); len(solutions) != 0 {
+		t.Errorf("found solutions longer than the maximum: %v", solutions)
+	}
+	options = puzzle.DefaultOptions()
+	options.MaxPositions = 10
+	if solutions := puzzle.Solve(game, puzzle.GOAL_WIN, options); len(solutions) != 0 {
+		t.Errorf("found solutions beyond the position budget: %v", solutions)
+	}
+}
+
+func TestFromReplay(t *testing.T) {
+	game := parse(t, PEEKABOO_START)
+	initial := game.Clone()
+	turns := bot.PlayMatch(game,
+		[]bot.Bot{bot.NewGreedyBot(1), bot.NewRandomBot(1)}, time.Second, 12)
+	playerTurns := make([]wits.PlayerTurn, len(turns))
+	for i := range turns {
+		playerTurns[i] = turns[i]
+	}
+	puzzles, err := puzzle.FromReplay(initial, playerTurns, puzzle.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, found := range puzzles {
+		if _, err := notation.ParseOn(initial.Map(), found.Position); err != nil {
+			t.Errorf("puzzle position %q: %v", found.Position, err)
+		}
+		if len(found.Solution) < 2 {
+			t.Errorf("puzzle %v is too short", found)
+		}
+	}
+}
+
+// Whether the solutions have the same actions, in any order.
+func sameActions(actual, expected []string) bool {
+	return len(actual) == len(expected) &&
+		slices.Equal(slices.Sorted(slices.Values(actual)), slices.Sorted(slices.Values(expected)))
+}
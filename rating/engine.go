@@ -0,0 +1,227 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/rating/engine.go
+
+package rating
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/archive"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/playerrole"
+	entrating "github.com/kevindamm/wits-go/ent/rating"
+	"github.com/kevindamm/wits-go/ent/ratinghistory"
+	"github.com/kevindamm/wits-go/state"
+)
+
+// Solo (1v1) and duos (2v2) ratings are computed independently.
+type Mode = entrating.Mode
+
+const (
+	MODE_SOLO = entrating.ModeSOLO
+	MODE_DUOS = entrating.ModeDUOS
+)
+
+// Ratings and their history are written in batches of this size.
+const BATCH_SIZE = 100
+
+// The rating of a player in one mode, as it is being computed.
+type playerMode struct {
+	player int
+	mode   Mode
+}
+
+type current struct {
+	Rating
+	games   int
+	updated time.Time
+}
+
+// Recomputes every player's ratings by replaying the results of all matches in
+// the order they were played.  Each match is its own rating period, and every
+// player's update is based on the ratings from before the match.  In duos, the
+// opposing pair is treated as a single opponent with their average rating.
+// Matches without a decisive result (unknown, or a delay of game) are not
+// rated.  The previous ratings and history are replaced, returning the number
+// of matches that were rated.
+func Recompute(ctx context.Context, client *ent.Client) (int, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := recompute(ctx, tx.Client())
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	return count, tx.Commit()
+}
+
+func recompute(ctx context.Context, client *ent.Client) (int, error) {
+	if _, err := client.RatingHistory.Delete().Exec(ctx); err != nil {
+		return 0, err
+	}
+	if _, err := client.Rating.Delete().Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	matches, err := client.Match.Query().
+		WithMap().
+		WithRoles(func(query *ent.PlayerRoleQuery) {
+			query.Order(playerrole.ByTurnOrder())
+		}).
+		Order(archive.ByPlayed()).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	ratings := make(map[playerMode]*current)
+	history := make([]*ent.RatingHistoryCreate, 0)
+	rated := 0
+	for _, played := range matches {
+		roles := played.Edges.Roles
+		scores, ok := scoreRoles(roles)
+		if !ok {
+			continue
+		}
+		mode := MODE_SOLO
+		if len(roles) > 2 {
+			mode = MODE_DUOS
+		}
+		updated := archive.PlayedAt(played)
+
+		before := make([]Rating, len(roles))
+		for i, role := range roles {
+			key := playerMode{role.PlayerID, mode}
+			if ratings[key] == nil {
+				ratings[key] = &current{Default(), 0, updated}
+			}
+			before[i] = ratings[key].Rating
+		}
+		for i, role := range roles {
+			side := state.Side(wits.FriendlyEnum(role.TurnOrder))
+			opponents := make([]Rating, 0, len(roles)/2)
+			for j, other := range roles {
+				if state.Side(wits.FriendlyEnum(other.TurnOrder)) != side {
+					opponents = append(opponents, before[j])
+				}
+			}
+			after := before[i].Update([]Outcome{{composite(opponents), scores[i]}})
+
+			entry := ratings[playerMode{role.PlayerID, mode}]
+			entry.Rating, entry.games, entry.updated = after, entry.games+1, updated
+			history = append(history, client.RatingHistory.Create().
+				SetMode(ratinghistory.Mode(mode)).
+				SetRating(after.Rating).
+				SetDeviation(after.Deviation).
+				SetVolatility(after.Volatility).
+				SetDelta(after.Rating-before[i].Rating).
+				SetPlayerID(role.PlayerID).
+				SetMatchID(played.ID))
+		}
+		rated += 1
+	}
+
+	for start := 0; start < len(history); start += BATCH_SIZE {
+		batch := history[start:min(start+BATCH_SIZE, len(history))]
+		if err := client.RatingHistory.CreateBulk(batch...).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	creates := make([]*ent.RatingCreate, 0, len(ratings))
+	for key, entry := range ratings {
+		creates = append(creates, client.Rating.Create().
+			SetMode(key.mode).
+			SetRating(entry.Rating.Rating).
+			SetDeviation(entry.Deviation).
+			SetVolatility(entry.Volatility).
+			SetGames(entry.games).
+			SetUpdatedTs(entry.updated).
+			SetPlayerID(key.player))
+	}
+	for start := 0; start < len(creates); start += BATCH_SIZE {
+		batch := creates[start:min(start+BATCH_SIZE, len(creates))]
+		if err := client.Rating.CreateBulk(batch...).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return rated, nil
+}
+
+// The score of each role (1 for a victory, 0 for a loss), or false if the
+// match should not be rated.
+func scoreRoles(roles []*ent.PlayerRole) ([]float64, bool) {
+	if len(roles) < 2 {
+		return nil, false
+	}
+	scores := make([]float64, len(roles))
+	for i, role := range roles {
+		switch role.Result {
+		case wits.VICTORY_DESTRUCTION, wits.VICTORY_EXTINCTION, wits.VICTORY_RESIGNATION:
+			scores[i] = 1
+		case wits.LOSS_DESTRUCTION, wits.LOSS_EXTINCTION, wits.LOSS_RESIGNATION:
+			scores[i] = 0
+		default:
+			return nil, false
+		}
+	}
+	return scores, true
+}
+
+// A single opponent representing a team, with the average rating and the
+// root-mean-square of their deviations.
+func composite(ratings []Rating) Rating {
+	if len(ratings) == 1 {
+		return ratings[0]
+	}
+	var total, variance float64
+	for _, rating := range ratings {
+		total += rating.Rating
+		variance += rating.Deviation * rating.Deviation
+	}
+	count := float64(len(ratings))
+	return Rating{total / count, math.Sqrt(variance / count), DEFAULT_VOLATILITY}
+}
+
+// The highest rated players in this mode, ordered by their conservative rating
+// (see Rating.Conservative), with their player entity loaded.
+func Leaderboard(ctx context.Context, client *ent.Client,
+	mode Mode, limit int, offset int) ([]*ent.Rating, error) {
+	if err := entrating.ModeValidator(mode); err != nil {
+		return nil, fmt.Errorf("unknown rating mode %q", mode)
+	}
+	return client.Rating.Query().
+		Where(entrating.ModeEQ(mode)).
+		WithPlayer().
+		Order(func(selector *sql.Selector) {
+			selector.OrderExpr(sql.ExprP(fmt.Sprintf("%s - 2 * %s DESC",
+				selector.C(entrating.FieldRating), selector.C(entrating.FieldDeviation))))
+		}, entrating.ByID()).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+}
+
+// Converts the ent representation of a rating.
+func FromEnt(entity *ent.Rating) Rating {
+	return Rating{entity.Rating, entity.Deviation, entity.Volatility}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/rating/engine_test.go
+
+package rating_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/enttest"
+	"github.com/kevindamm/wits-go/rating"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRecompute(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	players := make(map[string]*ent.Player)
+	for _, name := range []string{"alice", "bob", "carol", "dave"} {
+		players[name] = client.Player.Create().SetName(name).SaveX(ctx)
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	played := 0
+	record := func(result wits.TerminalStatus, names ...string) {
+		recorded := client.Match.Create().
+			SetMatchHash(string(rune('a' + played))).
+			SetVersion(1603).
+			SetTurnCount(10).
+			SetCreatedTs(start.Add(time.Duration(played) * time.Hour)).
+			SaveX(ctx)
+		played += 1
+		for i, name := range names {
+			team := wits.FriendlyEnum(i + 1)
+			outcome := result
+			if i%2 == 1 {
+				outcome = result.Opposing()
+			}
+			client.PlayerRole.Create().
+				SetMatchID(recorded.ID).
+				SetPlayerID(players[name].ID).
+				SetPosition(int(team)).
+				SetTurnOrder(int(team)).
+				SetRace(wits.RACE_FEEDBACK).
+				SetResult(outcome).
+				AddMatch(recorded).
+				AddPlayers(players[name]).
+				ExecX(ctx)
+		}
+	}
+	record(wits.VICTORY_DESTRUCTION, "alice", "bob")
+	record(wits.VICTORY_RESIGNATION, "alice", "carol")
+	record(wits.LOSS_EXTINCTION, "carol", "alice")
+	record(wits.DELAY_OF_GAME, "bob", "carol")
+	record(wits.VICTORY_DESTRUCTION, "alice", "bob", "carol", "dave")
+
+	for range 2 {
+		count, err := rating.Recompute(ctx, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 4 {
+			t.Errorf("expected 4 rated matches, got %d", count)
+		}
+	}
+	if count := client.RatingHistory.Query().CountX(ctx); count != 10 {
+		t.Errorf("expected 10 rating history entries, got %d", count)
+	}
+
+	solo, err := rating.Leaderboard(ctx, client, rating.MODE_SOLO, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(solo) != 3 {
+		t.Fatalf("expected 3 rated solo players, got %d", len(solo))
+	}
+	if solo[0].Edges.Player.Name != "alice" || solo[0].Games != 3 {
+		t.Errorf("expected alice to lead with 3 games, got %s with %d",
+			solo[0].Edges.Player.Name, solo[0].Games)
+	}
+	for i := 1; i < len(solo); i++ {
+		if rating.FromEnt(solo[i]).Conservative() > rating.FromEnt(solo[i-1]).Conservative() {
+			t.Errorf("leaderboard out of order at %d", i)
+		}
+	}
+
+	// Duos are rated separately; alice and carol won as the first side.
+	duos, err := rating.Leaderboard(ctx, client, rating.MODE_DUOS, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(duos) != 4 || duos[0].Rating <= rating.DEFAULT_RATING {
+		t.Errorf("expected 4 duos ratings led by a winner, got %v", duos)
+	}
+	if _, err := rating.Leaderboard(ctx, client, "TRIOS", 10, 0); err == nil {
+		t.Error("expected an error for an unknown mode")
+	}
+}
+
+func TestRecompute_Backfill(t *testing.T) {
+	type result struct {
+		winner, loser string
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := []result{{"alice", "bob"}, {"alice", "carol"}, {"bob", "alice"}, {"carol", "bob"}}
+
+	// Records the matches (numbered by when they were played) in the order they
+	// are ingested, returning the solo ratings by player name.
+	ratings := func(label string, ingested []int, known bool) map[string]float64 {
+		client := enttest.Open(t, "sqlite3", "file:"+t.Name()+label+"?mode=memory&_fk=1")
+		defer client.Close()
+		ctx := context.Background()
+		players := make(map[string]*ent.Player)
+		for _, name := range []string{"alice", "bob", "carol"} {
+			players[name] = client.Player.Create().SetName(name).SaveX(ctx)
+		}
+		for i, number := range ingested {
+			create := client.Match.Create().
+				SetMatchHash(string(rune('a' + number))).
+				SetVersion(1603).
+				SetTurnCount(10).
+				SetCreatedTs(start.Add(time.Duration(24+i) * time.Hour))
+			if known {
+				create.SetPlayedTs(start.Add(time.Duration(number) * time.Hour))
+			}
+			recorded := create.SaveX(ctx)
+			for j, name := range []string{history[number].winner, history[number].loser} {
+				outcome := wits.VICTORY_DESTRUCTION
+				if j == 1 {
+					outcome = outcome.Opposing()
+				}
+				client.PlayerRole.Create().
+					SetMatchID(recorded.ID).
+					SetPlayerID(players[name].ID).
+					SetPosition(j + 1).
+					SetTurnOrder(j + 1).
+					SetRace(wits.RACE_FEEDBACK).
+					SetResult(outcome).
+					AddMatch(recorded).
+					AddPlayers(players[name]).
+					ExecX(ctx)
+			}
+		}
+		if _, err := rating.Recompute(ctx, client); err != nil {
+			t.Fatal(err)
+		}
+		solo, err := rating.Leaderboard(ctx, client, rating.MODE_SOLO, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		byName := make(map[string]float64, len(solo))
+		for _, entry := range solo {
+			byName[entry.Edges.Player.Name] = entry.Rating
+		}
+		return byName
+	}
+
+	inOrder := ratings("InOrder", []int{0, 1, 2, 3}, false)
+	backfilled := ratings("Backfilled", []int{3, 2, 1, 0}, true)
+	for name, expected := range inOrder {
+		if backfilled[name] != expected {
+			t.Errorf("%s rated %.2f when backfilled, expected %.2f", name, backfilled[name], expected)
+		}
+	}
+	if unknown := ratings("Unknown", []int{3, 2, 1, 0}, false); unknown["alice"] == inOrder["alice"] {
+		t.Error("expected matches without a play time to be rated in the order they were recorded")
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/rating/glicko2.go
+
+package rating
+
+import "math"
+
+// A Glicko-2 rating, on the Glicko scale (new players are rated 1500 ± 350).
+// See Glickman, "Example of the Glicko-2 system" (2013).
+type Rating struct {
+	Rating     float64 `json:"rating"`
+	Deviation  float64 `json:"deviation"`
+	Volatility float64 `json:"volatility"`
+}
+
+const (
+	DEFAULT_RATING     = 1500.0
+	DEFAULT_DEVIATION  = 350.0
+	DEFAULT_VOLATILITY = 0.06
+
+	// Constrains the change in volatility over time, between 0.3 and 1.2.
+	TAU = 0.5
+
+	// Converts between the Glicko scale and the Glicko-2 scale.
+	GLICKO2_SCALE = 173.7178
+
+	// Convergence tolerance when solving for the new volatility.
+	EPSILON = 0.000001
+)
+
+// The rating of a player who has not played any rated matches.
+func Default() Rating {
+	return Rating{DEFAULT_RATING, DEFAULT_DEVIATION, DEFAULT_VOLATILITY}
+}
+
+// A conservative estimate of the player's skill, used for ordering leaderboards
+// so that players with few games are not ranked above established players.
+func (rating Rating) Conservative() float64 {
+	return rating.Rating - 2*rating.Deviation
+}
+
+// The result of a game against an opponent (1 for a win, 0 for a loss).
+type Outcome struct {
+	Opponent Rating
+	Score    float64
+}
+
+// The probability of winning against the opponent.
+func (rating Rating) Expected(opponent Rating) float64 {
+	mu, opponentMu := toMu(rating.Rating), toMu(opponent.Rating)
+	return expected(mu, opponentMu, toPhi(opponent.Deviation))
+}
+
+// Computes the rating after a rating period with these outcomes.  If there are
+// no outcomes the deviation still increases, reflecting the uncertainty from
+// not having played.
+func (rating Rating) Update(outcomes []Outcome) Rating {
+	mu, phi, sigma := toMu(rating.Rating), toPhi(rating.Deviation), rating.Volatility
+	if len(outcomes) == 0 {
+		phi = math.Sqrt(phi*phi + sigma*sigma)
+		return Rating{rating.Rating, fromPhi(phi), sigma}
+	}
+
+	// The estimated variance and improvement, based only on game outcomes.
+	var inverseV, sum float64
+	for _, outcome := range outcomes {
+		opponentMu, opponentPhi := toMu(outcome.Opponent.Rating), toPhi(outcome.Opponent.Deviation)
+		g := gPhi(opponentPhi)
+		e := expected(mu, opponentMu, opponentPhi)
+		inverseV += g * g * e * (1 - e)
+		sum += g * (outcome.Score - e)
+	}
+	v := 1 / inverseV
+	delta := v * sum
+
+	sigma = newVolatility(phi, sigma, v, delta)
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	phi = 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	mu = mu + phi*phi*sum
+	return Rating{fromMu(mu), fromPhi(phi), sigma}
+}
+
+// Solves for the new volatility with the Illinois algorithm.
+func newVolatility(phi, sigma, v, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		denominator := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*denominator*denominator) -
+			(x-a)/(TAU*TAU)
+	}
+
+	A, B := a, 0.0
+	if delta*delta > phi*phi+v {
+		B = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*TAU) < 0 {
+			k += 1
+		}
+		B = a - k*TAU
+	}
+	fA, fB := f(A), f(B)
+	for math.Abs(B-A) > EPSILON {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB <= 0 {
+			A, fA = B, fB
+		} else {
+			fA = fA / 2
+		}
+		B, fB = C, fC
+	}
+	return math.Exp(A / 2)
+}
+
+func gPhi(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+func expected(mu, opponentMu, opponentPhi float64) float64 {
+	return 1 / (1 + math.Exp(-gPhi(opponentPhi)*(mu-opponentMu)))
+}
+
+func toMu(rating float64) float64     { return (rating - DEFAULT_RATING) / GLICKO2_SCALE }
+func fromMu(mu float64) float64       { return mu*GLICKO2_SCALE + DEFAULT_RATING }
+func toPhi(deviation float64) float64 { return deviation / GLICKO2_SCALE }
+func fromPhi(phi float64) float64     { return phi * GLICKO2_SCALE }
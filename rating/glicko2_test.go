@@ -0,0 +1,59 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/rating/glicko2_test.go
+
+package rating_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kevindamm/wits-go/rating"
+)
+
+// The worked example from Glickman's description of the Glicko-2 system.
+func TestRating_Update(t *testing.T) {
+	player := rating.Rating{Rating: 1500, Deviation: 200, Volatility: 0.06}
+	updated := player.Update([]rating.Outcome{
+		{rating.Rating{Rating: 1400, Deviation: 30}, 1},
+		{rating.Rating{Rating: 1550, Deviation: 100}, 0},
+		{rating.Rating{Rating: 1700, Deviation: 300}, 0},
+	})
+	if math.Abs(updated.Rating-1464.06) > 0.01 {
+		t.Errorf("expected rating 1464.06, got %.2f", updated.Rating)
+	}
+	if math.Abs(updated.Deviation-151.52) > 0.01 {
+		t.Errorf("expected deviation 151.52, got %.2f", updated.Deviation)
+	}
+	if math.Abs(updated.Volatility-0.05999) > 0.00001 {
+		t.Errorf("expected volatility 0.05999, got %.5f", updated.Volatility)
+	}
+
+	idle := player.Update(nil)
+	if idle.Rating != player.Rating || idle.Deviation <= player.Deviation {
+		t.Errorf("not playing should only increase the deviation, got %v", idle)
+	}
+}
+
+func TestRating_Expected(t *testing.T) {
+	even := rating.Default().Expected(rating.Default())
+	if math.Abs(even-0.5) > 1e-9 {
+		t.Errorf("expected even odds between default ratings, got %f", even)
+	}
+	strong := rating.Rating{Rating: 1800, Deviation: 50, Volatility: 0.06}
+	if odds := strong.Expected(rating.Default()); odds <= 0.5 {
+		t.Errorf("expected the stronger player to be favored, got %f", odds)
+	}
+}
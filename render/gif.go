@@ -0,0 +1,324 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/render/gif.go
+
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// How long each kind of frame of an animation is shown.  GIF frame delays are
+// in hundredths of a second, shorter durations are rounded up to that.
+type Timing struct {
+	// The banner at the start of each turn.
+	Banner time.Duration
+
+	// Each action, after any movement.
+	Action time.Duration
+
+	// A unit sliding from one tile to another, over this many steps.
+	Move      time.Duration
+	MoveSteps int
+
+	// The final state, with the result.
+	End time.Duration
+}
+
+func DefaultTiming() Timing {
+	return Timing{
+		Banner:    800 * time.Millisecond,
+		Action:    500 * time.Millisecond,
+		Move:      300 * time.Millisecond,
+		MoveSteps: 4,
+		End:       3 * time.Second,
+	}
+}
+
+var (
+	DAMAGE_COLOR = color.RGBA{0xC8, 0x1E, 0x1E, 0xFF}
+	HEALED_COLOR = color.RGBA{0x2E, 0x9E, 0x4E, 0xFF}
+)
+
+// Animates the replay's turns from the initial state, with a banner at the
+// start of each turn, units sliding to where they move or are teleported, a
+// line from attackers to their targets with the damage done, and a ring
+// around spawned units.  If an action of the replay cannot be played, the
+// animation up to that action is returned along with the error.
+func (renderer *Renderer) Animate(initial *state.GameState, turns []wits.PlayerTurn, timing Timing) (*gif.GIF, error) {
+	animation := &animation{Renderer: renderer, Timing: timing, colors: make(map[color.RGBA]uint8)}
+	err := animation.play(initial.Clone(), turns)
+	return animation.encode(), err
+}
+
+type animation struct {
+	*Renderer
+	Timing
+	frames []*image.RGBA
+	delays []time.Duration
+	colors map[color.RGBA]uint8
+}
+
+func (animation *animation) play(game *state.GameState, turns []wits.PlayerTurn) error {
+	gamemap := game.Map()
+	for _, played := range turns {
+		if game.IsOver() {
+			return fmt.Errorf("turn %d played after the match ended", played.TurnCount())
+		}
+		if err := animation.banner(game, animation.Banner); err != nil {
+			return err
+		}
+		for i, encoded := range played.Actions() {
+			action, err := gamemap.Resolve(encoded)
+			if err != nil {
+				return fmt.Errorf("turn %d action %d: %w", played.TurnCount(), i+1, err)
+			}
+			if action.IsPass() {
+				break
+			}
+			before := game.Clone()
+			if err := game.Apply(action); err != nil {
+				return fmt.Errorf("turn %d action %d: %w", played.TurnCount(), i+1, err)
+			}
+			if err := animation.action(before, game, action); err != nil {
+				return err
+			}
+		}
+		game.EndTurn()
+	}
+	return animation.banner(game, animation.End)
+}
+
+// The state with a banner across the top, of the turn and the side to move or
+// the result if the game is over.
+func (animation *animation) banner(game *state.GameState, delay time.Duration) error {
+	frame, err := animation.Raster(game, state.NO_TILE)
+	if err != nil {
+		return err
+	}
+	team := game.Current()
+	text := fmt.Sprintf("turn %d %s", game.Turn(), witsjson.FriendlyEnumJSON(team))
+	if game.IsOver() {
+		text = "draw"
+		for i := range game.PlayerCount() {
+			team = wits.FriendlyEnum(i + 1)
+			if winner(game.ResultFor(team)) {
+				text = fmt.Sprintf("%s wins", witsjson.FriendlyEnumJSON(team))
+				break
+			}
+		}
+		if text == "draw" {
+			team = wits.FR_UNKNOWN
+		}
+	}
+	scale := 2 * animation.fontScale()
+	_, height := textSize(text, scale)
+	canvas := canvas{frame}
+	canvas.fillRect(0, 0, float64(frame.Rect.Dx()), float64(height+2*scale), TeamColor(team))
+	canvas.text(float64(frame.Rect.Dx())/2, float64(height)/2+float64(scale), scale, text, WHITE)
+	animation.add(frame, delay)
+	return nil
+}
+
+func winner(result wits.TerminalStatus) bool {
+	return result == wits.VICTORY_DESTRUCTION ||
+		result == wits.VICTORY_EXTINCTION ||
+		result == wits.VICTORY_RESIGNATION
+}
+
+// The frames for an action, from the state before it to the state after.
+func (animation *animation) action(before, after *state.GameState, action state.Action) error {
+	gamemap := after.Map()
+	switch action.Name {
+	case witsjson.MOVE_UNIT:
+		if err := animation.slide(before, action.Agent, action.Target); err != nil {
+			return err
+		}
+	case witsjson.TELEPORT_UNIT:
+		if err := animation.slide(before, action.Target, action.Dest); err != nil {
+			return err
+		}
+	}
+
+	frame, err := animation.Raster(after, state.NO_TILE)
+	if err != nil {
+		return err
+	}
+	canvas := canvas{frame}
+	visible := func(at wits.HexCoordIndex) bool {
+		return animation.Fog == wits.FR_UNKNOWN || after.Visible(animation.Fog)[at]
+	}
+	size := animation.TileSize
+	switch action.Name {
+	case witsjson.ATTACK:
+		if visible(action.Agent) && visible(action.Target) {
+			x0, y0 := animation.Center(gamemap.Coord(action.Agent))
+			x1, y1 := animation.Center(gamemap.Coord(action.Target))
+			canvas.line(x0, y0, x1, y1, max(size/8, 1), DAMAGE_COLOR)
+		}
+		if visible(action.Target) {
+			animation.number(canvas, gamemap.Coord(action.Target), "-", damage(before, after, action.Target), DAMAGE_COLOR)
+		}
+	case witsjson.HEAL_UNIT:
+		if visible(action.Target) {
+			healed := int(after.UnitAt(action.Target).Health()) - int(before.UnitAt(action.Target).Health())
+			animation.number(canvas, gamemap.Coord(action.Target), "+", healed, HEALED_COLOR)
+		}
+	case witsjson.SPAWN_UNIT, witsjson.TOGGLE_ALT, witsjson.CHARM_UNIT:
+		at := action.Agent
+		if action.Name == witsjson.CHARM_UNIT {
+			at = action.Target
+		}
+		if visible(at) {
+			x, y := animation.Center(gamemap.Coord(at))
+			canvas.ring(x, y, size*0.8, max(size/8, 1), 0, TeamColor(before.Current()))
+		}
+	}
+	animation.add(frame, animation.Action)
+	return nil
+}
+
+// Frames of the unit sliding in a straight line between the tiles, drawn on
+// the state before it moved.  Units hidden by the fog do not slide.
+func (animation *animation) slide(before *state.GameState, from, to wits.HexCoordIndex) error {
+	gamemap := before.Map()
+	coord := gamemap.Coord(from)
+	var moving *Unit
+	for _, unit := range animation.unitsOf(before) {
+		if unit.Coord.I() == coord.I() && unit.Coord.J() == coord.J() {
+			moving = &unit
+			break
+		}
+	}
+	steps := animation.MoveSteps
+	if moving == nil || steps <= 0 {
+		return nil
+	}
+	background, err := animation.Raster(before, from)
+	if err != nil {
+		return err
+	}
+	x0, y0 := animation.Center(coord)
+	x1, y1 := animation.Center(gamemap.Coord(to))
+	for step := range steps {
+		frame := image.NewRGBA(background.Rect)
+		copy(frame.Pix, background.Pix)
+		t := float64(step+1) / float64(steps+1)
+		animation.drawUnit(canvas{frame}, *moving, x0+t*(x1-x0), y0+t*(y1-y0))
+		animation.add(frame, animation.Move/time.Duration(steps))
+	}
+	return nil
+}
+
+// The damage done to the unit or base at the target.
+func damage(before, after *state.GameState, target wits.HexCoordIndex) int {
+	if team := before.Map().BaseTeam(target); team != wits.FR_UNKNOWN {
+		return int(before.BaseHP(team)) - int(after.BaseHP(team))
+	}
+	return int(before.UnitAt(target).Health()) - int(after.UnitAt(target).Health())
+}
+
+// Draws the sign and amount above the tile, in a box.
+func (animation *animation) number(canvas canvas, coord wits.HexCoord, sign string, amount int, c color.RGBA) {
+	if amount <= 0 {
+		return
+	}
+	text := sign + itoa(amount)
+	scale := animation.fontScale() + 1
+	x, y := animation.Center(coord)
+	y -= animation.TileSize * 0.7
+	width, height := textSize(text, scale)
+	pad := float64(scale)
+	left, top := x-float64(width)/2-pad, y-float64(height)/2-pad
+	right, bottom := x+float64(width)/2+pad+1, y+float64(height)/2+pad+1
+	canvas.fillRect(left-1, top-1, right+1, bottom+1, WALL_COLOR)
+	canvas.fillRect(left, top, right, bottom, WHITE)
+	canvas.text(x, y, scale, text, c)
+}
+
+func (animation *animation) add(frame *image.RGBA, delay time.Duration) {
+	for i := 0; i < len(frame.Pix); i += 4 {
+		c := color.RGBA{frame.Pix[i], frame.Pix[i+1], frame.Pix[i+2], 0xFF}
+		if _, found := animation.colors[c]; !found && len(animation.colors) < 256 {
+			animation.colors[c] = uint8(len(animation.colors))
+		}
+	}
+	animation.frames = append(animation.frames, frame)
+	animation.delays = append(animation.delays, delay)
+}
+
+// Converts the frames to the palette of all their colors.  After the first,
+// each frame only covers the pixels that changed from the frame before it.
+func (animation *animation) encode() *gif.GIF {
+	palette := make(color.Palette, len(animation.colors))
+	for c, index := range animation.colors {
+		palette[index] = c
+	}
+	encoded := &gif.GIF{}
+	var previous *image.Paletted
+	for i, frame := range animation.frames {
+		paletted := image.NewPaletted(frame.Rect, palette)
+		for p := range paletted.Pix {
+			c := color.RGBA{frame.Pix[4*p], frame.Pix[4*p+1], frame.Pix[4*p+2], 0xFF}
+			index, found := animation.colors[c]
+			if !found {
+				index = uint8(palette.Index(c))
+			}
+			paletted.Pix[p] = index
+		}
+		changed := paletted
+		if previous != nil {
+			changed = paletted.SubImage(difference(previous, paletted)).(*image.Paletted)
+		}
+		previous = paletted
+		encoded.Image = append(encoded.Image, changed)
+		encoded.Delay = append(encoded.Delay, hundredths(animation.delays[i]))
+		encoded.Disposal = append(encoded.Disposal, gif.DisposalNone)
+	}
+	if len(animation.frames) > 0 {
+		bounds := animation.frames[0].Rect
+		encoded.Config = image.Config{ColorModel: palette, Width: bounds.Dx(), Height: bounds.Dy()}
+	}
+	return encoded
+}
+
+// The bounds of the pixels that differ between the images (of the same size),
+// at least one pixel so that the frame is kept for its delay.
+func difference(a, b *image.Paletted) image.Rectangle {
+	changed := image.Rectangle{}
+	for y := a.Rect.Min.Y; y < a.Rect.Max.Y; y++ {
+		for x := a.Rect.Min.X; x < a.Rect.Max.X; x++ {
+			if a.ColorIndexAt(x, y) != b.ColorIndexAt(x, y) {
+				changed = changed.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	if changed.Empty() {
+		return image.Rect(0, 0, 1, 1)
+	}
+	return changed
+}
+
+func hundredths(delay time.Duration) int {
+	return int((delay + 10*time.Millisecond - 1) / (10 * time.Millisecond))
+}
@@ -0,0 +1,316 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/render/raster.go
+
+package render
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+)
+
+// Draws the game state as an image, the same as it is drawn as SVG, without
+// the unit on the hidden tile (NO_TILE to draw all of them).  The image is
+// drawn without anti-aliasing, so that it has few distinct colors.
+func (renderer *Renderer) Raster(game *state.GameState, hidden wits.HexCoordIndex) (*image.RGBA, error) {
+	fogged, ranges, err := renderer.shading(game)
+	if err != nil {
+		return nil, err
+	}
+	width, height := renderer.Size()
+	canvas := newCanvas(int(math.Ceil(width)), int(math.Ceil(height)))
+	size := renderer.TileSize
+	scale := renderer.fontScale()
+	for _, tile := range renderer.tiles {
+		fill := FLOOR_COLOR
+		switch tile.Kind {
+		case TILE_WALL:
+			fill = WALL_COLOR
+		case TILE_BONUS:
+			fill = BONUS_COLOR
+		case TILE_BASE:
+			fill = TeamColor(tile.Team)
+		}
+		canvas.fillPolygon(renderer.Corners(tile.Coord, 1), fill)
+		canvas.strokePolygon(renderer.Corners(tile.Coord, 1), 1, EDGE_COLOR)
+		if tile.Kind == TILE_SPAWN {
+			canvas.strokePolygon(renderer.Corners(tile.Coord, 0.8), size/8, TeamColor(tile.Team))
+		}
+		key := [2]int{tile.Coord.I(), tile.Coord.J()}
+		if ranges[key] {
+			canvas.fillPolygon(renderer.Corners(tile.Coord, 0.9), RANGE_COLOR)
+		}
+		if tile.Kind == TILE_BASE {
+			x, y := renderer.Center(tile.Coord)
+			canvas.text(x, y, scale, itoa(int(game.BaseHP(tile.Team))), WHITE)
+		}
+	}
+
+	skip := [2]int{-1, -1}
+	if hidden != state.NO_TILE {
+		coord := game.Map().Coord(hidden)
+		skip = [2]int{coord.I(), coord.J()}
+	}
+	for _, unit := range renderer.unitsOf(game) {
+		if [2]int{unit.Coord.I(), unit.Coord.J()} != skip {
+			x, y := renderer.Center(unit.Coord)
+			renderer.drawUnit(canvas, unit, x, y)
+		}
+	}
+	for _, tile := range renderer.tiles {
+		if fogged[[2]int{tile.Coord.I(), tile.Coord.J()}] {
+			canvas.fillPolygon(renderer.Corners(tile.Coord, 1), FOG_COLOR)
+		}
+	}
+	return canvas.RGBA, nil
+}
+
+// Draws the unit centered at (x, y), as it is drawn in writeUnit.  The dashed
+// outline of an alternate state is dashed by angle.
+func (renderer *Renderer) drawUnit(canvas canvas, unit Unit, x, y float64) {
+	size := renderer.TileSize
+	canvas.fillCircle(x, y, size*0.5, TeamColor(unit.Team))
+	dashes := 0
+	if unit.Alternate {
+		dashes = 12
+	}
+	canvas.ring(x, y, size*0.5, max(size/16, 1), dashes, WALL_COLOR)
+	canvas.text(x, y, renderer.fontScale(), Glyph(unit.Class, unit.Race), WHITE)
+	pips := max(int(unit.Health), int(state.HealthForUnit(unit.Class)))
+	for i := range pips {
+		px, py := x+(float64(i)-float64(pips-1)/2)*size*0.22, y+size*0.68
+		if i < int(unit.Health) {
+			canvas.fillCircle(px, py, size*0.08, WHITE)
+		}
+		canvas.ring(px, py, size*0.08, 1, 0, WALL_COLOR)
+	}
+}
+
+// The scale of the bitmap font for glyphs and numbers on tiles.
+func (renderer *Renderer) fontScale() int {
+	return max(1, int(renderer.TileSize/12))
+}
+
+var WHITE = color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+
+// An image being drawn, where translucent colors are blended with what is
+// already drawn and opaque colors replace it.
+type canvas struct{ *image.RGBA }
+
+func newCanvas(width, height int) canvas {
+	rgba := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := range rgba.Pix {
+		rgba.Pix[i] = 0xFF
+	}
+	return canvas{rgba}
+}
+
+func (canvas canvas) blend(x, y int, c color.RGBA) {
+	if !(image.Point{x, y}.In(canvas.Rect)) {
+		return
+	}
+	if c.A == 0xFF {
+		canvas.SetRGBA(x, y, c)
+		return
+	}
+	under := canvas.RGBAAt(x, y)
+	alpha := uint16(c.A)
+	mix := func(over, under uint8) uint8 {
+		return uint8((uint16(over)*alpha + uint16(under)*(0xFF-alpha)) / 0xFF)
+	}
+	canvas.SetRGBA(x, y, color.RGBA{mix(c.R, under.R), mix(c.G, under.G), mix(c.B, under.B), 0xFF})
+}
+
+// Calls draw for each pixel (by its center) within the bounds.
+func (canvas canvas) each(minX, minY, maxX, maxY float64, draw func(x, y int, px, py float64)) {
+	bounds := image.Rect(int(math.Floor(minX)), int(math.Floor(minY)),
+		int(math.Ceil(maxX))+1, int(math.Ceil(maxY))+1).Intersect(canvas.Rect)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			draw(x, y, float64(x)+0.5, float64(y)+0.5)
+		}
+	}
+}
+
+// Fills a convex polygon, with its corners in clockwise order.
+func (canvas canvas) fillPolygon(corners [6][2]float64, c color.RGBA) {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, corner := range corners {
+		minX, maxX = min(minX, corner[0]), max(maxX, corner[0])
+		minY, maxY = min(minY, corner[1]), max(maxY, corner[1])
+	}
+	canvas.each(minX, minY, maxX, maxY, func(x, y int, px, py float64) {
+		for i, from := range corners {
+			to := corners[(i+1)%len(corners)]
+			if (to[0]-from[0])*(py-from[1])-(to[1]-from[1])*(px-from[0]) < 0 {
+				return
+			}
+		}
+		canvas.blend(x, y, c)
+	})
+}
+
+func (canvas canvas) strokePolygon(corners [6][2]float64, width float64, c color.RGBA) {
+	for i, from := range corners {
+		to := corners[(i+1)%len(corners)]
+		canvas.line(from[0], from[1], to[0], to[1], width, c)
+	}
+}
+
+func (canvas canvas) fillRect(minX, minY, maxX, maxY float64, c color.RGBA) {
+	canvas.each(minX, minY, maxX-1, maxY-1, func(x, y int, _, _ float64) {
+		canvas.blend(x, y, c)
+	})
+}
+
+func (canvas canvas) line(x0, y0, x1, y1, width float64, c color.RGBA) {
+	half := max(width, 1) / 2
+	dx, dy := x1-x0, y1-y0
+	length := dx*dx + dy*dy
+	canvas.each(min(x0, x1)-half, min(y0, y1)-half, max(x0, x1)+half, max(y0, y1)+half,
+		func(x, y int, px, py float64) {
+			t := 0.0
+			if length > 0 {
+				t = max(0, min(1, ((px-x0)*dx+(py-y0)*dy)/length))
+			}
+			if math.Hypot(px-(x0+t*dx), py-(y0+t*dy)) <= half {
+				canvas.blend(x, y, c)
+			}
+		})
+}
+
+func (canvas canvas) fillCircle(cx, cy, radius float64, c color.RGBA) {
+	canvas.each(cx-radius, cy-radius, cx+radius, cy+radius, func(x, y int, px, py float64) {
+		if math.Hypot(px-cx, py-cy) <= radius {
+			canvas.blend(x, y, c)
+		}
+	})
+}
+
+// Draws a circle's outline, dashed into this many dashes (solid if zero).
+func (canvas canvas) ring(cx, cy, radius, width float64, dashes int, c color.RGBA) {
+	half := width / 2
+	reach := radius + half
+	canvas.each(cx-reach, cy-reach, cx+reach, cy+reach, func(x, y int, px, py float64) {
+		if math.Abs(math.Hypot(px-cx, py-cy)-radius) > half {
+			return
+		}
+		if dashes > 0 {
+			angle := math.Atan2(py-cy, px-cx) + math.Pi
+			if int(angle/math.Pi*float64(dashes))%2 == 1 {
+				return
+			}
+		}
+		canvas.blend(x, y, c)
+	})
+}
+
+// Draws the text in the bitmap font, centered at (cx, cy), with each of the
+// font's pixels drawn as a square of scale pixels.
+func (canvas canvas) text(cx, cy float64, scale int, text string, c color.RGBA) {
+	width, height := textSize(text, scale)
+	left, top := int(math.Round(cx))-width/2, int(math.Round(cy))-height/2
+	for i, char := range strings.ToUpper(text) {
+		glyph, found := FONT[char]
+		if !found {
+			continue
+		}
+		for row, line := range glyph {
+			for column, bit := range line {
+				if bit != '#' {
+					continue
+				}
+				x, y := left+(i*(FONT_WIDTH+1)+column)*scale, top+row*scale
+				for dy := range scale {
+					for dx := range scale {
+						canvas.blend(x+dx, y+dy, c)
+					}
+				}
+			}
+		}
+	}
+}
+
+// The size of the text in the bitmap font, in pixels.
+func textSize(text string, scale int) (width, height int) {
+	count := len([]rune(text))
+	return max(count*(FONT_WIDTH+1)-1, 0) * scale, FONT_HEIGHT * scale
+}
+
+func itoa(value int) string {
+	if value < 0 {
+		return "-" + itoa(-value)
+	}
+	if value < 10 {
+		return string(rune('0' + value))
+	}
+	return itoa(value/10) + string(rune('0'+value%10))
+}
+
+// A small bitmap font, enough for unit glyphs, numbers and banners.  Letters
+// are drawn in upper case.
+const (
+	FONT_WIDTH  = 3
+	FONT_HEIGHT = 5
+)
+
+var FONT = map[rune][FONT_HEIGHT]string{
+	' ': {"...", "...", "...", "...", "..."},
+	'+': {"...", ".#.", "###", ".#.", "..."},
+	'-': {"...", "...", "###", "...", "..."},
+	':': {"...", ".#.", "...", ".#.", "..."},
+	'0': {"###", "#.#", "#.#", "#.#", "###"},
+	'1': {".#.", "##.", ".#.", ".#.", "###"},
+	'2': {"###", "..#", "###", "#..", "###"},
+	'3': {"###", "..#", "###", "..#", "###"},
+	'4': {"#.#", "#.#", "###", "..#", "..#"},
+	'5': {"###", "#..", "###", "..#", "###"},
+	'6': {"###", "#..", "###", "#.#", "###"},
+	'7': {"###", "..#", "..#", ".#.", ".#."},
+	'8': {"###", "#.#", "###", "#.#", "###"},
+	'9': {"###", "#.#", "###", "..#", "###"},
+	'A': {".#.", "#.#", "###", "#.#", "#.#"},
+	'B': {"##.", "#.#", "##.", "#.#", "##."},
+	'C': {".##", "#..", "#..", "#..", ".##"},
+	'D': {"##.", "#.#", "#.#", "#.#", "##."},
+	'E': {"###", "#..", "##.", "#..", "###"},
+	'F': {"###", "#..", "##.", "#..", "#.."},
+	'G': {".##", "#..", "#.#", "#.#", ".##"},
+	'H': {"#.#", "#.#", "###", "#.#", "#.#"},
+	'I': {"###", ".#.", ".#.", ".#.", "###"},
+	'J': {"..#", "..#", "..#", "#.#", ".#."},
+	'K': {"#.#", "#.#", "##.", "#.#", "#.#"},
+	'L': {"#..", "#..", "#..", "#..", "###"},
+	'M': {"#.#", "###", "###", "#.#", "#.#"},
+	'N': {"##.", "#.#", "#.#", "#.#", "#.#"},
+	'O': {".#.", "#.#", "#.#", "#.#", ".#."},
+	'P': {"##.", "#.#", "##.", "#..", "#.."},
+	'Q': {".#.", "#.#", "#.#", "##.", ".##"},
+	'R': {"##.", "#.#", "##.", "#.#", "#.#"},
+	'S': {".##", "#..", ".#.", "..#", "##."},
+	'T': {"###", ".#.", ".#.", ".#.", ".#."},
+	'U': {"#.#", "#.#", "#.#", "#.#", "###"},
+	'V': {"#.#", "#.#", "#.#", "#.#", ".#."},
+	'W': {"#.#", "#.#", "###", "###", "#.#"},
+	'X': {"#.#", "#.#", ".#.", "#.#", "#.#"},
+	'Y': {"#.#", "#.#", ".#.", ".#.", ".#."},
+	'Z': {"###", "..#", ".#.", "#..", "###"},
+}
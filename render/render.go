@@ -0,0 +1,284 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/render/render.go
+
+// Package render draws maps and game states, as SVG for map reviews, bug
+// reports and thumbnails, as text for the terminal and as animated GIFs of
+// replays.  Hexagons are flat-topped, in columns of the axial coordinates'
+// first component (see state.ToAxial).
+package render
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+type Options struct {
+	// The distance from the center of a hexagon to its corners, in pixels.
+	TileSize float64
+
+	// Shades the tiles that this team cannot see, and hides the opposing
+	// units on them.  No fog is drawn for FR_UNKNOWN.
+	Fog wits.FriendlyEnum
+
+	// Shades the tiles that the units at these coordinates can move to.
+	Ranges []wits.HexCoord
+
+	// Labels each tile with its i:j coordinate.
+	Coords bool
+}
+
+func DefaultOptions() Options {
+	return Options{TileSize: 24}
+}
+
+// Team colors by the canonical team coloring (see witsjson.FriendlyEnumJSON).
+var TEAM_COLORS = map[string]color.RGBA{
+	"RED":   {0xD6, 0x45, 0x3D, 0xFF},
+	"BLUE":  {0x3B, 0x7D, 0xD8, 0xFF},
+	"GOLD":  {0xE2, 0xA9, 0x1E, 0xFF},
+	"GREEN": {0x3C, 0xA5, 0x5C, 0xFF},
+}
+
+var (
+	FLOOR_COLOR = color.RGBA{0xEE, 0xE8, 0xD8, 0xFF}
+	WALL_COLOR  = color.RGBA{0x5A, 0x55, 0x50, 0xFF}
+	BONUS_COLOR = color.RGBA{0xF6, 0xD8, 0x5A, 0xFF}
+	EDGE_COLOR  = color.RGBA{0x9A, 0x92, 0x80, 0xFF}
+	FOG_COLOR   = color.RGBA{0x20, 0x20, 0x30, 0x80}
+	RANGE_COLOR = color.RGBA{0x4C, 0xC0, 0x70, 0x60}
+)
+
+func TeamColor(team wits.FriendlyEnum) color.RGBA {
+	if rgba, found := TEAM_COLORS[witsjson.FriendlyEnumJSON(team).String()]; found {
+		return rgba
+	}
+	return EDGE_COLOR
+}
+
+// Glyphs drawn on units, by their class.  Specials are drawn with the glyph
+// of their race's special (when the race is known).
+var CLASS_GLYPHS = map[wits.UnitClassEnum]string{
+	wits.CLASS_RUNNER:  "R",
+	wits.CLASS_SOLDIER: "S",
+	wits.CLASS_MEDIC:   "+",
+	wits.CLASS_SNIPER:  "N",
+	wits.CLASS_HEAVY:   "H",
+	wits.CLASS_THORN:   "T",
+	wits.CLASS_SPECIAL: "X",
+}
+
+var SPECIAL_GLYPHS = map[state.SpecialEnum]string{
+	state.SPECIAL_SCRAMBLER: "Sc",
+	state.SPECIAL_MOBI:      "Mo",
+	state.SPECIAL_BOMBSHELL: "Bo",
+	state.SPECIAL_BRAMBLE:   "Br",
+}
+
+func Glyph(class wits.UnitClassEnum, race wits.UnitRaceEnum) string {
+	if class == wits.CLASS_SPECIAL {
+		if glyph, found := SPECIAL_GLYPHS[state.SpecialForRace(race)]; found {
+			return glyph
+		}
+	}
+	return CLASS_GLYPHS[class]
+}
+
+type TileKind byte
+
+const (
+	TILE_FLOOR TileKind = iota
+	TILE_WALL
+	TILE_BONUS
+	TILE_SPAWN
+	TILE_BASE
+)
+
+// A tile of the map as it is drawn, the team is that of a spawn or base.
+type Tile struct {
+	Coord witsjson.HexCoordJSON
+	Kind  TileKind
+	Team  wits.FriendlyEnum
+}
+
+// A unit as it is drawn.
+type Unit struct {
+	Coord     witsjson.HexCoordJSON
+	Team      wits.FriendlyEnum
+	Class     wits.UnitClassEnum
+	Race      wits.UnitRaceEnum
+	Health    wits.UnitHealth
+	Alternate bool
+}
+
+// Draws the map of a description, and game states on that map.
+type Renderer struct {
+	Options
+	mapID  wits.GameMapID
+	name   wits.GameMapName
+	legacy bool
+	tiles  []Tile
+	units  []Unit
+
+	// The extent of the tile centers, in pixels.
+	minX, minY, maxX, maxY float64
+}
+
+func New(description wits.MapDescription, options Options) *Renderer {
+	if options.TileSize <= 0 {
+		options.TileSize = DefaultOptions().TileSize
+	}
+	renderer := &Renderer{
+		Options: options,
+		mapID:   description.MapID(),
+		name:    description.MapName(),
+		legacy:  description.Legacy(),
+	}
+	terrain := description.Terrain()
+	seen := make(map[[2]int]bool)
+	add := func(defs []wits.TileDefinition, kind TileKind) {
+		for _, def := range defs {
+			coord := witsjson.NewHexCoord(def.Position().I(), def.Position().J())
+			if seen[[2]int{coord.I(), coord.J()}] {
+				continue
+			}
+			seen[[2]int{coord.I(), coord.J()}] = true
+			team := wits.FR_UNKNOWN
+			if kind == TILE_SPAWN || kind == TILE_BASE {
+				team = def.Team()
+			}
+			renderer.tiles = append(renderer.tiles, Tile{coord, kind, team})
+		}
+	}
+	// Bases and spawns take precedence over any floor at the same coordinate.
+	add(terrain.Base(), TILE_BASE)
+	add(terrain.Spawn(), TILE_SPAWN)
+	add(terrain.Bonus(), TILE_BONUS)
+	add(terrain.Floor(), TILE_FLOOR)
+	add(terrain.Wall(), TILE_WALL)
+
+	for _, unit := range description.Units() {
+		coord := witsjson.NewHexCoord(unit.Position().I(), unit.Position().J())
+		health := unit.Health()
+		if health == 0 {
+			health = state.HealthForUnit(unit.Class())
+		}
+		renderer.units = append(renderer.units, Unit{
+			coord, unit.Team(), unit.Class(), wits.RACE_UNKNOWN, health, false})
+	}
+
+	renderer.minX, renderer.minY = math.Inf(1), math.Inf(1)
+	renderer.maxX, renderer.maxY = math.Inf(-1), math.Inf(-1)
+	for _, tile := range renderer.tiles {
+		x, y := renderer.center(tile.Coord)
+		renderer.minX, renderer.maxX = min(renderer.minX, x), max(renderer.maxX, x)
+		renderer.minY, renderer.maxY = min(renderer.minY, y), max(renderer.maxY, y)
+	}
+	if len(renderer.tiles) == 0 {
+		renderer.minX, renderer.minY, renderer.maxX, renderer.maxY = 0, 0, 0, 0
+	}
+	return renderer
+}
+
+func (renderer *Renderer) Tiles() []Tile { return renderer.tiles }
+
+// The size of the drawing, in pixels.
+func (renderer *Renderer) Size() (width, height float64) {
+	size := renderer.TileSize
+	return renderer.maxX - renderer.minX + 2*size + 2*MARGIN,
+		renderer.maxY - renderer.minY + math.Sqrt(3)*size + 2*MARGIN
+}
+
+// Space around the tiles, in pixels.
+const MARGIN = 8
+
+// The center of the tile in the drawing, in pixels.
+func (renderer *Renderer) Center(coord wits.HexCoord) (x, y float64) {
+	x, y = renderer.center(coord)
+	return x - renderer.minX + renderer.TileSize + MARGIN,
+		y - renderer.minY + math.Sqrt(3)/2*renderer.TileSize + MARGIN
+}
+
+func (renderer *Renderer) center(coord wits.HexCoord) (x, y float64) {
+	axial := state.ToAxial(coord, renderer.legacy)
+	q, r := float64(axial[0]), float64(axial[1])
+	size := renderer.TileSize
+	return size * 1.5 * q, size * math.Sqrt(3) * (r + q/2)
+}
+
+// The corners of the tile's hexagon, starting from the right and going
+// clockwise (in the drawing's coordinates, where y increases downwards).
+func (renderer *Renderer) Corners(coord wits.HexCoord, scale float64) [6][2]float64 {
+	x, y := renderer.Center(coord)
+	var corners [6][2]float64
+	for i := range corners {
+		angle := math.Pi / 3 * float64(i)
+		radius := renderer.TileSize * scale
+		corners[i] = [2]float64{x + radius*math.Cos(angle), y + radius*math.Sin(angle)}
+	}
+	return corners
+}
+
+// The units of the game, as this team sees them if there is fog.
+func (renderer *Renderer) unitsOf(game *state.GameState) []Unit {
+	if renderer.Fog != wits.FR_UNKNOWN {
+		game = game.Fogged(renderer.Fog)
+	}
+	gamemap := game.Map()
+	units := make([]Unit, 0)
+	for index := range gamemap.TileCount() {
+		at := wits.HexCoordIndex(index)
+		unit := game.UnitAt(at)
+		if unit.IsEmpty() {
+			continue
+		}
+		units = append(units, Unit{gamemap.Coord(at), unit.Team(), unit.Class(),
+			unit.Race(), unit.Health(), unit.IsAlternate()})
+	}
+	return units
+}
+
+// The tiles (by coordinate) that the options shade for this game.
+func (renderer *Renderer) shading(game *state.GameState) (fogged, ranges map[[2]int]bool, err error) {
+	gamemap := game.Map()
+	if gamemap.MapID() != renderer.mapID {
+		return nil, nil, fmt.Errorf("game is on map %s, not %s", gamemap.MapID(), renderer.mapID)
+	}
+	fogged, ranges = make(map[[2]int]bool), make(map[[2]int]bool)
+	if renderer.Fog != wits.FR_UNKNOWN {
+		for index, visible := range game.Visible(renderer.Fog) {
+			if !visible {
+				coord := gamemap.Coord(wits.HexCoordIndex(index))
+				fogged[[2]int{coord.I(), coord.J()}] = true
+			}
+		}
+	}
+	for _, coord := range renderer.Ranges {
+		at := gamemap.Index(coord)
+		if at == state.NO_TILE || game.UnitAt(at).IsEmpty() {
+			return nil, nil, fmt.Errorf("no unit at %d:%d for its movement range", coord.I(), coord.J())
+		}
+		for _, to := range game.Reachable(at) {
+			coord := gamemap.Coord(to)
+			ranges[[2]int{coord.I(), coord.J()}] = true
+		}
+	}
+	return fogged, ranges, nil
+}
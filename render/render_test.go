@@ -0,0 +1,256 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/render/render_test.go
+
+package render_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image/gif"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/internal/testutil"
+	"github.com/kevindamm/wits-go/notation"
+	"github.com/kevindamm/wits-go/render"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+const PEEKABOO_START = "oml/solo/peekaboo 4H137S121M1132M211S227H2310 fs 5/5 3/0 1 1 - 1:4,11:5"
+
+// Counts the elements of the SVG by their name and class (as "name.class"),
+// and by the class of their enclosing group (as "group>name").
+func countElements(t *testing.T, svg []byte) map[string]int {
+	counts := make(map[string]int)
+	decoder := xml.NewDecoder(bytes.NewReader(svg))
+	groups := []string{""}
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("invalid SVG: %v", err)
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			class := ""
+			for _, attr := range element.Attr {
+				if attr.Name.Local == "class" {
+					class = attr.Value
+				}
+			}
+			counts[element.Name.Local+"."+class]++
+			counts[groups[len(groups)-1]+">"+element.Name.Local]++
+			if element.Name.Local == "g" {
+				groups = append(groups, class)
+			}
+		case xml.EndElement:
+			if element.Name.Local == "g" {
+				groups = groups[:len(groups)-1]
+			}
+		}
+	}
+	return counts
+}
+
+func TestRenderer_Map(t *testing.T) {
+	description := testutil.LoadMaps(t)["peekaboo"]
+	var svg bytes.Buffer
+	if err := render.New(description, render.DefaultOptions()).Map(&svg); err != nil {
+		t.Fatal(err)
+	}
+	counts := countElements(t, svg.Bytes())
+	terrain := description.Terrain()
+	expected := map[string]int{
+		"polygon.floor":      len(terrain.Floor()),
+		"polygon.wall":       len(terrain.Wall()),
+		"polygon.bonus":      len(terrain.Bonus()),
+		"polygon.spawn":      len(terrain.Spawn()),
+		"polygon.spawn-ring": len(terrain.Spawn()),
+		"polygon.base":       len(terrain.Base()),
+		"g.unit":             len(description.Units()),
+		"g.fog":              0,
+		"g.ranges":           0,
+		"g.bases":            0,
+	}
+	for key, count := range expected {
+		if counts[key] != count {
+			t.Errorf("expected %d %s, found %d", count, key, counts[key])
+		}
+	}
+}
+
+func TestRenderer_Game(t *testing.T) {
+	maps := testutil.LoadMaps(t)
+	game, err := notation.Parse(PEEKABOO_START, maps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gamemap := game.Map()
+	heavy := gamemap.Coord(4) // the first unit on the board
+	if game.UnitAt(4).Class() != wits.CLASS_HEAVY {
+		t.Fatalf("expected a heavy at %s", heavy)
+	}
+
+	options := render.DefaultOptions()
+	options.Fog = wits.FR_SELF
+	options.Ranges = []wits.HexCoord{heavy}
+	var svg bytes.Buffer
+	if err := render.New(maps["peekaboo"], options).Game(&svg, game); err != nil {
+		t.Fatal(err)
+	}
+	counts := countElements(t, svg.Bytes())
+
+	hidden, units := 0, 0
+	for index, visible := range game.Visible(wits.FR_SELF) {
+		unit := game.UnitAt(wits.HexCoordIndex(index))
+		switch {
+		case !visible:
+			hidden++
+		case !unit.IsEmpty():
+			units++
+		}
+	}
+	if counts["fog>polygon"] != hidden {
+		t.Errorf("expected %d fogged tiles, found %d", hidden, counts["fog>polygon"])
+	}
+	if counts["g.unit"] != units {
+		t.Errorf("expected %d visible units, found %d", units, counts["g.unit"])
+	}
+	if reachable := len(game.Reachable(4)); counts["ranges>polygon"] != reachable {
+		t.Errorf("expected %d tiles in range, found %d", reachable, counts["ranges>polygon"])
+	}
+	if counts["bases>text"] != 2 {
+		t.Errorf("expected the HP of both bases, found %d", counts["bases>text"])
+	}
+
+	options.Ranges = []wits.HexCoord{gamemap.Coord(0)}
+	if err := render.New(maps["peekaboo"], options).Game(io.Discard, game); err == nil {
+		t.Error("expected an error for the range of an empty tile")
+	}
+	if err := render.New(maps["glitch"], render.DefaultOptions()).Game(io.Discard, game); err == nil {
+		t.Error("expected an error for a game on a different map")
+	}
+}
+
+func TestRenderer_Center(t *testing.T) {
+	description := testutil.LoadMaps(t)["peekaboo"]
+	renderer := render.New(description, render.DefaultOptions())
+	gamemap := state.NewGameMap(description)
+	for index := range gamemap.TileCount() {
+		at := wits.HexCoordIndex(index)
+		x, y := renderer.Center(gamemap.Coord(at))
+		for _, neighbor := range gamemap.Neighbors(at) {
+			nx, ny := renderer.Center(gamemap.Coord(neighbor))
+			distance := math.Hypot(nx-x, ny-y)
+			if math.Abs(distance-math.Sqrt(3)*renderer.TileSize) > 1e-6 {
+				t.Fatalf("neighbors %s and %s are %.2f apart", gamemap.Coord(at), gamemap.Coord(neighbor), distance)
+			}
+		}
+	}
+}
+
+func TestRenderer_Text(t *testing.T) {
+	maps := testutil.LoadMaps(t)
+	game, err := notation.Parse(PEEKABOO_START, maps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var text bytes.Buffer
+	if err := render.New(maps["peekaboo"], render.DefaultOptions()).Text(&text, game); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"turn 1, RED to move", "RED    FEEDBACK    wits  3  base 5",
+		"H13", "S12", "M11", "B15", "(1)", "M21", "S22", "H23", "B25", "(2)", "###", " $ "} {
+		if !bytes.Contains(text.Bytes(), []byte(expected)) {
+			t.Errorf("expected %q in\n%s", expected, text.String())
+		}
+	}
+
+	options := render.DefaultOptions()
+	options.Fog = wits.FR_SELF
+	options.Ranges = []wits.HexCoord{game.Map().Coord(4)}
+	text.Reset()
+	if err := render.New(maps["peekaboo"], options).Text(&text, game); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(text.Bytes(), []byte("H23")) {
+		t.Errorf("expected the enemy heavy to be hidden in\n%s", text.String())
+	}
+	if count := bytes.Count(text.Bytes(), []byte(render.TEXT_RANGE)); count != len(game.Reachable(4)) {
+		t.Errorf("expected %d tiles in range, found %d", len(game.Reachable(4)), count)
+	}
+	if !bytes.Contains(text.Bytes(), []byte(render.TEXT_FOG)) {
+		t.Error("expected fogged tiles")
+	}
+}
+
+func TestRenderer_Animate(t *testing.T) {
+	maps := testutil.LoadMaps(t)
+	game, err := notation.Parse(PEEKABOO_START, maps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gamemap := game.Map()
+	spawn := game.LegalActions()[0]
+	move := state.MoveAction(4, game.Reachable(4)[0])
+	turns := []wits.PlayerTurn{
+		witsjson.PlayerTurnJSON{Turn_: 1, Actions_: []wits.PlayerAction{
+			gamemap.PlayerAction(spawn), gamemap.PlayerAction(move), wits.PassAction{}}},
+		witsjson.PlayerTurnJSON{Turn_: 2, Actions_: []wits.PlayerAction{wits.PassAction{}}},
+	}
+
+	timing := render.DefaultTiming()
+	renderer := render.New(maps["peekaboo"], render.DefaultOptions())
+	animation, err := renderer.Animate(game, turns, timing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A banner for each turn and the end, the spawn, and the move's steps.
+	if expected := 3 + 1 + timing.MoveSteps + 1; len(animation.Image) != expected {
+		t.Errorf("expected %d frames, found %d", expected, len(animation.Image))
+	}
+	var encoded bytes.Buffer
+	if err := gif.EncodeAll(&encoded, animation); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := gif.DecodeAll(&encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	width, height := renderer.Size()
+	if decoded.Config.Width != int(math.Ceil(width)) || decoded.Config.Height != int(math.Ceil(height)) {
+		t.Errorf("expected a %.0fx%.0f image, found %dx%d", width, height, decoded.Config.Width, decoded.Config.Height)
+	}
+	if decoded.Delay[0] != 80 || decoded.Delay[len(decoded.Delay)-1] != 300 {
+		t.Errorf("expected banner and end delays of 80 and 300, found %v", decoded.Delay)
+	}
+
+	// The animation up to an illegal action is kept.
+	turns[0] = witsjson.PlayerTurnJSON{Turn_: 1, Actions_: []wits.PlayerAction{
+		gamemap.PlayerAction(spawn), gamemap.PlayerAction(state.MoveAction(4, 4))}}
+	animation, err = renderer.Animate(game, turns, timing)
+	if err == nil {
+		t.Error("expected an error for the illegal move")
+	}
+	if len(animation.Image) != 2 {
+		t.Errorf("expected the banner and spawn frames, found %d", len(animation.Image))
+	}
+}
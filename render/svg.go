@@ -0,0 +1,209 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/render/svg.go
+
+package render
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"io"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+)
+
+// Writes the map as SVG, with the units that the map starts with.
+func (renderer *Renderer) Map(w io.Writer) error {
+	return renderer.writeSVG(w, renderer.units, nil, nil, nil)
+}
+
+// The radius of each hexagon in the thumbnails, in pixels.
+const THUMBNAIL_TILE_SIZE = 8
+
+// The SVG thumbnail of the map, as stored with the map's metadata.
+func Thumbnail(description wits.MapDescription) (string, error) {
+	var thumbnail strings.Builder
+	err := New(description, Options{TileSize: THUMBNAIL_TILE_SIZE}).Map(&thumbnail)
+	return thumbnail.String(), err
+}
+
+// Writes the game state as SVG, with the base HP on each base and shading for
+// the fog and movement ranges of the options.
+func (renderer *Renderer) Game(w io.Writer, game *state.GameState) error {
+	fogged, ranges, err := renderer.shading(game)
+	if err != nil {
+		return err
+	}
+	baseHP := make(map[wits.FriendlyEnum]wits.BaseHealth)
+	for i := range game.PlayerCount() {
+		team := wits.FriendlyEnum(i + 1)
+		baseHP[team] = game.BaseHP(team)
+	}
+	return renderer.writeSVG(w, renderer.unitsOf(game), baseHP, fogged, ranges)
+}
+
+func (renderer *Renderer) writeSVG(w io.Writer, units []Unit,
+	baseHP map[wits.FriendlyEnum]wits.BaseHealth, fogged, ranges map[[2]int]bool) error {
+	out := bufio.NewWriter(w)
+	size := renderer.TileSize
+	width, height := renderer.Size()
+	fmt.Fprintf(out, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.1f %.1f" font-family="sans-serif" text-anchor="middle">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(out, "<title>%s</title>\n", escape(string(renderer.name)))
+
+	out.WriteString(`<g class="tiles">` + "\n")
+	for _, tile := range renderer.tiles {
+		fill, stroke := FLOOR_COLOR, EDGE_COLOR
+		switch tile.Kind {
+		case TILE_WALL:
+			fill = WALL_COLOR
+		case TILE_BONUS:
+			fill = BONUS_COLOR
+		case TILE_BASE:
+			fill = TeamColor(tile.Team)
+		}
+		fmt.Fprintf(out, `<polygon class="%s" points="%s" fill="%s" stroke="%s"/>`+"\n",
+			tile.Kind, renderer.points(tile.Coord, 1), rgb(fill), rgb(stroke))
+		if tile.Kind == TILE_SPAWN {
+			fmt.Fprintf(out, `<polygon class="spawn-ring" points="%s" fill="none" stroke="%s" stroke-width="%.1f"/>`+"\n",
+				renderer.points(tile.Coord, 0.8), rgb(TeamColor(tile.Team)), size/8)
+		}
+	}
+	out.WriteString("</g>\n")
+
+	if len(ranges) > 0 {
+		out.WriteString(`<g class="ranges">` + "\n")
+		for _, tile := range renderer.tiles {
+			if ranges[[2]int{tile.Coord.I(), tile.Coord.J()}] {
+				fmt.Fprintf(out, `<polygon points="%s" %s/>`+"\n",
+					renderer.points(tile.Coord, 0.9), fill(RANGE_COLOR))
+			}
+		}
+		out.WriteString("</g>\n")
+	}
+
+	if baseHP != nil {
+		out.WriteString(`<g class="bases" fill="white" font-weight="bold">` + "\n")
+		for _, tile := range renderer.tiles {
+			if tile.Kind == TILE_BASE {
+				x, y := renderer.Center(tile.Coord)
+				fmt.Fprintf(out, `<text x="%.1f" y="%.1f" font-size="%.1f">%d</text>`+"\n",
+					x, y+size*0.25, size*0.7, baseHP[tile.Team])
+			}
+		}
+		out.WriteString("</g>\n")
+	}
+
+	out.WriteString(`<g class="units">` + "\n")
+	for _, unit := range units {
+		renderer.writeUnit(out, unit)
+	}
+	out.WriteString("</g>\n")
+
+	if len(fogged) > 0 {
+		out.WriteString(`<g class="fog">` + "\n")
+		for _, tile := range renderer.tiles {
+			if fogged[[2]int{tile.Coord.I(), tile.Coord.J()}] {
+				fmt.Fprintf(out, `<polygon points="%s" %s/>`+"\n",
+					renderer.points(tile.Coord, 1), fill(FOG_COLOR))
+			}
+		}
+		out.WriteString("</g>\n")
+	}
+
+	if renderer.Coords {
+		fmt.Fprintf(out, `<g class="coords" fill="%s" font-size="%.1f">`+"\n", rgb(EDGE_COLOR), size*0.3)
+		for _, tile := range renderer.tiles {
+			x, y := renderer.Center(tile.Coord)
+			fmt.Fprintf(out, `<text x="%.1f" y="%.1f">%d:%d</text>`+"\n",
+				x, y-size*0.55, tile.Coord.I(), tile.Coord.J())
+		}
+		out.WriteString("</g>\n")
+	}
+
+	out.WriteString("</svg>\n")
+	return out.Flush()
+}
+
+// A unit is a circle in its team's color with its glyph, and a pip for each
+// point of health (hollow for the health it is missing).  Units in their
+// alternate state have a dashed outline.
+func (renderer *Renderer) writeUnit(out *bufio.Writer, unit Unit) {
+	size := renderer.TileSize
+	x, y := renderer.Center(unit.Coord)
+	fmt.Fprintf(out, `<g class="unit" transform="translate(%.1f %.1f)">`, x, y)
+	dash := ""
+	if unit.Alternate {
+		dash = fmt.Sprintf(` stroke-dasharray="%.1f"`, size/8)
+	}
+	fmt.Fprintf(out, `<circle r="%.1f" fill="%s" stroke="%s" stroke-width="%.1f"%s/>`,
+		size*0.5, rgb(TeamColor(unit.Team)), rgb(WALL_COLOR), size/16, dash)
+	fmt.Fprintf(out, `<text y="%.1f" fill="white" font-size="%.1f" font-weight="bold">%s</text>`,
+		size*0.17, size*0.5, escape(Glyph(unit.Class, unit.Race)))
+	pips := max(int(unit.Health), int(state.HealthForUnit(unit.Class)))
+	for i := range pips {
+		pipFill := "white"
+		if i >= int(unit.Health) {
+			pipFill = "none"
+		}
+		fmt.Fprintf(out, `<circle cx="%.1f" cy="%.1f" r="%.1f" fill="%s" stroke="%s"/>`,
+			(float64(i)-float64(pips-1)/2)*size*0.22, size*0.68, size*0.08,
+			pipFill, rgb(WALL_COLOR))
+	}
+	out.WriteString("</g>\n")
+}
+
+func (kind TileKind) String() string {
+	switch kind {
+	case TILE_WALL:
+		return "wall"
+	case TILE_BONUS:
+		return "bonus"
+	case TILE_SPAWN:
+		return "spawn"
+	case TILE_BASE:
+		return "base"
+	}
+	return "floor"
+}
+
+// The corners of the hexagon, scaled, in the format of the points attribute.
+func (renderer *Renderer) points(coord wits.HexCoord, scale float64) string {
+	corners := renderer.Corners(coord, scale)
+	points := make([]string, len(corners))
+	for i, corner := range corners {
+		points[i] = fmt.Sprintf("%.1f,%.1f", corner[0], corner[1])
+	}
+	return strings.Join(points, " ")
+}
+
+func rgb(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// The fill attributes for a translucent color.
+func fill(c color.RGBA) string {
+	return fmt.Sprintf(`fill="%s" fill-opacity="%.2f"`, rgb(c), float64(c.A)/0xFF)
+}
+
+func escape(text string) string {
+	var escaped strings.Builder
+	xml.EscapeText(&escaped, []byte(text))
+	return escaped.String()
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/render/text.go
+
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/notation"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Each tile is drawn as three characters: a unit as its class letter (as in
+// package notation, lowercase in its alternate state), team and health; a
+// base as B, its team and HP; a spawn as its team in parentheses; and
+//
+//	" . " floor  " $ " bonus  "###" wall  " : " fog  " * " movement range
+//
+// Columns of tiles are offset by half a tile (one line), as hexagons are.
+const (
+	TEXT_FLOOR = " . "
+	TEXT_BONUS = " $ "
+	TEXT_WALL  = "###"
+	TEXT_FOG   = " : "
+	TEXT_RANGE = " * "
+)
+
+// Writes the game state as text for the terminal: a line with the turn and the
+// side to move, a line for each team's wits and base HP, then the board.
+func (renderer *Renderer) Text(w io.Writer, game *state.GameState) error {
+	fogged, ranges, err := renderer.shading(game)
+	if err != nil {
+		return err
+	}
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "turn %d, %s to move", game.Turn(), witsjson.FriendlyEnumJSON(game.Current()))
+	if game.IsOver() {
+		fmt.Fprintf(out, ", result %d", int(game.Result()))
+	}
+	out.WriteString("\n")
+	for i := range game.PlayerCount() {
+		team := wits.FriendlyEnum(i + 1)
+		player := game.Player(team)
+		fmt.Fprintf(out, "%-6s %-11s wits %2d  base %d\n", witsjson.FriendlyEnumJSON(team),
+			player.Race, player.Wits, player.BaseHP)
+	}
+
+	units := make(map[[2]int]Unit)
+	for _, unit := range renderer.unitsOf(game) {
+		units[[2]int{unit.Coord.I(), unit.Coord.J()}] = unit
+	}
+	cells := make(map[[2]int]string, len(renderer.tiles))
+	for _, tile := range renderer.tiles {
+		key := [2]int{tile.Coord.I(), tile.Coord.J()}
+		cell := TEXT_FLOOR
+		switch tile.Kind {
+		case TILE_WALL:
+			cell = TEXT_WALL
+		case TILE_BONUS:
+			cell = TEXT_BONUS
+		case TILE_SPAWN:
+			cell = fmt.Sprintf("(%d)", tile.Team)
+		case TILE_BASE:
+			cell = fmt.Sprintf("B%d%d", tile.Team, min(game.BaseHP(tile.Team), 9))
+		}
+		if unit, found := units[key]; found {
+			letter := string(notation.CLASS_LETTERS[unit.Class])
+			if unit.Alternate {
+				letter = strings.ToLower(letter)
+			}
+			cell = fmt.Sprintf("%s%d%d", letter, unit.Team, unit.Health)
+		} else if fogged[key] {
+			cell = TEXT_FOG
+		} else if ranges[key] {
+			cell = TEXT_RANGE
+		}
+		cells[key] = cell
+	}
+	out.Write(renderer.board(cells))
+	return out.Flush()
+}
+
+// Lays out the cells (by coordinate) as lines of text, each column is four
+// characters wide and two lines per tile, offset by a line from the next.
+func (renderer *Renderer) board(cells map[[2]int]string) []byte {
+	type placed struct {
+		line, column int
+		cell         string
+	}
+	all := make([]placed, 0, len(renderer.tiles))
+	minLine, minColumn, maxLine, maxColumn := 0, 0, 0, 0
+	for i, tile := range renderer.tiles {
+		axial := state.ToAxial(tile.Coord, renderer.legacy)
+		line, column := 2*axial[1]+axial[0], 4*axial[0]
+		if i == 0 {
+			minLine, maxLine, minColumn, maxColumn = line, line, column, column
+		}
+		minLine, maxLine = min(minLine, line), max(maxLine, line)
+		minColumn, maxColumn = min(minColumn, column), max(maxColumn, column)
+		all = append(all, placed{line, column, cells[[2]int{tile.Coord.I(), tile.Coord.J()}]})
+	}
+	lines := make([][]byte, maxLine-minLine+1)
+	for i := range lines {
+		lines[i] = bytes.Repeat([]byte{' '}, maxColumn-minColumn+3)
+	}
+	for _, tile := range all {
+		copy(lines[tile.line-minLine][tile.column-minColumn:], tile.cell)
+	}
+	var board bytes.Buffer
+	for _, line := range lines {
+		board.Write(bytes.TrimRight(line, " "))
+		board.WriteByte('\n')
+	}
+	return board.Bytes()
+}
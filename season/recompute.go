@@ -0,0 +1,163 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/season/recompute.go
+
+package season
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/archive"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/playerrole"
+	"github.com/kevindamm/wits-go/ent/standing"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Standings are written in batches of this size.
+const BATCH_SIZE = 100
+
+// Recomputes every season's standings by recording the ranked matches (those
+// with a season, see Match.season) in the order they were played.  Seasons
+// follow one another, each begins with the tiers that the previous one ended
+// with.  The previous standings are replaced, returning the number of matches
+// that were recorded.
+func Recompute(ctx context.Context, client *ent.Client) (int, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := recompute(ctx, tx.Client())
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	return count, tx.Commit()
+}
+
+func recompute(ctx context.Context, client *ent.Client) (int, error) {
+	if _, err := client.Standing.Delete().Exec(ctx); err != nil {
+		return 0, err
+	}
+	matches, err := client.Match.Query().
+		Where(match.SeasonGT(0)).
+		WithRoles(func(query *ent.PlayerRoleQuery) {
+			query.Order(playerrole.ByTurnOrder())
+		}).
+		Order(match.BySeason(), archive.ByPlayed()).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var current *Season
+	recorded := 0
+	for _, played := range matches {
+		if current == nil {
+			current = New(int(played.Season))
+		}
+		for current.Number < int(played.Season) {
+			if err := store(ctx, client, current); err != nil {
+				return 0, err
+			}
+			current, _ = current.End()
+		}
+		roles := make([]wits.PlayerRole, len(played.Edges.Roles))
+		for i, role := range played.Edges.Roles {
+			roles[i] = witsjson.PlayerRoleJSON{
+				PlayerID: witsjson.PlayerID{GCID_: playerKey(role.PlayerID)},
+				Team_:    witsjson.FriendlyEnumJSON(role.TurnOrder),
+				Result_:  witsjson.TerminalStatusJSON(role.Result),
+			}
+		}
+		if _, err := current.Record(roles); err != nil {
+			return 0, err
+		}
+		recorded += 1
+	}
+	if current != nil {
+		if err := store(ctx, client, current); err != nil {
+			return 0, err
+		}
+	}
+	return recorded, nil
+}
+
+// Players are identified within a season by their entity ID, because not every
+// player has a GCID (such as those who played on this server).
+func playerKey(id int) wits.GCID {
+	return wits.GCID(strconv.Itoa(id))
+}
+
+// Stores the standings of every player in the season.
+func store(ctx context.Context, client *ent.Client, season *Season) error {
+	creates := make([]*ent.StandingCreate, 0, len(season.players))
+	for _, tier := range TIERS {
+		for number, table := range season.Leagues(tier) {
+			for _, placed := range table {
+				id, err := strconv.Atoi(string(placed.Player))
+				if err != nil {
+					return err
+				}
+				creates = append(creates, client.Standing.Create().
+					SetSeason(int8(season.Number)).
+					SetTier(standing.Tier(tier)).
+					SetLeague(number).
+					SetRank(int(placed.Rank)).
+					SetPoints(placed.Points).
+					SetWins(placed.Wins).
+					SetLosses(placed.Losses).
+					SetPlayerID(id))
+			}
+		}
+	}
+	for start := 0; start < len(creates); start += BATCH_SIZE {
+		batch := creates[start:min(start+BATCH_SIZE, len(creates))]
+		if err := client.Standing.CreateBulk(batch...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The standings of a season, ordered by tier (highest first), league and rank,
+// with their player entity loaded.  The tier is optional.
+func Leagues(ctx context.Context, client *ent.Client, number int8,
+	tier wits.LeagueTier, limit int, offset int) ([]*ent.Standing, error) {
+	query := client.Standing.Query().
+		Where(standing.SeasonEQ(number))
+	if len(tier) > 0 {
+		query = query.Where(standing.TierEQ(standing.Tier(tier)))
+	}
+	return query.
+		WithPlayer().
+		Order(func(selector *sql.Selector) {
+			levels := make([]string, len(TIERS))
+			for level, tier := range TIERS {
+				levels[level] = fmt.Sprintf("WHEN '%s' THEN %d", tier, level)
+			}
+			selector.OrderExpr(sql.ExprP(fmt.Sprintf("CASE %s %s END DESC",
+				selector.C(standing.FieldTier), strings.Join(levels, " "))))
+		}, standing.ByLeague(), standing.ByRank()).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/season/recompute_test.go
+
+package season_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/enttest"
+	"github.com/kevindamm/wits-go/ent/standing"
+	"github.com/kevindamm/wits-go/season"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRecompute(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	players := make(map[string]*ent.Player)
+	opponents := []string{"bob", "carol", "dave"}
+	for _, name := range append(opponents, "alice") {
+		players[name] = client.Player.Create().SetName(name).SaveX(ctx)
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	played := 0
+	record := func(number int8, winner, loser string) {
+		recorded := client.Match.Create().
+			SetMatchHash(string(rune('a' + played))).
+			SetVersion(1603).
+			SetSeason(number).
+			SetTurnCount(10).
+			SetCreatedTs(start.Add(time.Duration(played) * time.Hour)).
+			SaveX(ctx)
+		played += 1
+		for i, name := range []string{winner, loser} {
+			team := wits.FriendlyEnum(i + 1)
+			result := wits.VICTORY_DESTRUCTION
+			if i == 1 {
+				result = result.Opposing()
+			}
+			client.PlayerRole.Create().
+				SetMatchID(recorded.ID).
+				SetPlayerID(players[name].ID).
+				SetPosition(int(team)).
+				SetTurnOrder(int(team)).
+				SetRace(wits.RACE_FEEDBACK).
+				SetResult(result).
+				AddMatch(recorded).
+				AddPlayers(players[name]).
+				ExecX(ctx)
+		}
+	}
+	// Alice wins enough of the first season to be promoted for the second, her
+	// opponents have not played enough to be promoted.
+	for i := range season.MIN_GAMES {
+		record(1, "alice", opponents[i%len(opponents)])
+	}
+	record(0, "bob", "alice")
+	record(2, "bob", "alice")
+
+	for range 2 {
+		count, err := season.Recompute(ctx, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != season.MIN_GAMES+1 {
+			t.Errorf("expected %d ranked matches, got %d", season.MIN_GAMES+1, count)
+		}
+	}
+
+	first, err := season.Leagues(ctx, client, 1, "", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 4 || first[0].Edges.Player.Name != "alice" || first[0].Rank != 1 ||
+		first[0].Wins != season.MIN_GAMES || first[0].Points != season.MIN_GAMES*season.WIN_POINTS {
+		t.Errorf("unexpected final standings of the first season %v", first)
+	}
+
+	second, err := season.Leagues(ctx, client, 2, "", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(second) != 4 ||
+		second[0].Edges.Player.Name != "alice" || second[0].Tier != standing.TierIntermediate ||
+		second[1].Edges.Player.Name != "bob" || second[1].Tier != standing.TierNovice {
+		t.Fatalf("expected alice to be promoted above bob, got %v", second)
+	}
+	if second[1].Points != season.WIN_POINTS+season.TIER_POINTS || second[0].Points != 0 {
+		t.Errorf("expected bob's upset to be worth more, got %v", second)
+	}
+
+	novices, err := season.Leagues(ctx, client, 2, wits.LEAGUE_TIER_NOVICE, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(novices) != 3 || novices[0].Edges.Player.Name != "bob" {
+		t.Errorf("expected bob to lead the novice tier, got %v", novices)
+	}
+}
+
+func TestRecompute_Backfill(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	carol := client.Player.Create().SetName("carol").SaveX(ctx)
+	dave := client.Player.Create().SetName("dave").SaveX(ctx)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Carol won and then lost, but the loss was ingested first.
+	for i, winner := range []*ent.Player{dave, carol} {
+		recorded := client.Match.Create().
+			SetMatchHash(string(rune('a' + i))).
+			SetVersion(1603).
+			SetSeason(1).
+			SetTurnCount(10).
+			SetPlayedTs(start.Add(time.Duration(1-i) * time.Hour)).
+			SetCreatedTs(start.Add(time.Duration(24+i) * time.Hour)).
+			SaveX(ctx)
+		loser := carol
+		if winner == carol {
+			loser = dave
+		}
+		for j, player := range []*ent.Player{winner, loser} {
+			result := wits.VICTORY_DESTRUCTION
+			if j == 1 {
+				result = result.Opposing()
+			}
+			client.PlayerRole.Create().
+				SetMatchID(recorded.ID).
+				SetPlayerID(player.ID).
+				SetPosition(j + 1).
+				SetTurnOrder(j + 1).
+				SetRace(wits.RACE_FEEDBACK).
+				SetResult(result).
+				AddMatch(recorded).
+				AddPlayers(player).
+				ExecX(ctx)
+		}
+	}
+	if _, err := season.Recompute(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	standings, err := season.Leagues(ctx, client, 1, "", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Dave's points cannot go below zero before his win.
+	if len(standings) != 2 ||
+		standings[0].Edges.Player.Name != "dave" || standings[0].Points != season.WIN_POINTS ||
+		standings[1].Points != season.WIN_POINTS-season.LOSS_POINTS {
+		t.Errorf("expected the matches to be recorded in the order they were played, got %v", standings)
+	}
+}
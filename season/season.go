@@ -0,0 +1,309 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/season/season.go
+
+package season
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The ranked tiers, from lowest to highest.  Unranked players are placed in
+// the lowest tier when they play their first ranked match.
+var TIERS = []wits.LeagueTier{
+	wits.LEAGUE_TIER_NOVICE,
+	wits.LEAGUE_TIER_INTERMEDIATE,
+	wits.LEAGUE_TIER_ADVANCED,
+	wits.LEAGUE_TIER_EXPERT,
+}
+
+const (
+	// Each tier is divided into leagues of at most this many players.
+	LEAGUE_SIZE = 100
+
+	// Rank points for a win or a loss against opponents of the same tier.
+	WIN_POINTS  = 20
+	LOSS_POINTS = 15
+
+	// The adjustment for each tier the opponents are above (or below) the
+	// player, and the least number of points a match can be worth.
+	TIER_POINTS = 5
+	MIN_POINTS  = 5
+
+	// At season end, players ranked this high in their league are promoted if
+	// they have played enough matches, and players ranked this low (or lower)
+	// are relegated.
+	PROMOTION_RANK  = 10
+	RELEGATION_RANK = 91
+	MIN_GAMES       = 5
+)
+
+// A ranked season, tracking the league and rank points of each player.
+type Season struct {
+	Number  int
+	players map[wits.GCID]*entry
+	leagues map[wits.LeagueTier][]*league
+}
+
+type league struct {
+	members []*entry
+}
+
+type entry struct {
+	player wits.GCID
+	tier   wits.LeagueTier
+	league *league
+	Record
+}
+
+// The rank points and match record of a player in the current season.
+type Record struct {
+	Points int `json:"points"`
+	Wins   int `json:"wins"`
+	Losses int `json:"losses"`
+}
+
+func New(number int) *Season {
+	return &Season{
+		Number:  number,
+		players: make(map[wits.GCID]*entry),
+		leagues: make(map[wits.LeagueTier][]*league),
+	}
+}
+
+// Adds the player to the first league in the tier that has room for them.
+// Players who are already placed are not moved.
+func (season *Season) Place(player wits.GCID, tier wits.LeagueTier) error {
+	if tierLevel(tier) < 0 {
+		return fmt.Errorf("cannot place %s in tier %q", player, tier)
+	}
+	if _, found := season.players[player]; found {
+		return nil
+	}
+	placed := &entry{player: player, tier: tier}
+	season.players[player] = placed
+	season.join(placed)
+	return nil
+}
+
+func (season *Season) join(placed *entry) {
+	leagues := season.leagues[placed.tier]
+	if len(leagues) == 0 || len(leagues[len(leagues)-1].members) >= LEAGUE_SIZE {
+		leagues = append(leagues, &league{})
+		season.leagues[placed.tier] = leagues
+	}
+	placed.league = leagues[len(leagues)-1]
+	placed.league.members = append(placed.league.members, placed)
+}
+
+// The player's tier and rank within their league, Unranked if not placed.
+func (season *Season) Standings(player wits.GCID) witsjson.PlayerStandingsJSON {
+	placed, found := season.players[player]
+	if !found {
+		return witsjson.PlayerStandingsJSON{
+			Tier_: witsjson.LeagueTierJSON(wits.LEAGUE_TIER_UNRANKED)}
+	}
+	return witsjson.PlayerStandingsJSON{
+		Tier_: witsjson.LeagueTierJSON(placed.tier),
+		Rank_: placed.league.rank(placed),
+	}
+}
+
+// The standings of each player before and after a match.
+type MatchStandings struct {
+	Player wits.GCID                    `json:"gcID"`
+	Before witsjson.PlayerStandingsJSON `json:"before"`
+	After  witsjson.StandingsAfterJSON  `json:"after"`
+}
+
+// Applies the result of a ranked match to the rank points of its players,
+// placing any unranked players first.  The after-standings are in the same
+// order as the roles, their Delta is the change in rank points.  A match
+// without a decisive result does not change any standings.
+func (season *Season) Record(roles []wits.PlayerRole) ([]MatchStandings, error) {
+	for _, role := range roles {
+		if err := season.Place(role.PlayerKey(), TIERS[0]); err != nil {
+			return nil, err
+		}
+	}
+	standings := make([]MatchStandings, len(roles))
+	deltas := make([]int, len(roles))
+	for i, role := range roles {
+		standings[i].Player = role.PlayerKey()
+		standings[i].Before = season.Standings(role.PlayerKey())
+		won, decided := outcome(role.Result())
+		if !decided {
+			continue
+		}
+
+		// Points are based on the average tier of the opposing side.
+		placed := season.players[role.PlayerKey()]
+		side := state.Side(role.Team())
+		opponents, levels := 0, 0
+		for _, other := range roles {
+			if state.Side(other.Team()) != side {
+				opponents += 1
+				levels += tierLevel(season.players[other.PlayerKey()].tier)
+			}
+		}
+		difference := 0
+		if opponents > 0 {
+			difference = levels/opponents - tierLevel(placed.tier)
+		}
+		if won {
+			deltas[i] = max(WIN_POINTS+TIER_POINTS*difference, MIN_POINTS)
+		} else {
+			deltas[i] = -max(LOSS_POINTS-TIER_POINTS*difference, MIN_POINTS)
+		}
+	}
+
+	for i, role := range roles {
+		placed := season.players[role.PlayerKey()]
+		if won, decided := outcome(role.Result()); decided {
+			before := placed.Points
+			placed.Points = max(placed.Points+deltas[i], 0)
+			deltas[i] = placed.Points - before
+			if won {
+				placed.Wins += 1
+			} else {
+				placed.Losses += 1
+			}
+		}
+	}
+	for i, role := range roles {
+		after := season.Standings(role.PlayerKey())
+		standings[i].After = witsjson.StandingsAfterJSON{
+			Tier_:  after.Tier_,
+			Rank_:  after.Rank_,
+			Delta_: deltas[i],
+		}
+	}
+	return standings, nil
+}
+
+// Whether the result was a win, and whether it was decisive at all.
+func outcome(result wits.TerminalStatus) (won bool, decided bool) {
+	switch result {
+	case wits.VICTORY_DESTRUCTION, wits.VICTORY_EXTINCTION, wits.VICTORY_RESIGNATION:
+		return true, true
+	case wits.LOSS_DESTRUCTION, wits.LOSS_EXTINCTION, wits.LOSS_RESIGNATION:
+		return false, true
+	}
+	return false, false
+}
+
+// A player's position in a league table.
+type Standing struct {
+	Player wits.GCID       `json:"gcID"`
+	Tier   wits.LeagueTier `json:"tier"`
+	Rank   wits.LeagueRank `json:"rank"`
+	Record `json:"record"`
+}
+
+// The league tables of this tier, each ordered by rank.
+func (season *Season) Leagues(tier wits.LeagueTier) [][]Standing {
+	tables := make([][]Standing, 0, len(season.leagues[tier]))
+	for _, league := range season.leagues[tier] {
+		table := make([]Standing, 0, len(league.members))
+		for i, member := range league.ordered() {
+			table = append(table, Standing{
+				member.player, tier, wits.LeagueRank(i + 1), member.Record})
+		}
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+// The change in a player's tier at the end of a season.
+type Movement struct {
+	Player wits.GCID                    `json:"gcID"`
+	Before witsjson.PlayerStandingsJSON `json:"before"`
+	After  witsjson.PlayerStandingsJSON `json:"after"`
+}
+
+// Ends the season, promoting the top of each league to the next higher tier
+// and relegating the bottom to the next lower tier.  Returns the following
+// season, where rank points are reset and the players of each tier are
+// regrouped into leagues, along with the final standings of every player
+// whose tier changed (their after-standings are from the new season).
+func (season *Season) End() (*Season, []Movement) {
+	next := New(season.Number + 1)
+	moved := make([]Movement, 0)
+	final := make([]Standing, 0, len(season.players))
+	for _, tier := range TIERS {
+		for _, table := range season.Leagues(tier) {
+			final = append(final, table...)
+		}
+	}
+	// Players are placed in the order of their final points, so that the
+	// strongest players of each tier begin the next season together.
+	order := slices.Clone(final)
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].Points > order[j].Points
+	})
+
+	changed := make(map[wits.GCID]bool)
+	for _, standing := range order {
+		level := tierLevel(standing.Tier)
+		switch {
+		case standing.Rank <= PROMOTION_RANK &&
+			standing.Wins+standing.Losses >= MIN_GAMES && level+1 < len(TIERS):
+			level += 1
+		case standing.Rank >= RELEGATION_RANK && level > 0:
+			level -= 1
+		}
+		next.Place(standing.Player, TIERS[level])
+		changed[standing.Player] = TIERS[level] != standing.Tier
+	}
+	for _, standing := range final {
+		if changed[standing.Player] {
+			moved = append(moved, Movement{
+				Player: standing.Player,
+				Before: witsjson.PlayerStandingsJSON{
+					Tier_: witsjson.LeagueTierJSON(standing.Tier),
+					Rank_: standing.Rank},
+				After: next.Standings(standing.Player),
+			})
+		}
+	}
+	return next, moved
+}
+
+// Members in order of rank: by points, then wins, then the order they joined.
+func (league *league) ordered() []*entry {
+	ordered := slices.Clone(league.members)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Points != ordered[j].Points {
+			return ordered[i].Points > ordered[j].Points
+		}
+		return ordered[i].Wins > ordered[j].Wins
+	})
+	return ordered
+}
+
+func (league *league) rank(member *entry) wits.LeagueRank {
+	return wits.LeagueRank(slices.Index(league.ordered(), member) + 1)
+}
+
+// The index of the tier in TIERS, or -1 if it is not a ranked tier.
+func tierLevel(tier wits.LeagueTier) int {
+	return slices.Index(TIERS, tier)
+}
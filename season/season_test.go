@@ -0,0 +1,147 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/season/season_test.go
+
+package season_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/season"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func role(player string, team wits.FriendlyEnum, result wits.TerminalStatus) wits.PlayerRole {
+	return witsjson.PlayerRoleJSON{
+		PlayerID: witsjson.PlayerID{GCID_: wits.GCID(player)},
+		Name_:    player,
+		Team_:    witsjson.FriendlyEnumJSON(team),
+		Result_:  witsjson.TerminalStatusJSON(result),
+	}
+}
+
+func TestSeason_Record(t *testing.T) {
+	current := season.New(1)
+	if tier := current.Standings("G:alice").Tier(); tier != wits.LEAGUE_TIER_UNRANKED {
+		t.Errorf("expected an unplaced player to be unranked, got %s", tier)
+	}
+	current.Place("G:carol", wits.LEAGUE_TIER_INTERMEDIATE)
+
+	standings, err := current.Record([]wits.PlayerRole{
+		role("G:alice", wits.FR_SELF, wits.VICTORY_DESTRUCTION),
+		role("G:bob", wits.FR_ENEMY, wits.LOSS_DESTRUCTION),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if standings[0].Before.Tier() != wits.LEAGUE_TIER_NOVICE || standings[0].Before.Rank() != 1 {
+		t.Errorf("expected alice to be placed as a novice, got %v", standings[0].Before)
+	}
+	if standings[0].After.Delta() != season.WIN_POINTS || standings[0].After.Rank() != 1 {
+		t.Errorf("unexpected standings for the winner %v", standings[0].After)
+	}
+	// Rank points do not go below zero.
+	if standings[1].After.Delta() != 0 || standings[1].After.Rank() != 2 {
+		t.Errorf("unexpected standings for the loser %v", standings[1].After)
+	}
+
+	// Beating a higher tier is worth more, losing to a lower tier costs more.
+	standings, _ = current.Record([]wits.PlayerRole{
+		role("G:bob", wits.FR_SELF, wits.VICTORY_RESIGNATION),
+		role("G:carol", wits.FR_ENEMY, wits.LOSS_RESIGNATION),
+	})
+	if standings[0].After.Delta() != season.WIN_POINTS+season.TIER_POINTS {
+		t.Errorf("unexpected points for an upset %d", standings[0].After.Delta())
+	}
+	if standings[0].After.Rank() != 1 {
+		t.Errorf("expected bob to lead the novice league, got rank %d", standings[0].After.Rank())
+	}
+
+	// Undecided matches are not counted.
+	standings, _ = current.Record([]wits.PlayerRole{
+		role("G:alice", wits.FR_SELF, wits.DELAY_OF_GAME),
+		role("G:bob", wits.FR_ENEMY, wits.DELAY_OF_GAME),
+	})
+	if standings[0].After.Delta() != 0 || standings[1].After.Delta() != 0 {
+		t.Errorf("expected no change for a delay of game, got %v", standings)
+	}
+	if err := current.Place("G:dave", wits.LEAGUE_TIER_UNRANKED); err == nil {
+		t.Error("expected an error placing a player in the unranked tier")
+	}
+}
+
+func TestSeason_End(t *testing.T) {
+	current := season.New(3)
+	for i := range season.LEAGUE_SIZE + 20 {
+		current.Place(wits.GCID(fmt.Sprintf("G:%03d", i)), wits.LEAGUE_TIER_INTERMEDIATE)
+	}
+	current.Place("G:expert", wits.LEAGUE_TIER_EXPERT)
+	leagues := current.Leagues(wits.LEAGUE_TIER_INTERMEDIATE)
+	if len(leagues) != 2 || len(leagues[0]) != season.LEAGUE_SIZE || len(leagues[1]) != 20 {
+		t.Fatalf("expected leagues of 100 and 20 players")
+	}
+
+	// Each of the first ten wins enough matches to be promoted.
+	for i := range 10 {
+		for range season.MIN_GAMES {
+			current.Record([]wits.PlayerRole{
+				role(fmt.Sprintf("G:%03d", i), wits.FR_SELF, wits.VICTORY_DESTRUCTION),
+				role(fmt.Sprintf("G:%03d", i+50), wits.FR_ENEMY, wits.LOSS_DESTRUCTION),
+			})
+		}
+	}
+	// One player wins only once, and stays in the top ten of the second league.
+	current.Record([]wits.PlayerRole{
+		role("G:110", wits.FR_SELF, wits.VICTORY_DESTRUCTION),
+		role("G:111", wits.FR_ENEMY, wits.LOSS_DESTRUCTION),
+	})
+
+	next, moved := current.End()
+	if next.Number != 4 {
+		t.Errorf("expected the next season to be 4, got %d", next.Number)
+	}
+	promoted, relegated := 0, 0
+	for _, movement := range moved {
+		switch movement.After.Tier() {
+		case wits.LEAGUE_TIER_ADVANCED:
+			promoted += 1
+		case wits.LEAGUE_TIER_NOVICE:
+			relegated += 1
+			if movement.Before.Rank() < season.RELEGATION_RANK {
+				t.Errorf("relegated %s from rank %d", movement.Player, movement.Before.Rank())
+			}
+		default:
+			t.Errorf("unexpected movement %v", movement)
+		}
+	}
+	if promoted != 10 || relegated != 10 {
+		t.Errorf("expected 10 promoted and 10 relegated, got %d and %d", promoted, relegated)
+	}
+	if tier := next.Standings("G:110").Tier(); tier != wits.LEAGUE_TIER_INTERMEDIATE {
+		t.Errorf("promotion requires %d games, G:110 moved to %s", season.MIN_GAMES, tier)
+	}
+	if tier := next.Standings("G:expert").Tier(); tier != wits.LEAGUE_TIER_EXPERT {
+		t.Errorf("expected the expert to remain, got %s", tier)
+	}
+	for _, table := range next.Leagues(wits.LEAGUE_TIER_ADVANCED) {
+		for _, standing := range table {
+			if standing.Points != 0 || standing.Wins != 0 {
+				t.Errorf("expected rank points to be reset, got %v", standing)
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/bots.go
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The time each bot is given to choose its turn, by default.
+const BOT_BUDGET = time.Second
+
+type botRequest struct {
+	Bot  string                `json:"bot" binding:"required"`
+	Race witsjson.UnitRaceJSON `json:"race"`
+}
+
+// Fills the next open seat with the bot, named after the bot and its team.
+func (game *liveGame) addBot(player bot.Bot, name string, race witsjson.UnitRaceJSON) error {
+	team, err := game.join(fmt.Sprintf("%s-bot-%d", name, len(game.seats)+1), race)
+	if err != nil {
+		return err
+	}
+	game.seats[team-1].Bot = name
+	game.seats[team-1].token = ""
+	if game.bots == nil {
+		game.bots = make(map[wits.FriendlyEnum]bot.Bot)
+	}
+	game.bots[team] = player
+	return nil
+}
+
+// The bot that plays the next turn, if the game is in progress and a bot is
+// next to play.
+func (game *liveGame) nextBot() (bot.Bot, bool) {
+	if game.state == nil || game.state.IsOver() || game.evicted {
+		return nil, false
+	}
+	player, found := game.bots[game.state.Current()]
+	return player, found
+}
+
+// Starts playing the turns of the bots that are next to play, unless they are
+// already playing.  Called while holding the game's lock, the bots play in the
+// background (see playBots).
+func (server *Server) startBots(game *liveGame) {
+	if game.botsPlaying {
+		return
+	}
+	if _, found := game.nextBot(); !found {
+		return
+	}
+	game.botsPlaying = true
+	go server.playBots(game)
+}
+
+// Plays the turns of any bots that are next to play, until it is a person's
+// turn or the game has ended.  Each bot plays on a copy of the state, the
+// game's lock is only held between the bots' turns so that the game can be
+// viewed and resigned while they are choosing.
+func (server *Server) playBots(game *liveGame) {
+	game.mutex.Lock()
+	defer game.mutex.Unlock()
+	defer func() { game.botsPlaying = false }()
+
+	for {
+		player, found := game.nextBot()
+		if !found {
+			return
+		}
+		position := game.state.Clone()
+		turn, team := position.Turn(), position.Current()
+		game.mutex.Unlock()
+		applied, err := bot.PlayTurn(position, player, server.BotBudget)
+		game.mutex.Lock()
+		if err != nil {
+			log.Printf("game %s: %s turn %d: %v", game.id, player.Name(), turn, err)
+		}
+
+		if game.evicted || game.state.IsOver() || game.state.Turn() != turn {
+			// A player resigned while the bot was choosing.
+			return
+		}
+		game.state = position
+		if !game.state.IsOver() || len(applied) > 0 {
+			game.turns = append(game.turns, bot.TurnJSON(game.gamemap, turn, applied))
+		}
+		game.publishTurn(game.seats[team-1].Player, turn, botFailure(err))
+		if err := server.recordIfEnded(context.Background(), game); err != nil {
+			log.Print(err)
+		}
+		server.games.touch(game)
+	}
+}
+
+// Why a bot's turn ended early, as told to the players.  The details are only
+// logged, an illegal action would reveal what the bot chose to do.
+func botFailure(err error) string {
+	var illegal state.IllegalActionError
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, bot.ErrTimeout):
+		return "the bot ran out of time, the game ends in a delay of game"
+	case errors.As(err, &illegal):
+		return "the bot's turn ended at an illegal action"
+	}
+	return "the bot's turn ended with an error"
+}
+
+// POST /api/games/:id/bots
+//
+// Seats a bot (see bot.NAMES) in the game, for playing against the computer.
+// When the bot is next to play, the response comes before its turn does.
+func (server *Server) AddBot(ctx *gin.Context) {
+	var request botRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "%s", err))
+		return
+	}
+	player, err := server.NewBot(request.Bot, rand.Uint64())
+	if err != nil {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "%s", err))
+		return
+	}
+	server.withGame(ctx, func(game *liveGame) error {
+		if err := game.addBot(player, request.Bot, request.Race); err != nil {
+			return err
+		}
+		server.startBots(game)
+		ctx.JSON(http.StatusOK, game.response(wits.FR_UNKNOWN))
+		return nil
+	})
+}
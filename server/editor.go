@@ -0,0 +1,218 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/editor.go
+
+package server
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kevindamm/wits-go/mapedit"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Maps being edited are kept in memory, the editor's frontend saves them.
+type editorSessions struct {
+	mutex    sync.Mutex
+	sessions map[string]*editorSession
+}
+
+func newEditorSessions() *editorSessions {
+	return &editorSessions{sessions: make(map[string]*editorSession)}
+}
+
+type editorSession struct {
+	mutex  sync.Mutex
+	id     string
+	editor *mapedit.Editor
+}
+
+func (sessions *editorSessions) add(session *editorSession) {
+	sessions.mutex.Lock()
+	defer sessions.mutex.Unlock()
+	sessions.sessions[session.id] = session
+}
+
+func (sessions *editorSessions) get(id string) (*editorSession, error) {
+	sessions.mutex.Lock()
+	defer sessions.mutex.Unlock()
+	session, found := sessions.sessions[id]
+	if !found {
+		return nil, requestError(http.StatusNotFound, "editor %s not found", id)
+	}
+	return session, nil
+}
+
+// The map as edited so far, with the problems that keep it from being played
+// and the difference made by the latest request (if it made one).
+type editorResponse struct {
+	ID         string                  `json:"id"`
+	Definition *witsjson.MapDefinition `json:"definition"`
+	Problems   []string                `json:"problems,omitempty"`
+	Undo       int                     `json:"undo"`
+	Redo       int                     `json:"redo"`
+	Diff       *mapedit.Diff           `json:"diff,omitempty"`
+}
+
+func (session *editorSession) response(diff *mapedit.Diff) editorResponse {
+	undo, redo := session.editor.History()
+	return editorResponse{
+		ID:         session.id,
+		Definition: session.editor.Definition(),
+		Problems:   session.editor.Problems(),
+		Undo:       undo,
+		Redo:       redo,
+		Diff:       diff,
+	}
+}
+
+// Editing starts from one of the server's maps (by its short name) or from a
+// definition sent with the request.
+type editorRequest struct {
+	Map        string                  `json:"map"`
+	Definition *witsjson.MapDefinition `json:"definition"`
+}
+
+type compareRequest struct {
+	Before *witsjson.MapDefinition `json:"before" binding:"required"`
+	After  *witsjson.MapDefinition `json:"after" binding:"required"`
+}
+
+// POST /api/editor
+func (server *Server) CreateEditor(ctx *gin.Context) {
+	var request editorRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "%s", err))
+		return
+	}
+	definition := request.Definition
+	if definition == nil {
+		description, found := server.maps[request.Map]
+		if !found {
+			abortWithError(ctx, requestError(http.StatusNotFound, "map %s not found", request.Map))
+			return
+		}
+		definition = description.Definition()
+	}
+	session := &editorSession{id: newGameID(), editor: mapedit.NewEditor(definition)}
+	server.editors.add(session)
+	ctx.JSON(http.StatusCreated, session.response(nil))
+}
+
+// GET /api/editor/:id
+func (server *Server) GetEditor(ctx *gin.Context) {
+	server.withEditor(ctx, func(session *editorSession) error {
+		ctx.JSON(http.StatusOK, session.response(nil))
+		return nil
+	})
+}
+
+// POST /api/editor/:id/edits
+func (server *Server) EditMap(ctx *gin.Context) {
+	var edit mapedit.Edit
+	if err := ctx.ShouldBindJSON(&edit); err != nil {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "%s", err))
+		return
+	}
+	server.withEditor(ctx, func(session *editorSession) error {
+		diff, err := session.editor.Apply(edit)
+		if err != nil {
+			return requestError(http.StatusUnprocessableEntity, "%s", err)
+		}
+		ctx.JSON(http.StatusOK, session.response(&diff))
+		return nil
+	})
+}
+
+// GET /api/editor/:id/diff
+//
+// The differences made by all of the edits, since editing began.
+func (server *Server) GetEditorDiff(ctx *gin.Context) {
+	server.withEditor(ctx, func(session *editorSession) error {
+		ctx.JSON(http.StatusOK, session.editor.Changes())
+		return nil
+	})
+}
+
+// POST /api/editor/:id/diff
+//
+// Makes every change in the diff, as a single edit.
+func (server *Server) ApplyEditorDiff(ctx *gin.Context) {
+	var diff mapedit.Diff
+	if err := ctx.ShouldBindJSON(&diff); err != nil {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "%s", err))
+		return
+	}
+	server.withEditor(ctx, func(session *editorSession) error {
+		if err := session.editor.ApplyDiff(diff); err != nil {
+			return requestError(http.StatusConflict, "%s", err)
+		}
+		ctx.JSON(http.StatusOK, session.response(&diff))
+		return nil
+	})
+}
+
+// POST /api/editor/:id/undo
+func (server *Server) UndoEdit(ctx *gin.Context) {
+	server.withEditor(ctx, func(session *editorSession) error {
+		return session.respondWithHistory(ctx, session.editor.Undo)
+	})
+}
+
+// POST /api/editor/:id/redo
+func (server *Server) RedoEdit(ctx *gin.Context) {
+	server.withEditor(ctx, func(session *editorSession) error {
+		return session.respondWithHistory(ctx, session.editor.Redo)
+	})
+}
+
+func (session *editorSession) respondWithHistory(ctx *gin.Context, step func() (mapedit.Diff, error)) error {
+	diff, err := step()
+	if errors.Is(err, mapedit.ErrNothingToUndo) || errors.Is(err, mapedit.ErrNothingToRedo) {
+		return requestError(http.StatusConflict, "%s", err)
+	} else if err != nil {
+		return err
+	}
+	ctx.JSON(http.StatusOK, session.response(&diff))
+	return nil
+}
+
+// POST /api/editor/compare
+func (server *Server) CompareMaps(ctx *gin.Context) {
+	var request compareRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "%s", err))
+		return
+	}
+	ctx.JSON(http.StatusOK, mapedit.Compare(request.Before, request.After))
+}
+
+// Looks up the editing session named in the path and calls the handler while
+// holding its lock.  Errors returned by the handler abort the request.
+func (server *Server) withEditor(ctx *gin.Context, handler func(*editorSession) error) {
+	session, err := server.editors.get(ctx.Param("id"))
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	session.mutex.Lock()
+	defer session.mutex.Unlock()
+	if err := handler(session); err != nil {
+		abortWithError(ctx, err)
+	}
+}
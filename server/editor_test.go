@@ -0,0 +1,104 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/editor_test.go
+
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/kevindamm/wits-go/mapedit"
+)
+
+type editorResponse struct {
+	ID         string          `json:"id"`
+	Definition json.RawMessage `json:"definition"`
+	Problems   []string        `json:"problems"`
+	Undo       int             `json:"undo"`
+	Redo       int             `json:"redo"`
+	Diff       *mapedit.Diff   `json:"diff"`
+}
+
+func TestServer_Editor(t *testing.T) {
+	client, router := newTestServer(t, "")
+	defer client.Close()
+
+	var created editorResponse
+	if code := post(t, router, "/api/editor", `{"map": "peekaboo"}`, &created); code != http.StatusCreated {
+		t.Fatalf("POST /api/editor status %d", code)
+	}
+	if len(created.Problems) > 0 || created.Undo != 0 {
+		t.Errorf("unexpected new editor %+v", created)
+	}
+	if code := post(t, router, "/api/editor", `{"map": "nowhere"}`, nil); code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown map, found %d", code)
+	}
+	url := "/api/editor/" + created.ID
+
+	var edited editorResponse
+	edit := `{"op": "add_tile", "coord": [-2, 0], "kind": "SPAWN", "team": "RED"}`
+	if code := post(t, router, url+"/edits", edit, &edited); code != http.StatusOK {
+		t.Fatalf("POST %s/edits status %d", url, code)
+	}
+	if edited.Diff == nil || len(edited.Diff.Added) != 1 || edited.Diff.Added[0].Kind != mapedit.TILE_SPAWN {
+		t.Errorf("expected the spawn to be added, found %+v", edited.Diff)
+	}
+	if len(edited.Problems) == 0 || edited.Undo != 1 {
+		t.Errorf("expected a problem with the disconnected spawn and an edit to undo, found %+v", edited)
+	}
+	if code := post(t, router, url+"/edits", edit, nil); code != http.StatusUnprocessableEntity {
+		t.Errorf("expected 422 adding the tile again, found %d", code)
+	}
+
+	var changes mapedit.Diff
+	if code := get(t, router, url+"/diff", &changes); code != http.StatusOK || len(changes.Added) != 1 {
+		t.Errorf("expected the added spawn since editing began, found %d %+v", code, changes)
+	}
+
+	var undone editorResponse
+	if code := post(t, router, url+"/undo", "", &undone); code != http.StatusOK {
+		t.Fatalf("POST %s/undo status %d", url, code)
+	}
+	if undone.Diff == nil || len(undone.Diff.Removed) != 1 || undone.Undo != 0 || undone.Redo != 1 {
+		t.Errorf("expected the spawn to be removed, found %+v", undone)
+	}
+	if code := post(t, router, url+"/undo", "", nil); code != http.StatusConflict {
+		t.Errorf("expected 409 with nothing to undo, found %d", code)
+	}
+
+	// Comparing the map before and after the edit gives the same difference.
+	var compared mapedit.Diff
+	body := `{"before": ` + string(undone.Definition) + `, "after": ` + string(edited.Definition) + `}`
+	if code := post(t, router, "/api/editor/compare", body, &compared); code != http.StatusOK {
+		t.Fatalf("POST /api/editor/compare status %d", code)
+	}
+	if len(compared.Added) != 1 || compared.Added[0] != edited.Diff.Added[0] {
+		t.Errorf("expected the compared maps to differ by %+v, found %+v", edited.Diff, compared)
+	}
+	diff, _ := json.Marshal(compared)
+	var applied editorResponse
+	if code := post(t, router, url+"/diff", string(diff), &applied); code != http.StatusOK || applied.Undo != 1 {
+		t.Errorf("expected the diff to apply, found %d %+v", code, applied)
+	}
+	if code := post(t, router, url+"/diff", string(diff), nil); code != http.StatusConflict {
+		t.Errorf("expected 409 applying the diff twice, found %d", code)
+	}
+
+	if code := get(t, router, "/api/editor/nowhere", nil); code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown editor, found %d", code)
+	}
+}
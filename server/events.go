@@ -0,0 +1,281 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/events.go
+
+package server
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The kinds of events that are pushed to a game's subscribers.
+const (
+	EVENT_TURN  = "turn"
+	EVENT_ENDED = "ended"
+	EVENT_CHAT  = "chat"
+)
+
+// Spectators follow the game this many turns behind the players, so that they
+// cannot relay what they see to either side.
+const SPECTATOR_DELAY = 2
+
+// Subscribers receive at most this many undelivered events before they are
+// considered too slow and are disconnected.
+const SUBSCRIBER_BUFFER = 32
+
+// An event in a hosted game, as seen by one of its subscribers.
+type GameEvent struct {
+	Type    string          `json:"type"`
+	Turn    uint            `json:"turn"`
+	Player  string          `json:"player,omitempty"`
+	Message string          `json:"message,omitempty"`
+	State   *state.GameView `json:"state,omitempty"`
+
+	// The state just after the event, from which each subscriber's view is made.
+	snapshot *state.GameState
+}
+
+// The event as seen from this team's perspective.  The complete state is seen
+// once the game has ended, with the result relative to the team.
+func (event GameEvent) viewedBy(team wits.FriendlyEnum) GameEvent {
+	if event.snapshot == nil {
+		return event
+	}
+	var view state.GameView
+	if event.snapshot.IsOver() {
+		view = event.snapshot.View()
+		view.Result = witsjson.TerminalStatusJSON(event.snapshot.ResultFor(team))
+	} else {
+		view = event.snapshot.ViewFor(team)
+	}
+	event.State = &view
+	return event
+}
+
+// Players receive events as they happen, spectators receive them after the
+// delay, both seeing the state through the fog of war of their team.
+type subscriber struct {
+	team      wits.FriendlyEnum
+	spectator bool
+	events    chan GameEvent
+}
+
+// The subscribers of a single game.  Its methods are only called while holding
+// the game's lock.
+type gameFeed struct {
+	subscribers map[*subscriber]struct{}
+
+	// Events that spectators have not been sent yet, in the order they happened.
+	delayed []GameEvent
+}
+
+func (feed *gameFeed) subscribe(team wits.FriendlyEnum, spectator bool) *subscriber {
+	if feed.subscribers == nil {
+		feed.subscribers = make(map[*subscriber]struct{})
+	}
+	sub := &subscriber{team, spectator, make(chan GameEvent, SUBSCRIBER_BUFFER)}
+	feed.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (feed *gameFeed) unsubscribe(sub *subscriber) {
+	if _, found := feed.subscribers[sub]; found {
+		delete(feed.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// Ends every subscription, when the game is no longer hosted.
+func (feed *gameFeed) close() {
+	for sub := range feed.subscribers {
+		feed.unsubscribe(sub)
+	}
+}
+
+// Sends the event to the players now, and to the spectators when the game has
+// progressed far enough past the event's turn (or has ended).
+func (feed *gameFeed) publish(event GameEvent, turn uint, ended bool) {
+	for sub := range feed.subscribers {
+		if !sub.spectator {
+			feed.send(sub, event)
+		}
+	}
+
+	feed.delayed = append(feed.delayed, event)
+	released := 0
+	for _, delayed := range feed.delayed {
+		if !ended && delayed.Turn+SPECTATOR_DELAY > turn {
+			break
+		}
+		for sub := range feed.subscribers {
+			if sub.spectator {
+				feed.send(sub, delayed)
+			}
+		}
+		released += 1
+	}
+	feed.delayed = feed.delayed[released:]
+}
+
+// A subscriber that is not keeping up is dropped rather than blocking the game.
+func (feed *gameFeed) send(sub *subscriber, event GameEvent) {
+	select {
+	case sub.events <- event.viewedBy(sub.team):
+	default:
+		feed.unsubscribe(sub)
+	}
+}
+
+// Publishes the events for a turn that was just played, and for the end of
+// the game if it has ended.  The message says why the turn ended early, if it
+// did (see botFailure).
+func (game *liveGame) publishTurn(player string, turn uint, message string) {
+	snapshot := game.state.Clone()
+	game.feed.publish(GameEvent{
+		Type:     EVENT_TURN,
+		Turn:     turn,
+		Player:   player,
+		Message:  message,
+		snapshot: snapshot,
+	}, game.state.Turn(), false)
+	game.publishEnded()
+}
+
+func (game *liveGame) publishEnded() {
+	if !game.state.IsOver() {
+		return
+	}
+	game.feed.publish(GameEvent{
+		Type:     EVENT_ENDED,
+		Turn:     game.state.Turn(),
+		snapshot: game.state.Clone(),
+	}, game.state.Turn(), true)
+}
+
+func (game *liveGame) chat(team wits.FriendlyEnum, message string) error {
+	if len(message) == 0 {
+		return requestError(http.StatusBadRequest, "a message is required")
+	}
+	var turn uint
+	ended := false
+	if game.state != nil {
+		turn = game.state.Turn()
+		ended = game.state.IsOver()
+	}
+	game.feed.publish(GameEvent{
+		Type:    EVENT_CHAT,
+		Turn:    turn,
+		Player:  game.seats[team-1].Player,
+		Message: message,
+	}, turn, ended)
+	return nil
+}
+
+// Subscribes to the events of a hosted game.  The player holding a seat's
+// token receives events as they happen, from their team's perspective.
+// Without a token the subscriber is a spectator and receives them delayed, seen
+// from the perspective of the given team.  The events channel is closed when
+// the subscription is cancelled, if the subscriber falls too far behind, or
+// when the game is no longer hosted.
+func (server *Server) Subscribe(gameID string, token string, team wits.FriendlyEnum) (
+	events <-chan GameEvent, cancel func(), err error) {
+	game, err := server.games.get(gameID)
+	if err != nil {
+		return nil, nil, err
+	}
+	game.mutex.Lock()
+	defer game.mutex.Unlock()
+
+	seated, err := game.authorize(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	spectator := true
+	if seated != wits.FR_UNKNOWN {
+		team, spectator = seated, false
+	} else if team == wits.FR_UNKNOWN || int(team) > game.gamemap.RoleCount() {
+		team = wits.FR_SELF
+	}
+	sub := game.feed.subscribe(team, spectator)
+	cancel = func() {
+		game.mutex.Lock()
+		defer game.mutex.Unlock()
+		game.feed.unsubscribe(sub)
+	}
+	return sub.events, cancel, nil
+}
+
+// GET /api/games/:id/events?token=<seat token>&team=<color>
+//
+// Streams the game's events as server-sent events, named by the event type.
+func (server *Server) StreamEvents(ctx *gin.Context) {
+	team := wits.FriendlyEnum(witsjson.ParseTeam(ctx.Query("team")))
+	events, cancel, err := server.Subscribe(ctx.Param("id"), seatToken(ctx), team)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	defer cancel()
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Flush()
+	done := ctx.Request.Context().Done()
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case event, open := <-events:
+			if !open {
+				return false
+			}
+			ctx.SSEvent(event.Type, event)
+			return event.Type != EVENT_ENDED
+		case <-done:
+			return false
+		}
+	})
+}
+
+type chatRequest struct {
+	Message string `json:"message"`
+}
+
+// POST /api/games/:id/chat
+//
+// Requires the token of the player's seat.
+func (server *Server) PostChat(ctx *gin.Context) {
+	var request chatRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "%s", err))
+		return
+	}
+	server.withGame(ctx, func(game *liveGame) error {
+		team, err := game.seated(seatToken(ctx))
+		if err != nil {
+			return err
+		}
+		if err := game.chat(team, request.Message); err != nil {
+			return err
+		}
+		ctx.Status(http.StatusNoContent)
+		return nil
+	})
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/events_test.go
+
+package server_test
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/server"
+)
+
+func nextEvent(t *testing.T, events <-chan server.GameEvent) server.GameEvent {
+	t.Helper()
+	select {
+	case event, open := <-events:
+		if !open {
+			t.Fatalf("event channel was closed")
+		}
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for an event")
+	}
+	return server.GameEvent{}
+}
+
+func expectNoEvent(t *testing.T, events <-chan server.GameEvent) {
+	t.Helper()
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %v", event)
+	default:
+	}
+}
+
+// Reads the names of server-sent events until the stream ends.
+func readEventNames(body *bufio.Scanner, names chan<- []string) {
+	read := make([]string, 0)
+	for body.Scan() {
+		if name, found := strings.CutPrefix(body.Text(), "event:"); found {
+			read = append(read, name)
+		}
+	}
+	names <- read
+}
+
+func TestServer_Events(t *testing.T) {
+	client, service := newTestService(t, "")
+	defer client.Close()
+	router := service.Router()
+
+	var game gameResponse
+	post(t, router, "/api/games", `{"map": "peekaboo", "player": "alice", "race": "FEEDBACK"}`, &game)
+	aliceToken := game.Token
+	url := "/api/games/" + game.ID
+	post(t, router, url+"/join", `{"player": "carol", "race": "VEGGIENAUTS"}`, &game)
+	carolToken := game.Token
+
+	alice, cancel, err := service.Subscribe(game.ID, aliceToken, wits.FR_UNKNOWN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+	spectator, cancel, err := service.Subscribe(game.ID, "", wits.FR_ENEMY)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+	if _, _, err := service.Subscribe("nonexistent", "", wits.FR_UNKNOWN); err == nil {
+		t.Errorf("expected an error subscribing to an unknown game")
+	}
+	if _, _, err := service.Subscribe(game.ID, "forged", wits.FR_UNKNOWN); err == nil {
+		t.Errorf("expected an error subscribing with a forged token")
+	}
+
+	// The second player follows along over HTTP.
+	httpServer := httptest.NewServer(router)
+	defer httpServer.Close()
+	forged, err := http.Get(httpServer.URL + url + "/events?player=carol&token=forged")
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged.Body.Close()
+	if forged.StatusCode != http.StatusForbidden {
+		t.Errorf("GET %s/events with a forged token status %d, expected 403", url, forged.StatusCode)
+	}
+	response, err := http.Get(httpServer.URL + url + "/events?token=" + carolToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("GET %s/events status %d", url, response.StatusCode)
+	}
+	names := make(chan []string)
+	go readEventNames(bufio.NewScanner(response.Body), names)
+
+	if code := postAs(t, router, aliceToken, url+"/chat", `{"message": "glhf"}`, nil); code != http.StatusNoContent {
+		t.Fatalf("POST %s/chat status %d", url, code)
+	}
+	if code := post(t, router, url+"/chat", `{"player": "alice", "message": "hi"}`, nil); code != http.StatusUnauthorized {
+		t.Errorf("chat without a token status %d, expected 401", code)
+	}
+	if event := nextEvent(t, alice); event.Type != server.EVENT_CHAT || event.Message != "glhf" {
+		t.Errorf("expected the chat message, got %v", event)
+	}
+
+	postAs(t, router, aliceToken, url+"/turns", `{"actions": []}`, nil)
+	event := nextEvent(t, alice)
+	if event.Type != server.EVENT_TURN || event.Turn != 1 || event.Player != "alice" {
+		t.Errorf("expected alice's turn, got %v", event)
+	}
+	if event.State == nil || event.State.Turn != 2 {
+		t.Errorf("turn event should include the following state %v", event.State)
+	}
+	expectNoEvent(t, spectator)
+
+	// After two turns, the spectator catches up to the first turn.
+	postAs(t, router, carolToken, url+"/turns", `{"actions": []}`, nil)
+	nextEvent(t, alice)
+	if event := nextEvent(t, spectator); event.Type != server.EVENT_CHAT {
+		t.Errorf("spectator expected the chat first, got %v", event)
+	}
+	event = nextEvent(t, spectator)
+	if event.Type != server.EVENT_TURN || event.Turn != 1 {
+		t.Errorf("spectator expected the first turn, got %v", event)
+	}
+	for _, unit := range event.State.Units {
+		if unit.Team != 2 {
+			t.Errorf("spectator watching BLUE should not see through the fog: %v", unit)
+		}
+	}
+	expectNoEvent(t, spectator)
+
+	// The end of the game flushes the spectators' delayed events.
+	postAs(t, router, aliceToken, url+"/resign", `{}`, nil)
+	if event := nextEvent(t, alice); event.Type != server.EVENT_ENDED ||
+		event.State == nil || wits.TerminalStatus(event.State.Result) != wits.LOSS_RESIGNATION {
+		t.Errorf("expected the game to end, got %v", event)
+	}
+	if event := nextEvent(t, spectator); event.Type != server.EVENT_TURN || event.Turn != 2 {
+		t.Errorf("spectator expected the second turn, got %v", event)
+	}
+	if event := nextEvent(t, spectator); event.Type != server.EVENT_ENDED || len(event.State.Units) != 6 {
+		t.Errorf("spectator expected the complete final state, got %v", event)
+	}
+
+	select {
+	case read := <-names:
+		expected := []string{"chat", "turn", "turn", "ended"}
+		if strings.Join(read, ",") != strings.Join(expected, ",") {
+			t.Errorf("streamed events %v, expected %v", read, expected)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("event stream did not end with the game")
+	}
+}
@@ -0,0 +1,570 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/games.go
+
+package server
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Games in progress are hosted in memory.  They are recorded in the DB (and as
+// a replay, if there is a replay directory) once they have ended, and evicted
+// some time later.
+type lobby struct {
+	mutex sync.Mutex
+	games map[string]*liveGame
+
+	// When each game last changed, and when each recorded game ended.
+	active map[string]time.Time
+	ended  map[string]time.Time
+}
+
+// Ended games are kept for their players to see the result for this long, and
+// games where nothing has happened for IDLE_GAME_TTL are abandoned.
+const (
+	ENDED_GAME_TTL = 10 * time.Minute
+	IDLE_GAME_TTL  = 24 * time.Hour
+)
+
+func newLobby() *lobby {
+	return &lobby{
+		games:  make(map[string]*liveGame),
+		active: make(map[string]time.Time),
+		ended:  make(map[string]time.Time),
+	}
+}
+
+func (lobby *lobby) add(game *liveGame) {
+	lobby.mutex.Lock()
+	defer lobby.mutex.Unlock()
+	lobby.games[game.id] = game
+	lobby.active[game.id] = time.Now()
+}
+
+// Notes that the game has changed, and whether it has ended and been recorded.
+// Called while holding the game's lock.
+func (lobby *lobby) touch(game *liveGame) {
+	lobby.mutex.Lock()
+	defer lobby.mutex.Unlock()
+	if _, found := lobby.games[game.id]; !found {
+		return
+	}
+	now := time.Now()
+	lobby.active[game.id] = now
+	if _, found := lobby.ended[game.id]; game.recorded && !found {
+		lobby.ended[game.id] = now
+	}
+}
+
+// Removes the games that ended longer than endedTTL ago or have been idle for
+// longer than idleTTL, and returns them.  Must not be called while holding any
+// game's lock.
+func (lobby *lobby) evict(now time.Time, endedTTL, idleTTL time.Duration) []*liveGame {
+	lobby.mutex.Lock()
+	defer lobby.mutex.Unlock()
+	evicted := make([]*liveGame, 0)
+	for id, game := range lobby.games {
+		ended, isEnded := lobby.ended[id]
+		if (isEnded && now.Sub(ended) >= endedTTL) || now.Sub(lobby.active[id]) >= idleTTL {
+			evicted = append(evicted, game)
+			delete(lobby.games, id)
+			delete(lobby.active, id)
+			delete(lobby.ended, id)
+		}
+	}
+	return evicted
+}
+
+func (lobby *lobby) get(id string) (*liveGame, error) {
+	lobby.mutex.Lock()
+	defer lobby.mutex.Unlock()
+	game, found := lobby.games[id]
+	if !found {
+		return nil, requestError(http.StatusNotFound, "game %s not found", id)
+	}
+	return game, nil
+}
+
+func (lobby *lobby) list() []*liveGame {
+	lobby.mutex.Lock()
+	defer lobby.mutex.Unlock()
+	games := make([]*liveGame, 0, len(lobby.games))
+	for _, game := range lobby.games {
+		games = append(games, game)
+	}
+	sort.Slice(games, func(i, j int) bool { return games[i].id < games[j].id })
+	return games
+}
+
+// A hosted game, waiting for its seats to be filled or in progress.  The state
+// is nil until every seat has been taken.
+type liveGame struct {
+	mutex sync.Mutex
+
+	id        string
+	shortname string
+	mapHash   string // see witsjson.MapDefinition.ContentHash
+	gamemap   *state.GameMap
+	rules     *state.Ruleset
+
+	seats    []seat // indexed by team-1
+	initial  *state.GameState
+	state    *state.GameState
+	turns    []witsjson.PlayerTurnJSON
+	started  time.Time
+	recorded bool
+
+	// The bots playing some of the seats, by their team, and whether they are
+	// playing their turns (see Server.playBots).
+	bots        map[wits.FriendlyEnum]bot.Bot
+	botsPlaying bool
+
+	// Set when the game is no longer hosted, stops its bots.
+	evicted bool
+
+	feed gameFeed
+}
+
+type seat struct {
+	Player string                    `json:"player"`
+	Race   witsjson.UnitRaceJSON     `json:"race"`
+	Team   witsjson.FriendlyEnumJSON `json:"team"`
+	Bot    string                    `json:"bot,omitempty"`
+
+	// The secret that the seat's player presents with each of their requests,
+	// given only to them when they take the seat.  Bots have none.
+	token string
+}
+
+// The lifecycle of a hosted game.
+const (
+	GAME_OPEN   = "open"
+	GAME_ACTIVE = "active"
+	GAME_ENDED  = "ended"
+)
+
+func (game *liveGame) status() string {
+	if game.state == nil {
+		return GAME_OPEN
+	}
+	if game.state.IsOver() {
+		return GAME_ENDED
+	}
+	return GAME_ACTIVE
+}
+
+// The team of the seat this player occupies, or FR_UNKNOWN if not seated.
+func (game *liveGame) team(player string) wits.FriendlyEnum {
+	for _, seat := range game.seats {
+		if seat.Player == player {
+			return wits.FriendlyEnum(seat.Team)
+		}
+	}
+	return wits.FR_UNKNOWN
+}
+
+// The team of the seat that the token was issued for.  A request without a
+// token is anonymous (FR_UNKNOWN), one with a token for no seat is forbidden.
+func (game *liveGame) authorize(token string) (wits.FriendlyEnum, error) {
+	if len(token) == 0 {
+		return wits.FR_UNKNOWN, nil
+	}
+	for _, seat := range game.seats {
+		if len(seat.token) > 0 &&
+			subtle.ConstantTimeCompare([]byte(seat.token), []byte(token)) == 1 {
+			return wits.FriendlyEnum(seat.Team), nil
+		}
+	}
+	return wits.FR_UNKNOWN, requestError(http.StatusForbidden,
+		"the token is not for a seat in game %s", game.id)
+}
+
+// The team of the seat that the token was issued for, which is required.
+func (game *liveGame) seated(token string) (wits.FriendlyEnum, error) {
+	if len(token) == 0 {
+		return wits.FR_UNKNOWN, requestError(http.StatusUnauthorized,
+			"a seat token is required")
+	}
+	return game.authorize(token)
+}
+
+// Fills the next open seat, starting the game once all seats are taken.
+// Returns the team of the seat.
+func (game *liveGame) join(player string, race witsjson.UnitRaceJSON) (wits.FriendlyEnum, error) {
+	if game.state != nil {
+		return wits.FR_UNKNOWN, requestError(http.StatusConflict, "game %s has already started", game.id)
+	}
+	if len(player) == 0 {
+		return wits.FR_UNKNOWN, requestError(http.StatusBadRequest, "a player name is required")
+	}
+	if game.team(player) != wits.FR_UNKNOWN {
+		return wits.FR_UNKNOWN, requestError(http.StatusConflict, "%s has already joined game %s", player, game.id)
+	}
+	if race == witsjson.UnitRaceJSON(wits.RACE_UNKNOWN) {
+		return wits.FR_UNKNOWN, requestError(http.StatusBadRequest, "a race is required")
+	}
+	team := wits.FriendlyEnum(len(game.seats) + 1)
+	game.seats = append(game.seats, seat{
+		Player: player,
+		Race:   race,
+		Team:   witsjson.FriendlyEnumJSON(team),
+		token:  newSecret(18),
+	})
+	if len(game.seats) < game.gamemap.RoleCount() {
+		return team, nil
+	}
+
+	races := make([]wits.UnitRaceEnum, len(game.seats))
+	for i, seat := range game.seats {
+		races[i] = wits.UnitRaceEnum(seat.Race)
+	}
+	started, err := state.NewGameWithRules(game.gamemap, races, game.rules)
+	if err != nil {
+		game.seats = game.seats[:len(game.seats)-1]
+		return wits.FR_UNKNOWN, err
+	}
+	game.initial, game.state = started, started.Clone()
+	game.started = time.Now()
+	return team, nil
+}
+
+// Plays all of the actions of the team's turn and ends the turn.  The turn
+// is played on a copy of the state, so that if any action is illegal none of
+// them take effect.
+func (game *liveGame) playTurn(team wits.FriendlyEnum, encoded []json.RawMessage) error {
+	if err := game.checkCurrent(team); err != nil {
+		return err
+	}
+	next := game.state.Clone()
+	actions := make([]wits.PlayerAction, 0, len(encoded))
+	for i, actionJSON := range encoded {
+		action, err := witsjson.DecodeAction(actionJSON)
+		if err != nil {
+			return requestError(http.StatusBadRequest, "action %d: %s", i, err)
+		}
+		resolved, err := game.gamemap.Resolve(action)
+		if err != nil {
+			return requestError(http.StatusBadRequest, "action %d: %s", i, err)
+		}
+		if resolved.IsPass() {
+			break
+		}
+		if err := next.Apply(resolved); err != nil {
+			return requestError(http.StatusUnprocessableEntity, "action %d: %s", i, err)
+		}
+		actions = append(actions, action)
+	}
+	actions = append(actions, wits.PassAction{})
+
+	turn := next.Turn()
+	game.turns = append(game.turns, witsjson.PlayerTurnJSON{
+		Turn_:    turn,
+		Actions_: actions})
+	next.EndTurn()
+	game.state = next
+	game.publishTurn(game.seats[team-1].Player, turn, "")
+	return nil
+}
+
+func (game *liveGame) resign(team wits.FriendlyEnum) error {
+	if game.state == nil || game.state.IsOver() {
+		return requestError(http.StatusConflict, "game %s is not in progress", game.id)
+	}
+	game.state.Resign(team)
+	game.publishEnded()
+	return nil
+}
+
+func (game *liveGame) checkCurrent(team wits.FriendlyEnum) error {
+	if game.state == nil || game.state.IsOver() {
+		return requestError(http.StatusConflict, "game %s is not in progress", game.id)
+	}
+	if team != game.state.Current() {
+		return requestError(http.StatusConflict, "it is not %s's turn", game.seats[team-1].Player)
+	}
+	return nil
+}
+
+// Hosted games are described by their seats and, for the players, the state as
+// seen from their team.  Anyone can see the complete state once it has ended.
+// The seat's token is only included in the response to taking the seat.
+type gameResponse struct {
+	ID     string          `json:"id"`
+	Map    string          `json:"map"`
+	Status string          `json:"status"`
+	Seats  []seat          `json:"seats"`
+	Rules  *state.Ruleset  `json:"rules"`
+	State  *state.GameView `json:"state,omitempty"`
+	Token  string          `json:"token,omitempty"`
+}
+
+func (game *liveGame) response(team wits.FriendlyEnum) gameResponse {
+	response := gameResponse{
+		ID:     game.id,
+		Map:    game.shortname,
+		Status: game.status(),
+		Seats:  game.seats,
+		Rules:  game.rules,
+	}
+	if game.state == nil {
+		return response
+	}
+	var view state.GameView
+	if team != wits.FR_UNKNOWN {
+		view = game.state.ViewFor(team)
+	} else if game.state.IsOver() {
+		view = game.state.View()
+	} else {
+		return response
+	}
+	response.State = &view
+	return response
+}
+
+// The response to taking a seat, with the seat's token.
+func (game *liveGame) seatResponse(team wits.FriendlyEnum) gameResponse {
+	response := game.response(team)
+	response.Token = game.seats[team-1].token
+	return response
+}
+
+// Game IDs are random and URL-safe, also used as the recorded match hash.
+func newGameID() string {
+	return newSecret(9)
+}
+
+// A random URL-safe string, encoding this many random bytes.
+func newSecret(size int) string {
+	random := make([]byte, size)
+	if _, err := rand.Read(random); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(random)
+}
+
+// The seat token of the request, from its "Authorization: Bearer" header or
+// else its token query parameter (event streams cannot set headers).
+func seatToken(ctx *gin.Context) string {
+	if token, found := strings.CutPrefix(ctx.GetHeader("Authorization"), "Bearer "); found {
+		return token
+	}
+	return ctx.Query("token")
+}
+
+type seatRequest struct {
+	Map    string                `json:"map"`
+	Player string                `json:"player" binding:"required"`
+	Race   witsjson.UnitRaceJSON `json:"race"`
+
+	// A custom variant of the rules (see state.ParseVariant) when creating a
+	// game, which is otherwise played under the latest rules.
+	Rules json.RawMessage `json:"rules,omitempty"`
+}
+
+type turnRequest struct {
+	Actions []json.RawMessage `json:"actions"`
+}
+
+// GET /api/games
+func (server *Server) ListGames(ctx *gin.Context) {
+	server.evictGames()
+	games := server.games.list()
+	response := make([]gameResponse, len(games))
+	for i, game := range games {
+		game.mutex.Lock()
+		response[i] = game.response(wits.FR_UNKNOWN)
+		game.mutex.Unlock()
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// POST /api/games
+//
+// Hosts a new game on the requested map, the creator takes the first seat.
+// The response includes the seat's token, for the creator's later requests.
+func (server *Server) CreateGame(ctx *gin.Context) {
+	var request seatRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "%s", err))
+		return
+	}
+	definition, found := server.maps[request.Map]
+	if !found {
+		abortWithError(ctx, requestError(http.StatusNotFound, "map %s not found", request.Map))
+		return
+	}
+	rules := state.LatestRules()
+	if len(request.Rules) > 0 {
+		var err error
+		if rules, err = state.ParseVariant(request.Rules); err != nil {
+			abortWithError(ctx, requestError(http.StatusBadRequest, "rules: %s", err))
+			return
+		}
+	}
+	gamemap := state.NewGameMap(definition)
+	game := &liveGame{
+		id:        newGameID(),
+		shortname: request.Map,
+		mapHash:   definition.Definition().ContentHash(),
+		gamemap:   &gamemap,
+		rules:     rules,
+	}
+	team, err := game.join(request.Player, request.Race)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	server.evictGames()
+	server.games.add(game)
+	ctx.JSON(http.StatusCreated, game.seatResponse(team))
+}
+
+// POST /api/games/:id/join
+//
+// Takes the next seat, the response includes the seat's token.
+func (server *Server) JoinGame(ctx *gin.Context) {
+	var request seatRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "%s", err))
+		return
+	}
+	server.withGame(ctx, func(game *liveGame) error {
+		team, err := game.join(request.Player, request.Race)
+		if err != nil {
+			return err
+		}
+		ctx.JSON(http.StatusOK, game.seatResponse(team))
+		return nil
+	})
+}
+
+// GET /api/games/:id
+//
+// The state is included for the player whose seat token is presented.
+func (server *Server) GetGame(ctx *gin.Context) {
+	server.withGame(ctx, func(game *liveGame) error {
+		team, err := game.authorize(seatToken(ctx))
+		if err != nil {
+			return err
+		}
+		ctx.JSON(http.StatusOK, game.response(team))
+		return nil
+	})
+}
+
+// POST /api/games/:id/turns
+//
+// Requires the token of the seat whose turn it is.  The turns of any bots that
+// follow are played in the background.
+func (server *Server) SubmitTurn(ctx *gin.Context) {
+	var request turnRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "%s", err))
+		return
+	}
+	server.withGame(ctx, func(game *liveGame) error {
+		team, err := game.seated(seatToken(ctx))
+		if err != nil {
+			return err
+		}
+		if err := game.playTurn(team, request.Actions); err != nil {
+			return err
+		}
+		if err := server.recordIfEnded(ctx.Request.Context(), game); err != nil {
+			return err
+		}
+		server.startBots(game)
+		ctx.JSON(http.StatusOK, game.response(team))
+		return nil
+	})
+}
+
+// POST /api/games/:id/resign
+//
+// Requires the token of the resigning player's seat.
+func (server *Server) ResignGame(ctx *gin.Context) {
+	server.withGame(ctx, func(game *liveGame) error {
+		team, err := game.seated(seatToken(ctx))
+		if err != nil {
+			return err
+		}
+		if err := game.resign(team); err != nil {
+			return err
+		}
+		if err := server.recordIfEnded(ctx.Request.Context(), game); err != nil {
+			return err
+		}
+		ctx.JSON(http.StatusOK, game.response(team))
+		return nil
+	})
+}
+
+// Looks up the game named in the path and calls the handler while holding the
+// game's lock.  Errors returned by the handler abort the request.
+func (server *Server) withGame(ctx *gin.Context, handler func(*liveGame) error) {
+	server.evictGames()
+	game, err := server.games.get(ctx.Param("id"))
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	game.mutex.Lock()
+	defer game.mutex.Unlock()
+	if err := handler(game); err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	server.games.touch(game)
+}
+
+// Evicts the games that ended or were abandoned long enough ago, closing the
+// event streams of any subscribers that remain.
+func (server *Server) evictGames() {
+	for _, game := range server.games.evict(time.Now(), server.EndedGameTTL, server.IdleGameTTL) {
+		game.mutex.Lock()
+		game.evicted = true
+		game.feed.close()
+		game.mutex.Unlock()
+	}
+}
+
+// An error that results from the request itself rather than from the service,
+// it is reported with its specific HTTP status.
+type statusError struct {
+	status  int
+	message string
+}
+
+func (err statusError) Error() string { return err.message }
+
+func requestError(status int, format string, args ...any) error {
+	return statusError{status, fmt.Sprintf(format, args...)}
+}
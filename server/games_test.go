@@ -0,0 +1,373 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/games_test.go
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/playerrole"
+	"github.com/kevindamm/wits-go/server"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func post(t *testing.T, handler http.Handler, url string, body string, into any) int {
+	return postAs(t, handler, "", url, body, into)
+}
+
+// Posts the request with the seat token, if there is one.
+func postAs(t *testing.T, handler http.Handler, token string, url string, body string, into any) int {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	if len(token) > 0 {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	handler.ServeHTTP(recorder, request)
+	if into != nil && recorder.Code < 300 {
+		if err := json.Unmarshal(recorder.Body.Bytes(), into); err != nil {
+			t.Fatalf("POST %s decoding response: %v\n%s", url, err, recorder.Body)
+		}
+	}
+	return recorder.Code
+}
+
+type gameResponse struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Seats  []struct {
+		Player string `json:"player"`
+	} `json:"seats"`
+	Rules *struct {
+		Name        string `json:"name"`
+		WitsPerTurn int    `json:"wits_per_turn"`
+	} `json:"rules"`
+	Token string `json:"token"`
+	State *struct {
+		Turn    uint   `json:"turn"`
+		Current string `json:"current"`
+		Units   []struct {
+			Team  string `json:"team"`
+			Class string `json:"class"`
+		} `json:"units"`
+		Result wits.TerminalStatus `json:"result"`
+	} `json:"state"`
+}
+
+func TestServer_LiveGame(t *testing.T) {
+	replayDir := t.TempDir()
+	client, service := newTestService(t, replayDir)
+	defer client.Close()
+	service.Season = 3
+	router := service.Router()
+
+	var game gameResponse
+	if code := post(t, router, "/api/games",
+		`{"map": "peekaboo", "player": "alice", "race": "FEEDBACK"}`, &game); code != http.StatusCreated {
+		t.Fatalf("POST /api/games status %d", code)
+	}
+	if game.Status != "open" || game.State != nil || len(game.Token) == 0 {
+		t.Fatalf("game should wait for its second player %v", game)
+	}
+	alice := game.Token
+	url := "/api/games/" + game.ID
+	if code := post(t, router, url+"/join",
+		`{"player": "alice", "race": "FEEDBACK"}`, nil); code != http.StatusConflict {
+		t.Errorf("joining twice status %d, expected 409", code)
+	}
+	if code := post(t, router, url+"/join",
+		`{"player": "carol", "race": "VEGGIENAUTS"}`, &game); code != http.StatusOK {
+		t.Fatalf("POST %s/join status %d", url, code)
+	}
+	carol := game.Token
+	if game.Status != "active" || game.State == nil || game.State.Current != "RED" {
+		t.Fatalf("game should have started with the first player %v", game)
+	}
+	if len(carol) == 0 || carol == alice {
+		t.Errorf("expected each seat to have its own token")
+	}
+	if code := post(t, router, url+"/join",
+		`{"player": "dave", "race": "ADORABLES"}`, nil); code != http.StatusConflict {
+		t.Errorf("joining a full game status %d, expected 409", code)
+	}
+
+	// Tokens are never shown to anyone else.
+	var listed []gameResponse
+	get(t, router, "/api/games", &listed)
+	if len(listed) != 1 || len(listed[0].Token) > 0 {
+		t.Errorf("listed games should not include tokens %v", listed)
+	}
+
+	// Only the current player may submit a turn, and only legal actions.
+	if code := post(t, router, url+"/turns", `{"actions": []}`, nil); code != http.StatusUnauthorized {
+		t.Errorf("submission without a token status %d, expected 401", code)
+	}
+	if code := postAs(t, router, "forged", url+"/turns", `{"actions": []}`, nil); code != http.StatusForbidden {
+		t.Errorf("submission with a forged token status %d, expected 403", code)
+	}
+	if code := postAs(t, router, carol, url+"/turns", `{"actions": []}`, nil); code != http.StatusConflict {
+		t.Errorf("out-of-turn submission status %d, expected 409", code)
+	}
+	if code := postAs(t, router, alice, url+"/turns", `{"actions": [
+		{"name": "SpawnUnit", "action": {"spawn": [5, 8], "class": "RUNNER"}},
+		{"name": "MoveUnit", "action": {"from": [0, 6], "to": [0, 7]}}]}`,
+		nil); code != http.StatusUnprocessableEntity {
+		t.Errorf("illegal action status %d, expected 422", code)
+	}
+	var before gameResponse
+	get(t, router, url+"?token="+alice, &before)
+	if code := postAs(t, router, alice, url+"/turns", `{"actions": [
+		{"name": "SpawnUnit", "action": {"spawn": [5, 8], "class": "RUNNER"}},
+		{"name": "Pass"}]}`, &game); code != http.StatusOK {
+		t.Fatalf("POST %s/turns status %d", url, code)
+	}
+	if game.State.Turn != 2 || len(game.State.Units) != len(before.State.Units)+1 {
+		t.Errorf("expected a spawned runner and the second turn %v", game.State)
+	}
+
+	// Spectators do not see the state of a game in progress, and cannot see
+	// a player's view by naming them.
+	var spectated gameResponse
+	if code := get(t, router, url+"?player=alice", &spectated); code != http.StatusOK || spectated.State != nil {
+		t.Errorf("spectator view status %d state %v", code, spectated.State)
+	}
+	if code := get(t, router, url+"?token=forged", nil); code != http.StatusForbidden {
+		t.Errorf("view with a forged token status %d, expected 403", code)
+	}
+
+	if code := post(t, router, url+"/resign", `{}`, nil); code != http.StatusUnauthorized {
+		t.Errorf("resignation without a token status %d, expected 401", code)
+	}
+	if code := postAs(t, router, carol, url+"/resign", `{}`, &game); code != http.StatusOK {
+		t.Fatalf("POST %s/resign status %d", url, code)
+	}
+	if game.Status != "ended" || game.State.Result != wits.LOSS_RESIGNATION {
+		t.Errorf("expected carol's resignation %v", game.State)
+	}
+
+	ctx := context.Background()
+	recorded, err := client.Match.Query().
+		Where(match.MatchHashEQ(game.ID)).
+		WithRoles(func(query *ent.PlayerRoleQuery) {
+			query.WithPlayers().Order(playerrole.ByTurnOrder())
+		}).
+		Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recorded.TurnCount != 2 || *recorded.FetchStatus != match.FetchStatusINDEXED ||
+		len(recorded.MapHash) == 0 || recorded.Season != 3 ||
+		recorded.PlayedTs == nil || recorded.PlayedTs.After(*recorded.CreatedTs) {
+		t.Errorf("unexpected recorded match %v", recorded)
+	}
+	roles := recorded.Edges.Roles
+	if len(roles) != 2 ||
+		roles[0].Edges.Players[0].Name != "alice" ||
+		roles[0].Result != wits.VICTORY_RESIGNATION ||
+		roles[1].Race != wits.RACE_VEGGIENAUTS ||
+		roles[1].Result != wits.LOSS_RESIGNATION {
+		t.Errorf("unexpected recorded roles %v", roles)
+	}
+	if _, err := os.Stat(filepath.Join(replayDir, game.ID+".json")); err != nil {
+		t.Errorf("replay was not written: %v", err)
+	}
+}
+
+func TestServer_BotGame(t *testing.T) {
+	client, service := newTestService(t, t.TempDir())
+	defer client.Close()
+	router := service.Router()
+
+	var game gameResponse
+	post(t, router, "/api/games",
+		`{"map": "peekaboo", "player": "alice", "race": "FEEDBACK"}`, &game)
+	alice := game.Token
+	url := "/api/games/" + game.ID
+	if code := post(t, router, url+"/bots",
+		`{"bot": "clever", "race": "ADORABLES"}`, nil); code != http.StatusBadRequest {
+		t.Errorf("unknown bot status %d, expected 400", code)
+	}
+	if code := post(t, router, url+"/bots",
+		`{"bot": "greedy", "race": "ADORABLES"}`, &game); code != http.StatusOK {
+		t.Fatalf("POST %s/bots status %d", url, code)
+	}
+	if game.Status != "active" || game.Seats[1].Player != "greedy-bot-2" {
+		t.Fatalf("expected the bot to take the second seat %v", game)
+	}
+	events, cancel, err := service.Subscribe(game.ID, alice, wits.FR_UNKNOWN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	// The bot plays its turn in the background after each of alice's turns.
+	for turn := uint(1); turn < 7; turn += 2 {
+		if code := postAs(t, router, alice, url+"/turns",
+			`{"actions": []}`, &game); code != http.StatusOK {
+			t.Fatalf("POST %s/turns status %d", url, code)
+		}
+		if game.State.Turn != turn+1 || game.State.Current != "BLUE" {
+			t.Errorf("expected the response before the bot's turn %d, got %v", turn+1, game.State)
+		}
+		nextEvent(t, events)
+		event := nextEvent(t, events)
+		if event.Type == server.EVENT_ENDED {
+			return
+		}
+		if event.Type != server.EVENT_TURN || event.Turn != turn+1 || event.Player != "greedy-bot-2" {
+			t.Fatalf("expected the bot's turn %d, got %v", turn+1, event)
+		}
+		get(t, router, url+"?token="+alice, &game)
+		if game.State.Turn != turn+2 || game.State.Current != "RED" {
+			t.Errorf("expected alice to play turn %d, got %v", turn+2, game.State)
+		}
+	}
+
+	// Resigning does not wait for the bot to finish its turn.
+	postAs(t, router, alice, url+"/turns", `{"actions": []}`, nil)
+	if code := postAs(t, router, alice, url+"/resign", `{}`, &game); code != http.StatusOK {
+		t.Fatalf("POST %s/resign status %d", url, code)
+	}
+	if game.Status != "ended" || game.State.Result != wits.LOSS_RESIGNATION {
+		t.Errorf("expected alice to have resigned %v", game)
+	}
+	for {
+		if event := nextEvent(t, events); event.Type == server.EVENT_ENDED {
+			break
+		}
+	}
+	expectNoEvent(t, events)
+}
+
+// A bot that takes longer than its budget to choose.
+type slowBot struct{}
+
+func (slowBot) Name() string { return "slow" }
+func (slowBot) Turn(view *state.GameState, budget time.Duration) []state.Action {
+	time.Sleep(budget + time.Second)
+	return nil
+}
+
+func TestServer_BotTimeout(t *testing.T) {
+	client, service := newTestService(t, t.TempDir())
+	defer client.Close()
+	service.NewBot = func(name string, seed uint64) (bot.Bot, error) { return slowBot{}, nil }
+	service.BotBudget = 10 * time.Millisecond
+	router := service.Router()
+
+	var game gameResponse
+	post(t, router, "/api/games",
+		`{"map": "peekaboo", "player": "alice", "race": "FEEDBACK"}`, &game)
+	alice := game.Token
+	url := "/api/games/" + game.ID
+	if code := post(t, router, url+"/bots",
+		`{"bot": "slow", "race": "ADORABLES"}`, &game); code != http.StatusOK {
+		t.Fatalf("POST %s/bots status %d", url, code)
+	}
+	events, cancel, err := service.Subscribe(game.ID, alice, wits.FR_UNKNOWN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	postAs(t, router, alice, url+"/turns", `{"actions": []}`, nil)
+	nextEvent(t, events)
+	if event := nextEvent(t, events); event.Type != server.EVENT_TURN ||
+		!strings.Contains(event.Message, "ran out of time") {
+		t.Errorf("expected the bot's turn to say why it ended, got %v", event)
+	}
+	if event := nextEvent(t, events); event.Type != server.EVENT_ENDED ||
+		event.State.Result != witsjson.TerminalStatusJSON(wits.DELAY_OF_GAME) {
+		t.Errorf("expected the game to end in a delay of game, got %v", event)
+	}
+}
+
+func TestServer_VariantGame(t *testing.T) {
+	client, router := newTestServer(t, t.TempDir())
+	defer client.Close()
+
+	var game gameResponse
+	if code := post(t, router, "/api/games", `{"map": "peekaboo", "player": "alice",
+		"race": "FEEDBACK", "rules": {"name": "rich", "wits_per_turn": 5}}`,
+		&game); code != http.StatusCreated {
+		t.Fatalf("POST /api/games with a variant status %d", code)
+	}
+	if game.Rules == nil || game.Rules.Name != "rich" || game.Rules.WitsPerTurn != 5 {
+		t.Errorf("game should be played with the variant rules %v", game.Rules)
+	}
+
+	if code := post(t, router, "/api/games", `{"map": "peekaboo", "player": "alice",
+		"race": "FEEDBACK"}`, &game); code != http.StatusCreated {
+		t.Fatalf("POST /api/games status %d", code)
+	}
+	if game.Rules == nil || game.Rules.Name != "standard" {
+		t.Errorf("game should be played with the standard rules %v", game.Rules)
+	}
+
+	for _, invalid := range []string{
+		`{"name": "standard"}`,
+		`{"name": "broke", "units": {"RUNNER": {"health": 0}}}`,
+		`"rich"`,
+	} {
+		if code := post(t, router, "/api/games", `{"map": "peekaboo", "player": "alice",
+			"race": "FEEDBACK", "rules": `+invalid+`}`, nil); code != http.StatusBadRequest {
+			t.Errorf("variant %s status %d, expected 400", invalid, code)
+		}
+	}
+}
+
+func TestServer_GameEviction(t *testing.T) {
+	client, service := newTestService(t, t.TempDir())
+	defer client.Close()
+	router := service.Router()
+	service.EndedGameTTL = 0
+
+	var game gameResponse
+	post(t, router, "/api/games", `{"map": "peekaboo", "player": "alice", "race": "FEEDBACK"}`, &game)
+	url := "/api/games/" + game.ID
+	post(t, router, url+"/join", `{"player": "carol", "race": "VEGGIENAUTS"}`, &game)
+	if code := postAs(t, router, game.Token, url+"/resign", `{}`, nil); code != http.StatusOK {
+		t.Fatalf("POST %s/resign status %d", url, code)
+	}
+	if code := get(t, router, url, nil); code != http.StatusNotFound {
+		t.Errorf("ended game status %d, expected it to be evicted", code)
+	}
+	if _, err := client.Match.Query().Where(match.MatchHashEQ(game.ID)).Only(context.Background()); err != nil {
+		t.Errorf("evicted game should have been recorded: %v", err)
+	}
+
+	// Games where nothing happens are abandoned.
+	service.IdleGameTTL = time.Millisecond
+	post(t, router, "/api/games", `{"map": "peekaboo", "player": "alice", "race": "FEEDBACK"}`, &game)
+	time.Sleep(5 * time.Millisecond)
+	var listed []gameResponse
+	if get(t, router, "/api/games", &listed); len(listed) != 0 {
+		t.Errorf("expected the idle game to be evicted, listed %v", listed)
+	}
+}
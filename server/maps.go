@@ -0,0 +1,90 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/maps.go
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/osnmap"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Map entities are served along with their full definition, which is read from
+// the library for maps that have not been synced (see cmd/sync_maps).
+func withDefinition(library witsjson.MapLibrary, entity *ent.OsnMap) *ent.OsnMap {
+	if entity.Definition == nil {
+		entity.Definition = library[entity.Shortname].Definition()
+	}
+	return entity
+}
+
+// GET /api/maps
+func (server *Server) ListMaps(ctx *gin.Context) {
+	limit, offset := pagination(ctx)
+	maps, err := server.client.OsnMap.Query().
+		Order(osnmap.ByID()).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+
+	for _, entity := range maps {
+		withDefinition(server.maps, entity)
+	}
+	ctx.JSON(http.StatusOK, maps)
+}
+
+// GET /api/maps/:shortname
+func (server *Server) GetMap(ctx *gin.Context) {
+	shortname := ctx.Param("shortname")
+	entity, err := server.client.OsnMap.Query().
+		Where(osnmap.ShortnameEQ(shortname)).
+		Only(ctx)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	if withDefinition(server.maps, entity).Definition == nil {
+		abortWithError(ctx, fmt.Errorf("definition not found for map %s", shortname))
+		return
+	}
+	ctx.JSON(http.StatusOK, entity)
+}
+
+// GET /api/maps/:shortname/thumbnail
+func (server *Server) GetMapThumbnail(ctx *gin.Context) {
+	shortname := ctx.Param("shortname")
+	entity, err := server.client.OsnMap.Query().
+		Where(osnmap.ShortnameEQ(shortname)).
+		Only(ctx)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	if len(entity.ThumbnailSvg) == 0 {
+		abortWithError(ctx, requestError(http.StatusNotFound,
+			"map %s has no thumbnail, it has not been synced", shortname))
+		return
+	}
+	ctx.Data(http.StatusOK, "image/svg+xml", []byte(entity.ThumbnailSvg))
+}
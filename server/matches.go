@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/matches.go
+
+package server
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/ent/osnmap"
+	"github.com/kevindamm/wits-go/ent/playerrole"
+	"github.com/kevindamm/wits-go/ent/turn"
+)
+
+// Matches are always loaded with their map and their player roles.
+func (server *Server) matchQuery() *ent.MatchQuery {
+	return server.client.Match.Query().
+		WithMap().
+		WithRoles(func(query *ent.PlayerRoleQuery) {
+			query.WithPlayers().Order(playerrole.ByTurnOrder())
+		})
+}
+
+// GET /api/matches?map=<shortname>&season=<number>
+func (server *Server) ListMatches(ctx *gin.Context) {
+	limit, offset := pagination(ctx)
+	query := server.matchQuery()
+	if shortname := ctx.Query("map"); shortname != "" {
+		query = query.Where(match.HasMapWith(osnmap.ShortnameEQ(shortname)))
+	}
+	if number := ctx.Query("season"); number != "" {
+		ranked, err := strconv.ParseInt(number, 10, 8)
+		if err != nil {
+			abortWithError(ctx, requestError(http.StatusBadRequest, "unknown season %q", number))
+			return
+		}
+		query = query.Where(match.SeasonEQ(int8(ranked)))
+	}
+
+	matches, err := query.
+		Order(match.ByCreatedTs(), match.ByID()).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, matches)
+}
+
+// GET /api/matches/:hash
+func (server *Server) GetMatch(ctx *gin.Context) {
+	found, err := server.matchQuery().
+		Where(match.MatchHashEQ(ctx.Param("hash"))).
+		Only(ctx)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, found)
+}
+
+// GET /api/matches/:hash/turns
+//
+// The turns indexed from the match's replay, in order, each with its actions.
+func (server *Server) GetTurns(ctx *gin.Context) {
+	found, err := server.client.Match.Query().
+		Where(match.MatchHashEQ(ctx.Param("hash"))).
+		Only(ctx)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	turns, err := found.QueryTurns().
+		WithActions(func(query *ent.ActionQuery) {
+			query.Order(action.BySequence())
+		}).
+		Order(turn.ByNumber()).
+		All(ctx)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, turns)
+}
+
+// GET /api/matches/:hash/replay
+//
+// Replays are kept in the replay directory as <match_hash>.json files.
+func (server *Server) GetReplay(ctx *gin.Context) {
+	hash := ctx.Param("hash")
+	if server.replayDir == "" {
+		ctx.AbortWithStatusJSON(http.StatusNotFound,
+			gin.H{"error": "replays are not available"})
+		return
+	}
+	exists, err := server.client.Match.Query().
+		Where(match.MatchHashEQ(hash)).
+		Exist(ctx)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	if !exists {
+		ctx.AbortWithStatusJSON(http.StatusNotFound,
+			gin.H{"error": "match not found " + hash})
+		return
+	}
+
+	// The hash is base-64 encoded and may contain a path separator.
+	replayPath := filepath.Join(server.replayDir, filepath.Base(hash)+".json")
+	if _, err := os.Stat(replayPath); errors.Is(err, os.ErrNotExist) {
+		ctx.AbortWithStatusJSON(http.StatusNotFound,
+			gin.H{"error": "replay not found for match " + hash})
+		return
+	}
+	ctx.Header("Content-Type", "application/json")
+	ctx.File(replayPath)
+}
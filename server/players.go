@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/players.go
+
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/player"
+	entrating "github.com/kevindamm/wits-go/ent/rating"
+	"github.com/kevindamm/wits-go/ent/standing"
+	"github.com/kevindamm/wits-go/rating"
+	"github.com/kevindamm/wits-go/season"
+)
+
+// The player profile includes the player's roles and the matches they are in.
+// The GCID is sensitive and is never included in the response.
+type playerResponse struct {
+	*ent.Player
+	MatchCount int `json:"match_count"`
+}
+
+// GET /api/players
+func (server *Server) ListPlayers(ctx *gin.Context) {
+	limit, offset := pagination(ctx)
+	players, err := server.client.Player.Query().
+		Order(player.ByName()).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, players)
+}
+
+// GET /api/players/:name
+func (server *Server) GetPlayer(ctx *gin.Context) {
+	profile, err := server.client.Player.Query().
+		Where(player.NameEQ(ctx.Param("name"))).
+		WithRoles(func(query *ent.PlayerRoleQuery) {
+			query.WithMatch(func(query *ent.MatchQuery) {
+				query.WithMap()
+			})
+		}).
+		Only(ctx)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, playerResponse{profile, len(profile.Edges.Roles)})
+}
+
+// GET /api/leaderboard?mode=SOLO
+//
+// Ratings are only as recent as the last time they were recomputed.
+func (server *Server) Leaderboard(ctx *gin.Context) {
+	limit, offset := pagination(ctx)
+	mode := rating.Mode(ctx.DefaultQuery("mode", string(rating.MODE_SOLO)))
+	if err := entrating.ModeValidator(mode); err != nil {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "unknown rating mode %q", mode))
+		return
+	}
+	ratings, err := rating.Leaderboard(ctx, server.client, mode, limit, offset)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, ratings)
+}
+
+// GET /api/seasons/:number?tier=Novice
+//
+// The league tables of a ranked season, highest tier first.  Standings are
+// only as recent as the last time they were recomputed.
+func (server *Server) GetSeason(ctx *gin.Context) {
+	limit, offset := pagination(ctx)
+	number, err := strconv.ParseInt(ctx.Param("number"), 10, 8)
+	if err != nil || number <= 0 {
+		abortWithError(ctx, requestError(http.StatusBadRequest, "unknown season %q", ctx.Param("number")))
+		return
+	}
+	tier := wits.LeagueTier(ctx.Query("tier"))
+	if len(tier) > 0 {
+		if err := standing.TierValidator(standing.Tier(tier)); err != nil {
+			abortWithError(ctx, requestError(http.StatusBadRequest, "unknown tier %q", tier))
+			return
+		}
+	}
+	standings, err := season.Leagues(ctx, server.client, int8(number), tier, limit, offset)
+	if err != nil {
+		abortWithError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, standings)
+}
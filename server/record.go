@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/record.go
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/archive"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/match"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Records the game as a Match (with its map, players, roles and turns) once it
+// has ended, and writes its replay if there is a replay directory.  Each game
+// is only recorded once.
+func (server *Server) recordIfEnded(ctx context.Context, game *liveGame) error {
+	if game.recorded || game.state == nil || !game.state.IsOver() {
+		return nil
+	}
+	if err := server.recordMatch(ctx, game); err != nil {
+		return fmt.Errorf("game %s ended but was not recorded: %w", game.id, err)
+	}
+	if len(server.replayDir) > 0 {
+		filename := filepath.Join(server.replayDir, game.id+".json")
+		if err := game.replay().WriteJSON(filename); err != nil {
+			return fmt.Errorf("game %s ended but its replay was not written: %w", game.id, err)
+		}
+	}
+	game.recorded = true
+	return nil
+}
+
+func (server *Server) recordMatch(ctx context.Context, game *liveGame) error {
+	tx, err := server.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := recordMatchTx(ctx, tx.Client(), game, server.Season); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func recordMatchTx(ctx context.Context, client *ent.Client, game *liveGame, season int8) error {
+	gamemap, err := archive.FindOrCreateMap(ctx, client,
+		game.shortname, game.gamemap.MapName(), game.gamemap.RoleCount())
+	if err != nil {
+		return err
+	}
+
+	create := client.Match.Create().
+		SetMatchHash(game.id).
+		SetVersion(game.rules.Version).
+		SetTurnCount(int(game.state.Turn())).
+		SetPlayedTs(game.started).
+		SetFetchStatus(match.FetchStatusINDEXED).
+		SetMap(gamemap).
+		SetMapHash(game.mapHash)
+	if game.rules.IsVariant() {
+		create.SetRules(game.rules)
+	} else if len(game.bots) == 0 {
+		create.SetSeason(season)
+	}
+	recorded, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, seat := range game.seats {
+		profile, err := archive.FindOrCreatePlayer(ctx, client,
+			wits.PlayerName(seat.Player), "")
+		if err != nil {
+			return err
+		}
+		team := wits.FriendlyEnum(seat.Team)
+		_, err = client.PlayerRole.Create().
+			SetMatchID(recorded.ID).
+			SetPlayerID(profile.ID).
+			SetPosition(int(team)).
+			SetTurnOrder(int(team)).
+			SetRace(wits.UnitRaceEnum(seat.Race)).
+			SetResult(game.state.ResultFor(team)).
+			AddMatch(recorded).
+			AddPlayers(profile).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	turns := make([]wits.PlayerTurn, len(game.turns))
+	for i, turn := range game.turns {
+		turns[i] = turn
+	}
+	return archive.IndexTurns(ctx, client, recorded, gamemap, game.initial, turns)
+}
+
+// The replay of a game, in the same format as the converted OSN replays.
+func (game *liveGame) replay() witsjson.GameReplayJSON {
+	players := make([]witsjson.PlayerRoleJSON, len(game.seats))
+	for i, seat := range game.seats {
+		team := wits.FriendlyEnum(seat.Team)
+		players[i] = witsjson.PlayerRoleJSON{
+			Name_:   seat.Player,
+			Race_:   seat.Race,
+			Team_:   seat.Team,
+			Result_: witsjson.TerminalStatusJSON(game.state.ResultFor(team)),
+			BaseHP_: witsjson.BaseHealth(game.state.BaseHP(team)),
+			Wits_:   int(game.state.Player(team).Wits),
+		}
+	}
+	return witsjson.GameReplayJSON{
+		GameID_:  witsjson.OsnGameID(game.id),
+		Played_:  &game.started,
+		MapID_:   game.gamemap.MapID(),
+		GameMap_: game.gamemap.MapName(),
+		Turns_:   game.turns,
+		Result_:  witsjson.TerminalStatusJSON(game.state.Result()),
+		Players_: players,
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/server.go
+
+package server
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The application service, serving JSON over HTTP from the ent-backed DB.
+type Server struct {
+	client *ent.Client
+	maps   witsjson.MapLibrary
+
+	// Directory where JSON-formatted replays are kept, named by match hash.
+	// When empty, replay downloads are not available.
+	replayDir string
+
+	// Games being played live, hosted in memory until they have ended.
+	games *lobby
+
+	// How long ended games are kept in memory, and how long games are kept
+	// while nothing happens in them (ENDED_GAME_TTL and IDLE_GAME_TTL).
+	EndedGameTTL time.Duration
+	IdleGameTTL  time.Duration
+
+	// The ranked season that live games between players are recorded in, zero
+	// (the default) if they are unranked.  Games with a bot or a variant of the
+	// rules are never ranked.
+	Season int8
+
+	// Creates the bots that games are played against by their name (bot.ByName
+	// unless replaced), and the time each bot is given to choose its turn.
+	NewBot    func(name string, seed uint64) (bot.Bot, error)
+	BotBudget time.Duration
+
+	// Maps being edited, by the ID of their editing session.
+	editors *editorSessions
+}
+
+// Returns a server backed by this DB client and these map definitions.
+// The replay directory is optional, see Server.replayDir.
+func New(client *ent.Client, maps witsjson.MapLibrary, replayDir string) *Server {
+	return &Server{
+		client:       client,
+		maps:         maps,
+		replayDir:    replayDir,
+		games:        newLobby(),
+		EndedGameTTL: ENDED_GAME_TTL,
+		IdleGameTTL:  IDLE_GAME_TTL,
+		NewBot:       bot.ByName,
+		BotBudget:    BOT_BUDGET,
+		editors:      newEditorSessions(),
+	}
+}
+
+// Builds the router with all of the API endpoints installed.
+func (server *Server) Router() *gin.Engine {
+	router := gin.New()
+	router.Use(gin.Logger(), gin.Recovery())
+
+	api := router.Group("/api")
+	api.GET("/maps", server.ListMaps)
+	api.GET("/maps/:shortname", server.GetMap)
+	api.GET("/maps/:shortname/thumbnail", server.GetMapThumbnail)
+
+	api.GET("/matches", server.ListMatches)
+	api.GET("/matches/:hash", server.GetMatch)
+	api.GET("/matches/:hash/replay", server.GetReplay)
+	api.GET("/matches/:hash/turns", server.GetTurns)
+
+	api.GET("/players", server.ListPlayers)
+	api.GET("/players/:name", server.GetPlayer)
+	api.GET("/leaderboard", server.Leaderboard)
+	api.GET("/seasons/:number", server.GetSeason)
+
+	api.GET("/games", server.ListGames)
+	api.POST("/games", server.CreateGame)
+	api.GET("/games/:id", server.GetGame)
+	api.POST("/games/:id/join", server.JoinGame)
+	api.POST("/games/:id/bots", server.AddBot)
+	api.POST("/games/:id/turns", server.SubmitTurn)
+	api.POST("/games/:id/resign", server.ResignGame)
+	api.POST("/games/:id/chat", server.PostChat)
+	api.GET("/games/:id/events", server.StreamEvents)
+
+	api.POST("/editor", server.CreateEditor)
+	api.POST("/editor/compare", server.CompareMaps)
+	api.GET("/editor/:id", server.GetEditor)
+	api.POST("/editor/:id/edits", server.EditMap)
+	api.GET("/editor/:id/diff", server.GetEditorDiff)
+	api.POST("/editor/:id/diff", server.ApplyEditorDiff)
+	api.POST("/editor/:id/undo", server.UndoEdit)
+	api.POST("/editor/:id/redo", server.RedoEdit)
+
+	return router
+}
+
+// Listing endpoints are paginated with these (overridable) defaults.
+const (
+	DEFAULT_PAGE_LIMIT = 50
+	MAX_PAGE_LIMIT     = 200
+)
+
+// Parses the `limit` and `offset` query parameters, clamping them to sane values.
+func pagination(ctx *gin.Context) (limit int, offset int) {
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", ""))
+	if err != nil || limit <= 0 {
+		limit = DEFAULT_PAGE_LIMIT
+	}
+	if limit > MAX_PAGE_LIMIT {
+		limit = MAX_PAGE_LIMIT
+	}
+	offset, err = strconv.Atoi(ctx.DefaultQuery("offset", ""))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// Errors are reported as a JSON object with a single "error" property.
+func abortWithError(ctx *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	var requestErr statusError
+	if errors.As(err, &requestErr) {
+		status = requestErr.status
+	} else if ent.IsNotFound(err) {
+		status = http.StatusNotFound
+	}
+	ctx.AbortWithStatusJSON(status, gin.H{"error": err.Error()})
+}
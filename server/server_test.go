@@ -0,0 +1,358 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/server/server_test.go
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/archive"
+	"github.com/kevindamm/wits-go/ent"
+	"github.com/kevindamm/wits-go/ent/action"
+	"github.com/kevindamm/wits-go/ent/enttest"
+	"github.com/kevindamm/wits-go/ent/playerrole"
+	"github.com/kevindamm/wits-go/rating"
+	"github.com/kevindamm/wits-go/render"
+	"github.com/kevindamm/wits-go/season"
+	"github.com/kevindamm/wits-go/server"
+	"github.com/kevindamm/wits-go/witsjson"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Populates an in-memory DB with one map, one match and its two players.
+func newTestServer(t *testing.T, replayDir string) (*ent.Client, http.Handler) {
+	client, service := newTestService(t, replayDir)
+	return client, service.Router()
+}
+
+func newTestService(t *testing.T, replayDir string) (*ent.Client, *server.Server) {
+	gin.SetMode(gin.TestMode)
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	ctx := context.Background()
+
+	gamemap := client.OsnMap.Create().
+		SetName("Peek-a-Boo").
+		SetShortname("peekaboo").
+		SetRoleCount(2).
+		SaveX(ctx)
+	match := client.Match.Create().
+		SetMatchHash("abc123").
+		SetVersion(1603).
+		SetTurnCount(21).
+		SetMap(gamemap).
+		SaveX(ctx)
+	for i, name := range []string{"alice", "bob"} {
+		player := client.Player.Create().
+			SetName(name).
+			SetGcid("G:" + name).
+			SaveX(ctx)
+		client.PlayerRole.Create().
+			SetMatchID(match.ID).
+			SetPlayerID(player.ID).
+			SetPosition(i + 1).
+			SetTurnOrder(i + 1).
+			AddMatch(match).
+			AddPlayers(player).
+			SaveX(ctx)
+	}
+
+	maps, _, err := witsjson.LoadMapLibrary("../maps/solo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, server.New(client, maps, replayDir)
+}
+
+func get(t *testing.T, handler http.Handler, url string, into any) int {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, url, nil))
+	if into != nil && recorder.Code == http.StatusOK {
+		if err := json.Unmarshal(recorder.Body.Bytes(), into); err != nil {
+			t.Fatalf("GET %s decoding response: %v\n%s", url, err, recorder.Body)
+		}
+	}
+	return recorder.Code
+}
+
+func TestServer_Maps(t *testing.T) {
+	client, router := newTestServer(t, "")
+	defer client.Close()
+
+	var maps []map[string]any
+	if code := get(t, router, "/api/maps", &maps); code != http.StatusOK {
+		t.Fatalf("GET /api/maps status %d", code)
+	}
+	if len(maps) != 1 || maps[0]["shortname"] != "peekaboo" {
+		t.Errorf("unexpected map listing %v", maps)
+	}
+
+	var peekaboo struct {
+		Name       string `json:"name"`
+		Definition struct {
+			MapID   string `json:"map_id"`
+			Terrain struct {
+				Spawn [][][]int `json:"spawn"`
+				Base  [][]int   `json:"base"`
+			} `json:"terrain"`
+		} `json:"definition"`
+	}
+	if code := get(t, router, "/api/maps/peekaboo", &peekaboo); code != http.StatusOK {
+		t.Fatalf("GET /api/maps/peekaboo status %d", code)
+	}
+	if peekaboo.Definition.MapID != "oml/solo/peekaboo" {
+		t.Errorf("map definition not included: %v", peekaboo)
+	}
+	if len(peekaboo.Definition.Terrain.Spawn) != 2 || len(peekaboo.Definition.Terrain.Base) != 2 {
+		t.Errorf("map terrain not encoded per-team: %v", peekaboo.Definition.Terrain)
+	}
+
+	if code := get(t, router, "/api/maps/nowhere", nil); code != http.StatusNotFound {
+		t.Errorf("GET unknown map status %d, expected 404", code)
+	}
+}
+
+func TestServer_SyncedMaps(t *testing.T) {
+	client, router := newTestServer(t, "")
+	defer client.Close()
+
+	// The duos maps are not in the server's library, only in the DB.
+	gamemap, err := witsjson.ReadMapFile("../maps/duos/acrospire.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	thumbnail, err := render.Thumbnail(gamemap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := archive.SyncMap(context.Background(), client, gamemap.Definition(), thumbnail); err != nil {
+		t.Fatal(err)
+	}
+
+	var acrospire struct {
+		MapID      string `json:"map_id"`
+		RoleCount  int    `json:"role_count"`
+		Definition struct {
+			MapID string `json:"map_id"`
+		} `json:"definition"`
+		Thumbnail *string `json:"thumbnail_svg"`
+	}
+	if code := get(t, router, "/api/maps/acrospire", &acrospire); code != http.StatusOK {
+		t.Fatalf("GET /api/maps/acrospire status %d", code)
+	}
+	if acrospire.MapID != "oml/duos/acrospire" || acrospire.Definition.MapID != acrospire.MapID ||
+		acrospire.RoleCount != 4 {
+		t.Errorf("unexpected synced map %+v", acrospire)
+	}
+	if acrospire.Thumbnail != nil {
+		t.Error("the thumbnail should not be included in the map's JSON")
+	}
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder,
+		httptest.NewRequest(http.MethodGet, "/api/maps/acrospire/thumbnail", nil))
+	if recorder.Code != http.StatusOK ||
+		recorder.Header().Get("Content-Type") != "image/svg+xml" ||
+		!strings.HasPrefix(recorder.Body.String(), "<svg") {
+		t.Errorf("GET thumbnail status %d, %s", recorder.Code, recorder.Header().Get("Content-Type"))
+	}
+	if code := get(t, router, "/api/maps/peekaboo/thumbnail", nil); code != http.StatusNotFound {
+		t.Errorf("GET thumbnail of unsynced map status %d, expected 404", code)
+	}
+}
+
+func TestServer_Matches(t *testing.T) {
+	replayDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(replayDir, "abc123.json"),
+		[]byte(`{"game_id": "abc123", "replay": []}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	client, router := newTestServer(t, replayDir)
+	defer client.Close()
+
+	var matches []ent.Match
+	if code := get(t, router, "/api/matches?map=peekaboo", &matches); code != http.StatusOK {
+		t.Fatalf("GET /api/matches status %d", code)
+	}
+	if len(matches) != 1 || len(matches[0].Edges.Roles) != 2 {
+		t.Fatalf("unexpected match listing %v", matches)
+	}
+
+	var match ent.Match
+	if code := get(t, router, "/api/matches/abc123", &match); code != http.StatusOK {
+		t.Fatalf("GET /api/matches/abc123 status %d", code)
+	}
+	if match.TurnCount != 21 || match.Edges.Map == nil {
+		t.Errorf("unexpected match %v", match)
+	}
+	if players := match.Edges.Roles[0].Edges.Players; len(players) != 1 || players[0].Name != "alice" {
+		t.Errorf("unexpected first player %v", players)
+	}
+
+	var replay map[string]any
+	if code := get(t, router, "/api/matches/abc123/replay", &replay); code != http.StatusOK {
+		t.Fatalf("GET replay status %d", code)
+	}
+	if replay["game_id"] != "abc123" {
+		t.Errorf("unexpected replay %v", replay)
+	}
+	if code := get(t, router, "/api/matches/xyz/replay", nil); code != http.StatusNotFound {
+		t.Errorf("GET replay for unknown match status %d, expected 404", code)
+	}
+
+	ctx := context.Background()
+	recorded := client.Match.Query().OnlyX(ctx)
+	for number := 2; number > 0; number-- {
+		played := client.Turn.Create().
+			SetNumber(number).
+			SetTeam(wits.FriendlyEnum(2 - number%2)).
+			SetMatch(recorded).
+			SaveX(ctx)
+		for sequence := 1; sequence >= 0; sequence-- {
+			client.Action.Create().
+				SetSequence(sequence).
+				SetName(action.NameTeleport).
+				SetClass(wits.CLASS_SPECIAL).
+				SetRace(wits.RACE_ADORABLES).
+				SetTurnNumber(number).
+				SetAgentI(3).SetAgentJ(4).
+				SetTargetI(3).SetTargetJ(5).
+				SetDestI(5).SetDestJ(sequence).
+				SetTurn(played).
+				ExecX(ctx)
+		}
+	}
+	var turns []ent.Turn
+	if code := get(t, router, "/api/matches/abc123/turns", &turns); code != http.StatusOK {
+		t.Fatalf("GET turns status %d", code)
+	}
+	if len(turns) != 2 || turns[0].Number != 1 || turns[1].Number != 2 {
+		t.Fatalf("expected the turns in order, got %v", turns)
+	}
+	for _, played := range turns {
+		actions := played.Edges.Actions
+		if len(actions) != 2 || actions[0].Sequence != 0 || actions[1].Sequence != 1 {
+			t.Fatalf("expected the actions of turn %d in order, got %v", played.Number, actions)
+		}
+		if dest := actions[1]; dest.DestI == nil || *dest.DestI != 5 || *dest.DestJ != 1 {
+			t.Errorf("expected the teleport's destination, got %v", dest)
+		}
+	}
+	if code := get(t, router, "/api/matches/xyz/turns", nil); code != http.StatusNotFound {
+		t.Errorf("GET turns for unknown match status %d, expected 404", code)
+	}
+}
+
+func TestServer_Players(t *testing.T) {
+	client, router := newTestServer(t, "")
+	defer client.Close()
+
+	var profile map[string]any
+	if code := get(t, router, "/api/players/bob", &profile); code != http.StatusOK {
+		t.Fatalf("GET /api/players/bob status %d", code)
+	}
+	if profile["match_count"] != float64(1) {
+		t.Errorf("unexpected player profile %v", profile)
+	}
+	if _, found := profile["gcid"]; found {
+		t.Errorf("player profile should not include the GCID")
+	}
+	if code := get(t, router, "/api/players/nobody", nil); code != http.StatusNotFound {
+		t.Errorf("GET unknown player status %d, expected 404", code)
+	}
+}
+
+func TestServer_Leaderboard(t *testing.T) {
+	client, router := newTestServer(t, "")
+	defer client.Close()
+	ctx := context.Background()
+	client.PlayerRole.Update().
+		Where(playerrole.TurnOrderEQ(1)).
+		SetResult(wits.VICTORY_DESTRUCTION).
+		ExecX(ctx)
+	client.PlayerRole.Update().
+		Where(playerrole.TurnOrderEQ(2)).
+		SetResult(wits.LOSS_DESTRUCTION).
+		ExecX(ctx)
+	if _, err := rating.Recompute(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+
+	var leaders []ent.Rating
+	if code := get(t, router, "/api/leaderboard", &leaders); code != http.StatusOK {
+		t.Fatalf("GET /api/leaderboard status %d", code)
+	}
+	if len(leaders) != 2 || leaders[0].Edges.Player.Name != "alice" {
+		t.Errorf("unexpected leaderboard %v", leaders)
+	}
+	if code := get(t, router, "/api/leaderboard?mode=DUOS", &leaders); code != http.StatusOK || len(leaders) != 0 {
+		t.Errorf("expected an empty duos leaderboard, status %d %v", code, leaders)
+	}
+	if code := get(t, router, "/api/leaderboard?mode=TRIOS", nil); code != http.StatusBadRequest {
+		t.Errorf("GET leaderboard for unknown mode status %d, expected 400", code)
+	}
+}
+
+func TestServer_Seasons(t *testing.T) {
+	client, router := newTestServer(t, "")
+	defer client.Close()
+	ctx := context.Background()
+	client.Match.Update().SetSeason(1).ExecX(ctx)
+	client.PlayerRole.Update().
+		Where(playerrole.TurnOrderEQ(1)).
+		SetResult(wits.VICTORY_DESTRUCTION).
+		ExecX(ctx)
+	client.PlayerRole.Update().
+		Where(playerrole.TurnOrderEQ(2)).
+		SetResult(wits.LOSS_DESTRUCTION).
+		ExecX(ctx)
+	if _, err := season.Recompute(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+
+	var standings []ent.Standing
+	if code := get(t, router, "/api/seasons/1", &standings); code != http.StatusOK {
+		t.Fatalf("GET /api/seasons/1 status %d", code)
+	}
+	if len(standings) != 2 || standings[0].Edges.Player.Name != "alice" ||
+		standings[0].Rank != 1 || standings[0].Points != season.WIN_POINTS {
+		t.Errorf("unexpected season standings %v", standings)
+	}
+	if code := get(t, router, "/api/seasons/1?tier=Expert", &standings); code != http.StatusOK || len(standings) != 0 {
+		t.Errorf("expected no experts, status %d %v", code, standings)
+	}
+	for _, url := range []string{"/api/seasons/1?tier=Grandmaster", "/api/seasons/0", "/api/matches?season=first"} {
+		if code := get(t, router, url, nil); code != http.StatusBadRequest {
+			t.Errorf("GET %s status %d, expected 400", url, code)
+		}
+	}
+
+	var matches []ent.Match
+	if code := get(t, router, "/api/matches?season=1", &matches); code != http.StatusOK || len(matches) != 1 {
+		t.Errorf("expected the ranked match, status %d %v", code, matches)
+	}
+	if code := get(t, router, "/api/matches?season=2", &matches); code != http.StatusOK || len(matches) != 0 {
+		t.Errorf("expected no matches in the second season, status %d %v", code, matches)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/action.go
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// A player action resolved against the tile indices of a specific GameMap.
+// Which of the tile references are used depends on the kind of action:
+//
+//	MoveUnit   Agent (unit) -> Target (destination)
+//	Attack     Agent (unit) -> Target (unit or base)
+//	HealUnit   Agent (medic) -> Target (unit)
+//	CharmUnit  Agent (scrambler) -> Target (unit)
+//	SpawnUnit  Agent (spawn tile, or tile next to a bramble/thorn) + Class
+//	ToggleAlt  Agent (bombshell or bramble)
+//	Teleport   Agent (mobi), Target (unit) -> Dest
+//	Pass       (none)
+type Action struct {
+	Name   witsjson.ActionNameJSON
+	Agent  wits.HexCoordIndex
+	Target wits.HexCoordIndex
+	Dest   wits.HexCoordIndex
+	Class  wits.UnitClassEnum
+}
+
+var PassAction = Action{witsjson.PASS_PLAY, NO_TILE, NO_TILE, NO_TILE, wits.CLASS_UNKNOWN}
+
+func MoveAction(from, to wits.HexCoordIndex) Action {
+	return Action{witsjson.MOVE_UNIT, from, to, NO_TILE, wits.CLASS_UNKNOWN}
+}
+
+func AttackAction(agent, target wits.HexCoordIndex) Action {
+	return Action{witsjson.ATTACK, agent, target, NO_TILE, wits.CLASS_UNKNOWN}
+}
+
+func HealAction(medic, target wits.HexCoordIndex) Action {
+	return Action{witsjson.HEAL_UNIT, medic, target, NO_TILE, wits.CLASS_UNKNOWN}
+}
+
+func CharmAction(scrambler, target wits.HexCoordIndex) Action {
+	return Action{witsjson.CHARM_UNIT, scrambler, target, NO_TILE, wits.CLASS_UNKNOWN}
+}
+
+func SpawnAction(at wits.HexCoordIndex, class wits.UnitClassEnum) Action {
+	return Action{witsjson.SPAWN_UNIT, at, NO_TILE, NO_TILE, class}
+}
+
+func ToggleAction(at wits.HexCoordIndex) Action {
+	return Action{witsjson.TOGGLE_ALT, at, NO_TILE, NO_TILE, wits.CLASS_UNKNOWN}
+}
+
+func TeleportAction(mobi, from, to wits.HexCoordIndex) Action {
+	return Action{witsjson.TELEPORT_UNIT, mobi, from, to, wits.CLASS_UNKNOWN}
+}
+
+func (action Action) IsPass() bool { return action.Name == witsjson.PASS_PLAY }
+
+// Resolves the coordinates of a decoded player action into tile indices.
+func (gamemap GameMap) Resolve(action wits.PlayerAction) (Action, error) {
+	index := func(coord wits.HexCoord) (wits.HexCoordIndex, error) {
+		at := gamemap.Index(coord)
+		if at == NO_TILE {
+			return at, fmt.Errorf("%s refers to [%d, %d] which is not a map tile",
+				action.ActionName(), coord.I(), coord.J())
+		}
+		return at, nil
+	}
+	indices := func(coords ...wits.HexCoord) ([]wits.HexCoordIndex, error) {
+		resolved := make([]wits.HexCoordIndex, len(coords))
+		for i, coord := range coords {
+			at, err := index(coord)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = at
+		}
+		return resolved, nil
+	}
+
+	switch typed := action.(type) {
+	case wits.PassAction:
+		return PassAction, nil
+	case witsjson.MoveUnitAction:
+		at, err := indices(typed.From, typed.To)
+		if err != nil {
+			return Action{}, err
+		}
+		return MoveAction(at[0], at[1]), nil
+	case witsjson.AttackAction:
+		at, err := indices(typed.Agent, typed.Target)
+		if err != nil {
+			return Action{}, err
+		}
+		return AttackAction(at[0], at[1]), nil
+	case witsjson.HealUnitAction:
+		at, err := indices(typed.Healer, typed.Target)
+		if err != nil {
+			return Action{}, err
+		}
+		return HealAction(at[0], at[1]), nil
+	case witsjson.CharmUnitAction:
+		at, err := indices(typed.Agent, typed.Target)
+		if err != nil {
+			return Action{}, err
+		}
+		return CharmAction(at[0], at[1]), nil
+	case witsjson.SpawnUnitAction:
+		at, err := index(typed.Spawn)
+		if err != nil {
+			return Action{}, err
+		}
+		return SpawnAction(at, wits.UnitClassEnum(typed.Class)), nil
+	case witsjson.ToggleAltAction:
+		at, err := index(typed.Position)
+		if err != nil {
+			return Action{}, err
+		}
+		return ToggleAction(at), nil
+	case witsjson.TeleportUnitAction:
+		at, err := indices(typed.Mobi, typed.From, typed.To)
+		if err != nil {
+			return Action{}, err
+		}
+		return TeleportAction(at[0], at[1], at[2]), nil
+	}
+	return Action{}, wits.UnknownActionError{Name: action.ActionName()}
+}
+
+// Converts the action back into its coordinate-based representation.
+func (gamemap GameMap) PlayerAction(action Action) wits.PlayerAction {
+	coord := gamemap.Coord
+	switch action.Name {
+	case witsjson.MOVE_UNIT:
+		return witsjson.MoveUnitAction{
+			From: coord(action.Agent), To: coord(action.Target)}
+	case witsjson.ATTACK:
+		return witsjson.AttackAction{
+			Agent: coord(action.Agent), Target: coord(action.Target)}
+	case witsjson.HEAL_UNIT:
+		return witsjson.HealUnitAction{
+			Healer: coord(action.Agent), Target: coord(action.Target)}
+	case witsjson.CHARM_UNIT:
+		return witsjson.CharmUnitAction{
+			Agent: coord(action.Agent), Target: coord(action.Target)}
+	case witsjson.SPAWN_UNIT:
+		return witsjson.SpawnUnitAction{
+			Spawn: coord(action.Agent), Class: witsjson.UnitClassJSON(action.Class)}
+	case witsjson.TOGGLE_ALT:
+		return witsjson.ToggleAltAction{Position: coord(action.Agent)}
+	case witsjson.TELEPORT_UNIT:
+		return witsjson.TeleportUnitAction{
+			Mobi: coord(action.Agent), From: coord(action.Target), To: coord(action.Dest)}
+	}
+	return wits.PassAction{}
+}
@@ -0,0 +1,406 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/apply.go
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The reason that an action was not allowed in the current state.
+type IllegalActionError struct {
+	Action Action
+	Reason string
+}
+
+func (err IllegalActionError) Error() string {
+	return fmt.Sprintf("illegal %s: %s", err.Action.Name, err.Reason)
+}
+
+// Validates the action for the current player and, if it is legal, performs it.
+// The state is not modified when an error is returned.  A PassAction is always
+// legal and has no effect, the turn ends only when EndTurn is called.
+func (state *GameState) Apply(action Action) error {
+	if err := state.Check(action); err != nil {
+		return err
+	}
+	state.perform(action)
+	return nil
+}
+
+// Returns nil if the action is legal for the current player, otherwise an
+// IllegalActionError describing why it is not.
+func (state *GameState) Check(action Action) error {
+	illegal := func(format string, args ...any) error {
+		return IllegalActionError{action, fmt.Sprintf(format, args...)}
+	}
+	if state.IsOver() {
+		return illegal("the match is over")
+	}
+	if action.IsPass() {
+		return nil
+	}
+	if !state.isTile(action.Agent) {
+		return illegal("no tile at index %d", action.Agent)
+	}
+	player := state.players[state.current-1]
+	cost := state.rules.ActionCost
+	if action.Name == witsjson.SPAWN_UNIT {
+		cost = state.rules.Cost(action.Class)
+	}
+	if player.Wits < cost {
+		return illegal("requires %d wits, only %d remain", cost, player.Wits)
+	}
+
+	if action.Name == witsjson.SPAWN_UNIT {
+		return state.checkSpawn(action, illegal)
+	}
+
+	agent := state.board[action.Agent]
+	if agent.IsEmpty() || agent.Team() != state.current {
+		return illegal("no unit of the current player at %s", state.at(action.Agent))
+	}
+
+	switch action.Name {
+	case witsjson.MOVE_UNIT:
+		if agent.HasMoved() || agent.HasActed() {
+			return illegal("unit at %s cannot move again this turn", state.at(action.Agent))
+		}
+		if !state.canReach(action.Agent, action.Target) {
+			return illegal("%s is not reachable from %s", state.at(action.Target), state.at(action.Agent))
+		}
+
+	case witsjson.ATTACK:
+		if agent.HasActed() {
+			return illegal("unit at %s has already acted this turn", state.at(action.Agent))
+		}
+		if !state.isTile(action.Target) || !state.isEnemyTarget(action.Target) {
+			return illegal("nothing to attack at %s", state.at(action.Target))
+		}
+		if !state.InRange(action.Agent, action.Target) {
+			return illegal("%s is out of range of %s", state.at(action.Target), state.at(action.Agent))
+		}
+
+	case witsjson.HEAL_UNIT:
+		if agent.Class() != wits.CLASS_MEDIC || agent.HasActed() {
+			return illegal("unit at %s cannot heal", state.at(action.Agent))
+		}
+		if !state.isAdjacentUnit(action.Agent, action.Target) ||
+			Side(state.board[action.Target].Team()) != Side(state.current) {
+			return illegal("no friendly unit next to the medic at %s", state.at(action.Target))
+		}
+
+	case witsjson.CHARM_UNIT:
+		if agent.Special() != SPECIAL_SCRAMBLER || agent.HasActed() {
+			return illegal("unit at %s cannot charm", state.at(action.Agent))
+		}
+		if !state.isAdjacentUnit(action.Agent, action.Target) {
+			return illegal("no unit next to the scrambler at %s", state.at(action.Target))
+		}
+		target := state.board[action.Target]
+		if Side(target.Team()) == Side(state.current) ||
+			target.IsSpecial() || target.Class() == wits.CLASS_THORN {
+			return illegal("unit at %s cannot be charmed", state.at(action.Target))
+		}
+
+	case witsjson.TOGGLE_ALT:
+		if !agent.Special().HasAlternate() || agent.HasAlted() {
+			return illegal("unit at %s cannot toggle", state.at(action.Agent))
+		}
+		if agent.Special() == SPECIAL_BOMBSHELL && !agent.IsAlternate() && agent.HasActed() {
+			return illegal("bombshell at %s has already acted", state.at(action.Agent))
+		}
+
+	case witsjson.TELEPORT_UNIT:
+		if agent.Special() != SPECIAL_MOBI || agent.HasActed() {
+			return illegal("unit at %s cannot teleport", state.at(action.Agent))
+		}
+		if !state.isAdjacentUnit(action.Agent, action.Target) {
+			return illegal("no unit next to the mobi at %s", state.at(action.Target))
+		}
+		target := state.board[action.Target]
+		if Side(target.Team()) != Side(state.current) || state.rules.Distance(target) == 0 {
+			return illegal("unit at %s cannot be teleported", state.at(action.Target))
+		}
+		if !state.isTile(action.Dest) || !state.isEmptyFloor(action.Dest) ||
+			state.gamemap.Distance(action.Agent, action.Dest) > state.rules.Distance(agent) {
+			return illegal("cannot teleport to %s", state.at(action.Dest))
+		}
+
+	default:
+		return wits.UnknownActionError{Name: string(action.Name)}
+	}
+	return nil
+}
+
+// Units are spawned at the current player's spawn tiles, once per tile each
+// turn.  Thorns are spawned instead next to a rooted bramble or its thorns.
+func (state *GameState) checkSpawn(action Action, illegal func(string, ...any) error) error {
+	at := action.Agent
+	if !state.isEmptyFloor(at) {
+		return illegal("cannot spawn at occupied tile %s", state.at(at))
+	}
+
+	if action.Class == wits.CLASS_THORN {
+		if state.thornParent(at) == NO_TILE {
+			return illegal("no rooted bramble or thorn next to %s", state.at(at))
+		}
+		return nil
+	}
+
+	if action.Class == wits.CLASS_UNKNOWN || action.Class > wits.CLASS_SPECIAL {
+		return illegal("cannot spawn a unit of class %s", action.Class)
+	}
+	if state.gamemap.SpawnTeam(at) != state.current {
+		return illegal("%s is not a spawn tile of the current player", state.at(at))
+	}
+	if state.used[at] {
+		return illegal("spawn tile %s was already used this turn", state.at(at))
+	}
+	if action.Class == wits.CLASS_SPECIAL {
+		for _, unit := range state.board {
+			if unit.IsSpecial() && unit.Team() == state.current {
+				return illegal("only one special unit may be on the board")
+			}
+		}
+	}
+	return nil
+}
+
+// Performs the (already validated) action.
+func (state *GameState) perform(action Action) {
+	if action.IsPass() {
+		return
+	}
+	player := &state.players[state.current-1]
+	agent := state.board[action.Agent]
+
+	switch action.Name {
+	case witsjson.MOVE_UNIT:
+		player.Wits -= state.rules.ActionCost
+		state.board[action.Target] = agent | movedBit
+		state.board[action.Agent] = 0
+
+	case witsjson.ATTACK:
+		player.Wits -= state.rules.ActionCost
+		state.board[action.Agent] = agent | actedBit
+		strength := state.rules.Strength(agent)
+		if team := state.gamemap.BaseTeam(action.Target); team != wits.FR_UNKNOWN {
+			state.damageBase(team, strength)
+		} else {
+			state.damageUnit(action.Target, strength)
+		}
+		if agent.Special() == SPECIAL_BOMBSHELL {
+			// Splash damage to the enemies surrounding the target.
+			for _, neighbor := range state.gamemap.Neighbors(action.Target) {
+				unit := state.board[neighbor]
+				if !unit.IsEmpty() && Side(unit.Team()) != Side(state.current) {
+					state.damageUnit(neighbor, state.rules.SplashDamage)
+				}
+			}
+		}
+
+	case witsjson.HEAL_UNIT:
+		player.Wits -= state.rules.ActionCost
+		state.board[action.Agent] = agent | actedBit
+		state.board[action.Target] = state.rules.Boost(state.board[action.Target])
+
+	case witsjson.CHARM_UNIT:
+		player.Wits -= state.rules.ActionCost
+		state.board[action.Agent] = agent | actedBit
+		state.board[action.Target] = state.board[action.Target].charm(state.current)
+
+	case witsjson.TOGGLE_ALT:
+		player.Wits -= state.rules.ActionCost
+		toggled := agent.toggle()
+		if agent.Special() == SPECIAL_BRAMBLE && !toggled.IsAlternate() {
+			state.retractThorns(action.Agent)
+		}
+		state.board[action.Agent] = toggled
+
+	case witsjson.TELEPORT_UNIT:
+		player.Wits -= state.rules.ActionCost
+		state.board[action.Agent] = agent | actedBit
+		state.board[action.Dest] = state.board[action.Target]
+		state.board[action.Target] = 0
+
+	case witsjson.SPAWN_UNIT:
+		player.Wits -= state.rules.Cost(action.Class)
+		race := player.Race
+		if action.Class == wits.CLASS_THORN {
+			parent := state.thornParent(action.Agent)
+			state.parent[action.Agent] = parent
+			race = state.board[parent].Race()
+		} else {
+			state.used[action.Agent] = true
+		}
+		state.board[action.Agent] = state.rules.NewUnit(action.Class, race, state.current).exhaust()
+	}
+	state.checkDestruction()
+}
+
+func (state *GameState) damageBase(team wits.FriendlyEnum, amount wits.UnitHealth) {
+	player := &state.players[team-1]
+	if wits.UnitHealth(player.BaseHP) <= amount {
+		player.BaseHP = 0
+		// The units of a defeated player are removed along with their base.
+		for index, unit := range state.board {
+			if unit.Team() == team {
+				state.removeUnit(wits.HexCoordIndex(index))
+			}
+		}
+		return
+	}
+	player.BaseHP -= wits.BaseHealth(amount)
+}
+
+func (state *GameState) damageUnit(at wits.HexCoordIndex, amount wits.UnitHealth) {
+	damaged := state.board[at].damage(amount)
+	if damaged.IsEmpty() {
+		state.removeUnit(at)
+		return
+	}
+	state.board[at] = damaged
+}
+
+// Removes the unit, along with any thorns that grew from it.
+func (state *GameState) removeUnit(at wits.HexCoordIndex) {
+	state.board[at] = 0
+	state.parent[at] = NO_TILE
+	state.retractThorns(at)
+}
+
+func (state *GameState) retractThorns(from wits.HexCoordIndex) {
+	for index, parent := range state.parent {
+		if parent == from {
+			state.removeUnit(wits.HexCoordIndex(index))
+		}
+	}
+}
+
+// A thorn grows from an adjacent rooted bramble or thorn of the current player.
+func (state *GameState) thornParent(at wits.HexCoordIndex) wits.HexCoordIndex {
+	for _, neighbor := range state.gamemap.Neighbors(at) {
+		unit := state.board[neighbor]
+		if unit.Team() != state.current {
+			continue
+		}
+		if (unit.Special() == SPECIAL_BRAMBLE && unit.IsAlternate()) ||
+			unit.Class() == wits.CLASS_THORN {
+			return neighbor
+		}
+	}
+	return NO_TILE
+}
+
+//
+// Positional queries.
+//
+
+// Returns the tiles that the unit at this tile can move to.  Units may pass
+// through tiles occupied by their own side but not through their opponents.
+func (state *GameState) Reachable(from wits.HexCoordIndex) []wits.HexCoordIndex {
+	unit := state.board[from]
+	reachable := make([]wits.HexCoordIndex, 0)
+	distance := state.rules.Distance(unit)
+	if unit.IsEmpty() || distance == 0 {
+		return reachable
+	}
+	side := Side(unit.Team())
+	visited := make([]bool, len(state.board))
+	visited[from] = true
+	frontier := []wits.HexCoordIndex{from}
+	for step := wits.TileDistance(0); step < distance; step++ {
+		next := make([]wits.HexCoordIndex, 0)
+		for _, index := range frontier {
+			for _, neighbor := range state.gamemap.Neighbors(index) {
+				if visited[neighbor] || !state.gamemap.IsWalkable(neighbor) {
+					continue
+				}
+				visited[neighbor] = true
+				occupant := state.board[neighbor]
+				if occupant.IsEmpty() {
+					reachable = append(reachable, neighbor)
+				} else if Side(occupant.Team()) != side {
+					continue
+				}
+				next = append(next, neighbor)
+			}
+		}
+		frontier = next
+	}
+	return reachable
+}
+
+func (state *GameState) canReach(from, to wits.HexCoordIndex) bool {
+	if !state.isTile(to) {
+		return false
+	}
+	for _, index := range state.Reachable(from) {
+		if index == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether the unit at agent can attack the target tile from where it stands.
+// Bases are larger than a single tile, so they are one tile closer.
+func (state *GameState) InRange(agent, target wits.HexCoordIndex) bool {
+	reach := state.rules.Range(state.board[agent])
+	if reach == 0 {
+		return false
+	}
+	distance := state.gamemap.Distance(agent, target)
+	if state.gamemap.BaseTeam(target) != wits.FR_UNKNOWN {
+		distance -= 1
+	}
+	return distance <= reach
+}
+
+func (state *GameState) isTile(index wits.HexCoordIndex) bool {
+	return int(index) < len(state.board)
+}
+
+func (state *GameState) isEmptyFloor(index wits.HexCoordIndex) bool {
+	return state.gamemap.IsWalkable(index) && state.board[index].IsEmpty()
+}
+
+func (state *GameState) isAdjacentUnit(from, to wits.HexCoordIndex) bool {
+	return state.isTile(to) && !state.board[to].IsEmpty() &&
+		state.gamemap.Distance(from, to) == 1
+}
+
+// An opponent's unit or a standing base of an opponent.
+func (state *GameState) isEnemyTarget(index wits.HexCoordIndex) bool {
+	if team := state.gamemap.BaseTeam(index); team != wits.FR_UNKNOWN {
+		return Side(team) != Side(state.current) && state.players[team-1].BaseHP > 0
+	}
+	unit := state.board[index]
+	return !unit.IsEmpty() && Side(unit.Team()) != Side(state.current)
+}
+
+// Formats the tile's coordinate for error messages.
+func (state *GameState) at(index wits.HexCoordIndex) string {
+	if !state.isTile(index) {
+		return fmt.Sprintf("#%d", index)
+	}
+	coord := state.gamemap.Coord(index)
+	return fmt.Sprintf("[%d, %d]", coord.I(), coord.J())
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/coords.go
+
+package state
+
+import (
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Converts the coordinate into axial (q, r) coordinates.  Legacy coordinates
+// are column-major with odd columns shifted down by half a tile, the others
+// are already in axial form.
+func ToAxial(coord wits.HexCoord, legacy bool) [2]int {
+	if !legacy {
+		return [2]int{coord.I(), coord.J()}
+	}
+	axial := witsjson.LegacyToAxial(coord)
+	return [2]int{axial.I(), axial.J()}
+}
+
+// The number of steps between the axial coordinates, regardless of obstacles.
+func AxialDistance(a, b [2]int) int {
+	dq, dr := a[0]-b[0], a[1]-b[1]
+	return (abs(dq) + abs(dr) + abs(dq+dr)) / 2
+}
+
+// The offsets of a tile's neighbors, in axial coordinates.
+var AXIAL_NEIGHBORS = [6][2]int{{1, 0}, {1, -1}, {0, -1}, {-1, 0}, {-1, 1}, {0, 1}}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
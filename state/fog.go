@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/fog.go
+
+package state
+
+import "github.com/kevindamm/wits-go"
+
+// Returns which tiles (by index) are visible to this team.  Vision is shared
+// between allies and extends from every unit and every base of the side.
+func (state *GameState) Visible(team wits.FriendlyEnum) []bool {
+	gamemap := state.gamemap
+	visible := make([]bool, len(state.board))
+	reveal := func(from wits.HexCoordIndex, vision wits.TileDistance) {
+		for index := range visible {
+			if gamemap.Distance(from, wits.HexCoordIndex(index)) <= vision {
+				visible[index] = true
+			}
+		}
+	}
+
+	side := Side(team)
+	for i, player := range state.players {
+		owner := wits.FriendlyEnum(i + 1)
+		if Side(owner) == side && player.BaseHP > 0 {
+			reveal(gamemap.Base(owner), state.rules.BaseVision)
+		}
+	}
+	for index, unit := range state.board {
+		if !unit.IsEmpty() && Side(unit.Team()) == side {
+			reveal(wits.HexCoordIndex(index), state.rules.Vision(unit.Class()))
+		}
+	}
+	return visible
+}
+
+// Returns a copy of the state as this team would see it, without any of the
+// opposing units that are hidden in the fog of war.  Terrain, bases and the
+// players' resources are always known.
+func (state *GameState) Fogged(team wits.FriendlyEnum) *GameState {
+	fogged := state.Clone()
+	visible := state.Visible(team)
+	side := Side(team)
+	for index, unit := range fogged.board {
+		if !unit.IsEmpty() && Side(unit.Team()) != side && !visible[index] {
+			fogged.board[index] = 0
+			fogged.parent[index] = NO_TILE
+		}
+	}
+	return fogged
+}
+
+// Returns a copy of the state with these units added, as a guess at the units
+// hidden in the fog of war.  Units on tiles that are occupied or not walkable
+// are not added.
+func (state *GameState) Determinized(hidden []TileUnit) *GameState {
+	determinized := state.Clone()
+	for _, unit := range hidden {
+		if determinized.isTile(unit.At) && determinized.isEmptyFloor(unit.At) &&
+			!unit.IsEmpty() && unit.Class() != wits.CLASS_THORN {
+			determinized.board[unit.At] = unit.UnitBits
+		}
+	}
+	return determinized
+}
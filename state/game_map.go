@@ -0,0 +1,199 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/game_map.go
+
+package state
+
+import (
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The game map, prepared for simulation.  Every tile that a unit may stand on
+// (and every base, which units may attack) is given a HexCoordIndex, and the
+// adjacency and distances between indices are precomputed.  Walls are not
+// indexed because nothing can interact with them.
+type GameMap struct {
+	id     wits.GameMapID
+	name   wits.GameMapName
+	legacy bool
+
+	tiles     []tile
+	lookup    map[[2]int]wits.HexCoordIndex
+	neighbors [][]wits.HexCoordIndex
+	distance  [][]wits.TileDistance
+
+	bases  []wits.HexCoordIndex   // indexed by team-1
+	spawns [][]wits.HexCoordIndex // indexed by team-1
+	bonus  []wits.HexCoordIndex
+	units  []wits.UnitInit
+}
+
+// Sentinel value for tile references that do not (or cannot) exist.
+const NO_TILE wits.HexCoordIndex = 0xFF
+
+type tileKind byte
+
+const (
+	TILE_FLOOR tileKind = iota
+	TILE_BONUS
+	TILE_SPAWN
+	TILE_BASE
+)
+
+type tile struct {
+	coord witsjson.HexCoordJSON
+	axial [2]int
+	kind  tileKind
+	team  wits.FriendlyEnum
+}
+
+// Prepares the map description for simulation.  Assumes the description has
+// already been validated (see cmd/validate_map), tiles at repeated coordinates
+// will take the index of their first appearance.
+func NewGameMap(description wits.MapDescription) GameMap {
+	gamemap := GameMap{
+		id:     description.MapID(),
+		name:   description.MapName(),
+		legacy: description.Legacy(),
+		lookup: make(map[[2]int]wits.HexCoordIndex),
+		units:  description.Units(),
+	}
+
+	terrain := description.Terrain()
+	gamemap.addTiles(terrain.Floor(), TILE_FLOOR)
+	gamemap.addTiles(terrain.Bonus(), TILE_BONUS)
+	gamemap.addTiles(terrain.Spawn(), TILE_SPAWN)
+	gamemap.addTiles(terrain.Base(), TILE_BASE)
+
+	count := len(gamemap.tiles)
+	gamemap.neighbors = make([][]wits.HexCoordIndex, count)
+	gamemap.distance = make([][]wits.TileDistance, count)
+	for i, from := range gamemap.tiles {
+		gamemap.distance[i] = make([]wits.TileDistance, count)
+		for j, to := range gamemap.tiles {
+			dist := AxialDistance(from.axial, to.axial)
+			gamemap.distance[i][j] = wits.TileDistance(min(dist, 0xFF))
+			if dist == 1 {
+				gamemap.neighbors[i] = append(gamemap.neighbors[i], wits.HexCoordIndex(j))
+			}
+		}
+	}
+	return gamemap
+}
+
+func (gamemap *GameMap) addTiles(defs []wits.TileDefinition, kind tileKind) {
+	for _, def := range defs {
+		coord := witsjson.NewHexCoord(def.Position().I(), def.Position().J())
+		key := [2]int{coord.I(), coord.J()}
+		if _, exists := gamemap.lookup[key]; exists {
+			continue
+		}
+		index := wits.HexCoordIndex(len(gamemap.tiles))
+		gamemap.tiles = append(gamemap.tiles, tile{
+			coord, ToAxial(coord, gamemap.legacy), kind, def.Team()})
+		gamemap.lookup[key] = index
+
+		team := int(def.Team()) - 1
+		switch kind {
+		case TILE_BONUS:
+			gamemap.bonus = append(gamemap.bonus, index)
+		case TILE_SPAWN:
+			for len(gamemap.spawns) <= team {
+				gamemap.spawns = append(gamemap.spawns, nil)
+			}
+			gamemap.spawns[team] = append(gamemap.spawns[team], index)
+		case TILE_BASE:
+			for len(gamemap.bases) <= team {
+				gamemap.bases = append(gamemap.bases, NO_TILE)
+			}
+			gamemap.bases[team] = index
+		}
+	}
+}
+
+func (gamemap GameMap) MapID() wits.GameMapID     { return gamemap.id }
+func (gamemap GameMap) MapName() wits.GameMapName { return gamemap.name }
+func (gamemap GameMap) Legacy() bool              { return gamemap.legacy }
+func (gamemap GameMap) Units() []wits.UnitInit    { return gamemap.units }
+
+// The number of indexed tiles, all indices are less than this value.
+func (gamemap GameMap) TileCount() int { return len(gamemap.tiles) }
+
+// The number of players this map is made for (one base per player).
+func (gamemap GameMap) RoleCount() int { return len(gamemap.bases) }
+
+// Returns the index for this coordinate, or NO_TILE if the coordinate is not
+// walkable (or a base) on this map.
+func (gamemap GameMap) Index(coord wits.HexCoord) wits.HexCoordIndex {
+	if index, ok := gamemap.lookup[[2]int{coord.I(), coord.J()}]; ok {
+		return index
+	}
+	return NO_TILE
+}
+
+// Converts the index back into its coordinate (as written in the map's file).
+func (gamemap GameMap) Coord(index wits.HexCoordIndex) witsjson.HexCoordJSON {
+	return gamemap.tiles[index].coord
+}
+
+// The adjacent indexed tiles, walls and off-map positions are not included.
+func (gamemap GameMap) Neighbors(index wits.HexCoordIndex) []wits.HexCoordIndex {
+	return gamemap.neighbors[index]
+}
+
+// Straight-line distance between the tiles, regardless of obstacles.
+func (gamemap GameMap) Distance(from, to wits.HexCoordIndex) wits.TileDistance {
+	return gamemap.distance[from][to]
+}
+
+func (gamemap GameMap) IsWalkable(index wits.HexCoordIndex) bool {
+	return gamemap.tiles[index].kind != TILE_BASE
+}
+
+func (gamemap GameMap) IsBonus(index wits.HexCoordIndex) bool {
+	return gamemap.tiles[index].kind == TILE_BONUS
+}
+
+// Returns the team that may spawn units at this tile, or FR_UNKNOWN if none.
+func (gamemap GameMap) SpawnTeam(index wits.HexCoordIndex) wits.FriendlyEnum {
+	if gamemap.tiles[index].kind != TILE_SPAWN {
+		return wits.FR_UNKNOWN
+	}
+	return gamemap.tiles[index].team
+}
+
+// Returns the team whose base is at this tile, or FR_UNKNOWN if not a base.
+func (gamemap GameMap) BaseTeam(index wits.HexCoordIndex) wits.FriendlyEnum {
+	if gamemap.tiles[index].kind != TILE_BASE {
+		return wits.FR_UNKNOWN
+	}
+	return gamemap.tiles[index].team
+}
+
+func (gamemap GameMap) Base(team wits.FriendlyEnum) wits.HexCoordIndex {
+	return gamemap.bases[team-1]
+}
+
+func (gamemap GameMap) Spawns(team wits.FriendlyEnum) []wits.HexCoordIndex {
+	if int(team) > len(gamemap.spawns) {
+		return nil
+	}
+	return gamemap.spawns[team-1]
+}
+
+func (gamemap GameMap) BonusTiles() []wits.HexCoordIndex {
+	return gamemap.bonus
+}
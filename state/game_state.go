@@ -0,0 +1,304 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/game_state.go
+
+package state
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/kevindamm/wits-go"
+)
+
+// The complete state of a match in progress, sufficient for simulating it.
+// Satisfies the wits.GameState interface.
+type GameState struct {
+	gamemap *GameMap
+	rules   *Ruleset
+	turn    uint
+	current wits.FriendlyEnum
+	players []PlayerState // indexed by team-1
+
+	board  []UnitBits           // indexed by tile
+	parent []wits.HexCoordIndex // indexed by tile, NO_TILE if no parent
+	used   []bool               // spawn tiles that were used this turn
+
+	// The result is relative to the first player (FR_SELF).
+	result wits.TerminalStatus
+}
+
+// The per-player resources.
+type PlayerState struct {
+	Race   wits.UnitRaceEnum
+	Wits   wits.ActionPoints
+	BaseHP wits.BaseHealth
+}
+
+// Starts a new match on this map with the players' choice of race (in order
+// of their team, the first player is FR_SELF).  The first turn has started.
+func NewGame(gamemap *GameMap, races []wits.UnitRaceEnum) (*GameState, error) {
+	return NewGameWithRules(gamemap, races, LatestRules())
+}
+
+// Starts a new match (as NewGame does) played under these rules, which are
+// shared with every copy of the state and must not be modified.
+func NewGameWithRules(gamemap *GameMap, races []wits.UnitRaceEnum, rules *Ruleset) (*GameState, error) {
+	if len(races) != gamemap.RoleCount() {
+		return nil, fmt.Errorf("map %s requires %d players, %d races were given",
+			gamemap.MapID(), gamemap.RoleCount(), len(races))
+	}
+	count := gamemap.TileCount()
+	state := &GameState{
+		gamemap: gamemap,
+		rules:   rules,
+		turn:    1,
+		current: wits.FR_SELF,
+		players: make([]PlayerState, len(races)),
+		board:   make([]UnitBits, count),
+		parent:  make([]wits.HexCoordIndex, count),
+		used:    make([]bool, count),
+	}
+	for i, race := range races {
+		if race == wits.RACE_UNKNOWN || race > wits.RACE_VEGGIENAUTS {
+			return nil, fmt.Errorf("invalid race %d for player %d", race, i+1)
+		}
+		state.players[i] = PlayerState{race, 0, rules.BaseHP}
+	}
+	for i := range state.parent {
+		state.parent[i] = NO_TILE
+	}
+
+	for _, init := range gamemap.Units() {
+		at := gamemap.Index(init.Position())
+		if at == NO_TILE || !gamemap.IsWalkable(at) {
+			return nil, fmt.Errorf("initial unit at [%d, %d] is not on a walkable tile",
+				init.Position().I(), init.Position().J())
+		}
+		team := init.Team()
+		if team == wits.FR_UNKNOWN || int(team) > len(races) {
+			return nil, fmt.Errorf("initial unit at [%d, %d] has no team",
+				init.Position().I(), init.Position().J())
+		}
+		unit := rules.NewUnit(init.Class(), races[team-1], team)
+		if init.Health() > 0 {
+			unit = unit.withHealth(init.Health())
+		}
+		state.board[at] = unit
+	}
+
+	state.startTurn()
+	return state, nil
+}
+
+// Deep-copies the state, the map is shared because it is never modified.
+func (state *GameState) Clone() *GameState {
+	copied := *state
+	copied.players = slices.Clone(state.players)
+	copied.board = slices.Clone(state.board)
+	copied.parent = slices.Clone(state.parent)
+	copied.used = slices.Clone(state.used)
+	return &copied
+}
+
+func (state *GameState) Map() *GameMap { return state.gamemap }
+
+// The rules the match is played under, shared by every copy of the state.
+func (state *GameState) Rules() *Ruleset { return state.rules }
+
+// The turn count, starting at 1 and advancing with every player's turn.
+func (state *GameState) Turn() uint { return state.turn }
+
+// The team of the player whose turn it is.
+func (state *GameState) Current() wits.FriendlyEnum { return state.current }
+
+func (state *GameState) PlayerCount() int { return len(state.players) }
+
+func (state *GameState) Player(team wits.FriendlyEnum) PlayerState {
+	return state.players[team-1]
+}
+
+func (state *GameState) UnitAt(index wits.HexCoordIndex) UnitBits {
+	return state.board[index]
+}
+
+// The tile of the bramble or thorn that this thorn grew from, or NO_TILE.
+func (state *GameState) Parent(index wits.HexCoordIndex) wits.HexCoordIndex {
+	return state.parent[index]
+}
+
+func (state *GameState) IsSpawnUsed(index wits.HexCoordIndex) bool {
+	return state.used[index]
+}
+
+// The match result, relative to the first player (FR_SELF).
+func (state *GameState) Result() wits.TerminalStatus { return state.result }
+
+// The match result relative to the player of this team.
+func (state *GameState) ResultFor(team wits.FriendlyEnum) wits.TerminalStatus {
+	if Side(team) == wits.FR_SELF {
+		return state.result
+	}
+	return state.result.Opposing()
+}
+
+func (state *GameState) IsOver() bool { return state.result != wits.STATUS_UNKNOWN }
+
+// The side (FR_SELF or FR_ENEMY) that a team plays for.  Allies in duos share
+// the side of the first player, the second enemy shares the side of the first.
+func Side(team wits.FriendlyEnum) wits.FriendlyEnum {
+	return team.Opponent().Opponent()
+}
+
+//
+// Satisfying the wits.GameState interface.
+//
+
+func (state *GameState) BaseHP(player wits.FriendlyEnum) wits.BaseHealth {
+	return state.players[player-1].BaseHP
+}
+
+func (state *GameState) BonusWits() []wits.HexCoord {
+	bonus := make([]wits.HexCoord, 0, len(state.gamemap.BonusTiles()))
+	for _, index := range state.gamemap.BonusTiles() {
+		bonus = append(bonus, state.gamemap.Coord(index))
+	}
+	return bonus
+}
+
+func (state *GameState) Units() []wits.UnitPlacement {
+	units := make([]wits.UnitPlacement, 0)
+	for index, unit := range state.board {
+		if !unit.IsEmpty() {
+			units = append(units, TileUnit{unit, wits.HexCoordIndex(index)})
+		}
+	}
+	return units
+}
+
+// A unit along with the index of the tile it is on.  Satisfies UnitPlacement.
+type TileUnit struct {
+	UnitBits
+	At wits.HexCoordIndex
+}
+
+func (unit TileUnit) Index() wits.HexCoordIndex { return unit.At }
+
+//
+// Turn progression.
+//
+
+// Refreshes the current team's units and collects their wits for the turn.
+func (state *GameState) startTurn() {
+	for index, unit := range state.board {
+		if unit.Team() == state.current {
+			state.board[index] = unit.refresh()
+		}
+	}
+	clear(state.used)
+	player := &state.players[state.current-1]
+	player.Wits = min(player.Wits+state.Income(state.current), state.rules.MaxWits)
+}
+
+// The wits a team collects at the start of their turn, including one for each
+// bonus tile that one of their units is standing on.
+func (state *GameState) Income(team wits.FriendlyEnum) wits.ActionPoints {
+	income := state.rules.WitsPerTurn
+	for _, index := range state.gamemap.BonusTiles() {
+		if state.board[index].Team() == team {
+			income += state.rules.WitsPerBonus
+		}
+	}
+	return income
+}
+
+// Ends the current player's turn, determining whether the match has ended and,
+// if it has not, starting the next player's turn.
+func (state *GameState) EndTurn() {
+	if state.IsOver() {
+		return
+	}
+	state.checkExtinction()
+	if state.IsOver() {
+		return
+	}
+
+	next := state.current
+	for range state.players {
+		next = next%wits.FriendlyEnum(len(state.players)) + 1
+		if state.players[next-1].BaseHP > 0 {
+			break
+		}
+	}
+	state.current = next
+	state.turn += 1
+	state.startTurn()
+}
+
+// The player of this team concedes the match, on behalf of their whole side.
+func (state *GameState) Resign(team wits.FriendlyEnum) {
+	if state.IsOver() {
+		return
+	}
+	state.setWinner(Side(team).Opponent(), wits.VICTORY_RESIGNATION)
+}
+
+// The current player ran out of time; this is not counted as a resignation.
+func (state *GameState) Timeout() {
+	if state.IsOver() {
+		return
+	}
+	state.result = wits.DELAY_OF_GAME
+}
+
+func (state *GameState) setWinner(side wits.FriendlyEnum, victory wits.TerminalStatus) {
+	if side == wits.FR_SELF {
+		state.result = victory
+	} else {
+		state.result = victory.Opposing()
+	}
+}
+
+// A side without any units remaining has been driven extinct.
+func (state *GameState) checkExtinction() {
+	var remaining [3]int // indexed by side
+	for _, unit := range state.board {
+		if !unit.IsEmpty() {
+			remaining[Side(unit.Team())] += 1
+		}
+	}
+	for _, side := range []wits.FriendlyEnum{wits.FR_SELF, wits.FR_ENEMY} {
+		if remaining[side] == 0 {
+			state.setWinner(side.Opponent(), wits.VICTORY_EXTINCTION)
+			return
+		}
+	}
+}
+
+// A side with all of its bases destroyed has lost the match.
+func (state *GameState) checkDestruction() {
+	var standing [3]int // indexed by side
+	for i, player := range state.players {
+		if player.BaseHP > 0 {
+			standing[Side(wits.FriendlyEnum(i+1))] += 1
+		}
+	}
+	for _, side := range []wits.FriendlyEnum{wits.FR_SELF, wits.FR_ENEMY} {
+		if standing[side] == 0 {
+			state.setWinner(side.Opponent(), wits.VICTORY_DESTRUCTION)
+			return
+		}
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/game_state_test.go
+
+package state_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// A corridor in axial coordinates with a base at each end.
+const corridorJSON = `{
+	"name": "Corridor",
+	"map_id": "test/corridor",
+	"terrain": {
+		"floor": [[0, 0], [1, 0], [2, 0], [3, 0], [4, 0], [5, 0], [6, 0]],
+		"wall": [],
+		"bonus": [[3, 1]],
+		"spawn": [[[0, 1]], [[6, -1]]],
+		"base": [[-2, 0], [8, 0]]
+	},
+	"init": {
+		"units": [
+			{ "team": "RED", "class": "SOLDIER", "coord": [4, 0] },
+			{ "team": "BLUE", "class": "HEAVY", "coord": [5, 0] }
+		]
+	}
+}`
+
+func loadMap(t *testing.T, encoded string) *state.GameMap {
+	var defn witsjson.MapDefinition
+	if err := json.Unmarshal([]byte(encoded), &defn); err != nil {
+		t.Fatal(err)
+	}
+	gamemap := state.NewGameMap(witsjson.NewGameMap(&defn))
+	return &gamemap
+}
+
+func newCorridorGame(t *testing.T) (*state.GameMap, *state.GameState) {
+	gamemap := loadMap(t, corridorJSON)
+	game, err := state.NewGame(gamemap,
+		[]wits.UnitRaceEnum{wits.RACE_FEEDBACK, wits.RACE_SCALLYWAGS})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gamemap, game
+}
+
+func at(gamemap *state.GameMap, i, j int) wits.HexCoordIndex {
+	return gamemap.Index(witsjson.NewHexCoord(i, j))
+}
+
+func TestNewGame(t *testing.T) {
+	gamemap, game := newCorridorGame(t)
+	if game.Current() != wits.FR_SELF || game.Turn() != 1 {
+		t.Errorf("game should start on turn 1 with the first player")
+	}
+	if game.Player(wits.FR_SELF).Wits != state.WITS_PER_TURN {
+		t.Errorf("first player has %d wits", game.Player(wits.FR_SELF).Wits)
+	}
+	if game.BaseHP(wits.FR_ENEMY) != state.BASE_HP {
+		t.Errorf("second player base HP %d", game.BaseHP(wits.FR_ENEMY))
+	}
+	heavy := game.UnitAt(at(gamemap, 5, 0))
+	if heavy.Class() != wits.CLASS_HEAVY || heavy.Race() != wits.RACE_SCALLYWAGS ||
+		heavy.Team() != wits.FR_ENEMY || heavy.Health() != 3 {
+		t.Errorf("unexpected initial unit %v", heavy)
+	}
+
+	if _, err := state.NewGame(gamemap, []wits.UnitRaceEnum{wits.RACE_FEEDBACK}); err == nil {
+		t.Errorf("expected an error for too few players")
+	}
+}
+
+func TestGameState_Apply(t *testing.T) {
+	gamemap, game := newCorridorGame(t)
+	soldier, heavy := at(gamemap, 4, 0), at(gamemap, 5, 0)
+
+	// Units may not move through their opponents.
+	if err := game.Apply(state.MoveAction(soldier, at(gamemap, 6, 0))); err == nil {
+		t.Errorf("expected moving through an enemy to be illegal")
+	}
+	if err := game.Apply(state.AttackAction(soldier, heavy)); err != nil {
+		t.Fatal(err)
+	}
+	if hp := game.UnitAt(heavy).Health(); hp != 1 {
+		t.Errorf("heavy should have 1 health remaining, has %d", hp)
+	}
+	if err := game.Apply(state.AttackAction(soldier, heavy)); err == nil {
+		t.Errorf("expected a second attack to be illegal")
+	}
+	if err := game.Apply(state.MoveAction(soldier, at(gamemap, 3, 0))); err == nil {
+		t.Errorf("expected moving after attacking to be illegal")
+	}
+	if err := game.Apply(state.SpawnAction(at(gamemap, 0, 1), wits.CLASS_SOLDIER)); err != nil {
+		t.Fatal(err)
+	}
+	if wits := game.Player(game.Current()).Wits; wits != 0 {
+		t.Errorf("expected all wits to be spent, %d remain", wits)
+	}
+	if err := game.Apply(state.SpawnAction(at(gamemap, 0, 1), wits.CLASS_RUNNER)); err == nil {
+		t.Errorf("expected spawning without wits to be illegal")
+	}
+
+	game.EndTurn()
+	if game.Current() != wits.FR_ENEMY || game.Turn() != 2 {
+		t.Fatalf("expected the second player's turn")
+	}
+	// The heavy destroys the soldier, but a spawned soldier remains.
+	if err := game.Apply(state.AttackAction(heavy, soldier)); err != nil {
+		t.Fatal(err)
+	}
+	if !game.UnitAt(soldier).IsEmpty() {
+		t.Errorf("soldier should have been destroyed")
+	}
+	game.EndTurn()
+	if game.IsOver() {
+		t.Errorf("the first player still has a unit, game should not be over")
+	}
+}
+
+func TestGameState_Extinction(t *testing.T) {
+	gamemap, game := newCorridorGame(t)
+	soldier, heavy := at(gamemap, 4, 0), at(gamemap, 5, 0)
+	game.EndTurn()
+
+	clone := game.Clone()
+	if err := game.Apply(state.MoveAction(heavy, at(gamemap, 0, 0))); err == nil {
+		t.Fatalf("expected the heavy cannot move past the soldier")
+	}
+	if err := clone.Apply(state.AttackAction(heavy, soldier)); err != nil {
+		t.Fatal(err)
+	}
+	if game.UnitAt(soldier).IsEmpty() || !clone.UnitAt(soldier).IsEmpty() {
+		t.Errorf("clone should not share the board with the original")
+	}
+	clone.EndTurn()
+	if clone.Result() != wits.LOSS_EXTINCTION {
+		t.Errorf("expected extinction of the first player, got %d", clone.Result())
+	}
+	if clone.ResultFor(wits.FR_ENEMY) != wits.VICTORY_EXTINCTION {
+		t.Errorf("expected victory for the second player, got %d",
+			clone.ResultFor(wits.FR_ENEMY))
+	}
+
+	game.Resign(wits.FR_ENEMY)
+	if game.Result() != wits.VICTORY_RESIGNATION {
+		t.Errorf("expected resignation, got %d", game.Result())
+	}
+}
+
+func TestGameState_Fogged(t *testing.T) {
+	gamemap := loadMap(t, corridorJSON)
+	game, err := state.NewGame(gamemap,
+		[]wits.UnitRaceEnum{wits.RACE_FEEDBACK, wits.RACE_ADORABLES})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Everything in the corridor is close enough to be seen.
+	fogged := game.Fogged(wits.FR_SELF)
+	if fogged.UnitAt(at(gamemap, 5, 0)).IsEmpty() {
+		t.Errorf("adjacent enemy should be visible")
+	}
+	view := game.ViewFor(wits.FR_ENEMY)
+	if len(view.Units) != 2 || len(view.Visible) == 0 {
+		t.Errorf("unexpected view %v", view)
+	}
+	encoded, err := json.Marshal(view)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("view did not encode as JSON: %v\n%s", err, encoded)
+	}
+}
+
+func TestGameState_FoggedMap(t *testing.T) {
+	defn, err := witsjson.ReadMapFile("../maps/solo/peekaboo.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gamemap := state.NewGameMap(defn)
+	game, err := state.NewGame(&gamemap,
+		[]wits.UnitRaceEnum{wits.RACE_VEGGIENAUTS, wits.RACE_FEEDBACK})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(game.Units()) != 6 {
+		t.Fatalf("expected 6 initial units, found %d", len(game.Units()))
+	}
+	// The opposing medic is across the map, out of sight.
+	if !game.Fogged(wits.FR_SELF).UnitAt(at(&gamemap, 9, 1)).IsEmpty() {
+		t.Errorf("distant enemy should be hidden in the fog")
+	}
+	if game.Fogged(wits.FR_ENEMY).UnitAt(at(&gamemap, 9, 1)).IsEmpty() {
+		t.Errorf("own units should never be hidden")
+	}
+}
+
+func TestGameState_LegalActions(t *testing.T) {
+	gamemap, game := newCorridorGame(t)
+	legal := game.LegalActions()
+	contains := func(action state.Action) bool {
+		for _, candidate := range legal {
+			if candidate == action {
+				return true
+			}
+		}
+		return false
+	}
+	if !contains(state.SpawnAction(at(gamemap, 0, 1), wits.CLASS_RUNNER)) {
+		t.Errorf("expected spawning a runner to be legal")
+	}
+	if contains(state.SpawnAction(at(gamemap, 0, 1), wits.CLASS_HEAVY)) {
+		t.Errorf("a heavy costs more wits than the player has")
+	}
+	if !contains(state.AttackAction(at(gamemap, 4, 0), at(gamemap, 5, 0))) {
+		t.Errorf("expected the soldier to be able to attack the heavy")
+	}
+	for _, action := range legal {
+		if action.IsPass() {
+			t.Errorf("passing should not be enumerated")
+		}
+		if err := game.Clone().Apply(action); err != nil {
+			t.Errorf("enumerated an illegal action: %v", err)
+		}
+	}
+
+	// Every action costs wits, so applying them eventually runs out.
+	for steps := 0; len(game.LegalActions()) > 0; steps++ {
+		if steps > int(state.MAX_WITS) {
+			t.Fatalf("legal actions remain after spending %d wits", steps)
+		}
+		game.Apply(game.LegalActions()[0])
+	}
+}
+
+func TestGameState_Determinized(t *testing.T) {
+	gamemap, game := newCorridorGame(t)
+	runner := state.NewUnit(wits.CLASS_RUNNER, wits.RACE_SCALLYWAGS, wits.FR_ENEMY)
+	determinized := game.Determinized([]state.TileUnit{
+		{UnitBits: runner, At: at(gamemap, 6, 0)},
+		{UnitBits: runner, At: at(gamemap, 4, 0)},
+	})
+	if determinized.UnitAt(at(gamemap, 6, 0)) != runner {
+		t.Errorf("expected the hidden runner to be placed")
+	}
+	if determinized.UnitAt(at(gamemap, 4, 0)).Class() != wits.CLASS_SOLDIER {
+		t.Errorf("hidden units should not replace visible units")
+	}
+	if !game.UnitAt(at(gamemap, 6, 0)).IsEmpty() {
+		t.Errorf("the original state should not be modified")
+	}
+}
+
+func TestFromView(t *testing.T) {
+	gamemap, game := newCorridorGame(t)
+	if err := game.Apply(state.MoveAction(at(gamemap, 4, 0), at(gamemap, 3, 0))); err != nil {
+		t.Fatal(err)
+	}
+	if err := game.Apply(state.SpawnAction(at(gamemap, 0, 1), wits.CLASS_RUNNER)); err != nil {
+		t.Fatal(err)
+	}
+
+	restored, err := state.FromView(gamemap, game.View())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for index := range gamemap.TileCount() {
+		tile := wits.HexCoordIndex(index)
+		if restored.UnitAt(tile) != game.UnitAt(tile) {
+			t.Errorf("unit at %d is %v, expected %v", index, restored.UnitAt(tile), game.UnitAt(tile))
+		}
+		if restored.IsSpawnUsed(tile) != game.IsSpawnUsed(tile) {
+			t.Errorf("spawn at %d used %t, expected %t", index, restored.IsSpawnUsed(tile), game.IsSpawnUsed(tile))
+		}
+	}
+	if restored.Turn() != game.Turn() || restored.Current() != game.Current() ||
+		restored.Player(wits.FR_SELF) != game.Player(wits.FR_SELF) {
+		t.Errorf("restored state differs: %v", restored.View())
+	}
+
+	view := game.View()
+	view.Units[0].Health = 0
+	if _, err := state.FromView(gamemap, view); err == nil {
+		t.Errorf("expected an error for a unit without health")
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/legal.go
+
+package state
+
+import (
+	"github.com/kevindamm/wits-go"
+)
+
+// Enumerates every legal action for the current player, not including the
+// PassAction (which is always legal).  The actions are in a stable order: all
+// spawns first, then the actions of each unit in the order of their tiles.
+func (state *GameState) LegalActions() []Action {
+	legal := make([]Action, 0)
+	if state.IsOver() {
+		return legal
+	}
+	add := func(action Action) {
+		if state.Check(action) == nil {
+			legal = append(legal, action)
+		}
+	}
+
+	available := state.players[state.current-1].Wits
+	for _, spawn := range state.gamemap.Spawns(state.current) {
+		if state.used[spawn] || !state.board[spawn].IsEmpty() {
+			continue
+		}
+		for class := wits.CLASS_RUNNER; class <= wits.CLASS_SPECIAL; class++ {
+			if class != wits.CLASS_THORN && state.rules.Cost(class) <= available {
+				add(SpawnAction(spawn, class))
+			}
+		}
+	}
+	for index := range state.board {
+		at := wits.HexCoordIndex(index)
+		if state.gamemap.IsWalkable(at) && state.board[at].IsEmpty() &&
+			state.thornParent(at) != NO_TILE {
+			add(SpawnAction(at, wits.CLASS_THORN))
+		}
+	}
+
+	for index, unit := range state.board {
+		if unit.IsEmpty() || unit.Team() != state.current {
+			continue
+		}
+		if available < state.rules.ActionCost {
+			break
+		}
+		agent := wits.HexCoordIndex(index)
+		// Candidates are filtered before being checked, the checks are costly.
+		if !unit.HasMoved() && !unit.HasActed() {
+			for _, to := range state.Reachable(agent) {
+				legal = append(legal, MoveAction(agent, to))
+			}
+		}
+		if !unit.HasActed() && state.rules.Range(unit) > 0 {
+			for target := range state.board {
+				at := wits.HexCoordIndex(target)
+				if state.isEnemyTarget(at) && state.InRange(agent, at) {
+					add(AttackAction(agent, at))
+				}
+			}
+		}
+		if unit.Special().HasAlternate() {
+			add(ToggleAction(agent))
+		}
+		for _, neighbor := range state.gamemap.Neighbors(agent) {
+			switch {
+			case unit.Class() == wits.CLASS_MEDIC:
+				add(HealAction(agent, neighbor))
+			case unit.Special() == SPECIAL_SCRAMBLER:
+				add(CharmAction(agent, neighbor))
+			case unit.Special() == SPECIAL_MOBI:
+				for dest := range state.board {
+					at := wits.HexCoordIndex(dest)
+					if state.isEmptyFloor(at) && state.gamemap.Distance(agent, at) <= state.rules.Distance(unit) {
+						add(TeleportAction(agent, neighbor, at))
+					}
+				}
+			}
+		}
+	}
+	return legal
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/rules.go
+
+package state
+
+import "github.com/kevindamm/wits-go"
+
+// The runtime version that hosted matches are recorded as being played on.
+const LATEST_VERSION = 1603
+
+const (
+	BASE_HP        wits.BaseHealth   = 5
+	WITS_PER_TURN  wits.ActionPoints = 3
+	WITS_PER_BONUS wits.ActionPoints = 1
+	MAX_WITS       wits.ActionPoints = 10
+
+	// Every action except spawning costs a single wit.
+	ACTION_COST wits.ActionPoints = 1
+)
+
+// Each race has its own special unit, with its own special action.
+type SpecialEnum byte
+
+const (
+	SPECIAL_UNKNOWN SpecialEnum = iota
+	SPECIAL_SCRAMBLER
+	SPECIAL_MOBI
+	SPECIAL_BOMBSHELL
+	SPECIAL_BRAMBLE
+)
+
+// The specials are enumerated in the same order as the races they belong to.
+func SpecialForRace(race wits.UnitRaceEnum) SpecialEnum {
+	if race > wits.RACE_VEGGIENAUTS {
+		return SPECIAL_UNKNOWN
+	}
+	return SpecialEnum(race)
+}
+
+func (special SpecialEnum) String() string {
+	if special > SPECIAL_BRAMBLE {
+		special = SPECIAL_UNKNOWN
+	}
+	return []string{
+		"UNKNOWN",
+		"SCRAMBLER",
+		"MOBI",
+		"BOMBSHELL",
+		"BRAMBLE",
+	}[int(special)]
+}
+
+// Bombshells and brambles are rooted in place while in their alternate state.
+func (special SpecialEnum) HasAlternate() bool {
+	return special == SPECIAL_BOMBSHELL || special == SPECIAL_BRAMBLE
+}
+
+// The health that a unit is spawned with.  Medics can heal a unit to one more
+// than this value.
+func HealthForUnit(class wits.UnitClassEnum) wits.UnitHealth {
+	return health[class]
+}
+
+var health = []wits.UnitHealth{
+	0, // CLASS_UNKNOWN
+	1, // CLASS_RUNNER
+	2, // CLASS_SOLDIER
+	1, // CLASS_MEDIC
+	1, // CLASS_SNIPER
+	3, // CLASS_HEAVY
+	1, // CLASS_THORN
+	3, // CLASS_SPECIAL
+}
+
+// How far away a unit may attack from.  Zero means that it cannot attack.
+// Special units only attack when they are a bombshell in its alternate state.
+func RangeForUnit(class wits.UnitClassEnum) wits.TileDistance {
+	return attackRange[class]
+}
+
+var attackRange = []wits.TileDistance{
+	0, // CLASS_UNKNOWN
+	1, // CLASS_RUNNER
+	2, // CLASS_SOLDIER
+	0, // CLASS_MEDIC
+	3, // CLASS_SNIPER
+	1, // CLASS_HEAVY
+	1, // CLASS_THORN
+	3, // CLASS_SPECIAL (only the deployed bombshell)
+}
+
+// Each unit reveals the tiles within this distance of it, for fog of war.
+// Bases reveal their surroundings as if they were a unit with this vision.
+func VisionForUnit(class wits.UnitClassEnum) wits.TileDistance {
+	return wits.DistanceForUnit(class) + 1
+}
+
+const BASE_VISION wits.TileDistance = 3
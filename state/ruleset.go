@@ -0,0 +1,358 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/ruleset.go
+
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/kevindamm/wits-go"
+)
+
+// The stats of each unit class, which have changed between runtime versions.
+type UnitStats struct {
+	Cost     wits.ActionPoints `json:"cost"`
+	Strength wits.UnitHealth   `json:"strength"`
+	Travel   wits.TileDistance `json:"travel"`
+	Health   wits.UnitHealth   `json:"health"`
+	Range    wits.TileDistance `json:"range"`
+}
+
+// The stats indexed by unit class, encoded in JSON as an object keyed by the
+// class names.  Decoding only replaces the stats that are present, so that a
+// variant need only list the stats it changes.
+type UnitTable [wits.CLASS_SPECIAL + 1]UnitStats
+
+// The rules that a match is played under: the unit stats, the economy of wits
+// and the health and vision of the bases.  Each runtime version that changed
+// the rules has its own Ruleset, custom variants are based on one of them.
+type Ruleset struct {
+	// The name of a variant, or STANDARD_RULES for the rules of a version.
+	Name string `json:"name"`
+
+	// The runtime version that the rules were introduced in, or the version
+	// that a variant is based on.
+	Version int `json:"version"`
+
+	Units        UnitTable         `json:"units"`
+	BaseHP       wits.BaseHealth   `json:"base_hp"`
+	BaseVision   wits.TileDistance `json:"base_vision"`
+	WitsPerTurn  wits.ActionPoints `json:"wits_per_turn"`
+	WitsPerBonus wits.ActionPoints `json:"wits_per_bonus"`
+	MaxWits      wits.ActionPoints `json:"max_wits"`
+
+	// The cost of every action except spawning.
+	ActionCost wits.ActionPoints `json:"action_cost"`
+
+	// The damage done by a bombshell's attack to the enemies around its target.
+	SplashDamage wits.UnitHealth `json:"splash_damage"`
+}
+
+const STANDARD_RULES = "standard"
+
+var ErrUnknownVersion = errors.New("no rules are known for runtime version")
+
+// The rules of each runtime version that changed them, by that version.  Only
+// the rules of the latest version are built in, earlier versions are LEGACY
+// unless their rules are added (see LoadVersions).
+var versions = map[int]Ruleset{
+	LATEST_VERSION: latest,
+}
+
+// Units are valued by the latest rules when no game is at hand.
+var latest = standardRules()
+
+// The rules of the latest version are those of the (unversioned) unit stats
+// in package wits and of this package's constants.
+func standardRules() Ruleset {
+	rules := Ruleset{
+		Name:         STANDARD_RULES,
+		Version:      LATEST_VERSION,
+		BaseHP:       BASE_HP,
+		BaseVision:   BASE_VISION,
+		WitsPerTurn:  WITS_PER_TURN,
+		WitsPerBonus: WITS_PER_BONUS,
+		MaxWits:      MAX_WITS,
+		ActionCost:   ACTION_COST,
+		SplashDamage: 1,
+	}
+	for class := range rules.Units {
+		class := wits.UnitClassEnum(class)
+		rules.Units[class] = UnitStats{
+			Cost:     wits.CostForUnit(class),
+			Strength: wits.StrengthForUnit(class),
+			Travel:   wits.DistanceForUnit(class),
+			Health:   HealthForUnit(class),
+			Range:    RangeForUnit(class),
+		}
+	}
+	return rules
+}
+
+// The rules of the latest runtime version, which hosted matches are played on.
+func LatestRules() *Ruleset {
+	rules := latest
+	return &rules
+}
+
+// The rules that a match of this runtime version was played under, those of
+// the most recent version (up to and including it) that changed the rules.
+func RulesForVersion(version int) (*Ruleset, error) {
+	known := slices.Sorted(maps.Keys(versions))
+	for i := len(known) - 1; i >= 0; i-- {
+		if known[i] <= version && version <= LATEST_VERSION {
+			rules := versions[known[i]]
+			return &rules, nil
+		}
+	}
+	return nil, fmt.Errorf("%w %d", ErrUnknownVersion, version)
+}
+
+// Adds the rules that an earlier runtime version introduced, so that matches
+// played on it (up to the next version that changed the rules) are simulated
+// under them.  Versions are added before any match is simulated, they are not
+// safe to change concurrently.
+func AddVersion(rules *Ruleset) error {
+	if rules.IsVariant() {
+		return fmt.Errorf("rules of version %d are named %q, not %q",
+			rules.Version, rules.Name, STANDARD_RULES)
+	}
+	if rules.Version < 1 || rules.Version >= LATEST_VERSION {
+		return fmt.Errorf("version %d is not earlier than the latest %d",
+			rules.Version, LATEST_VERSION)
+	}
+	if err := rules.Validate(); err != nil {
+		return fmt.Errorf("version %d: %w", rules.Version, err)
+	}
+	versions[rules.Version] = *rules
+	return nil
+}
+
+// Decodes the rules of an earlier runtime version, which names the version and
+// lists the stats that differ from the latest rules:
+//
+//	{"version": 1500, "units": {"RUNNER": {"travel": 4}}, "wits_per_turn": 4}
+func ParseVersion(encoded []byte) (*Ruleset, error) {
+	rules := LatestRules()
+	rules.Version = 0
+	if err := json.Unmarshal(encoded, rules); err != nil {
+		return nil, err
+	}
+	if len(rules.Name) == 0 {
+		rules.Name = STANDARD_RULES
+	}
+	return rules, nil
+}
+
+// Adds the rules of each JSON file in the directory (see ParseVersion), the
+// rules of the versions before the latest are not included with the engine.
+func LoadVersions(dir string) error {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, filename := range filenames {
+		encoded, err := os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		rules, err := ParseVersion(encoded)
+		if err == nil {
+			err = AddVersion(rules)
+		}
+		if err != nil {
+			return fmt.Errorf("rules %s: %w", filename, err)
+		}
+	}
+	return nil
+}
+
+// Decodes a custom variant, which names the version of the rules it is based
+// on (the latest if it does not) and the stats that differ from them:
+//
+//	{"name": "cheap-heavies", "units": {"HEAVY": {"cost": 3}}, "max_wits": 12}
+func ParseVariant(encoded []byte) (*Ruleset, error) {
+	var header struct {
+		Name    string `json:"name"`
+		Version int    `json:"version"`
+	}
+	if err := json.Unmarshal(encoded, &header); err != nil {
+		return nil, err
+	}
+	if len(header.Name) == 0 || header.Name == STANDARD_RULES {
+		return nil, fmt.Errorf("a variant needs a name (other than %q)", STANDARD_RULES)
+	}
+	if header.Version == 0 {
+		header.Version = LATEST_VERSION
+	}
+	rules, err := RulesForVersion(header.Version)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(encoded, rules); err != nil {
+		return nil, err
+	}
+	return rules, rules.Validate()
+}
+
+// Reads a custom variant (see ParseVariant) from a JSON file.
+func ReadVariantFile(filename string) (*Ruleset, error) {
+	encoded, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := ParseVariant(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("variant %s: %w", filename, err)
+	}
+	return rules, nil
+}
+
+// Checks that the stats can be represented (units have at most 7 health, and
+// medics heal one more than a unit's initial health), that every unit can move
+// and every unit but the medic can attack, and that every action costs at
+// least one wit, so that every turn ends.
+func (rules *Ruleset) Validate() error {
+	for class := wits.CLASS_RUNNER; class <= wits.CLASS_SPECIAL; class++ {
+		if health := rules.Units[class].Health; health < 1 || health > 6 {
+			return fmt.Errorf("%s health %d is not between 1 and 6", class, health)
+		}
+		stats := rules.Units[class]
+		if stats.Cost < 1 {
+			return fmt.Errorf("%s must cost at least one wit", class)
+		}
+		if stats.Travel < 1 {
+			return fmt.Errorf("%s must travel at least one tile", class)
+		}
+		// Medics heal rather than attack, every other class attacks.
+		switch {
+		case stats.Strength < 0 || (stats.Strength == 0 && class != wits.CLASS_MEDIC):
+			return fmt.Errorf("%s strength %d is not positive", class, stats.Strength)
+		case stats.Strength > 0 && stats.Range < 1:
+			return fmt.Errorf("%s attacks with strength %d but has no range", class, stats.Strength)
+		}
+	}
+	switch {
+	case rules.ActionCost < 1:
+		return fmt.Errorf("actions must cost at least one wit")
+	case rules.BaseHP < 1:
+		return fmt.Errorf("bases must have at least one HP")
+	case rules.MaxWits < rules.ActionCost:
+		return fmt.Errorf("max wits %d is less than the cost of an action", rules.MaxWits)
+	}
+	return nil
+}
+
+func (rules *Ruleset) IsVariant() bool { return rules.Name != STANDARD_RULES }
+
+func (rules *Ruleset) String() string {
+	if rules.IsVariant() {
+		return fmt.Sprintf("%s (based on %d)", rules.Name, rules.Version)
+	}
+	return fmt.Sprintf("%d", rules.Version)
+}
+
+// A unit healed by a medic, to one more than its initial health.
+func (rules *Ruleset) Boost(unit UnitBits) UnitBits {
+	return unit.withHealth(rules.Health(unit.Class()) + 1)
+}
+
+// The cost of spawning a unit of this class.
+func (rules *Ruleset) Cost(class wits.UnitClassEnum) wits.ActionPoints {
+	return rules.Units[class].Cost
+}
+
+// The health that a unit is spawned with.  Medics can heal a unit to one more
+// than this value.
+func (rules *Ruleset) Health(class wits.UnitClassEnum) wits.UnitHealth {
+	return rules.Units[class].Health
+}
+
+// The damage of the unit's attack.  Specials only attack when they are a
+// bombshell in its alternate state.
+func (rules *Ruleset) Strength(unit UnitBits) wits.UnitHealth {
+	if unit.IsSpecial() {
+		if unit.Special() == SPECIAL_BOMBSHELL && unit.IsAlternate() {
+			return rules.Units[wits.CLASS_SPECIAL].Strength
+		}
+		return 0
+	}
+	return rules.Units[unit.Class()].Strength
+}
+
+// Movement distance, which is zero for units that are rooted in place.
+func (rules *Ruleset) Distance(unit UnitBits) wits.TileDistance {
+	if unit.Class() == wits.CLASS_THORN || unit.IsAlternate() {
+		return 0
+	}
+	return rules.Units[unit.Class()].Travel
+}
+
+// The distance this unit can attack from, zero if it cannot attack.
+func (rules *Ruleset) Range(unit UnitBits) wits.TileDistance {
+	if rules.Strength(unit) == 0 {
+		return 0
+	}
+	return rules.Units[unit.Class()].Range
+}
+
+// Each unit reveals the tiles within this distance of it, for fog of war.
+func (rules *Ruleset) Vision(class wits.UnitClassEnum) wits.TileDistance {
+	return rules.Units[class].Travel + 1
+}
+
+// Constructs a unit at its initial (full) health under these rules.
+func (rules *Ruleset) NewUnit(class wits.UnitClassEnum, race wits.UnitRaceEnum, team wits.FriendlyEnum) UnitBits {
+	unit := NewUnit(class, race, team)
+	if unit.IsEmpty() {
+		return unit
+	}
+	return unit.withHealth(rules.Health(class))
+}
+
+func (table UnitTable) MarshalJSON() ([]byte, error) {
+	byName := make(map[string]UnitStats, len(table)-1)
+	for class := wits.CLASS_RUNNER; class <= wits.CLASS_SPECIAL; class++ {
+		byName[class.String()] = table[class]
+	}
+	return json.Marshal(byName)
+}
+
+func (table *UnitTable) UnmarshalJSON(encoded []byte) error {
+	var byName map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &byName); err != nil {
+		return err
+	}
+	for name, stats := range byName {
+		class := wits.CLASS_RUNNER
+		for class <= wits.CLASS_SPECIAL && class.String() != name {
+			class++
+		}
+		if class > wits.CLASS_SPECIAL {
+			return fmt.Errorf("unknown unit class %q", name)
+		}
+		if err := json.Unmarshal(stats, &table[class]); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/ruleset_test.go
+
+package state_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/state"
+)
+
+func TestRulesForVersion(t *testing.T) {
+	rules, err := state.RulesForVersion(state.LATEST_VERSION)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rules, state.LatestRules()) || rules.IsVariant() {
+		t.Errorf("rules of the latest version differ from the latest rules")
+	}
+	for class := wits.CLASS_RUNNER; class <= wits.CLASS_SPECIAL; class++ {
+		if rules.Cost(class) != wits.CostForUnit(class) ||
+			rules.Health(class) != state.HealthForUnit(class) ||
+			rules.Units[class].Travel != wits.DistanceForUnit(class) {
+			t.Errorf("%s stats differ from the unit tables", class)
+		}
+	}
+	for _, version := range []int{1500, state.LATEST_VERSION + 1} {
+		if _, err := state.RulesForVersion(version); !errors.Is(err, state.ErrUnknownVersion) {
+			t.Errorf("RulesForVersion(%d) error = %v, expected %v",
+				version, err, state.ErrUnknownVersion)
+		}
+	}
+}
+
+func TestLoadVersions(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, encoded string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(encoded), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("1550.json", `{"version": 1550, "units": {"RUNNER": {"travel": 4}}, "wits_per_turn": 4}`)
+	write("notes.txt", `not rules`)
+	if err := state.LoadVersions(dir); err != nil {
+		t.Fatal(err)
+	}
+	for _, version := range []int{1550, 1602} {
+		rules, err := state.RulesForVersion(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rules.Version != 1550 || rules.IsVariant() || rules.WitsPerTurn != 4 ||
+			rules.Units[wits.CLASS_RUNNER].Travel != 4 {
+			t.Errorf("version %d has unexpected rules %+v", version, rules)
+		}
+		if rules.MaxWits != state.LatestRules().MaxWits {
+			t.Errorf("unlisted stats should be those of the latest rules")
+		}
+	}
+	if _, err := state.RulesForVersion(1549); !errors.Is(err, state.ErrUnknownVersion) {
+		t.Errorf("expected no rules before the earliest added version, got %v", err)
+	}
+
+	for _, invalid := range []string{
+		`{"version": 1603}`,
+		`{"name": "variant", "version": 1560}`,
+		`{"version": 1560, "action_cost": 0}`,
+		`{"version": 1560, "units": {"RUNNER": {"travel": 0}}}`,
+		`{"version": 1560, "units": {"SNIPER": {"range": 0}}}`,
+	} {
+		write("invalid.json", invalid)
+		if err := state.LoadVersions(dir); err == nil {
+			t.Errorf("expected an error for rules %s", invalid)
+		}
+	}
+}
+
+func TestParseVariant(t *testing.T) {
+	rules, err := state.ParseVariant([]byte(`{
+		"name": "glass-heavies",
+		"units": {"HEAVY": {"cost": 3, "health": 2}, "SOLDIER": {"strength": 3}},
+		"wits_per_turn": 5
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	latest := state.LatestRules()
+	if !rules.IsVariant() || rules.Version != state.LATEST_VERSION {
+		t.Errorf("unexpected variant %s", rules)
+	}
+	heavy := rules.Units[wits.CLASS_HEAVY]
+	if heavy.Cost != 3 || heavy.Health != 2 ||
+		heavy.Travel != latest.Units[wits.CLASS_HEAVY].Travel ||
+		heavy.Range != latest.Units[wits.CLASS_HEAVY].Range {
+		t.Errorf("variant should only change the listed stats, heavy %+v", heavy)
+	}
+	if rules.WitsPerTurn != 5 || rules.MaxWits != latest.MaxWits {
+		t.Errorf("unexpected economy %+v", rules)
+	}
+
+	// The full ruleset is decoded from its encoding, as it is stored.
+	encoded, err := json.Marshal(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded state.Ruleset
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&decoded, rules) {
+		t.Errorf("round trip differs:\n%+v\n%+v", decoded, *rules)
+	}
+
+	for _, invalid := range []string{
+		`{"units": {"HEAVY": {"cost": 3}}}`,
+		`{"name": "standard"}`,
+		`{"name": "healthy", "units": {"HEAVY": {"health": 7}}}`,
+		`{"name": "free", "action_cost": 0}`,
+		`{"name": "rooted", "units": {"SOLDIER": {"travel": 0}}}`,
+		`{"name": "pacifist", "units": {"HEAVY": {"strength": 0}}}`,
+		`{"name": "healing", "units": {"SOLDIER": {"strength": -1}}}`,
+		`{"name": "melee", "units": {"SNIPER": {"range": 0}}}`,
+		`{"name": "backwards", "units": {"RUNNER": {"travel": -1}}}`,
+		`{"name": "fighting-medics", "units": {"MEDIC": {"strength": 1}}}`,
+		`{"name": "mystery", "units": {"WIZARD": {"cost": 3}}}`,
+		`{"name": "ancient", "version": 1500}`,
+	} {
+		if _, err := state.ParseVariant([]byte(invalid)); err == nil {
+			t.Errorf("expected an error for variant %s", invalid)
+		}
+	}
+}
+
+func TestNewGameWithRules(t *testing.T) {
+	rules, err := state.ParseVariant([]byte(`{
+		"name": "glass-heavies",
+		"units": {"HEAVY": {"cost": 3, "health": 2}},
+		"wits_per_turn": 5
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gamemap := loadMap(t, corridorJSON)
+	game, err := state.NewGameWithRules(gamemap,
+		[]wits.UnitRaceEnum{wits.RACE_FEEDBACK, wits.RACE_SCALLYWAGS}, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if game.Rules() != rules || game.Clone().Rules() != rules {
+		t.Errorf("the rules should be shared by copies of the state")
+	}
+	if wits := game.Player(wits.FR_SELF).Wits; wits != 5 {
+		t.Errorf("expected 5 wits for the first turn, has %d", wits)
+	}
+	soldier, heavy := at(gamemap, 4, 0), at(gamemap, 5, 0)
+	if hp := game.UnitAt(heavy).Health(); hp != 2 {
+		t.Errorf("initial heavy should have the variant's health, has %d", hp)
+	}
+
+	// The soldier's attack destroys the weaker heavy.
+	if err := game.Apply(state.AttackAction(soldier, heavy)); err != nil {
+		t.Fatal(err)
+	}
+	if !game.UnitAt(heavy).IsEmpty() {
+		t.Errorf("heavy should have been destroyed")
+	}
+	if err := game.Apply(state.SpawnAction(at(gamemap, 0, 1), wits.CLASS_HEAVY)); err != nil {
+		t.Fatal(err)
+	}
+	if wits := game.Player(wits.FR_SELF).Wits; wits != 1 {
+		t.Errorf("expected the heavy to cost 3 wits, %d of 5 remain after an attack", wits)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/symmetry.go
+
+package state
+
+import (
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// Maps an axial coordinate to its image under a map's rotation or reflection.
+// Applying it twice returns the original coordinate.
+type Symmetry func(axial [2]int) [2]int
+
+// The symmetry that the map definition declares, false if it declares none or
+// its declaration is not a symmetry of the hex grid (reflecting across a
+// column of edges instead of tile centers).
+func MapSymmetry(definition *witsjson.MapDefinition) (Symmetry, bool) {
+	legacy := definition.Legacy != nil && *definition.Legacy
+	switch {
+	case definition.Rotate != nil:
+		center := ToAxial(definition.Rotate.Position, legacy)
+		// Twice the center of rotation, which may be the midpoint of an edge.
+		q, r := 2*center[0], 2*center[1]
+		if !definition.Rotate.Center {
+			r += 1
+		}
+		return func(axial [2]int) [2]int {
+			return [2]int{q - axial[0], r - axial[1]}
+		}, true
+
+	case definition.Mirror != nil:
+		axis := definition.Mirror.Axis
+		switch definition.Mirror.Flip {
+		case witsjson.FLIP_VERTICAL:
+			if !definition.Mirror.Center {
+				return nil, false
+			}
+			return func(axial [2]int) [2]int {
+				return [2]int{2*axis - axial[0], axial[1] + axial[0] - axis}
+			}, true
+		case witsjson.FLIP_HORIZONTAL:
+			// The axis is a row of the offset (legacy) layout, where
+			// r + q/2 is constant.
+			twice := 2 * axis
+			if !definition.Mirror.Center {
+				twice += 1
+			}
+			return func(axial [2]int) [2]int {
+				return [2]int{axial[0], twice - axial[0] - axial[1]}
+			}, true
+		}
+	}
+	return nil, false
+}
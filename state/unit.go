@@ -0,0 +1,181 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/unit.go
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// The packed representation of a unit's state, including its turn status.
+// The zero value is an empty tile (its class is CLASS_UNKNOWN).
+//
+//	bits 0..2    class
+//	bits 3..4    race - 1
+//	bits 5..6    team - 1
+//	bits 7..9    health
+//	bit  10      alternate (toggled) state
+//	bits 11..13  has moved, has acted, has alted (this turn)
+//
+// The race and team are squeezed into two bits each by dropping their UNKNOWN
+// value, which is only meaningful for an empty tile anyway.
+type UnitBits uint16
+
+const (
+	classMask   UnitBits = 0b111
+	raceShift            = 3
+	teamShift            = 5
+	healthShift          = 7
+	twoBits     UnitBits = 0b11
+
+	altBit    UnitBits = 1 << 10
+	movedBit  UnitBits = 1 << 11
+	actedBit  UnitBits = 1 << 12
+	altedBit  UnitBits = 1 << 13
+	turnFlags UnitBits = movedBit | actedBit | altedBit
+)
+
+// Constructs a unit at its initial (full) health, not yet having acted.
+func NewUnit(class wits.UnitClassEnum, race wits.UnitRaceEnum, team wits.FriendlyEnum) UnitBits {
+	if class == wits.CLASS_UNKNOWN || race == wits.RACE_UNKNOWN || team == wits.FR_UNKNOWN {
+		return 0
+	}
+	unit := UnitBits(class)&classMask |
+		(UnitBits(race-1)&twoBits)<<raceShift |
+		(UnitBits(team-1)&twoBits)<<teamShift
+	return unit.withHealth(HealthForUnit(class))
+}
+
+func (unit UnitBits) IsEmpty() bool { return unit.Class() == wits.CLASS_UNKNOWN }
+
+func (unit UnitBits) Class() wits.UnitClassEnum {
+	return wits.UnitClassEnum(unit & classMask)
+}
+
+func (unit UnitBits) IsSpecial() bool { return unit.Class() == wits.CLASS_SPECIAL }
+
+func (unit UnitBits) Race() wits.UnitRaceEnum {
+	if unit.IsEmpty() {
+		return wits.RACE_UNKNOWN
+	}
+	return wits.UnitRaceEnum((unit>>raceShift)&twoBits) + 1
+}
+
+func (unit UnitBits) Team() wits.FriendlyEnum {
+	if unit.IsEmpty() {
+		return wits.FR_UNKNOWN
+	}
+	return wits.FriendlyEnum((unit>>teamShift)&twoBits) + 1
+}
+
+// Which special unit this is, or SPECIAL_UNKNOWN if it is not a special.
+func (unit UnitBits) Special() SpecialEnum {
+	if !unit.IsSpecial() {
+		return SPECIAL_UNKNOWN
+	}
+	return SpecialForRace(unit.Race())
+}
+
+// The stats of a unit are those of the latest rules, GameState (and its
+// Rules) applies the rules that the match is played under.
+func (unit UnitBits) Cost() wits.ActionPoints { return latest.Cost(unit.Class()) }
+
+func (unit UnitBits) Strength() wits.UnitHealth { return latest.Strength(unit) }
+
+// Movement distance, which is zero for units that are rooted in place.
+func (unit UnitBits) Distance() wits.TileDistance { return latest.Distance(unit) }
+
+// The distance this unit can attack from, zero if it cannot attack.
+func (unit UnitBits) Range() wits.TileDistance { return latest.Range(unit) }
+
+func (unit UnitBits) Health() wits.UnitHealth {
+	return wits.UnitHealth((unit >> healthShift) & 0b111)
+}
+
+func (unit UnitBits) withHealth(hp wits.UnitHealth) UnitBits {
+	hp = max(0, min(hp, 7))
+	return unit&^(0b111<<healthShift) | UnitBits(hp)<<healthShift
+}
+
+func (unit UnitBits) IsAlternate() bool { return unit&altBit != 0 }
+func (unit UnitBits) HasMoved() bool    { return unit&movedBit != 0 }
+func (unit UnitBits) HasActed() bool    { return unit&actedBit != 0 }
+func (unit UnitBits) HasAlted() bool    { return unit&altedBit != 0 }
+
+// Flips the alternate state, for the specials that have one.
+func (unit UnitBits) Toggle() wits.UnitState {
+	return unit.toggle()
+}
+
+func (unit UnitBits) toggle() UnitBits {
+	return (unit ^ altBit) | altedBit
+}
+
+// Medics heal a unit to one more than its initial health, under the latest
+// rules (see Ruleset.Boost).
+func (unit UnitBits) ReceiveBoost() wits.UnitState {
+	return latest.Boost(unit)
+}
+
+// Damage is the attacker's strength.  A unit reduced to zero health is
+// returned as the empty tile.
+func (unit UnitBits) ReceiveDamage(other wits.Unit) wits.UnitState {
+	return unit.damage(other.Strength())
+}
+
+func (unit UnitBits) damage(amount wits.UnitHealth) UnitBits {
+	remaining := unit.Health() - amount
+	if remaining <= 0 {
+		return 0
+	}
+	return unit.withHealth(remaining)
+}
+
+// A charmed unit joins the team of the charming unit, but cannot act this turn.
+func (unit UnitBits) ReceiveCharm(other wits.Unit) wits.UnitState {
+	return unit.charm(other.Team())
+}
+
+func (unit UnitBits) charm(team wits.FriendlyEnum) UnitBits {
+	unit = unit&^(twoBits<<teamShift) | (UnitBits(team-1)&twoBits)<<teamShift
+	return unit | movedBit | actedBit
+}
+
+// Units only record that they have performed an action, the effects of the
+// action are carried out by the GameState (see GameState.Apply).
+func (unit UnitBits) DoAction(action wits.PlayerAction) (wits.UnitState, error) {
+	if unit.IsEmpty() {
+		return unit, fmt.Errorf("no unit to perform %s", action.ActionName())
+	}
+	switch action.ActionName() {
+	case string(witsjson.MOVE_UNIT):
+		return unit | movedBit, nil
+	case string(witsjson.TOGGLE_ALT):
+		return unit.toggle(), nil
+	case string(witsjson.PASS_PLAY):
+		return unit, nil
+	}
+	return unit | actedBit, nil
+}
+
+// Clears the turn status flags, at the start of the owning team's turn.
+func (unit UnitBits) refresh() UnitBits { return unit &^ turnFlags }
+
+// Marks a newly spawned (or charmed) unit as having used its turn.
+func (unit UnitBits) exhaust() UnitBits { return unit | movedBit | actedBit | altedBit }
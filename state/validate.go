@@ -0,0 +1,138 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/validate.go
+
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kevindamm/wits-go"
+)
+
+// Checks that the map description can be prepared for simulation (by
+// NewGameMap) and played:  no two tiles at the same coordinate, a base for
+// each of two or four teams, each surrounded by a ring of empty coordinates
+// (the base is drawn over them) and reachable from a walkable tile beyond
+// that ring, at least one spawn for each team, every walkable tile connected
+// to the others, and initial units on distinct walkable tiles.  Every problem
+// found is included in the error.
+func ValidateMap(description wits.MapDescription) error {
+	legacy := description.Legacy()
+	terrain := description.Terrain()
+	problems := make([]error, 0)
+	problem := func(format string, args ...any) {
+		problems = append(problems, fmt.Errorf(format, args...))
+	}
+
+	tiles := make(map[[2]int]wits.TileDefinition)
+	walkable := make(map[[2]int]bool)
+	for _, defs := range [][]wits.TileDefinition{
+		terrain.Floor(), terrain.Wall(), terrain.Bonus(), terrain.Spawn(), terrain.Base()} {
+		for _, def := range defs {
+			at := ToAxial(def.Position(), legacy)
+			if other, found := tiles[at]; found {
+				problem("%s and %s at the same coordinate [%d, %d]", other.Typename(), def.Typename(),
+					def.Position().I(), def.Position().J())
+				continue
+			}
+			tiles[at] = def
+			if def.CanWalk() {
+				walkable[at] = true
+			}
+		}
+	}
+	if len(walkable) == 0 {
+		problem("no walkable tiles")
+	}
+
+	bases := terrain.Base()
+	teams := len(bases)
+	if teams != 2 && teams != 4 {
+		problem("%d bases, expected 2 (solo) or 4 (duos)", teams)
+	}
+	for _, base := range bases {
+		at := ToAxial(base.Position(), legacy)
+		reachable := false
+		for _, offset := range AXIAL_NEIGHBORS {
+			ring := [2]int{at[0] + offset[0], at[1] + offset[1]}
+			if tile, found := tiles[ring]; found {
+				problem("%s at [%d, %d] is within the base of team %d", tile.Typename(),
+					tile.Position().I(), tile.Position().J(), base.Team())
+			}
+			for _, beyond := range AXIAL_NEIGHBORS {
+				if walkable[[2]int{ring[0] + beyond[0], ring[1] + beyond[1]}] {
+					reachable = true
+				}
+			}
+		}
+		if !reachable {
+			problem("the base of team %d is not next to any walkable tile", base.Team())
+		}
+	}
+
+	spawns := make(map[wits.FriendlyEnum]int)
+	for _, spawn := range terrain.Spawn() {
+		spawns[spawn.Team()]++
+		if int(spawn.Team()) < 1 || int(spawn.Team()) > teams {
+			problem("spawn at [%d, %d] for team %d, which has no base",
+				spawn.Position().I(), spawn.Position().J(), spawn.Team())
+		}
+	}
+	for team := range teams {
+		if spawns[wits.FriendlyEnum(team+1)] == 0 {
+			problem("no spawn for team %d", team+1)
+		}
+	}
+
+	// Every walkable tile is reached from any one of them.
+	for start := range walkable {
+		reached := map[[2]int]bool{start: true}
+		queue := [][2]int{start}
+		for len(queue) > 0 {
+			at := queue[0]
+			queue = queue[1:]
+			for _, offset := range AXIAL_NEIGHBORS {
+				next := [2]int{at[0] + offset[0], at[1] + offset[1]}
+				if walkable[next] && !reached[next] {
+					reached[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+		if len(reached) < len(walkable) {
+			problem("%d of %d walkable tiles are not connected to the others",
+				len(walkable)-len(reached), len(walkable))
+		}
+		break
+	}
+
+	occupied := make(map[[2]int]bool)
+	for _, unit := range description.Units() {
+		at := ToAxial(unit.Position(), legacy)
+		switch {
+		case !walkable[at]:
+			problem("unit at [%d, %d] is not on a walkable tile", unit.Position().I(), unit.Position().J())
+		case occupied[at]:
+			problem("more than one unit at [%d, %d]", unit.Position().I(), unit.Position().J())
+		case int(unit.Team()) < 1 || int(unit.Team()) > teams:
+			problem("unit at [%d, %d] for team %d, which has no base",
+				unit.Position().I(), unit.Position().J(), unit.Team())
+		}
+		occupied[at] = true
+	}
+	return errors.Join(problems...)
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/state/view.go
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+// A JSON-friendly view of the game state, referring to tiles by coordinate.
+type GameView struct {
+	MapID      wits.GameMapID              `json:"map_id"`
+	Turn       uint                        `json:"turn"`
+	Current    witsjson.FriendlyEnumJSON   `json:"current"`
+	Players    []PlayerView                `json:"players"`
+	Units      []UnitView                  `json:"units"`
+	UsedSpawns []witsjson.HexCoordJSON     `json:"used_spawns"`
+	Visible    []witsjson.HexCoordJSON     `json:"visible,omitempty"`
+	Result     witsjson.TerminalStatusJSON `json:"result"`
+}
+
+type PlayerView struct {
+	Team   witsjson.FriendlyEnumJSON `json:"team"`
+	Race   witsjson.UnitRaceJSON     `json:"race"`
+	Wits   wits.ActionPoints         `json:"wits"`
+	BaseHP wits.BaseHealth           `json:"base_hp"`
+}
+
+type UnitView struct {
+	Coord     witsjson.HexCoordJSON     `json:"coord"`
+	Team      witsjson.FriendlyEnumJSON `json:"team"`
+	Class     witsjson.UnitClassJSON    `json:"class"`
+	Race      witsjson.UnitRaceJSON     `json:"race"`
+	Health    wits.UnitHealth           `json:"health"`
+	Alternate bool                      `json:"alt,omitempty"`
+	Moved     bool                      `json:"moved,omitempty"`
+	Acted     bool                      `json:"acted,omitempty"`
+	Alted     bool                      `json:"alted,omitempty"`
+	Parent    *witsjson.HexCoordJSON    `json:"parent,omitempty"`
+}
+
+// The complete (unfiltered) view of the state.  The result is relative to the
+// first player.
+func (state *GameState) View() GameView {
+	gamemap := state.gamemap
+	view := GameView{
+		MapID:      gamemap.MapID(),
+		Turn:       state.turn,
+		Current:    witsjson.FriendlyEnumJSON(state.current),
+		Players:    make([]PlayerView, len(state.players)),
+		Units:      make([]UnitView, 0),
+		UsedSpawns: make([]witsjson.HexCoordJSON, 0),
+		Result:     witsjson.TerminalStatusJSON(state.result),
+	}
+	for i, player := range state.players {
+		view.Players[i] = PlayerView{
+			witsjson.FriendlyEnumJSON(i + 1),
+			witsjson.UnitRaceJSON(player.Race),
+			player.Wits,
+			player.BaseHP}
+	}
+	for i, unit := range state.board {
+		index := wits.HexCoordIndex(i)
+		if state.used[index] {
+			view.UsedSpawns = append(view.UsedSpawns, gamemap.Coord(index))
+		}
+		if unit.IsEmpty() {
+			continue
+		}
+		unitView := UnitView{
+			Coord:     gamemap.Coord(index),
+			Team:      witsjson.FriendlyEnumJSON(unit.Team()),
+			Class:     witsjson.UnitClassJSON(unit.Class()),
+			Race:      witsjson.UnitRaceJSON(unit.Race()),
+			Health:    unit.Health(),
+			Alternate: unit.IsAlternate(),
+			Moved:     unit.HasMoved(),
+			Acted:     unit.HasActed(),
+			Alted:     unit.HasAlted(),
+		}
+		if parent := state.parent[index]; parent != NO_TILE {
+			coord := gamemap.Coord(parent)
+			unitView.Parent = &coord
+		}
+		view.Units = append(view.Units, unitView)
+	}
+	return view
+}
+
+// The view of the state from this team's perspective, hiding opposing units
+// that are in the fog of war and listing the tiles that are visible.  The
+// result is relative to this team.
+func (state *GameState) ViewFor(team wits.FriendlyEnum) GameView {
+	view := state.Fogged(team).View()
+	view.Result = witsjson.TerminalStatusJSON(state.ResultFor(team))
+	for index, visible := range state.Visible(team) {
+		if visible {
+			view.Visible = append(view.Visible, state.gamemap.Coord(wits.HexCoordIndex(index)))
+		}
+	}
+	return view
+}
+
+// Reconstructs the state from its view on this map, the inverse of View.  The
+// tiles of the visible list are not needed, a view of the fogged state becomes
+// a state without the hidden units.  The state is played under the latest rules.
+func FromView(gamemap *GameMap, view GameView) (*GameState, error) {
+	return FromViewWithRules(gamemap, view, LatestRules())
+}
+
+// Reconstructs the state (as FromView does) played under these rules, which
+// are shared with every copy of the state and must not be modified.
+func FromViewWithRules(gamemap *GameMap, view GameView, rules *Ruleset) (*GameState, error) {
+	if len(view.Players) != gamemap.RoleCount() {
+		return nil, fmt.Errorf("map %s requires %d players, view has %d",
+			gamemap.MapID(), gamemap.RoleCount(), len(view.Players))
+	}
+	current := wits.FriendlyEnum(view.Current)
+	if current == wits.FR_UNKNOWN || int(current) > len(view.Players) {
+		return nil, fmt.Errorf("invalid current player %d", current)
+	}
+	count := gamemap.TileCount()
+	state := &GameState{
+		gamemap: gamemap,
+		rules:   rules,
+		turn:    view.Turn,
+		current: current,
+		players: make([]PlayerState, len(view.Players)),
+		board:   make([]UnitBits, count),
+		parent:  make([]wits.HexCoordIndex, count),
+		used:    make([]bool, count),
+		result:  wits.TerminalStatus(view.Result),
+	}
+	for i, player := range view.Players {
+		race := wits.UnitRaceEnum(player.Race)
+		if race == wits.RACE_UNKNOWN || race > wits.RACE_VEGGIENAUTS {
+			return nil, fmt.Errorf("invalid race %d for player %d", race, i+1)
+		}
+		state.players[i] = PlayerState{race, player.Wits, player.BaseHP}
+	}
+	for i := range state.parent {
+		state.parent[i] = NO_TILE
+	}
+
+	for _, coord := range view.UsedSpawns {
+		at := gamemap.Index(coord)
+		if at == NO_TILE {
+			return nil, fmt.Errorf("used spawn %s is not on the map", coord)
+		}
+		state.used[at] = true
+	}
+	for _, unitView := range view.Units {
+		at := gamemap.Index(unitView.Coord)
+		if at == NO_TILE || !gamemap.IsWalkable(at) {
+			return nil, fmt.Errorf("unit at %s is not on a walkable tile", unitView.Coord)
+		}
+		team := wits.FriendlyEnum(unitView.Team)
+		if team == wits.FR_UNKNOWN || int(team) > len(view.Players) {
+			return nil, fmt.Errorf("unit at %s has no team", unitView.Coord)
+		}
+		if unitView.Health == 0 || unitView.Health > 7 {
+			return nil, fmt.Errorf("unit at %s has invalid health %d", unitView.Coord, unitView.Health)
+		}
+		class, race := wits.UnitClassEnum(unitView.Class), wits.UnitRaceEnum(unitView.Race)
+		if class == wits.CLASS_UNKNOWN || class > wits.CLASS_SPECIAL ||
+			race == wits.RACE_UNKNOWN || race > wits.RACE_VEGGIENAUTS {
+			return nil, fmt.Errorf("unit at %s has an invalid class or race", unitView.Coord)
+		}
+		unit := NewUnit(class, race, team)
+		unit = unit.withHealth(unitView.Health)
+		if unitView.Alternate {
+			unit |= altBit
+		}
+		if unitView.Moved {
+			unit |= movedBit
+		}
+		if unitView.Acted {
+			unit |= actedBit
+		}
+		if unitView.Alted {
+			unit |= altedBit
+		}
+		state.board[at] = unit
+		if unitView.Parent != nil {
+			parent := gamemap.Index(*unitView.Parent)
+			if parent == NO_TILE {
+				return nil, fmt.Errorf("parent of unit at %s is not on the map", unitView.Coord)
+			}
+			state.parent[at] = parent
+		}
+	}
+	return state, nil
+}
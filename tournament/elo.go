@@ -0,0 +1,175 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/tournament/elo.go
+
+package tournament
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"text/tabwriter"
+)
+
+// The results between each pair of entrants, Points[i][j] is the points that
+// entrant i scored against entrant j in Games[i][j] games.
+type CrossTable struct {
+	Names  []string
+	Points [][]float64
+	Games  [][]int
+}
+
+func (tournament *Tournament) CrossTable() CrossTable {
+	count := len(tournament.Entrants)
+	table := CrossTable{
+		Names:  make([]string, count),
+		Points: make([][]float64, count),
+		Games:  make([][]int, count),
+	}
+	for i, entrant := range tournament.Entrants {
+		table.Names[i] = entrant.Name
+		table.Points[i] = make([]float64, count)
+		table.Games[i] = make([]int, count)
+	}
+	for _, game := range tournament.Games {
+		first, second := game.Players[0], game.Players[1]
+		table.Points[first][second] += game.Score()
+		table.Points[second][first] += 1 - game.Score()
+		table.Games[first][second]++
+		table.Games[second][first]++
+	}
+	return table
+}
+
+// An Elo estimate with its 95% confidence interval (Elo ± Margin).
+type Elo struct {
+	Elo    float64
+	Margin float64
+}
+
+func (elo Elo) String() string {
+	return fmt.Sprintf("%+.0f ± %.0f", elo.Elo, elo.Margin)
+}
+
+const (
+	// The number of Elo points for a tenfold increase in the odds of winning.
+	ELO_SCALE = 400.0
+
+	// Each entrant is given this many drawn games against an average opponent,
+	// so that the estimates are finite when an entrant wins (or loses) all of
+	// their games.
+	PRIOR_DRAWS = 1.0
+
+	// The normal quantile of the confidence intervals.
+	Z_95 = 1.96
+
+	ELO_ITERATIONS = 1000
+	ELO_TOLERANCE  = 1e-9
+)
+
+// Fits a Bradley-Terry model to the cross-table by maximum likelihood (with
+// Hunter's MM algorithm), counting draws as half a win for each side.  The
+// estimates are relative to the average entrant.  The margins come from the
+// diagonal of the Fisher information, ignoring the covariance between
+// entrants' estimates.
+func (table CrossTable) Elo() []Elo {
+	count := len(table.Names)
+	strength := make([]float64, count)
+	for i := range strength {
+		strength[i] = 1
+	}
+	for range ELO_ITERATIONS {
+		change := 0.0
+		mean := geometricMean(strength)
+		for i := range strength {
+			wins, denominator := PRIOR_DRAWS/2, PRIOR_DRAWS/(strength[i]+mean)
+			for j := range strength {
+				if j == i || table.Games[i][j] == 0 {
+					continue
+				}
+				wins += table.Points[i][j]
+				denominator += float64(table.Games[i][j]) / (strength[i] + strength[j])
+			}
+			next := wins / denominator
+			change = max(change, math.Abs(math.Log(next/strength[i])))
+			strength[i] = next
+		}
+		if change < ELO_TOLERANCE {
+			break
+		}
+	}
+
+	mean := geometricMean(strength)
+	toElo := ELO_SCALE / math.Ln10
+	estimates := make([]Elo, count)
+	for i := range strength {
+		information := 0.0
+		for j := range strength {
+			if j == i || table.Games[i][j] == 0 {
+				continue
+			}
+			p := strength[i] / (strength[i] + strength[j])
+			information += float64(table.Games[i][j]) * p * (1 - p)
+		}
+		margin := math.Inf(1)
+		if information > 0 {
+			margin = Z_95 * toElo / math.Sqrt(information)
+		}
+		estimates[i] = Elo{toElo * math.Log(strength[i]/mean), margin}
+	}
+	return estimates
+}
+
+func geometricMean(values []float64) float64 {
+	sum := 0.0
+	for _, value := range values {
+		sum += math.Log(value)
+	}
+	return math.Exp(sum / float64(len(values)))
+}
+
+// Writes the cross-table, with a row for each entrant showing their Elo
+// estimate, total score and their score against each opponent.
+func (table CrossTable) Write(out io.Writer) error {
+	writer := tabwriter.NewWriter(out, 0, 4, 2, ' ', tabwriter.AlignRight)
+	header := []string{"#", "entrant", "elo", "score"}
+	for i := range table.Names {
+		header = append(header, fmt.Sprint(i+1))
+	}
+	fmt.Fprintln(writer, strings.Join(header, "\t")+"\t")
+
+	for i, elo := range table.Elo() {
+		points, games := 0.0, 0
+		for j := range table.Names {
+			points += table.Points[i][j]
+			games += table.Games[i][j]
+		}
+		row := []string{fmt.Sprint(i + 1), table.Names[i], elo.String(),
+			fmt.Sprintf("%g/%d", points, games)}
+		for j := range table.Names {
+			switch {
+			case j == i:
+				row = append(row, "-")
+			case table.Games[i][j] == 0:
+				row = append(row, "")
+			default:
+				row = append(row, fmt.Sprintf("%g/%d", table.Points[i][j], table.Games[i][j]))
+			}
+		}
+		fmt.Fprintln(writer, strings.Join(row, "\t")+"\t")
+	}
+	return writer.Flush()
+}
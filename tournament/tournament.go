@@ -0,0 +1,317 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/tournament/tournament.go
+
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+type Format string
+
+const (
+	// Every entrant plays every other entrant on every map.
+	ROUND_ROBIN Format = "round-robin"
+
+	// Entrants are paired with others of a similar score each round.
+	SWISS Format = "swiss"
+)
+
+// A participant in the tournament, creating a fresh bot for each game.
+type Entrant struct {
+	Name string
+	New  func(seed uint64) bot.Bot
+}
+
+type Config struct {
+	Format Format
+	Maps   []*state.GameMap
+
+	// Races are chosen (by the seed) from these for each pairing, both sides
+	// of the pairing have the same races.  Defaults to all races.
+	Races []wits.UnitRaceEnum
+
+	// The number of rounds of a Swiss tournament, each pairing in a round plays
+	// on one of the maps.  Ignored for round-robin.
+	Rounds int
+
+	Seed     uint64
+	Budget   time.Duration
+	MaxTurns uint
+
+	// Directory where each game's replay is written, if not empty.
+	ReplayDir string
+}
+
+// Each pairing is played as two games, with the entrants swapping sides (and
+// races) for the second.
+type Pairing struct {
+	Round   int
+	Players [2]int
+	Map     *state.GameMap
+	Races   [2]wits.UnitRaceEnum
+	Seed    uint64
+}
+
+// The outcome of a game, Players are in turn order and Result is relative to
+// the first of them.
+type Game struct {
+	Pairing
+	Result wits.TerminalStatus
+	Turns  int
+	Replay witsjson.GameReplayJSON
+}
+
+// The points for the first player; a draw (delay of game) is half a point.
+func (game Game) Score() float64 {
+	switch game.Result {
+	case wits.VICTORY_DESTRUCTION, wits.VICTORY_EXTINCTION, wits.VICTORY_RESIGNATION:
+		return 1
+	case wits.LOSS_DESTRUCTION, wits.LOSS_EXTINCTION, wits.LOSS_RESIGNATION:
+		return 0
+	}
+	return 0.5
+}
+
+var ErrNoMaps = errors.New("tournament has no two-player maps")
+
+type Tournament struct {
+	Config
+	Entrants []Entrant
+	Games    []Game
+
+	// Called after each game is played, for reporting progress.
+	OnGame func(game Game)
+
+	rng  *rand.Rand
+	byes []int
+}
+
+func New(config Config, entrants []Entrant) (*Tournament, error) {
+	if len(entrants) < 2 {
+		return nil, fmt.Errorf("tournament needs at least two entrants, has %d", len(entrants))
+	}
+	names := make(map[string]bool)
+	for _, entrant := range entrants {
+		if names[entrant.Name] {
+			return nil, fmt.Errorf("duplicate entrant %q", entrant.Name)
+		}
+		names[entrant.Name] = true
+	}
+	config.Maps = slices.DeleteFunc(slices.Clone(config.Maps),
+		func(gamemap *state.GameMap) bool { return gamemap.RoleCount() != 2 })
+	if len(config.Maps) == 0 {
+		return nil, ErrNoMaps
+	}
+	if len(config.Races) == 0 {
+		config.Races = []wits.UnitRaceEnum{
+			wits.RACE_FEEDBACK, wits.RACE_ADORABLES,
+			wits.RACE_SCALLYWAGS, wits.RACE_VEGGIENAUTS}
+	}
+	if config.Format == SWISS && config.Rounds <= 0 {
+		return nil, fmt.Errorf("swiss tournament needs a positive number of rounds")
+	}
+	if config.Format != SWISS && config.Format != ROUND_ROBIN {
+		return nil, fmt.Errorf("unknown tournament format %q", config.Format)
+	}
+	return &Tournament{
+		Config:   config,
+		Entrants: entrants,
+		rng:      rand.New(rand.NewPCG(config.Seed, config.Seed)),
+		byes:     make([]int, len(entrants)),
+	}, nil
+}
+
+// Plays all of the tournament's games.
+func (tournament *Tournament) Run() error {
+	if tournament.Format == ROUND_ROBIN {
+		round := 0
+		for i := range tournament.Entrants {
+			for j := i + 1; j < len(tournament.Entrants); j++ {
+				for _, gamemap := range tournament.Maps {
+					round++
+					if err := tournament.playPairing(round, i, j, gamemap); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	}
+
+	for round := 1; round <= tournament.Rounds; round++ {
+		gamemap := tournament.Maps[(round-1)%len(tournament.Maps)]
+		for _, pair := range tournament.swissPairs() {
+			if err := tournament.playPairing(round, pair[0], pair[1], gamemap); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Pairs the entrants by their current score, each with the next highest that
+// they have not yet played (if possible).  With an odd number of entrants,
+// the lowest scoring entrant with the fewest byes sits out the round.
+func (tournament *Tournament) swissPairs() [][2]int {
+	scores := tournament.Scores()
+	order := make([]int, len(tournament.Entrants))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		if scores[a] != scores[b] {
+			if scores[a] > scores[b] {
+				return -1
+			}
+			return 1
+		}
+		return a - b
+	})
+
+	if len(order)%2 == 1 {
+		bye := len(order) - 1
+		for i := len(order) - 1; i >= 0; i-- {
+			if tournament.byes[order[i]] < tournament.byes[order[bye]] {
+				bye = i
+			}
+		}
+		tournament.byes[order[bye]]++
+		order = slices.Delete(order, bye, bye+1)
+	}
+
+	played := tournament.played()
+	pairs := make([][2]int, 0, len(order)/2)
+	for len(order) > 0 {
+		first, opponent := order[0], 1
+		for i := 1; i < len(order); i++ {
+			if !played[[2]int{first, order[i]}] {
+				opponent = i
+				break
+			}
+		}
+		pairs = append(pairs, [2]int{first, order[opponent]})
+		order = slices.Delete(order, opponent, opponent+1)[1:]
+	}
+	return pairs
+}
+
+func (tournament *Tournament) played() map[[2]int]bool {
+	played := make(map[[2]int]bool)
+	for _, game := range tournament.Games {
+		played[game.Players] = true
+		played[[2]int{game.Players[1], game.Players[0]}] = true
+	}
+	return played
+}
+
+func (tournament *Tournament) playPairing(round, first, second int, gamemap *state.GameMap) error {
+	races := [2]wits.UnitRaceEnum{
+		tournament.Races[tournament.rng.IntN(len(tournament.Races))],
+		tournament.Races[tournament.rng.IntN(len(tournament.Races))]}
+	seed := tournament.rng.Uint64()
+	pairing := Pairing{round, [2]int{first, second}, gamemap, races, seed}
+	if err := tournament.play(pairing); err != nil {
+		return err
+	}
+	pairing.Players = [2]int{second, first}
+	pairing.Races = [2]wits.UnitRaceEnum{races[1], races[0]}
+	return tournament.play(pairing)
+}
+
+func (tournament *Tournament) play(pairing Pairing) error {
+	game, err := state.NewGame(pairing.Map, pairing.Races[:])
+	if err != nil {
+		return err
+	}
+	bots := make([]bot.Bot, 2)
+	for i, index := range pairing.Players {
+		bots[i] = tournament.Entrants[index].New(pairing.Seed + uint64(i))
+	}
+	turns := bot.PlayMatch(game, bots, tournament.Budget, tournament.MaxTurns)
+
+	played := Game{
+		Pairing: pairing,
+		Result:  game.Result(),
+		Turns:   len(turns),
+		Replay:  tournament.replay(pairing, game, turns),
+	}
+	tournament.Games = append(tournament.Games, played)
+	if len(tournament.ReplayDir) > 0 {
+		filename := filepath.Join(tournament.ReplayDir,
+			string(played.Replay.GameID_)+".json")
+		if err := played.Replay.WriteJSON(filename); err != nil {
+			return err
+		}
+	}
+	if tournament.OnGame != nil {
+		tournament.OnGame(played)
+	}
+	return nil
+}
+
+// The replay of a game, in the same format as the converted OSN replays.
+func (tournament *Tournament) replay(pairing Pairing, game *state.GameState,
+	turns []witsjson.PlayerTurnJSON) witsjson.GameReplayJSON {
+	players := make([]witsjson.PlayerRoleJSON, 2)
+	for i, index := range pairing.Players {
+		team := wits.FriendlyEnum(i + 1)
+		players[i] = witsjson.PlayerRoleJSON{
+			Name_:   tournament.Entrants[index].Name,
+			Race_:   witsjson.UnitRaceJSON(pairing.Races[i]),
+			Team_:   witsjson.FriendlyEnumJSON(team),
+			Result_: witsjson.TerminalStatusJSON(game.ResultFor(team)),
+			BaseHP_: witsjson.BaseHealth(game.BaseHP(team)),
+			Wits_:   int(game.Player(team).Wits),
+		}
+	}
+	gameID := fmt.Sprintf("%03d-%s-%s-vs-%s", len(tournament.Games)+1,
+		witsjson.ShortName(pairing.Map.MapID()),
+		tournament.Entrants[pairing.Players[0]].Name,
+		tournament.Entrants[pairing.Players[1]].Name)
+	return witsjson.GameReplayJSON{
+		GameID_:  witsjson.OsnGameID(gameID),
+		MapID_:   pairing.Map.MapID(),
+		GameMap_: pairing.Map.MapName(),
+		Turns_:   turns,
+		Result_:  witsjson.TerminalStatusJSON(game.Result()),
+		Players_: players,
+	}
+}
+
+// The total points of each entrant.  A bye counts as winning both games of
+// a pairing.
+func (tournament *Tournament) Scores() []float64 {
+	scores := make([]float64, len(tournament.Entrants))
+	for i, byes := range tournament.byes {
+		scores[i] = 2 * float64(byes)
+	}
+	for _, game := range tournament.Games {
+		scores[game.Players[0]] += game.Score()
+		scores[game.Players[1]] += 1 - game.Score()
+	}
+	return scores
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/tournament/tournament_test.go
+
+package tournament_test
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/bot"
+	"github.com/kevindamm/wits-go/ingest"
+	"github.com/kevindamm/wits-go/internal/testutil"
+	"github.com/kevindamm/wits-go/state"
+	"github.com/kevindamm/wits-go/tournament"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func loadMaps(t *testing.T) (witsjson.MapLibrary, []*state.GameMap) {
+	library := testutil.LoadMaps(t)
+	maps := make([]*state.GameMap, 0)
+	for _, name := range []string{"peekaboo", "glitch"} {
+		gamemap := state.NewGameMap(library[name])
+		maps = append(maps, &gamemap)
+	}
+	return library, maps
+}
+
+func entrants(names ...string) []tournament.Entrant {
+	entrants := make([]tournament.Entrant, len(names))
+	for i, name := range names {
+		entrants[i] = tournament.Entrant{
+			Name: name,
+			New: func(seed uint64) bot.Bot {
+				// Entrants can be distinguished by a suffix, as in random-2.
+				botName, _, _ := strings.Cut(name, "-")
+				if botName == "greedy" {
+					return testutil.NewGreedyBot(seed)
+				}
+				created, _ := bot.ByName(botName, seed)
+				return created
+			},
+		}
+	}
+	return entrants
+}
+
+func TestTournament_RoundRobin(t *testing.T) {
+	library, maps := loadMaps(t)
+	event, err := tournament.New(tournament.Config{
+		Format:   tournament.ROUND_ROBIN,
+		Maps:     maps,
+		Seed:     1,
+		Budget:   time.Minute,
+		MaxTurns: 100,
+	}, entrants("random", "greedy"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := event.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if len(event.Games) != 4 {
+		t.Fatalf("expected 4 games, played %d", len(event.Games))
+	}
+
+	// Sides are swapped for the second game of each pairing.
+	for i := 0; i < len(event.Games); i += 2 {
+		first, second := event.Games[i], event.Games[i+1]
+		if first.Players[0] != second.Players[1] || first.Races[0] != second.Races[1] {
+			t.Errorf("games %d and %d did not swap sides", i, i+1)
+		}
+	}
+
+	// Every replay can be validated by the ingestion pipeline.
+	for _, game := range event.Games {
+		_, _, err := ingest.Prepare(library, game.Replay, state.LatestRules())
+		if err != nil {
+			t.Errorf("replay %s: %v", game.Replay.GameID_, err)
+		}
+		if len(game.Replay.Players_) != 2 ||
+			game.Replay.Players_[0].Result() != game.Result {
+			t.Errorf("replay %s has the wrong players", game.Replay.GameID_)
+		}
+	}
+
+	table := event.CrossTable()
+	if table.Games[0][1] != 4 || table.Points[0][1]+table.Points[1][0] != 4 {
+		t.Errorf("cross-table does not total the games: %v", table)
+	}
+	var out bytes.Buffer
+	if err := table.Write(&out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "greedy") {
+		t.Errorf("cross-table is missing an entrant:\n%s", out.String())
+	}
+}
+
+func TestTournament_Swiss(t *testing.T) {
+	_, maps := loadMaps(t)
+	event, err := tournament.New(tournament.Config{
+		Format:   tournament.SWISS,
+		Maps:     maps,
+		Races:    []wits.UnitRaceEnum{wits.RACE_FEEDBACK},
+		Rounds:   3,
+		Seed:     2,
+		Budget:   time.Minute,
+		MaxTurns: 40,
+	}, entrants("random", "greedy", "random-2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := event.Run(); err != nil {
+		t.Fatal(err)
+	}
+	// One pairing (of two games) per round, the third entrant has a bye.
+	if len(event.Games) != 6 {
+		t.Fatalf("expected 6 games, played %d", len(event.Games))
+	}
+	pairs := make(map[[2]int]bool)
+	for i := 0; i < len(event.Games); i += 2 {
+		players := event.Games[i].Players
+		if players[0] > players[1] {
+			players[0], players[1] = players[1], players[0]
+		}
+		if pairs[players] {
+			t.Errorf("pairing %v was repeated", players)
+		}
+		pairs[players] = true
+	}
+	total := 0.0
+	for _, score := range event.Scores() {
+		total += score
+	}
+	// Each game is worth a point and each of the 3 byes is worth 2.
+	if total != 12 {
+		t.Errorf("expected 12 points in total, have %f", total)
+	}
+}
+
+func TestCrossTable_Elo(t *testing.T) {
+	table := tournament.CrossTable{
+		Names:  []string{"weak", "even", "strong"},
+		Points: [][]float64{{0, 25, 10}, {75, 0, 50}, {90, 50, 0}},
+		Games:  [][]int{{0, 100, 100}, {100, 0, 100}, {100, 100, 0}},
+	}
+	elo := table.Elo()
+	if !(elo[0].Elo < elo[1].Elo && elo[1].Elo < elo[2].Elo) {
+		t.Fatalf("estimates are out of order: %v", elo)
+	}
+	if sum := elo[0].Elo + elo[1].Elo + elo[2].Elo; math.Abs(sum) > 1e-6 {
+		t.Errorf("estimates are not centered: %v", elo)
+	}
+	// Winning 75% is about 191 Elo.
+	if gap := elo[1].Elo - elo[0].Elo; gap < 150 || gap > 300 {
+		t.Errorf("gap between weak and even is %f", gap)
+	}
+	for _, estimate := range elo {
+		if estimate.Margin <= 0 || estimate.Margin > 100 {
+			t.Errorf("unexpected margin %f", estimate.Margin)
+		}
+	}
+}
@@ -28,7 +28,43 @@ type PlayerTurnJSON struct {
 	Actions_ []wits.PlayerAction `json:"actions"`
 
 	// Temporarily here so that we can validate the simulation against the intermediate states.
-	State_ wits.GameState `json:"state"`
+	State_ wits.GameState `json:"-"`
+}
+
+// Actions are encoded with their name alongside the action's parameters, so
+// that the concrete action type can be determined when decoding.
+type playerTurnEncoding struct {
+	Turn    uint              `json:"turn"`
+	Actions []json.RawMessage `json:"actions"`
+}
+
+func (turn PlayerTurnJSON) MarshalJSON() ([]byte, error) {
+	encoding := playerTurnEncoding{turn.Turn_, make([]json.RawMessage, len(turn.Actions_))}
+	for i, action := range turn.Actions_ {
+		encoded, err := EncodeAction(action)
+		if err != nil {
+			return nil, err
+		}
+		encoding.Actions[i] = encoded
+	}
+	return json.Marshal(encoding)
+}
+
+func (turn *PlayerTurnJSON) UnmarshalJSON(encoded []byte) error {
+	var encoding playerTurnEncoding
+	if err := json.Unmarshal(encoded, &encoding); err != nil {
+		return err
+	}
+	turn.Turn_ = encoding.Turn
+	turn.Actions_ = make([]wits.PlayerAction, len(encoding.Actions))
+	for i, actionJSON := range encoding.Actions {
+		action, err := DecodeAction(actionJSON)
+		if err != nil {
+			return fmt.Errorf("turn %d action %d: %w", encoding.Turn, i, err)
+		}
+		turn.Actions_[i] = action
+	}
+	return nil
 }
 
 func (turn PlayerTurnJSON) TurnCount() uint {
@@ -61,7 +97,7 @@ func (turn PlayerTurnJSON) State() wits.GameState {
 }
 
 type playerActionJSON struct {
-	Name_ ActionNameJSON `json:"name"`
+	Name_ ActionNameJSON `json:"name,omitempty"`
 }
 
 type ActionNameJSON string
@@ -110,6 +146,30 @@ func ParseGenericAction(typename string, encoded []byte) (wits.PlayerAction, err
 	return nil, wits.UnknownActionError{Name: typename}
 }
 
+// Decodes an action from its {"name": ..., "action": {...}} representation.
+func DecodeAction(encoded []byte) (wits.PlayerAction, error) {
+	var named struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(encoded, &named); err != nil {
+		return nil, err
+	}
+	return ParseGenericAction(named.Name, encoded)
+}
+
+// Encodes the action along with its name, the inverse of DecodeAction.
+func EncodeAction(action wits.PlayerAction) ([]byte, error) {
+	if _, isPass := action.(wits.PassAction); isPass {
+		return json.Marshal(struct {
+			Name string `json:"name"`
+		}{action.ActionName()})
+	}
+	return json.Marshal(struct {
+		Name   string            `json:"name"`
+		Action wits.PlayerAction `json:"action"`
+	}{action.ActionName(), action})
+}
+
 // Generic JSON-decoding routine for all PlayerAction implementing types.
 func coerce[T wits.PlayerAction](encoded []byte) (T, error) {
 	var output struct {
@@ -128,8 +188,8 @@ func coerce[T wits.PlayerAction](encoded []byte) (T, error) {
 // Moves a unit from a HexCoord position to a (different) HexCoord position.
 type MoveUnitAction struct {
 	playerActionJSON
-	From wits.HexCoord `json:"from"`
-	To   wits.HexCoord `json:"to"`
+	From HexCoordJSON `json:"from"`
+	To   HexCoordJSON `json:"to"`
 }
 
 func (action MoveUnitAction) ActionName() string { return string(MOVE_UNIT) }
@@ -148,8 +208,8 @@ func (action MoveUnitAction) Visit(state *wits.GameState) error {
 // Heals a friendly unit to their initial HP + 1.
 type HealUnitAction struct {
 	playerActionJSON
-	Healer wits.HexCoord `json:"healer"`
-	Target wits.HexCoord `json:"target"`
+	Healer HexCoordJSON `json:"healer"`
+	Target HexCoordJSON `json:"target"`
 }
 
 func (action HealUnitAction) ActionName() string { return string(HEAL_UNIT) }
@@ -168,15 +228,15 @@ func (action HealUnitAction) Visit(state *wits.GameState) error {
 // Units may be spawned only from specific locations on the map.
 type SpawnUnitAction struct {
 	playerActionJSON
-	Spawn wits.HexCoord `json:"spawn"`
+	Spawn HexCoordJSON  `json:"spawn"`
 	Class UnitClassJSON `json:"class"`
 }
 
 func (action SpawnUnitAction) ActionName() string { return string(SPAWN_UNIT) }
 
 func (action SpawnUnitAction) RelVarEncoding() string {
-	return fmt.Sprintf(`["spawn", ["ij", %d, %d], %s]`,
-		action.Spawn.I(), action.Spawn.J(), string(action.Class))
+	return fmt.Sprintf(`["spawn", ["ij", %d, %d], "%s"]`,
+		action.Spawn.I(), action.Spawn.J(), wits.UnitClassEnum(action.Class))
 }
 
 // Parentage is determined by this action but the parent/spawned-from state is
@@ -193,8 +253,8 @@ func (action SpawnUnitAction) Visit(state *wits.GameState) error {
 // state.  The action itself only needs to mention the attacker's location and
 // the location of the unit's target (only units may attack).
 type AttackAction struct {
-	Agent  wits.HexCoord `json:"agent"`
-	Target wits.HexCoord `json:"target"`
+	Agent  HexCoordJSON `json:"agent"`
+	Target HexCoordJSON `json:"target"`
 }
 
 func (action AttackAction) ActionName() string { return string(ATTACK) }
@@ -212,8 +272,8 @@ func (action AttackAction) Visit(state *wits.GameState) error {
 // This is a special action for the Scrambler unit class.  It converts the unit
 // of an opposing team onto the player's team.
 type CharmUnitAction struct {
-	Agent  wits.HexCoord `json:"agent"`
-	Target wits.HexCoord `json:"target"`
+	Agent  HexCoordJSON `json:"agent"`
+	Target HexCoordJSON `json:"target"`
 }
 
 func (action CharmUnitAction) ActionName() string { return string(CHARM_UNIT) }
@@ -229,13 +289,13 @@ func (action CharmUnitAction) Visit(state *wits.GameState) error {
 }
 
 type ToggleAltAction struct {
-	wits.HexCoord `json:"position"`
+	Position HexCoordJSON `json:"position"`
 }
 
 func (action ToggleAltAction) ActionName() string { return string(TOGGLE_ALT) }
 
 func (action ToggleAltAction) RelVarEncoding() string {
-	return fmt.Sprintf(`["toggle", ["ij", %d, %d]]`, action.I(), action.J())
+	return fmt.Sprintf(`["toggle", ["ij", %d, %d]]`, action.Position.I(), action.Position.J())
 }
 
 func (action ToggleAltAction) Visit(state *wits.GameState) error {
@@ -244,16 +304,16 @@ func (action ToggleAltAction) Visit(state *wits.GameState) error {
 }
 
 type TeleportUnitAction struct {
-	wits.HexCoord `json:"mobi"`
-	From          wits.HexCoord `json:"from"`
-	To            wits.HexCoord `json:"to"`
+	Mobi HexCoordJSON `json:"mobi"`
+	From HexCoordJSON `json:"from"`
+	To   HexCoordJSON `json:"to"`
 }
 
 func (action TeleportUnitAction) ActionName() string { return string(TELEPORT_UNIT) }
 
 func (action TeleportUnitAction) RelVarEncoding() string {
 	return fmt.Sprintf(`["port", ["ij", %d, %d], ["ij", %d, %d], ["ij", %d, %d]]`,
-		action.I(), action.J(),
+		action.Mobi.I(), action.Mobi.J(),
 		action.From.I(), action.From.J(),
 		action.To.I(), action.To.J())
 }
@@ -0,0 +1,239 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/witsjson/convert.go
+
+package witsjson
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kevindamm/wits-go"
+)
+
+// The position of a tile in the legacy maps and replays, by column and row.
+// Odd columns are shifted down by half a tile.  Satisfies RectilinearCoord.
+type LegacyCoord struct {
+	column, row uint
+}
+
+func NewLegacyCoord(column, row uint) LegacyCoord {
+	return LegacyCoord{column, row}
+}
+
+func (coord LegacyCoord) Column() uint { return coord.column }
+func (coord LegacyCoord) Row() uint    { return coord.row }
+
+// The axial coordinate of the same tile.
+func (coord LegacyCoord) ToHexCoord() wits.HexCoord {
+	return LegacyToAxial(NewHexCoord(int(coord.column), int(coord.row)))
+}
+
+// Converts the (i, j) coordinate of a legacy map into the axial coordinate of
+// the same tile.
+func LegacyToAxial(coord wits.HexCoord) HexCoordJSON {
+	i, j := coord.I(), coord.J()
+	return NewHexCoord(i, j-(i-(i&1))/2)
+}
+
+// Converts the axial coordinate into the (i, j) coordinate of a legacy map,
+// the inverse of LegacyToAxial.
+func AxialToLegacy(coord wits.HexCoord) HexCoordJSON {
+	q, r := coord.I(), coord.J()
+	return NewHexCoord(q, r+(q-(q&1))/2)
+}
+
+// Legacy coordinates are a column and row, neither of which can be negative.
+var ErrNegativeLegacy = errors.New("coordinate is left of or above the legacy origin")
+
+// Rewrites the map in axial coordinates, with the same tiles, units and
+// symmetry.  Maps that are already axial are copied as they are.
+func (defn MapDefinition) ToAxial() MapDefinition {
+	if defn.Legacy == nil || !*defn.Legacy {
+		return defn.convert(identity)
+	}
+	converted := defn.convert(LegacyToAxial)
+	converted.Legacy = nil
+	return converted
+}
+
+// Rewrites the map in legacy coordinates, the inverse of ToAxial.  Maps with
+// tiles (or units) left of or above the legacy origin cannot be rewritten.
+func (defn MapDefinition) ToLegacy() (MapDefinition, error) {
+	if defn.Legacy != nil && *defn.Legacy {
+		return defn.convert(identity), nil
+	}
+	var converter legacyConverter
+	converted := defn.convert(converter.convert)
+	if converter.invalid != nil {
+		return MapDefinition{}, converter.invalid
+	}
+	legacy := true
+	converted.Legacy = &legacy
+	return converted, nil
+}
+
+func identity(coord wits.HexCoord) HexCoordJSON {
+	return NewHexCoord(coord.I(), coord.J())
+}
+
+// Converts axial coordinates to legacy, keeping the first coordinate that is
+// outside of the legacy map.
+type legacyConverter struct {
+	invalid error
+}
+
+func (converter *legacyConverter) convert(coord wits.HexCoord) HexCoordJSON {
+	legacy := AxialToLegacy(coord)
+	if converter.invalid == nil && (legacy.I() < 0 || legacy.J() < 0) {
+		converter.invalid = fmt.Errorf("%w: %v", ErrNegativeLegacy, identity(coord))
+	}
+	return legacy
+}
+
+// A copy of the map with every coordinate converted.  Mirror axes are defined
+// on axial coordinates (see state.MapSymmetry), they are the same in either
+// form.
+func (defn MapDefinition) convert(convert func(wits.HexCoord) HexCoordJSON) MapDefinition {
+	tiles := func(defs []wits.TileDefinition) []wits.TileDefinition {
+		converted := make([]wits.TileDefinition, len(defs))
+		for k, def := range defs {
+			coord := convert(def.Position())
+			switch {
+			case def.IsSpawn():
+				converted[k] = NewSpawn(coord.I(), coord.J(), def.Team())
+			case def.IsBase():
+				converted[k] = NewBase(coord.I(), coord.J(), def.Team())
+			default:
+				converted[k] = NewTile(def.Typename(), coord.I(), coord.J())
+			}
+		}
+		return converted
+	}
+	terrain := defn.Terrain
+	defn.Terrain = TerrainDefinition{
+		Floor_: tiles(terrain.Floor()),
+		Wall_:  tiles(terrain.Wall()),
+		Bonus_: tiles(terrain.Bonus()),
+		Spawn_: tiles(terrain.Spawn()),
+		Base_:  tiles(terrain.Base()),
+	}
+
+	units := make([]UnitInitJSON, len(defn.Init.Units))
+	for k, unit := range defn.Init.Units {
+		units[k] = unit
+		units[k].Coord = convert(unit.Coord)
+	}
+	defn.Init.Units = units
+
+	if defn.Rotate != nil {
+		rotate := *defn.Rotate
+		rotate.Position = convert(rotate.Position)
+		defn.Rotate = &rotate
+	}
+	if defn.Mirror != nil {
+		mirror := *defn.Mirror
+		defn.Mirror = &mirror
+	}
+	return defn
+}
+
+// Rewrites a replay on a legacy map (in the same coordinates as its map) in
+// axial coordinates.
+func (replay GameReplayJSON) ToAxial() (GameReplayJSON, error) {
+	return replay.convert(LegacyToAxial)
+}
+
+// Rewrites a replay on an axial map in the legacy coordinates, the inverse of
+// ToAxial.
+func (replay GameReplayJSON) ToLegacy() (GameReplayJSON, error) {
+	var converter legacyConverter
+	converted, err := replay.convert(converter.convert)
+	if err == nil {
+		err = converter.invalid
+	}
+	if err != nil {
+		return GameReplayJSON{}, err
+	}
+	return converted, nil
+}
+
+func (replay GameReplayJSON) convert(convert func(wits.HexCoord) HexCoordJSON) (GameReplayJSON, error) {
+	coords := func(list []HexCoordJSON) []HexCoordJSON {
+		if list == nil {
+			return nil
+		}
+		converted := make([]HexCoordJSON, len(list))
+		for k, coord := range list {
+			converted[k] = convert(coord)
+		}
+		return converted
+	}
+	init := replay.Init_
+	if init.Units_ != nil {
+		init.Units_ = make([]UnitInitJSON, len(replay.Init_.Units_))
+		for k, unit := range replay.Init_.Units_ {
+			init.Units_[k] = unit
+			init.Units_[k].Coord = convert(unit.Coord)
+		}
+	}
+	init.UsedSpawns_ = coords(init.UsedSpawns_)
+	init.BonusWits_ = coords(init.BonusWits_)
+	replay.Init_ = init
+
+	turns := make([]PlayerTurnJSON, len(replay.Turns_))
+	for t, turn := range replay.Turns_ {
+		turns[t] = PlayerTurnJSON{Turn_: turn.Turn_, Actions_: make([]wits.PlayerAction, len(turn.Actions_))}
+		for k, action := range turn.Actions_ {
+			converted, err := convertAction(action, convert)
+			if err != nil {
+				return GameReplayJSON{}, fmt.Errorf("turn %d action %d: %w", turn.Turn_, k, err)
+			}
+			turns[t].Actions_[k] = converted
+		}
+	}
+	replay.Turns_ = turns
+	return replay, nil
+}
+
+func convertAction(action wits.PlayerAction, convert func(wits.HexCoord) HexCoordJSON) (wits.PlayerAction, error) {
+	switch action := action.(type) {
+	case wits.PassAction:
+		return action, nil
+	case MoveUnitAction:
+		action.From, action.To = convert(action.From), convert(action.To)
+		return action, nil
+	case HealUnitAction:
+		action.Healer, action.Target = convert(action.Healer), convert(action.Target)
+		return action, nil
+	case SpawnUnitAction:
+		action.Spawn = convert(action.Spawn)
+		return action, nil
+	case AttackAction:
+		action.Agent, action.Target = convert(action.Agent), convert(action.Target)
+		return action, nil
+	case CharmUnitAction:
+		action.Agent, action.Target = convert(action.Agent), convert(action.Target)
+		return action, nil
+	case ToggleAltAction:
+		action.Position = convert(action.Position)
+		return action, nil
+	case TeleportUnitAction:
+		action.Mobi = convert(action.Mobi)
+		action.From, action.To = convert(action.From), convert(action.To)
+		return action, nil
+	}
+	return nil, wits.UnknownActionError{Name: action.ActionName()}
+}
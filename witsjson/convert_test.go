@@ -0,0 +1,169 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/witsjson/convert_test.go
+
+package witsjson_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func TestLegacyToAxial(t *testing.T) {
+	// Neighbors of an even column and of an odd column.
+	neighbors := map[[2]int][][2]int{
+		{2, 3}: {{2, 2}, {2, 4}, {1, 2}, {1, 3}, {3, 2}, {3, 3}},
+		{3, 3}: {{3, 2}, {3, 4}, {2, 3}, {2, 4}, {4, 3}, {4, 4}},
+	}
+	distance := func(a, b witsjson.HexCoordJSON) int {
+		dq, dr := a.I()-b.I(), a.J()-b.J()
+		return (abs(dq) + abs(dr) + abs(dq+dr)) / 2
+	}
+	for center, adjacent := range neighbors {
+		axial := witsjson.LegacyToAxial(witsjson.NewHexCoord(center[0], center[1]))
+		for _, neighbor := range adjacent {
+			other := witsjson.LegacyToAxial(witsjson.NewHexCoord(neighbor[0], neighbor[1]))
+			if distance(axial, other) != 1 {
+				t.Errorf("legacy %v and %v are adjacent, axial %v and %v are not",
+					center, neighbor, axial, other)
+			}
+		}
+	}
+
+	for i := 0; i < 13; i++ {
+		for j := 0; j < 13; j++ {
+			legacy := witsjson.NewHexCoord(i, j)
+			axial := witsjson.LegacyToAxial(legacy)
+			if converted := witsjson.NewLegacyCoord(uint(i), uint(j)).ToHexCoord(); converted != axial {
+				t.Errorf("LegacyCoord(%d, %d) = %v, expected %v", i, j, converted, axial)
+			}
+			if back := witsjson.AxialToLegacy(axial); back != legacy {
+				t.Errorf("round trip of %v gave %v", legacy, back)
+			}
+		}
+	}
+}
+
+func TestMapDefinition_ToAxial(t *testing.T) {
+	gamemap, err := witsjson.ReadMapFile("../maps/solo/peekaboo.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy := gamemap.Definition()
+	axial := legacy.ToAxial()
+	if axial.Legacy != nil {
+		t.Error("converted map is still marked as legacy")
+	}
+	if len(axial.Terrain.Floor()) != len(legacy.Terrain.Floor()) ||
+		len(axial.Terrain.Spawn()) != len(legacy.Terrain.Spawn()) ||
+		len(axial.Terrain.Base()) != len(legacy.Terrain.Base()) ||
+		len(axial.Init.Units) != len(legacy.Init.Units) {
+		t.Errorf("converted map has different tiles or units")
+	}
+	for k, unit := range axial.Init.Units {
+		want := witsjson.LegacyToAxial(legacy.Init.Units[k].Coord)
+		if unit.Coord != want || unit.Class_ != legacy.Init.Units[k].Class_ {
+			t.Errorf("unit %d converted to %v, expected %v", k, unit, want)
+		}
+	}
+	if again := axial.ToAxial(); !bytes.Equal(again.Format(), axial.Format()) {
+		t.Error("converting an axial map to axial changed it")
+	}
+
+	back, err := axial.ToLegacy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.Legacy == nil || !*back.Legacy {
+		t.Error("converted map is not marked as legacy")
+	}
+	if !bytes.Equal(back.Format(), legacy.Format()) {
+		t.Errorf("round trip differs:\n%s\n%s", legacy.Format(), back.Format())
+	}
+}
+
+func TestMapDefinition_ToLegacy_Negative(t *testing.T) {
+	var defn witsjson.MapDefinition
+	defn.Terrain.Floor_ = []wits.TileDefinition{
+		witsjson.NewTile("FLOOR", 2, 0),
+		witsjson.NewTile("FLOOR", 4, -3),
+	}
+	if _, err := defn.ToLegacy(); !errors.Is(err, witsjson.ErrNegativeLegacy) {
+		t.Errorf("ToLegacy() error = %v, expected %v", err, witsjson.ErrNegativeLegacy)
+	}
+}
+
+func TestGameReplay_ToAxial(t *testing.T) {
+	c := func(i, j int) witsjson.HexCoordJSON { return witsjson.NewHexCoord(i, j) }
+	replay := witsjson.GameReplayJSON{
+		MapID_: "oml/solo/peekaboo",
+		Init_: witsjson.GameInitJSON{
+			Units_: []witsjson.UnitInitJSON{
+				{Team_: witsjson.FriendlyEnumJSON(wits.FR_SELF),
+					Class_: witsjson.UnitClassJSON(wits.CLASS_SOLDIER), Coord: c(3, 7)}},
+			UsedSpawns_: []witsjson.HexCoordJSON{c(5, 9)},
+			BonusWits_:  []witsjson.HexCoordJSON{c(6, 4)},
+		},
+		Turns_: []witsjson.PlayerTurnJSON{
+			{Turn_: 1, Actions_: []wits.PlayerAction{
+				witsjson.MoveUnitAction{From: c(3, 7), To: c(4, 6)},
+				witsjson.HealUnitAction{Healer: c(1, 2), Target: c(2, 2)},
+				witsjson.SpawnUnitAction{Spawn: c(5, 9), Class: witsjson.UnitClassJSON(wits.CLASS_SNIPER)},
+				witsjson.AttackAction{Agent: c(4, 6), Target: c(5, 5)},
+				wits.PassAction{},
+			}},
+			{Turn_: 2, Actions_: []wits.PlayerAction{
+				witsjson.CharmUnitAction{Agent: c(7, 3), Target: c(8, 3)},
+				witsjson.ToggleAltAction{Position: c(9, 1)},
+				witsjson.TeleportUnitAction{Mobi: c(9, 1), From: c(3, 3), To: c(10, 0)},
+			}},
+		},
+	}
+
+	axial, err := replay.ToAxial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	move := axial.Turns_[0].Actions_[0].(witsjson.MoveUnitAction)
+	if move.From != witsjson.LegacyToAxial(c(3, 7)) || move.To != witsjson.LegacyToAxial(c(4, 6)) {
+		t.Errorf("move converted to %v", move)
+	}
+	if axial.Init_.Units_[0].Coord != witsjson.LegacyToAxial(c(3, 7)) {
+		t.Errorf("unit converted to %v", axial.Init_.Units_[0])
+	}
+	if replay.Init_.Units_[0].Coord != c(3, 7) {
+		t.Error("converting modified the original replay")
+	}
+
+	back, err := axial.ToLegacy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(back, replay) {
+		t.Errorf("round trip differs:\n%v\n%v", replay, back)
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
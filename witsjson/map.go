@@ -19,6 +19,7 @@ package witsjson
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/kevindamm/wits-go"
 )
@@ -36,6 +37,32 @@ func (gamemap GameMapJSON) MapID() wits.GameMapID {
 	return wits.GameMapID(gamemap.id)
 }
 
+// Wraps an already-decoded map definition so that it satisfies MapDescription.
+func NewGameMap(defn *MapDefinition) GameMapJSON {
+	return GameMapJSON{wits.GameMapID(defn.MapID), defn}
+}
+
+// Reads and decodes the map definition found in the file at this path.
+func ReadMapFile(filename string) (GameMapJSON, error) {
+	filedata, err := os.ReadFile(filename)
+	if err != nil {
+		return GameMapJSON{}, err
+	}
+	var gamemap GameMapJSON
+	if err := json.Unmarshal(filedata, &gamemap); err != nil {
+		return GameMapJSON{}, fmt.Errorf("decoding map %s: %w", filename, err)
+	}
+	if !gamemap.IsLoaded() {
+		return GameMapJSON{}, fmt.Errorf("map file %s has no definition", filename)
+	}
+	return gamemap, nil
+}
+
+// The complete map definition, or nil if only the identifier is known.
+func (gamemap GameMapJSON) Definition() *MapDefinition {
+	return gamemap.defn
+}
+
 // Returns true if the full map definition has been loaded from disk.
 func (m GameMapJSON) IsLoaded() bool {
 	// Any valid map must have at least one traversible tile.
@@ -110,6 +137,30 @@ type MapDefinition struct {
 	Legacy  *bool             `json:"legacy,omitempty"`
 }
 
+// Some of the original map files list their units at the top level instead of
+// under "init", or mark their legacy coordinates with "layout": "legacy".
+// These are read as if written in the current layout.
+func (defn *MapDefinition) UnmarshalJSON(encoded []byte) error {
+	type mapDefinition MapDefinition
+	var data struct {
+		mapDefinition
+		Units  []UnitInitJSON `json:"units"`
+		Layout string         `json:"layout"`
+	}
+	if err := json.Unmarshal(encoded, &data); err != nil {
+		return err
+	}
+	*defn = MapDefinition(data.mapDefinition)
+	if len(defn.Init.Units) == 0 && len(data.Units) > 0 {
+		defn.Init.Units = data.Units
+	}
+	if defn.Legacy == nil && data.Layout == "legacy" {
+		legacy := true
+		defn.Legacy = &legacy
+	}
+	return nil
+}
+
 type TerrainDefinition struct {
 	Floor_ FloorList `json:"floor"`
 	Wall_  WallList  `json:"wall"`
@@ -223,6 +274,7 @@ func MarshalTerrain[T ~[]wits.TileDefinition](
 				teamspawns[index] = append(teamspawns[index], []int{pos.I(), pos.J()})
 			}
 		}
+		return json.Marshal(teamspawns)
 	case "BASE":
 		// match any type of 'base' and index by team
 		teambase := make([][]int, 2)
@@ -239,6 +291,7 @@ func MarshalTerrain[T ~[]wits.TileDefinition](
 				teambase[index] = []int{pos.I(), pos.J()}
 			}
 		}
+		return json.Marshal(teambase)
 	}
 
 	return json.Marshal(coords)
@@ -285,8 +338,8 @@ func (defs *FloorList) UnmarshalJSON(encoded []byte) error {
 	return UnmarshalTerrain(encoded, "FLOOR", defs)
 }
 
-func (defs *FloorList) MarshalJSON() ([]byte, error) {
-	return MarshalTerrain("FLOOR", defs)
+func (defs FloorList) MarshalJSON() ([]byte, error) {
+	return MarshalTerrain("FLOOR", &defs)
 }
 
 // Unpacked from a JSON of []HexCoord into a []TileDefinition of TERRAIN_TYPE_WALL.
@@ -319,8 +372,8 @@ func (defs *WallList) UnmarshalJSON(encoded []byte) error {
 	return UnmarshalTerrain(encoded, "WALL", defs)
 }
 
-func (defs *WallList) MarshalJSON() ([]byte, error) {
-	return MarshalTerrain("WALL", defs)
+func (defs WallList) MarshalJSON() ([]byte, error) {
+	return MarshalTerrain("WALL", &defs)
 }
 
 // Unpacked from a JSON of []HexCoord into a []TileDefinition of TERRAIN_TYPE_SPAWN.
@@ -377,8 +430,8 @@ func (defs *SpawnList) UnmarshalJSON(encoded []byte) error {
 	return nil
 }
 
-func (defs *SpawnList) MarshalJSON() ([]byte, error) {
-	return MarshalTerrain("SPAWN", defs)
+func (defs SpawnList) MarshalJSON() ([]byte, error) {
+	return MarshalTerrain("SPAWN", &defs)
 }
 
 // Unpacked from a JSON of []HexCoord into a []TileDefinition of TERRAIN_TYPE_BASE.
@@ -432,8 +485,8 @@ func (defs *BaseList) UnmarshalJSON(encoded []byte) error {
 	return nil
 }
 
-func (defs *BaseList) MarshalJSON() ([]byte, error) {
-	return MarshalTerrain("BASE", defs)
+func (defs BaseList) MarshalJSON() ([]byte, error) {
+	return MarshalTerrain("BASE", &defs)
 }
 
 // Unpacked from a JSON of []HexCoord into a []TileDefinition of TERRAIN_TYPE_BONUS.
@@ -466,8 +519,8 @@ func (defs *BonusList) UnmarshalJSON(encoded []byte) error {
 	return UnmarshalTerrain(encoded, "BONUS", defs)
 }
 
-func (defs *BonusList) MarshalJSON() ([]byte, error) {
-	return MarshalTerrain("BONUS", defs)
+func (defs BonusList) MarshalJSON() ([]byte, error) {
+	return MarshalTerrain("BONUS", &defs)
 }
 
 // Initialization of map-related game state that is not terrain related.
@@ -475,17 +528,26 @@ type MapInit struct {
 	Units []UnitInitJSON `json:"units"`
 }
 
+// A half-turn rotation about the center of the tile at the position, or (if
+// not center) about the midpoint of its edge with the tile below it.
 type Rotation struct {
 	Position HexCoordJSON `json:"position"`
 	Center   bool         `json:"center"`
 }
 
+// A reflection across the line through the centers of a column of tiles
+// (VERTICAL) or a row of tiles (HORIZONTAL), the row below it if not center.
 type Reflection struct {
 	Axis   int            `json:"axis"`
 	Flip   ReflectionType `json:"flip"`
-	Cetner bool           `json:"center"`
+	Center bool           `json:"center"`
 }
 
 type ReflectionType string
 
+const (
+	FLIP_VERTICAL   ReflectionType = "VERTICAL"
+	FLIP_HORIZONTAL ReflectionType = "HORIZONTAL"
+)
+
 type TileDistance int
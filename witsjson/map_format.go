@@ -0,0 +1,136 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/witsjson/map_format.go
+
+package witsjson
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+)
+
+// Encodes the map definition as JSON laid out like the map files in maps/,
+// with the floor coordinates on a line for each column, other terrain a few
+// coordinates to a line and each of the initial units on its own line.
+func (defn MapDefinition) Format() []byte {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "{\n  \"name\": %q,\n  \"map_id\": %q,\n", defn.Name, defn.MapID)
+	out.WriteString("  \"terrain\": {\n")
+	fmt.Fprintf(&out, "    \"floor\": %s,\n", formatCoords(defn.Terrain.Floor(), 0))
+	fmt.Fprintf(&out, "    \"wall\": %s,\n", formatCoords(defn.Terrain.Wall(), COORDS_PER_LINE))
+	fmt.Fprintf(&out, "    \"bonus\": %s,\n", formatCoords(defn.Terrain.Bonus(), COORDS_PER_LINE))
+
+	spawns := make([]string, 0)
+	for _, spawn := range defn.Terrain.Spawn() {
+		index := int(spawn.Team()) - 1
+		for len(spawns) <= index {
+			spawns = append(spawns, "")
+		}
+		if len(spawns[index]) > 0 {
+			spawns[index] += ", "
+		}
+		spawns[index] += formatCoord(spawn.Position())
+	}
+	bases := make([]string, 0)
+	for _, base := range defn.Terrain.Base() {
+		index := int(base.Team()) - 1
+		for len(bases) <= index {
+			bases = append(bases, "[]")
+		}
+		bases[index] = formatCoord(base.Position())
+	}
+	if len(spawns) == 0 {
+		out.WriteString("    \"spawn\": [],\n")
+	} else {
+		fmt.Fprintf(&out, "    \"spawn\": [\n      [%s]\n    ],\n", strings.Join(spawns, "],\n      ["))
+	}
+	fmt.Fprintf(&out, "    \"base\": [\n      %s\n    ]\n  },\n", strings.Join(bases, ", "))
+
+	units := make([]string, len(defn.Init.Units))
+	for i, unit := range defn.Init.Units {
+		units[i] = fmt.Sprintf("{ \"team\": %q, \"class\": %q, \"coord\": %s }",
+			unit.Team_.String(), wits.UnitClassEnum(unit.Class_).String(), formatCoord(unit.Coord))
+	}
+	if len(units) == 0 {
+		out.WriteString("  \"init\": {\n    \"units\": []\n  }")
+	} else {
+		fmt.Fprintf(&out, "  \"init\": {\n    \"units\": [\n      %s\n    ]\n  }", strings.Join(units, ",\n      "))
+	}
+
+	if defn.Rotate != nil {
+		fmt.Fprintf(&out, ",\n  \"rotate\": {\n    \"position\": %s,\n    \"center\": %t\n  }",
+			formatCoord(defn.Rotate.Position), defn.Rotate.Center)
+	}
+	if defn.Mirror != nil {
+		fmt.Fprintf(&out, ",\n  \"mirror\": {\n    \"axis\": %d,\n    \"flip\": %q,\n    \"center\": %t\n  }",
+			defn.Mirror.Axis, defn.Mirror.Flip, defn.Mirror.Center)
+	}
+	if defn.Legacy != nil {
+		fmt.Fprintf(&out, ",\n  \"legacy\": %t", *defn.Legacy)
+	}
+	out.WriteString("\n}\n")
+	return out.Bytes()
+}
+
+// The hash of the formatted definition, so that it does not change with only
+// the whitespace of the map's file.  Matches record it as their map_hash.
+func (defn MapDefinition) ContentHash() string {
+	sum := sha256.Sum256(defn.Format())
+	return hex.EncodeToString(sum[:])
+}
+
+func formatCoord(coord wits.HexCoord) string {
+	return fmt.Sprintf("[%d, %d]", coord.I(), coord.J())
+}
+
+const COORDS_PER_LINE = 6
+
+// The coordinates in order, a line for each value of their first component
+// or (if perLine is positive) that many to a line.
+func formatCoords(tiles []wits.TileDefinition, perLine int) string {
+	if len(tiles) == 0 {
+		return "[]"
+	}
+	coords := make([][2]int, len(tiles))
+	for i, tile := range tiles {
+		coords[i] = [2]int{tile.Position().I(), tile.Position().J()}
+	}
+	slices.SortFunc(coords, func(a, b [2]int) int {
+		if a[0] != b[0] {
+			return a[0] - b[0]
+		}
+		return a[1] - b[1]
+	})
+	lines := make([]string, 0)
+	line := ""
+	for i, coord := range coords {
+		if (perLine <= 0 && i > 0 && coord[0] != coords[i-1][0]) || (perLine > 0 && i > 0 && i%perLine == 0) {
+			lines = append(lines, line)
+			line = ""
+		}
+		if len(line) > 0 {
+			line += ", "
+		}
+		line += fmt.Sprintf("[%d, %d]", coord[0], coord[1])
+	}
+	lines = append(lines, line)
+	return "[\n      " + strings.Join(lines, ",\n      ") + "\n    ]"
+}
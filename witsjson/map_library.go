@@ -0,0 +1,83 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/witsjson/map_library.go
+
+package witsjson
+
+import (
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/kevindamm/wits-go"
+)
+
+// The map definitions are held in JSON files rather than in the DB.  They are
+// indexed here by their short name, the last segment of their map ID, which is
+// the same as the OsnMap's shortname.
+type MapLibrary map[string]GameMapJSON
+
+// Reads every map definition found in the directory (and its subdirectories).
+// Files that cannot be decoded as a map definition (such as maps drawn in
+// another format) are skipped, with the reason for each returned in skipped.
+func LoadMapLibrary(root string) (library MapLibrary, skipped []error, err error) {
+	library = make(MapLibrary)
+	err = filepath.WalkDir(root,
+		func(fpath string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !strings.HasSuffix(fpath, ".json") {
+				return nil
+			}
+			gamemap, err := ReadMapFile(fpath)
+			if err != nil {
+				skipped = append(skipped, err)
+				return nil
+			}
+			library.Add(gamemap)
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+	return library, skipped, nil
+}
+
+// Adds (or replaces) the map definition, indexed by its short name.
+func (library MapLibrary) Add(gamemap GameMapJSON) {
+	library[ShortName(gamemap.MapID())] = gamemap
+}
+
+// Finds the map by its ID, or by its name if the ID is not known (as in older
+// replays that only name the map).
+func (library MapLibrary) Find(mapID wits.GameMapID, name wits.GameMapName) (GameMapJSON, bool) {
+	if len(mapID) > 0 {
+		gamemap, found := library[ShortName(mapID)]
+		return gamemap, found
+	}
+	for _, gamemap := range library {
+		if gamemap.MapName() == name {
+			return gamemap, true
+		}
+	}
+	return GameMapJSON{}, false
+}
+
+// The short name is the last path segment of the map ID.
+func ShortName(mapID wits.GameMapID) string {
+	return path.Base(string(mapID))
+}
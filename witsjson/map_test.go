@@ -17,8 +17,10 @@
 package witsjson_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/kevindamm/wits-go"
@@ -89,6 +91,41 @@ func TestGameMap_Unmarshal(t *testing.T) {
 		}
 		verifyTestGameMap(gamemap, t)
 	})
+
+	t.Run("round-trip formatted definition", func(t *testing.T) {
+		var defn witsjson.MapDefinition
+		if err := json.Unmarshal([]byte(glitchEncoded), &defn); err != nil {
+			t.Fatalf("MapDefinition JSON decode error = %v", err)
+		}
+		formatted := defn.Format()
+		var decoded witsjson.MapDefinition
+		if err := json.Unmarshal(formatted, &decoded); err != nil {
+			t.Fatalf("formatted MapDefinition decode error = %v\n%s", err, formatted)
+		}
+		verifyTestGameMap(witsjson.NewGameMap(&decoded), t)
+		if decoded.Mirror == nil || *decoded.Mirror != *defn.Mirror {
+			t.Errorf("formatted mirror %v, expected %v", decoded.Mirror, defn.Mirror)
+		}
+		if !bytes.Equal(decoded.Format(), formatted) {
+			t.Errorf("formatting is not stable:\n%s\n%s", formatted, decoded.Format())
+		}
+	})
+
+	t.Run("round-trip full definition", func(t *testing.T) {
+		var defn witsjson.MapDefinition
+		if err := json.Unmarshal([]byte(glitchEncoded), &defn); err != nil {
+			t.Fatalf("MapDefinition JSON decode error = %v", err)
+		}
+		encoded, err := json.Marshal(defn)
+		if err != nil {
+			t.Fatalf("MapDefinition JSON encode error = %v", err)
+		}
+		var decoded witsjson.MapDefinition
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatalf("MapDefinition JSON re-decode error = %v\n%s", err, encoded)
+		}
+		verifyTestGameMap(witsjson.NewGameMap(&decoded), t)
+	})
 }
 
 func verifyTestGameMap(gamemap witsjson.GameMapJSON, t *testing.T) {
@@ -255,3 +292,75 @@ func verifyTestGameMap(gamemap witsjson.GameMapJSON, t *testing.T) {
 			gamemap.Units(), units)
 	}
 }
+
+func TestLoadMapLibrary(t *testing.T) {
+	library, skipped, err := witsjson.LoadMapLibrary("../maps")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every map file except tic-tac-rainbow, which lists its terrain as tuples.
+	if len(skipped) != 1 || !strings.Contains(skipped[0].Error(), "tic-tac-rainbow.json") {
+		t.Errorf("expected only tic-tac-rainbow to be skipped, skipped %v", skipped)
+	}
+	for _, shortname := range []string{"peekaboo", "thorn-gulley", "candy-core-mine", "machination"} {
+		if gamemap, found := library[shortname]; !found || !gamemap.IsLoaded() {
+			t.Errorf("expected map %s in the library", shortname)
+		}
+	}
+	if len(library) != 17 {
+		t.Errorf("expected 17 maps in the library, found %d", len(library))
+	}
+}
+
+func TestMapDefinition_UnmarshalOriginalLayout(t *testing.T) {
+	var defn witsjson.MapDefinition
+	if err := json.Unmarshal([]byte(`{
+		"name": "Original",
+		"map_id": "oml/solo/original",
+		"terrain": {"floor": [[1, 1], [1, 2]], "wall": [], "bonus": [],
+			"spawn": [[], []], "base": [[0, 0], [2, 2]]},
+		"units": [{ "team": "RED", "class": "HEAVY", "coord": [1, 1] }],
+		"layout": "legacy"
+	}`), &defn); err != nil {
+		t.Fatal(err)
+	}
+	if len(defn.Init.Units) != 1 || defn.Init.Units[0].Class() != wits.CLASS_HEAVY {
+		t.Errorf("expected the top-level units to be read as initial units, got %v", defn.Init)
+	}
+	if defn.Legacy == nil || !*defn.Legacy {
+		t.Errorf("expected the legacy layout to set legacy")
+	}
+}
+
+func TestMapDefinition_ContentHash(t *testing.T) {
+	decode := func(encoded string) witsjson.MapDefinition {
+		var defn witsjson.MapDefinition
+		if err := json.Unmarshal([]byte(encoded), &defn); err != nil {
+			t.Fatal(err)
+		}
+		return defn
+	}
+	defn := decode(`{"name": "Hashed", "map_id": "oml/solo/hashed",
+		"terrain": {"floor": [[1, 1], [1, 2]], "wall": [], "bonus": [[1, 2]],
+			"spawn": [[], []], "base": [[0, 0], [2, 2]]}}`)
+	spaced := decode(`{
+		"name": "Hashed",
+		"map_id": "oml/solo/hashed",
+		"terrain": {
+			"floor": [[1, 1],  [1, 2]],
+			"wall": [],
+			"bonus": [[1, 2]],
+			"spawn": [[], []],
+			"base": [[0, 0], [2, 2]]
+		}
+	}`)
+	if defn.ContentHash() != spaced.ContentHash() {
+		t.Error("the content hash should not depend on whitespace")
+	}
+	changed := defn
+	changed.Terrain.Bonus_ = nil
+	if len(defn.ContentHash()) != 64 || changed.ContentHash() == defn.ContentHash() {
+		t.Errorf("expected a different hash for different terrain, got %s",
+			changed.ContentHash())
+	}
+}
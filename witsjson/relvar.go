@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/witsjson/relvar.go
+
+package witsjson
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kevindamm/wits-go"
+)
+
+// Decodes an action from its RelVar encoding, the inverse of the actions'
+// RelVarEncoding().  Coordinates are encoded as ["ij", i, j].
+func ParseRelVar(encoded string) (wits.PlayerAction, error) {
+	var parts []json.RawMessage
+	if err := json.Unmarshal([]byte(encoded), &parts); err != nil {
+		return nil, fmt.Errorf("invalid RelVar %s: %w", encoded, err)
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("empty RelVar action")
+	}
+	var name string
+	if err := json.Unmarshal(parts[0], &name); err != nil {
+		return nil, fmt.Errorf("invalid RelVar action name %s", parts[0])
+	}
+
+	if name == "spawn" {
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("RelVar spawn expects 2 arguments, has %d", len(parts)-1)
+		}
+		coord, err := parseRelVarCoord(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		var class UnitClassJSON
+		if err := json.Unmarshal(parts[2], &class); err != nil {
+			return nil, err
+		}
+		return SpawnUnitAction{Spawn: coord, Class: class}, nil
+	}
+
+	arity, known := relVarArity[name]
+	if !known {
+		return nil, wits.UnknownActionError{Name: name}
+	}
+	if len(parts) != arity+1 {
+		return nil, fmt.Errorf("RelVar %s expects %d arguments, has %d", name, arity, len(parts)-1)
+	}
+	coords := make([]HexCoordJSON, arity)
+	for i, part := range parts[1:] {
+		coord, err := parseRelVarCoord(part)
+		if err != nil {
+			return nil, err
+		}
+		coords[i] = coord
+	}
+
+	switch name {
+	case "move":
+		return MoveUnitAction{From: coords[0], To: coords[1]}, nil
+	case "heal":
+		return HealUnitAction{Healer: coords[0], Target: coords[1]}, nil
+	case "pow":
+		return AttackAction{Agent: coords[0], Target: coords[1]}, nil
+	case "charm":
+		return CharmUnitAction{Agent: coords[0], Target: coords[1]}, nil
+	case "toggle":
+		return ToggleAltAction{Position: coords[0]}, nil
+	case "port":
+		return TeleportUnitAction{Mobi: coords[0], From: coords[1], To: coords[2]}, nil
+	}
+	return wits.PassAction{}, nil
+}
+
+// The number of coordinates in each RelVar action (other than spawn).
+var relVarArity = map[string]int{
+	"pass":   0,
+	"move":   2,
+	"heal":   2,
+	"pow":    2,
+	"charm":  2,
+	"toggle": 1,
+	"port":   3,
+}
+
+func parseRelVarCoord(encoded json.RawMessage) (HexCoordJSON, error) {
+	var parts []json.RawMessage
+	var tag string
+	var i, j int
+	if err := json.Unmarshal(encoded, &parts); err != nil || len(parts) != 3 ||
+		json.Unmarshal(parts[0], &tag) != nil || tag != "ij" ||
+		json.Unmarshal(parts[1], &i) != nil || json.Unmarshal(parts[2], &j) != nil {
+		return HexCoordJSON{}, fmt.Errorf("invalid RelVar coordinate %s", encoded)
+	}
+	return NewHexCoord(i, j), nil
+}
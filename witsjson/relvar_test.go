@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Kevin Damm
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// github:kevindamm/wits-go/witsjson/relvar_test.go
+
+package witsjson_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kevindamm/wits-go"
+	"github.com/kevindamm/wits-go/witsjson"
+)
+
+func TestParseRelVar(t *testing.T) {
+	a, b, c := witsjson.NewHexCoord(1, 2), witsjson.NewHexCoord(-3, 4), witsjson.NewHexCoord(0, -1)
+	actions := []wits.PlayerAction{
+		wits.PassAction{},
+		witsjson.MoveUnitAction{From: a, To: b},
+		witsjson.HealUnitAction{Healer: a, Target: b},
+		witsjson.SpawnUnitAction{Spawn: c, Class: witsjson.UnitClassJSON(wits.CLASS_SNIPER)},
+		witsjson.AttackAction{Agent: b, Target: c},
+		witsjson.CharmUnitAction{Agent: c, Target: a},
+		witsjson.ToggleAltAction{Position: b},
+		witsjson.TeleportUnitAction{Mobi: a, From: b, To: c},
+	}
+	for _, action := range actions {
+		t.Run(action.ActionName(), func(t *testing.T) {
+			parsed, err := witsjson.ParseRelVar(action.RelVarEncoding())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(parsed, action) {
+				t.Errorf("ParseRelVar(%s) = %#v", action.RelVarEncoding(), parsed)
+			}
+		})
+	}
+}
+
+func TestParseRelVar_Invalid(t *testing.T) {
+	for _, encoded := range []string{
+		``,
+		`[]`,
+		`["fly", ["ij", 1, 2]]`,
+		`["move", ["ij", 1, 2]]`,
+		`["move", ["xy", 1, 2], ["ij", 1, 3]]`,
+		`["toggle", ["ij", 1]]`,
+		`["spawn", ["ij", 1, 2], "DRAGON"]`,
+	} {
+		if action, err := witsjson.ParseRelVar(encoded); err == nil {
+			t.Errorf("ParseRelVar(%s) = %v, expected an error", encoded, action)
+		}
+	}
+}
@@ -19,6 +19,7 @@ package witsjson
 import (
 	"encoding/json"
 	"os"
+	"time"
 
 	"github.com/kevindamm/wits-go"
 )
@@ -33,12 +34,36 @@ func (replay GameReplayJSON) WriteJSON(filename string) error {
 }
 
 type GameReplayJSON struct {
-	GameID_  OsnGameID        `json:"game_id"`
-	GameMap_ wits.GameMapName `json:"map_name"`
-	Init_    GameInitJSON     `json:"init,omitempty"`
-	Turns_   []PlayerTurnJSON `json:"replay"`
+	GameID_  OsnGameID          `json:"game_id"`
+	MapID_   wits.GameMapID     `json:"map_id,omitempty"`
+	GameMap_ wits.GameMapName   `json:"map_name"`
+	Theme_   string             `json:"theme,omitempty"`
+	Init_    GameInitJSON       `json:"init,omitempty"`
+	Turns_   []PlayerTurnJSON   `json:"replay"`
+	Result_  TerminalStatusJSON `json:"result,omitempty"`
 
 	Players_ []PlayerRoleJSON `json:"players"`
+
+	// When the match was played, if known.  The OSN replays do not include it
+	// but it may be added when they are fetched.
+	Played_ *time.Time `json:"played_ts,omitempty"`
+}
+
+func (replay GameReplayJSON) MapID() wits.GameMapID    { return replay.MapID_ }
+func (replay GameReplayJSON) MapTheme() string         { return replay.Theme_ }
+func (replay GameReplayJSON) InitState() wits.GameInit { return replay.Init_ }
+
+// The result of the match, relative to the first player.
+func (replay GameReplayJSON) MatchResult() wits.TerminalStatus {
+	return wits.TerminalStatus(replay.Result_)
+}
+
+func (replay GameReplayJSON) Players() []wits.PlayerRole {
+	players := make([]wits.PlayerRole, len(replay.Players_))
+	for i, player := range replay.Players_ {
+		players[i] = player
+	}
+	return players
 }
 
 func (replay GameReplayJSON) GameID() wits.MatchID {
@@ -61,14 +86,18 @@ func (replay GameReplayJSON) MatchReplay() []wits.PlayerTurn {
 
 type GameInitJSON struct {
 	// Defaults for all these values are defined in the map (see GameMap)
-	Units_      []wits.UnitInit `json:"units,omitempty"`
-	UsedSpawns_ []wits.HexCoord `json:"used_spawns,omitempty"`
-	BonusWits_  []wits.HexCoord `json:"bonus_wits,omitempty"`
-	BaseHP_     []BaseHealth    `json:"base_hp,omitempty"` // all bases default 5hp
+	Units_      []UnitInitJSON `json:"units,omitempty"`
+	UsedSpawns_ []HexCoordJSON `json:"used_spawns,omitempty"`
+	BonusWits_  []HexCoordJSON `json:"bonus_wits,omitempty"`
+	BaseHP_     []BaseHealth   `json:"base_hp,omitempty"` // all bases default 5hp
 }
 
 func (init GameInitJSON) Units() []wits.UnitInit {
-	return init.Units_
+	units := make([]wits.UnitInit, len(init.Units_))
+	for i, unit := range init.Units_ {
+		units[i] = unit
+	}
+	return units
 }
 
 func (init GameInitJSON) UsedSpawns() []wits.HexCoordIndex {
@@ -150,7 +150,9 @@ func ParseClass(name string) wits.UnitClassEnum {
 	return map[string]wits.UnitClassEnum{
 		"UNKNOWN": wits.CLASS_UNKNOWN,
 		"RUNNER":  wits.CLASS_RUNNER,
+		"SCOUT":   wits.CLASS_RUNNER, // OML name for the runner.
 		"SOLDIER": wits.CLASS_SOLDIER,
+		"SOLIDER": wits.CLASS_SOLDIER, // As misspelled in some original maps.
 		"MEDIC":   wits.CLASS_MEDIC,
 		"SNIPER":  wits.CLASS_SNIPER,
 		"HEAVY":   wits.CLASS_HEAVY,
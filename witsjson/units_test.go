@@ -37,6 +37,10 @@ func TestUnitInit_JSON(t *testing.T) {
 			true, witsjson.UnitInitJSON{witsjson.NewHexCoord(1, 2),
 				witsjson.FriendlyEnumJSON(wits.FR_SELF),
 				witsjson.UnitClassJSON(wits.CLASS_HEAVY)}},
+		{"misspelled soldier", `{"class": "SOLIDER", "coord": [9, 1], "team": "BLUE"}`,
+			true, witsjson.UnitInitJSON{witsjson.NewHexCoord(9, 1),
+				witsjson.FriendlyEnumJSON(wits.FR_ENEMY),
+				witsjson.UnitClassJSON(wits.CLASS_SOLDIER)}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {